@@ -184,12 +184,119 @@ func (ClusterType) EnumDescriptor() ([]byte, []int) {
 	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{1}
 }
 
+type DeletionPolicy int32
+
+const (
+	// DELETION_POLICY_UNSPECIFIED keeps the current deletion policy, which defaults to ORPHAN.
+	DeletionPolicy_DELETION_POLICY_UNSPECIFIED DeletionPolicy = 0
+	// ORPHAN keeps the kantaloupeflows, the kantaloupe CRDs and the gateway listeners
+	// installed in the cluster, only the ServiceMonitors are removed.
+	DeletionPolicy_ORPHAN DeletionPolicy = 1
+	// CLEANUP removes the kantaloupeflows, the kantaloupe CRDs, the gateway listeners
+	// and the ServiceMonitors installed in the cluster.
+	DeletionPolicy_CLEANUP DeletionPolicy = 2
+)
+
+// Enum value maps for DeletionPolicy.
+var (
+	DeletionPolicy_name = map[int32]string{
+		0: "DELETION_POLICY_UNSPECIFIED",
+		1: "ORPHAN",
+		2: "CLEANUP",
+	}
+	DeletionPolicy_value = map[string]int32{
+		"DELETION_POLICY_UNSPECIFIED": 0,
+		"ORPHAN":                      1,
+		"CLEANUP":                     2,
+	}
+)
+
+func (x DeletionPolicy) Enum() *DeletionPolicy {
+	p := new(DeletionPolicy)
+	*p = x
+	return p
+}
+
+func (x DeletionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[2].Descriptor()
+}
+
+func (DeletionPolicy) Type() protoreflect.EnumType {
+	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[2]
+}
+
+func (x DeletionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletionPolicy.Descriptor instead.
+func (DeletionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{2}
+}
+
+type DrainStrategy int32
+
+const (
+	// DRAIN_STRATEGY_UNSPECIFIED keeps the kantaloupeflows running in the cluster.
+	DrainStrategy_DRAIN_STRATEGY_UNSPECIFIED DrainStrategy = 0
+	// HIBERNATE scales the kantaloupeflows to zero replicas until the maintenance is over.
+	DrainStrategy_HIBERNATE DrainStrategy = 1
+	// MIGRATE recreates the kantaloupeflows in the target cluster.
+	DrainStrategy_MIGRATE DrainStrategy = 2
+)
+
+// Enum value maps for DrainStrategy.
+var (
+	DrainStrategy_name = map[int32]string{
+		0: "DRAIN_STRATEGY_UNSPECIFIED",
+		1: "HIBERNATE",
+		2: "MIGRATE",
+	}
+	DrainStrategy_value = map[string]int32{
+		"DRAIN_STRATEGY_UNSPECIFIED": 0,
+		"HIBERNATE":                  1,
+		"MIGRATE":                    2,
+	}
+)
+
+func (x DrainStrategy) Enum() *DrainStrategy {
+	p := new(DrainStrategy)
+	*p = x
+	return p
+}
+
+func (x DrainStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DrainStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[3].Descriptor()
+}
+
+func (DrainStrategy) Type() protoreflect.EnumType {
+	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[3]
+}
+
+func (x DrainStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DrainStrategy.Descriptor instead.
+func (DrainStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{3}
+}
+
 type ClusterState int32
 
 const (
-	ClusterState_UNSPECIFED ClusterState = 0
-	ClusterState_RUNNING    ClusterState = 1
-	ClusterState_UNHEALTH   ClusterState = 2
+	ClusterState_UNSPECIFED  ClusterState = 0
+	ClusterState_RUNNING     ClusterState = 1
+	ClusterState_UNHEALTH    ClusterState = 2
+	ClusterState_MAINTENANCE ClusterState = 3
 )
 
 // Enum value maps for ClusterState.
@@ -198,11 +305,13 @@ var (
 		0: "UNSPECIFED",
 		1: "RUNNING",
 		2: "UNHEALTH",
+		3: "MAINTENANCE",
 	}
 	ClusterState_value = map[string]int32{
-		"UNSPECIFED": 0,
-		"RUNNING":    1,
-		"UNHEALTH":   2,
+		"UNSPECIFED":  0,
+		"RUNNING":     1,
+		"UNHEALTH":    2,
+		"MAINTENANCE": 3,
 	}
 )
 
@@ -217,11 +326,11 @@ func (x ClusterState) String() string {
 }
 
 func (ClusterState) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[2].Descriptor()
+	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[4].Descriptor()
 }
 
 func (ClusterState) Type() protoreflect.EnumType {
-	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[2]
+	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[4]
 }
 
 func (x ClusterState) Number() protoreflect.EnumNumber {
@@ -230,7 +339,7 @@ func (x ClusterState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClusterState.Descriptor instead.
 func (ClusterState) EnumDescriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{2}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{4}
 }
 
 type RankOption int32
@@ -269,11 +378,11 @@ func (x RankOption) String() string {
 }
 
 func (RankOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[3].Descriptor()
+	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[5].Descriptor()
 }
 
 func (RankOption) Type() protoreflect.EnumType {
-	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[3]
+	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[5]
 }
 
 func (x RankOption) Number() protoreflect.EnumNumber {
@@ -282,7 +391,7 @@ func (x RankOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RankOption.Descriptor instead.
 func (RankOption) EnumDescriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{3}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{5}
 }
 
 type KantaloupePluginName int32
@@ -315,11 +424,11 @@ func (x KantaloupePluginName) String() string {
 }
 
 func (KantaloupePluginName) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[4].Descriptor()
+	return file_api_clusters_v1alpha1_cluster_proto_enumTypes[6].Descriptor()
 }
 
 func (KantaloupePluginName) Type() protoreflect.EnumType {
-	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[4]
+	return &file_api_clusters_v1alpha1_cluster_proto_enumTypes[6]
 }
 
 func (x KantaloupePluginName) Number() protoreflect.EnumNumber {
@@ -328,7 +437,7 @@ func (x KantaloupePluginName) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KantaloupePluginName.Descriptor instead.
 func (KantaloupePluginName) EnumDescriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{4}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{6}
 }
 
 type Cluster struct {
@@ -424,6 +533,12 @@ type ClusterSpec struct {
 	PrometheusAddress string `protobuf:"bytes,8,opt,name=prometheus_address,json=prometheusAddress,proto3" json:"prometheus_address,omitempty"`
 	// GatewayAddress represents the address of gateway for the apiserver.
 	GatewayAddress string `protobuf:"bytes,9,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	// Maintenance indicates that the cluster is in maintenance mode.
+	Maintenance bool `protobuf:"varint,10,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// MaintenancePolicy describes how the cluster is drained in maintenance mode.
+	MaintenancePolicy *MaintenancePolicy `protobuf:"bytes,11,opt,name=maintenance_policy,json=maintenancePolicy,proto3" json:"maintenance_policy,omitempty"`
+	// DeletionPolicy describes what happens to the resources installed in the cluster when it is deleted.
+	DeletionPolicy DeletionPolicy `protobuf:"varint,12,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy" json:"deletion_policy,omitempty"`
 }
 
 func (x *ClusterSpec) Reset() {
@@ -521,6 +636,204 @@ func (x *ClusterSpec) GetGatewayAddress() string {
 	return ""
 }
 
+func (x *ClusterSpec) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+func (x *ClusterSpec) GetMaintenancePolicy() *MaintenancePolicy {
+	if x != nil {
+		return x.MaintenancePolicy
+	}
+	return nil
+}
+
+func (x *ClusterSpec) GetDeletionPolicy() DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return DeletionPolicy_DELETION_POLICY_UNSPECIFIED
+}
+
+type MaintenancePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cordon_nodes indicates whether all nodes of the cluster are marked unschedulable.
+	CordonNodes bool `protobuf:"varint,1,opt,name=cordon_nodes,json=cordonNodes,proto3" json:"cordon_nodes,omitempty"`
+	// drain_strategy describes how the kantaloupeflows are drained.
+	DrainStrategy DrainStrategy `protobuf:"varint,2,opt,name=drain_strategy,json=drainStrategy,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.DrainStrategy" json:"drain_strategy,omitempty"`
+	// target_cluster is the cluster the kantaloupeflows are migrated to.
+	TargetCluster string `protobuf:"bytes,3,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	// reason represents why the cluster is in maintenance.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MaintenancePolicy) Reset() {
+	*x = MaintenancePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenancePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenancePolicy) ProtoMessage() {}
+
+func (x *MaintenancePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenancePolicy.ProtoReflect.Descriptor instead.
+func (*MaintenancePolicy) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaintenancePolicy) GetCordonNodes() bool {
+	if x != nil {
+		return x.CordonNodes
+	}
+	return false
+}
+
+func (x *MaintenancePolicy) GetDrainStrategy() DrainStrategy {
+	if x != nil {
+		return x.DrainStrategy
+	}
+	return DrainStrategy_DRAIN_STRATEGY_UNSPECIFIED
+}
+
+func (x *MaintenancePolicy) GetTargetCluster() string {
+	if x != nil {
+		return x.TargetCluster
+	}
+	return ""
+}
+
+func (x *MaintenancePolicy) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MaintenanceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// phase is the current phase of the maintenance, e.g. Cordoning, Draining or Completed.
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// start_time is the time the cluster entered maintenance mode.
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cordoned_nodes is the number of nodes cordoned by the maintenance.
+	CordonedNodes int32 `protobuf:"varint,3,opt,name=cordoned_nodes,json=cordonedNodes,proto3" json:"cordoned_nodes,omitempty"`
+	// total_flows is the number of kantaloupeflows to drain.
+	TotalFlows int32 `protobuf:"varint,4,opt,name=total_flows,json=totalFlows,proto3" json:"total_flows,omitempty"`
+	// drained_flows is the number of kantaloupeflows that have been drained.
+	DrainedFlows int32 `protobuf:"varint,5,opt,name=drained_flows,json=drainedFlows,proto3" json:"drained_flows,omitempty"`
+	// failed_flows is the number of kantaloupeflows failed to drain.
+	FailedFlows int32 `protobuf:"varint,6,opt,name=failed_flows,json=failedFlows,proto3" json:"failed_flows,omitempty"`
+	// message is a human readable message of the last drain attempt.
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MaintenanceStatus) Reset() {
+	*x = MaintenanceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceStatus) ProtoMessage() {}
+
+func (x *MaintenanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceStatus.ProtoReflect.Descriptor instead.
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MaintenanceStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *MaintenanceStatus) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MaintenanceStatus) GetCordonedNodes() int32 {
+	if x != nil {
+		return x.CordonedNodes
+	}
+	return 0
+}
+
+func (x *MaintenanceStatus) GetTotalFlows() int32 {
+	if x != nil {
+		return x.TotalFlows
+	}
+	return 0
+}
+
+func (x *MaintenanceStatus) GetDrainedFlows() int32 {
+	if x != nil {
+		return x.DrainedFlows
+	}
+	return 0
+}
+
+func (x *MaintenanceStatus) GetFailedFlows() int32 {
+	if x != nil {
+		return x.FailedFlows
+	}
+	return 0
+}
+
+func (x *MaintenanceStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type ClusterStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -553,12 +866,19 @@ type ClusterStatus struct {
 	State              ClusterState `protobuf:"varint,17,opt,name=state,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState" json:"state,omitempty"`
 	// Current condition of cluster.
 	Conditions []*types.Condition `protobuf:"bytes,18,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// Maintenance represents the progress of the maintenance of the cluster.
+	Maintenance *MaintenanceStatus `protobuf:"bytes,19,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// Provider represents the cloud provider detected from the nodes of the cluster.
+	Provider ClusterProvider `protobuf:"varint,20,opt,name=provider,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider" json:"provider,omitempty"`
+	// AcceleratorTypes represents the accelerator types of all vendors detected
+	// from the nodes of the cluster.
+	AcceleratorTypes []ClusterType `protobuf:"varint,21,rep,packed,name=accelerator_types,json=acceleratorTypes,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType" json:"accelerator_types,omitempty"`
 }
 
 func (x *ClusterStatus) Reset() {
 	*x = ClusterStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[2]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -571,7 +891,7 @@ func (x *ClusterStatus) String() string {
 func (*ClusterStatus) ProtoMessage() {}
 
 func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[2]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +904,7 @@ func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterStatus.ProtoReflect.Descriptor instead.
 func (*ClusterStatus) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{2}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ClusterStatus) GetKubernetesVersion() string {
@@ -713,6 +1033,27 @@ func (x *ClusterStatus) GetConditions() []*types.Condition {
 	return nil
 }
 
+func (x *ClusterStatus) GetMaintenance() *MaintenanceStatus {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetProvider() ClusterProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return ClusterProvider_CLUSTER_PROVIDER_UNSPECIFIED
+}
+
+func (x *ClusterStatus) GetAcceleratorTypes() []ClusterType {
+	if x != nil {
+		return x.AcceleratorTypes
+	}
+	return nil
+}
+
 // ResourceSummary refers to a resource totally.
 type ResourceSummary struct {
 	state         protoimpl.MessageState
@@ -728,7 +1069,7 @@ type ResourceSummary struct {
 func (x *ResourceSummary) Reset() {
 	*x = ResourceSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[3]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +1082,7 @@ func (x *ResourceSummary) String() string {
 func (*ResourceSummary) ProtoMessage() {}
 
 func (x *ResourceSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[3]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1095,7 @@ func (x *ResourceSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceSummary.ProtoReflect.Descriptor instead.
 func (*ResourceSummary) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{3}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResourceSummary) GetTotalNum() int32 {
@@ -781,12 +1122,15 @@ type PlatformSummury struct {
 	AcceleratorCardNum     int32                     `protobuf:"varint,3,opt,name=accelerator_card_num,json=acceleratorCardNum,proto3" json:"accelerator_card_num,omitempty"`
 	KantaloupeflowNum      int32                     `protobuf:"varint,4,opt,name=kantaloupeflow_num,json=kantaloupeflowNum,proto3" json:"kantaloupeflow_num,omitempty"`
 	AcceleratorCardSummury []*AcceleratorCardSummury `protobuf:"bytes,5,rep,name=accelerator_card_summury,json=acceleratorCardSummury,proto3" json:"accelerator_card_summury,omitempty"`
+	// cluster_errors are the errors of the clusters whose accelerator cards are not summarized,
+	// e.g. the Prometheus of the cluster timed out.
+	ClusterErrors []*types.ClusterError `protobuf:"bytes,6,rep,name=cluster_errors,json=clusterErrors,proto3" json:"cluster_errors,omitempty"`
 }
 
 func (x *PlatformSummury) Reset() {
 	*x = PlatformSummury{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[4]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1143,7 @@ func (x *PlatformSummury) String() string {
 func (*PlatformSummury) ProtoMessage() {}
 
 func (x *PlatformSummury) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[4]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1156,7 @@ func (x *PlatformSummury) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformSummury.ProtoReflect.Descriptor instead.
 func (*PlatformSummury) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{4}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlatformSummury) GetClusterNum() int32 {
@@ -850,6 +1194,13 @@ func (x *PlatformSummury) GetAcceleratorCardSummury() []*AcceleratorCardSummury
 	return nil
 }
 
+func (x *PlatformSummury) GetClusterErrors() []*types.ClusterError {
+	if x != nil {
+		return x.ClusterErrors
+	}
+	return nil
+}
+
 type AcceleratorCardSummury struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -864,7 +1215,7 @@ type AcceleratorCardSummury struct {
 func (x *AcceleratorCardSummury) Reset() {
 	*x = AcceleratorCardSummury{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[5]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -877,7 +1228,7 @@ func (x *AcceleratorCardSummury) String() string {
 func (*AcceleratorCardSummury) ProtoMessage() {}
 
 func (x *AcceleratorCardSummury) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[5]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1241,7 @@ func (x *AcceleratorCardSummury) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceleratorCardSummury.ProtoReflect.Descriptor instead.
 func (*AcceleratorCardSummury) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{5}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AcceleratorCardSummury) GetMode() string {
@@ -934,7 +1285,7 @@ type GetPlatformSummuryRequest struct {
 func (x *GetPlatformSummuryRequest) Reset() {
 	*x = GetPlatformSummuryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[6]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1298,7 @@ func (x *GetPlatformSummuryRequest) String() string {
 func (*GetPlatformSummuryRequest) ProtoMessage() {}
 
 func (x *GetPlatformSummuryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[6]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1311,7 @@ func (x *GetPlatformSummuryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlatformSummuryRequest.ProtoReflect.Descriptor instead.
 func (*GetPlatformSummuryRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{6}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPlatformSummuryRequest) GetThreshold() int32 {
@@ -993,7 +1344,7 @@ type ListClustersRequest struct {
 func (x *ListClustersRequest) Reset() {
 	*x = ListClustersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[7]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1006,7 +1357,7 @@ func (x *ListClustersRequest) String() string {
 func (*ListClustersRequest) ProtoMessage() {}
 
 func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[7]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1370,7 @@ func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
 func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{7}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListClustersRequest) GetName() string {
@@ -1086,7 +1437,7 @@ type ListClustersResponse struct {
 func (x *ListClustersResponse) Reset() {
 	*x = ListClustersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[8]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1099,7 +1450,7 @@ func (x *ListClustersResponse) String() string {
 func (*ListClustersResponse) ProtoMessage() {}
 
 func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[8]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1463,7 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
 func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{8}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListClustersResponse) GetItems() []*Cluster {
@@ -1154,14 +1505,17 @@ type IntegrateClusterRequest struct {
 	PrometheusAddress string `protobuf:"bytes,8,opt,name=prometheus_address,json=prometheusAddress,proto3" json:"prometheus_address,omitempty"`
 	// gatewayAddress represents the address of gateway for the apiserver.
 	GatewayAddress string `protobuf:"bytes,9,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
-	// ClusterType represents the type of cluster.
+	// ClusterType represents the type of cluster, it is detected from the nodes
+	// of the cluster if not specified.
 	Type ClusterType `protobuf:"varint,10,opt,name=type,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType" json:"type,omitempty"`
+	// deletion_policy describes what happens to the resources installed in the cluster when it is deleted.
+	DeletionPolicy DeletionPolicy `protobuf:"varint,11,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy" json:"deletion_policy,omitempty"`
 }
 
 func (x *IntegrateClusterRequest) Reset() {
 	*x = IntegrateClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[9]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1174,7 +1528,7 @@ func (x *IntegrateClusterRequest) String() string {
 func (*IntegrateClusterRequest) ProtoMessage() {}
 
 func (x *IntegrateClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[9]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1541,7 @@ func (x *IntegrateClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrateClusterRequest.ProtoReflect.Descriptor instead.
 func (*IntegrateClusterRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{9}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *IntegrateClusterRequest) GetName() string {
@@ -1260,6 +1614,13 @@ func (x *IntegrateClusterRequest) GetType() ClusterType {
 	return ClusterType_CLUSTER_TYPE_UNSPECIFIED
 }
 
+func (x *IntegrateClusterRequest) GetDeletionPolicy() DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return DeletionPolicy_DELETION_POLICY_UNSPECIFIED
+}
+
 // DeleteClusterRequest defines a request for deleting a cluster.
 type DeleteClusterRequest struct {
 	state         protoimpl.MessageState
@@ -1269,12 +1630,14 @@ type DeleteClusterRequest struct {
 	// Name is the user-specified identifier.
 	// This field may not be updated.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// deletion_policy overrides the deletion policy of the cluster if specified.
+	DeletionPolicy DeletionPolicy `protobuf:"varint,2,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy" json:"deletion_policy,omitempty"`
 }
 
 func (x *DeleteClusterRequest) Reset() {
 	*x = DeleteClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[10]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +1650,7 @@ func (x *DeleteClusterRequest) String() string {
 func (*DeleteClusterRequest) ProtoMessage() {}
 
 func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[10]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1663,7 @@ func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteClusterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{10}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteClusterRequest) GetName() string {
@@ -1310,6 +1673,13 @@ func (x *DeleteClusterRequest) GetName() string {
 	return ""
 }
 
+func (x *DeleteClusterRequest) GetDeletionPolicy() DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return DeletionPolicy_DELETION_POLICY_UNSPECIFIED
+}
+
 // GetClusterRequest returns cluster information.
 type GetClusterRequest struct {
 	state         protoimpl.MessageState
@@ -1323,7 +1693,7 @@ type GetClusterRequest struct {
 func (x *GetClusterRequest) Reset() {
 	*x = GetClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[11]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1336,7 +1706,7 @@ func (x *GetClusterRequest) String() string {
 func (*GetClusterRequest) ProtoMessage() {}
 
 func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[11]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,7 +1719,7 @@ func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
 func (*GetClusterRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{11}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetClusterRequest) GetName() string {
@@ -1372,7 +1742,7 @@ type ValidateKubeconfigRequest struct {
 func (x *ValidateKubeconfigRequest) Reset() {
 	*x = ValidateKubeconfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[12]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1385,7 +1755,7 @@ func (x *ValidateKubeconfigRequest) String() string {
 func (*ValidateKubeconfigRequest) ProtoMessage() {}
 
 func (x *ValidateKubeconfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[12]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1768,7 @@ func (x *ValidateKubeconfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateKubeconfigRequest.ProtoReflect.Descriptor instead.
 func (*ValidateKubeconfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{12}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ValidateKubeconfigRequest) GetKubeconfig() string {
@@ -1421,7 +1791,7 @@ type ValidateKubeconfigResponse struct {
 func (x *ValidateKubeconfigResponse) Reset() {
 	*x = ValidateKubeconfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[13]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +1804,7 @@ func (x *ValidateKubeconfigResponse) String() string {
 func (*ValidateKubeconfigResponse) ProtoMessage() {}
 
 func (x *ValidateKubeconfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[13]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +1817,7 @@ func (x *ValidateKubeconfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateKubeconfigResponse.ProtoReflect.Descriptor instead.
 func (*ValidateKubeconfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{13}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidateKubeconfigResponse) GetValidate() bool {
@@ -1480,12 +1850,14 @@ type UpdateClusterRequest struct {
 	GatewayAddress string `protobuf:"bytes,7,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
 	// kubeConfig of the cluster.
 	KubeConfig string `protobuf:"bytes,8,opt,name=kube_config,json=kubeConfig,proto3" json:"kube_config,omitempty"`
+	// deletion_policy describes what happens to the resources installed in the cluster when it is deleted.
+	DeletionPolicy DeletionPolicy `protobuf:"varint,9,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy" json:"deletion_policy,omitempty"`
 }
 
 func (x *UpdateClusterRequest) Reset() {
 	*x = UpdateClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[14]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1498,7 +1870,7 @@ func (x *UpdateClusterRequest) String() string {
 func (*UpdateClusterRequest) ProtoMessage() {}
 
 func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[14]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1883,7 @@ func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateClusterRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClusterRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{14}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateClusterRequest) GetName() string {
@@ -1570,90 +1942,44 @@ func (x *UpdateClusterRequest) GetKubeConfig() string {
 	return ""
 }
 
-type ListClusterVersionsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// versions represents existing cluster versions.
-	Versions []string `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
-}
-
-func (x *ListClusterVersionsResponse) Reset() {
-	*x = ListClusterVersionsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListClusterVersionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListClusterVersionsResponse) ProtoMessage() {}
-
-func (x *ListClusterVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListClusterVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListClusterVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListClusterVersionsResponse) GetVersions() []string {
+func (x *UpdateClusterRequest) GetDeletionPolicy() DeletionPolicy {
 	if x != nil {
-		return x.Versions
+		return x.DeletionPolicy
 	}
-	return nil
+	return DeletionPolicy_DELETION_POLICY_UNSPECIFIED
 }
 
-type GPUSummary struct {
+// UpdateClusterMaintenanceRequest defines a request for entering or leaving maintenance mode.
+type UpdateClusterMaintenanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// model represents the gpu type.
-	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	// total represents the total number of gpu.
-	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	// mem_allocated represents the gpumemory allocated number of gpu.
-	MemAllocated float64 `protobuf:"fixed64,3,opt,name=mem_allocated,json=memAllocated,proto3" json:"mem_allocated,omitempty"`
-	// mem_usage represents the gpumemory usage rate of gpu.
-	MemUsage float64 `protobuf:"fixed64,4,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`
-	// core_allocated represents the gpucore allocated number of gpu.
-	CoreAllocated float64 `protobuf:"fixed64,5,opt,name=core_allocated,json=coreAllocated,proto3" json:"core_allocated,omitempty"`
-	// core_usage represents the gpucore usage rate of gpu.
-	CoreUsage float64 `protobuf:"fixed64,6,opt,name=core_usage,json=coreUsage,proto3" json:"core_usage,omitempty"`
+	// Name is the cluster name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// maintenance indicates whether the cluster enters maintenance mode.
+	Maintenance bool `protobuf:"varint,2,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// policy describes how the cluster is drained in maintenance mode.
+	Policy *MaintenancePolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *GPUSummary) Reset() {
-	*x = GPUSummary{}
+func (x *UpdateClusterMaintenanceRequest) Reset() {
+	*x = UpdateClusterMaintenanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[16]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GPUSummary) String() string {
+func (x *UpdateClusterMaintenanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GPUSummary) ProtoMessage() {}
+func (*UpdateClusterMaintenanceRequest) ProtoMessage() {}
 
-func (x *GPUSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[16]
+func (x *UpdateClusterMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,80 +1990,58 @@ func (x *GPUSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GPUSummary.ProtoReflect.Descriptor instead.
-func (*GPUSummary) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateClusterMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GPUSummary) GetModel() string {
+func (x *UpdateClusterMaintenanceRequest) GetName() string {
 	if x != nil {
-		return x.Model
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GPUSummary) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GPUSummary) GetMemAllocated() float64 {
-	if x != nil {
-		return x.MemAllocated
-	}
-	return 0
-}
-
-func (x *GPUSummary) GetMemUsage() float64 {
-	if x != nil {
-		return x.MemUsage
-	}
-	return 0
-}
-
-func (x *GPUSummary) GetCoreAllocated() float64 {
+func (x *UpdateClusterMaintenanceRequest) GetMaintenance() bool {
 	if x != nil {
-		return x.CoreAllocated
+		return x.Maintenance
 	}
-	return 0
+	return false
 }
 
-func (x *GPUSummary) GetCoreUsage() float64 {
+func (x *UpdateClusterMaintenanceRequest) GetPolicy() *MaintenancePolicy {
 	if x != nil {
-		return x.CoreUsage
+		return x.Policy
 	}
-	return 0
+	return nil
 }
 
-type GetPlatformGPUTopRequest struct {
+type ListClusterVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// topn is the number of top n gpu
-	Topn       int32      `protobuf:"varint,1,opt,name=topn,proto3" json:"topn,omitempty"`
-	RankOption RankOption `protobuf:"varint,2,opt,name=rank_option,json=rankOption,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption" json:"rank_option,omitempty"`
+	// versions represents existing cluster versions.
+	Versions []string `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *GetPlatformGPUTopRequest) Reset() {
-	*x = GetPlatformGPUTopRequest{}
+func (x *ListClusterVersionsResponse) Reset() {
+	*x = ListClusterVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[17]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPlatformGPUTopRequest) String() string {
+func (x *ListClusterVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformGPUTopRequest) ProtoMessage() {}
+func (*ListClusterVersionsResponse) ProtoMessage() {}
 
-func (x *GetPlatformGPUTopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[17]
+func (x *ListClusterVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,50 +2052,44 @@ func (x *GetPlatformGPUTopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformGPUTopRequest.ProtoReflect.Descriptor instead.
-func (*GetPlatformGPUTopRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GetPlatformGPUTopRequest) GetTopn() int32 {
-	if x != nil {
-		return x.Topn
-	}
-	return 0
+// Deprecated: Use ListClusterVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetPlatformGPUTopRequest) GetRankOption() RankOption {
+func (x *ListClusterVersionsResponse) GetVersions() []string {
 	if x != nil {
-		return x.RankOption
+		return x.Versions
 	}
-	return RankOption_RANK_OPTION_UNSPECIFIED
+	return nil
 }
 
-type GetPlatformGPUTopResponse struct {
+type GetClusterCapabilitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Gpus []*GPUSummary `protobuf:"bytes,1,rep,name=gpus,proto3" json:"gpus,omitempty"`
+	// Name is the cluster name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetPlatformGPUTopResponse) Reset() {
-	*x = GetPlatformGPUTopResponse{}
+func (x *GetClusterCapabilitiesRequest) Reset() {
+	*x = GetClusterCapabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[18]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPlatformGPUTopResponse) String() string {
+func (x *GetClusterCapabilitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformGPUTopResponse) ProtoMessage() {}
+func (*GetClusterCapabilitiesRequest) ProtoMessage() {}
 
-func (x *GetPlatformGPUTopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[18]
+func (x *GetClusterCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,44 +2100,49 @@ func (x *GetPlatformGPUTopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformGPUTopResponse.ProtoReflect.Descriptor instead.
-func (*GetPlatformGPUTopResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetClusterCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetPlatformGPUTopResponse) GetGpus() []*GPUSummary {
+func (x *GetClusterCapabilitiesRequest) GetName() string {
 	if x != nil {
-		return x.Gpus
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type KantaloupePlugin struct {
+// RouteKind represents a route kind of gateway-api served by the cluster.
+type RouteKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      KantaloupePluginName `protobuf:"varint,1,opt,name=name,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName" json:"name,omitempty"`
-	Namespace string               `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// kind is the kind of the route, e.g. HTTPRoute or TCPRoute.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// version is the API version the route is served with, e.g. v1 or v1alpha2.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// alpha indicates that the route is only served with an alpha version.
+	Alpha bool `protobuf:"varint,3,opt,name=alpha,proto3" json:"alpha,omitempty"`
 }
 
-func (x *KantaloupePlugin) Reset() {
-	*x = KantaloupePlugin{}
+func (x *RouteKind) Reset() {
+	*x = RouteKind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[19]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KantaloupePlugin) String() string {
+func (x *RouteKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KantaloupePlugin) ProtoMessage() {}
+func (*RouteKind) ProtoMessage() {}
 
-func (x *KantaloupePlugin) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[19]
+func (x *RouteKind) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,51 +2153,74 @@ func (x *KantaloupePlugin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KantaloupePlugin.ProtoReflect.Descriptor instead.
-func (*KantaloupePlugin) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RouteKind.ProtoReflect.Descriptor instead.
+func (*RouteKind) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *KantaloupePlugin) GetName() KantaloupePluginName {
+func (x *RouteKind) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
-	return KantaloupePluginName_KANTALOUPE_PLUGIN_NAME_UNSPECIFED
+	return ""
 }
 
-func (x *KantaloupePlugin) GetNamespace() string {
+func (x *RouteKind) GetVersion() string {
 	if x != nil {
-		return x.Namespace
+		return x.Version
 	}
 	return ""
 }
 
-type GetClusterPluginsRequest struct {
+func (x *RouteKind) GetAlpha() bool {
+	if x != nil {
+		return x.Alpha
+	}
+	return false
+}
+
+// ClusterCapabilities represents the versions of the components installed in
+// the cluster and the kantaloupeflow features they enable.
+type ClusterCapabilities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Name is the cluster name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *GetClusterPluginsRequest) Reset() {
-	*x = GetClusterPluginsRequest{}
+	// kubernetes_version is the version of Kubernetes.
+	KubernetesVersion string `protobuf:"bytes,2,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+	// hami_version is the version of HAMi, it is empty if HAMi is not installed.
+	HamiVersion string `protobuf:"bytes,3,opt,name=hami_version,json=hamiVersion,proto3" json:"hami_version,omitempty"`
+	// gateway_api_version is the bundle version of gateway-api, it is empty if
+	// gateway-api is not installed.
+	GatewayApiVersion string `protobuf:"bytes,4,opt,name=gateway_api_version,json=gatewayApiVersion,proto3" json:"gateway_api_version,omitempty"`
+	// route_kinds holds the route kinds of gateway-api served by the cluster.
+	RouteKinds []*RouteKind `protobuf:"bytes,5,rep,name=route_kinds,json=routeKinds,proto3" json:"route_kinds,omitempty"`
+	// prometheus_version is the version of the Prometheus of the cluster.
+	PrometheusVersion string `protobuf:"bytes,6,opt,name=prometheus_version,json=prometheusVersion,proto3" json:"prometheus_version,omitempty"`
+	// kantaloupeflow_features holds the kantaloupeflow features usable in the
+	// cluster, e.g. httproute, tcproute or gpu-sharing.
+	KantaloupeflowFeatures []string `protobuf:"bytes,7,rep,name=kantaloupeflow_features,json=kantaloupeflowFeatures,proto3" json:"kantaloupeflow_features,omitempty"`
+}
+
+func (x *ClusterCapabilities) Reset() {
+	*x = ClusterCapabilities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[20]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClusterPluginsRequest) String() string {
+func (x *ClusterCapabilities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterPluginsRequest) ProtoMessage() {}
+func (*ClusterCapabilities) ProtoMessage() {}
 
-func (x *GetClusterPluginsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[20]
+func (x *ClusterCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,9 +2231,359 @@ func (x *GetClusterPluginsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterPluginsRequest.ProtoReflect.Descriptor instead.
+// Deprecated: Use ClusterCapabilities.ProtoReflect.Descriptor instead.
+func (*ClusterCapabilities) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClusterCapabilities) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetHamiVersion() string {
+	if x != nil {
+		return x.HamiVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetGatewayApiVersion() string {
+	if x != nil {
+		return x.GatewayApiVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetRouteKinds() []*RouteKind {
+	if x != nil {
+		return x.RouteKinds
+	}
+	return nil
+}
+
+func (x *ClusterCapabilities) GetPrometheusVersion() string {
+	if x != nil {
+		return x.PrometheusVersion
+	}
+	return ""
+}
+
+func (x *ClusterCapabilities) GetKantaloupeflowFeatures() []string {
+	if x != nil {
+		return x.KantaloupeflowFeatures
+	}
+	return nil
+}
+
+type GPUSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// model represents the gpu type.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// total represents the total number of gpu.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// mem_allocated represents the gpumemory allocated number of gpu.
+	MemAllocated float64 `protobuf:"fixed64,3,opt,name=mem_allocated,json=memAllocated,proto3" json:"mem_allocated,omitempty"`
+	// mem_usage represents the gpumemory usage rate of gpu.
+	MemUsage float64 `protobuf:"fixed64,4,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`
+	// core_allocated represents the gpucore allocated number of gpu.
+	CoreAllocated float64 `protobuf:"fixed64,5,opt,name=core_allocated,json=coreAllocated,proto3" json:"core_allocated,omitempty"`
+	// core_usage represents the gpucore usage rate of gpu.
+	CoreUsage float64 `protobuf:"fixed64,6,opt,name=core_usage,json=coreUsage,proto3" json:"core_usage,omitempty"`
+}
+
+func (x *GPUSummary) Reset() {
+	*x = GPUSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUSummary) ProtoMessage() {}
+
+func (x *GPUSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUSummary.ProtoReflect.Descriptor instead.
+func (*GPUSummary) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GPUSummary) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GPUSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GPUSummary) GetMemAllocated() float64 {
+	if x != nil {
+		return x.MemAllocated
+	}
+	return 0
+}
+
+func (x *GPUSummary) GetMemUsage() float64 {
+	if x != nil {
+		return x.MemUsage
+	}
+	return 0
+}
+
+func (x *GPUSummary) GetCoreAllocated() float64 {
+	if x != nil {
+		return x.CoreAllocated
+	}
+	return 0
+}
+
+func (x *GPUSummary) GetCoreUsage() float64 {
+	if x != nil {
+		return x.CoreUsage
+	}
+	return 0
+}
+
+type GetPlatformGPUTopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// topn is the number of top n gpu
+	Topn       int32      `protobuf:"varint,1,opt,name=topn,proto3" json:"topn,omitempty"`
+	RankOption RankOption `protobuf:"varint,2,opt,name=rank_option,json=rankOption,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption" json:"rank_option,omitempty"`
+}
+
+func (x *GetPlatformGPUTopRequest) Reset() {
+	*x = GetPlatformGPUTopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlatformGPUTopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformGPUTopRequest) ProtoMessage() {}
+
+func (x *GetPlatformGPUTopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformGPUTopRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformGPUTopRequest) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPlatformGPUTopRequest) GetTopn() int32 {
+	if x != nil {
+		return x.Topn
+	}
+	return 0
+}
+
+func (x *GetPlatformGPUTopRequest) GetRankOption() RankOption {
+	if x != nil {
+		return x.RankOption
+	}
+	return RankOption_RANK_OPTION_UNSPECIFIED
+}
+
+type GetPlatformGPUTopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gpus []*GPUSummary `protobuf:"bytes,1,rep,name=gpus,proto3" json:"gpus,omitempty"`
+	// cluster_errors are the errors of the clusters whose gpus are not ranked.
+	ClusterErrors []*types.ClusterError `protobuf:"bytes,2,rep,name=cluster_errors,json=clusterErrors,proto3" json:"cluster_errors,omitempty"`
+}
+
+func (x *GetPlatformGPUTopResponse) Reset() {
+	*x = GetPlatformGPUTopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlatformGPUTopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformGPUTopResponse) ProtoMessage() {}
+
+func (x *GetPlatformGPUTopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformGPUTopResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformGPUTopResponse) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPlatformGPUTopResponse) GetGpus() []*GPUSummary {
+	if x != nil {
+		return x.Gpus
+	}
+	return nil
+}
+
+func (x *GetPlatformGPUTopResponse) GetClusterErrors() []*types.ClusterError {
+	if x != nil {
+		return x.ClusterErrors
+	}
+	return nil
+}
+
+type KantaloupePlugin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      KantaloupePluginName `protobuf:"varint,1,opt,name=name,proto3,enum=kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName" json:"name,omitempty"`
+	Namespace string               `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *KantaloupePlugin) Reset() {
+	*x = KantaloupePlugin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KantaloupePlugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KantaloupePlugin) ProtoMessage() {}
+
+func (x *KantaloupePlugin) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KantaloupePlugin.ProtoReflect.Descriptor instead.
+func (*KantaloupePlugin) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KantaloupePlugin) GetName() KantaloupePluginName {
+	if x != nil {
+		return x.Name
+	}
+	return KantaloupePluginName_KANTALOUPE_PLUGIN_NAME_UNSPECIFED
+}
+
+func (x *KantaloupePlugin) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetClusterPluginsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the cluster name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetClusterPluginsRequest) Reset() {
+	*x = GetClusterPluginsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClusterPluginsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterPluginsRequest) ProtoMessage() {}
+
+func (x *GetClusterPluginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterPluginsRequest.ProtoReflect.Descriptor instead.
 func (*GetClusterPluginsRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{20}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetClusterPluginsRequest) GetName() string {
@@ -1928,7 +2604,7 @@ type GetClusterPluginsResponse struct {
 func (x *GetClusterPluginsResponse) Reset() {
 	*x = GetClusterPluginsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[21]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1941,7 +2617,7 @@ func (x *GetClusterPluginsResponse) String() string {
 func (*GetClusterPluginsResponse) ProtoMessage() {}
 
 func (x *GetClusterPluginsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[21]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2630,7 @@ func (x *GetClusterPluginsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterPluginsResponse.ProtoReflect.Descriptor instead.
 func (*GetClusterPluginsResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{21}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetClusterPluginsResponse) GetPlugins() []*KantaloupePlugin {
@@ -1977,7 +2653,7 @@ type ResourceName struct {
 func (x *ResourceName) Reset() {
 	*x = ResourceName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[22]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2666,7 @@ func (x *ResourceName) String() string {
 func (*ResourceName) ProtoMessage() {}
 
 func (x *ResourceName) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[22]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2679,7 @@ func (x *ResourceName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceName.ProtoReflect.Descriptor instead.
 func (*ResourceName) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{22}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ResourceName) GetCardModel() string {
@@ -2032,7 +2708,7 @@ type CardRequestType struct {
 func (x *CardRequestType) Reset() {
 	*x = CardRequestType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[23]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2045,7 +2721,7 @@ func (x *CardRequestType) String() string {
 func (*CardRequestType) ProtoMessage() {}
 
 func (x *CardRequestType) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[23]
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,7 +2734,7 @@ func (x *CardRequestType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardRequestType.ProtoReflect.Descriptor instead.
 func (*CardRequestType) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{23}
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CardRequestType) GetRequestType() string {
@@ -2070,36 +2746,137 @@ func (x *CardRequestType) GetRequestType() string {
 
 func (x *CardRequestType) GetResourceNames() []*ResourceName {
 	if x != nil {
-		return x.ResourceNames
+		return x.ResourceNames
+	}
+	return nil
+}
+
+type GetClusterCardRequestTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetClusterCardRequestTypeRequest) Reset() {
+	*x = GetClusterCardRequestTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClusterCardRequestTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCardRequestTypeRequest) ProtoMessage() {}
+
+func (x *GetClusterCardRequestTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCardRequestTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterCardRequestTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetClusterCardRequestTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetClusterCardRequestTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestTypes []*CardRequestType `protobuf:"bytes,1,rep,name=request_types,json=requestTypes,proto3" json:"request_types,omitempty"`
+}
+
+func (x *GetClusterCardRequestTypeResponse) Reset() {
+	*x = GetClusterCardRequestTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClusterCardRequestTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCardRequestTypeResponse) ProtoMessage() {}
+
+func (x *GetClusterCardRequestTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCardRequestTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterCardRequestTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetClusterCardRequestTypeResponse) GetRequestTypes() []*CardRequestType {
+	if x != nil {
+		return x.RequestTypes
 	}
 	return nil
 }
 
-type GetClusterCardRequestTypeRequest struct {
+// WatchClustersRequest watches the clusters.
+type WatchClustersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Name is used for fuzzy search.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// LabelSelector limits the watch to the matching clusters.
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// ResourceVersion resumes the watch after the event of the version, the current
+	// clusters are sent first if it is empty.
+	ResourceVersion string `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
-func (x *GetClusterCardRequestTypeRequest) Reset() {
-	*x = GetClusterCardRequestTypeRequest{}
+func (x *WatchClustersRequest) Reset() {
+	*x = WatchClustersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[24]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClusterCardRequestTypeRequest) String() string {
+func (x *WatchClustersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterCardRequestTypeRequest) ProtoMessage() {}
+func (*WatchClustersRequest) ProtoMessage() {}
 
-func (x *GetClusterCardRequestTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[24]
+func (x *WatchClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,43 +2887,62 @@ func (x *GetClusterCardRequestTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterCardRequestTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterCardRequestTypeRequest) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use WatchClustersRequest.ProtoReflect.Descriptor instead.
+func (*WatchClustersRequest) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetClusterCardRequestTypeRequest) GetName() string {
+func (x *WatchClustersRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetClusterCardRequestTypeResponse struct {
+func (x *WatchClustersRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *WatchClustersRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+// WatchClustersResponse is an event of the watched clusters.
+type WatchClustersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestTypes []*CardRequestType `protobuf:"bytes,1,rep,name=request_types,json=requestTypes,proto3" json:"request_types,omitempty"`
+	Type types.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=kantaloupe.dynamia.ai.api.types.WatchEventType" json:"type,omitempty"`
+	// Object is empty for the BOOKMARK events.
+	Object *Cluster `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// ResourceVersion is the version to resume the watch from after this event.
+	ResourceVersion string `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
-func (x *GetClusterCardRequestTypeResponse) Reset() {
-	*x = GetClusterCardRequestTypeResponse{}
+func (x *WatchClustersResponse) Reset() {
+	*x = WatchClustersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[25]
+		mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClusterCardRequestTypeResponse) String() string {
+func (x *WatchClustersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterCardRequestTypeResponse) ProtoMessage() {}
+func (*WatchClustersResponse) ProtoMessage() {}
 
-func (x *GetClusterCardRequestTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[25]
+func (x *WatchClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clusters_v1alpha1_cluster_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,18 +2953,32 @@ func (x *GetClusterCardRequestTypeResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterCardRequestTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterCardRequestTypeResponse) Descriptor() ([]byte, []int) {
-	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WatchClustersResponse.ProtoReflect.Descriptor instead.
+func (*WatchClustersResponse) Descriptor() ([]byte, []int) {
+	return file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetClusterCardRequestTypeResponse) GetRequestTypes() []*CardRequestType {
+func (x *WatchClustersResponse) GetType() types.WatchEventType {
 	if x != nil {
-		return x.RequestTypes
+		return x.Type
+	}
+	return types.WatchEventType(0)
+}
+
+func (x *WatchClustersResponse) GetObject() *Cluster {
+	if x != nil {
+		return x.Object
 	}
 	return nil
 }
 
+func (x *WatchClustersResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 var File_api_clusters_v1alpha1_cluster_proto protoreflect.FileDescriptor
 
 var file_api_clusters_v1alpha1_cluster_proto_rawDesc = []byte{
@@ -2177,313 +2987,451 @@ var file_api_clusters_v1alpha1_cluster_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2b, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
 	0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x1a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
-	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c,
-	0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c,
-	0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x0b,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x58, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e,
-	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
+	0x61, 0x31, 0x1a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x61, 0x70, 0x69, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70,
+	0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f,
+	0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f,
+	0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x94, 0x05, 0x0a, 0x0b, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b,
+	0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x12,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x64, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
+	0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0xd8, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x64, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x0e, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0d,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf2, 0x01, 0x0a,
+	0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x64, 0x6f,
+	0x6e, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x46,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xf5, 0x09, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
 	0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x69, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f,
-	0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75,
-	0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0x07, 0x0a, 0x0d,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6b,
-	0x75, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12,
-	0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
-	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x0b, 0x70, 0x6f, 0x64, 0x5f,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5d,
+	0x0a, 0x0b, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
+	0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x0a, 0x70, 0x6f, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x73, 0x0a,
+	0x16, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
 	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
 	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x6f, 0x64,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x73, 0x0a, 0x16, 0x6b, 0x61, 0x6e, 0x74, 0x61,
-	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c,
-	0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x15, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
-	0x65, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x67, 0x70, 0x75, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x67, 0x70, 0x75, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x70, 0x75,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x67, 0x70, 0x75,
-	0x43, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x70, 0x75,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0e, 0x67, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x10, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x12, 0x67, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x39, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x15, 0x6b, 0x61, 0x6e,
+	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70, 0x75, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x28, 0x0a, 0x10, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x70, 0x75, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70,
+	0x75, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x67, 0x70, 0x75, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x67, 0x70, 0x75, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x70, 0x75, 0x5f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x67, 0x70, 0x75, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
 	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4e, 0x75, 0x6d, 0x22, 0xad, 0x02,
-	0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72,
-	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a,
-	0x14, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x61, 0x63, 0x63,
-	0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x12,
-	0x2d, 0x0a, 0x12, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f,
-	0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6b, 0x61, 0x6e,
-	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x7d,
-	0x0a, 0x18, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61,
-	0x72, 0x64, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x43, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x64, 0x53, 0x75,
-	0x6d, 0x6d, 0x75, 0x72, 0x79, 0x52, 0x16, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x43, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x22, 0x83, 0x01,
-	0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72,
-	0x64, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
-	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75,
-	0x73, 0x65, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6c,
-	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x64, 0x65, 0x6c,
-	0x4e, 0x75, 0x6d, 0x22, 0x39, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa1,
-	0x03, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
-	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61,
-	0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x61,
 	0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61,
 	0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x39, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
-	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61,
-	0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e,
 	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e,
 	0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61,
-	0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61,
-	0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed, 0x05, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75,
-	0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x68, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x65, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x38, 0x2e,
 	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
 	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x6b,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x4e, 0x75, 0x6d, 0x22, 0x83, 0x03, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x6b, 0x61, 0x6e, 0x74,
+	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
+	0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x7d, 0x0a, 0x18, 0x61, 0x63, 0x63, 0x65, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
+	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61,
+	0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x52, 0x16,
+	0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x64, 0x53,
+	0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x12, 0x54, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0d, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x83, 0x01, 0x0a,
+	0x16, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x64,
+	0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6c, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x64, 0x65, 0x6c, 0x4e,
+	0x75, 0x6d, 0x22, 0x39, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x75, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa1, 0x03,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e,
+	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e,
+	0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x4f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x39, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
+	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61,
+	0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x61, 0x6e,
+	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e,
+	0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x06, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
+	0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x6b,
 	0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
 	0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
 	0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75,
-	0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
+	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x6b, 0x61,
+	0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61,
+	0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x3b, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x27, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x38, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75,
+	0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa2, 0x05, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x65, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
+	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x74, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
+	0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x64, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x6b, 0x61, 0x6e, 0x74,
 	0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61,
 	0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xaf, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x19, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x38, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0xbc, 0x04, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x65, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x6b,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x61,
+	0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61,
+	0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x39, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x22, 0xec, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x61, 0x6d, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x6d, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x70,
+	0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c,
+	0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68,
+	0x65, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x17, 0x6b, 0x61,
+	0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x6b, 0x61, 0x6e,
+	0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x72,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x50, 0x55, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x6e, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6b,
 	0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
 	0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x12, 0x74, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61,
-	0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70,
-	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68,
-	0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x39, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x47,
-	0x50, 0x55, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65,
-	0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
-	0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
-	0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x5f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x50, 0x55,
-	0x54, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f,
-	0x70, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x6e, 0x12, 0x58,
-	0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
-	0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x61,
-	0x6e, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x50, 0x55, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x67, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65,
-	0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x67, 0x70,
-	0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x4b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x47, 0x50, 0x55, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x04, 0x67, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x50, 0x55, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x67, 0x70, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x0e,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
+	0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x4b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70,
 	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x55, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75,
 	0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70,
@@ -2529,32 +3477,64 @@ var file_api_clusters_v1alpha1_cluster_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79,
 	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x2a, 0xb5, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49,
-	0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x44, 0x48, 0x41, 0x54, 0x5f, 0x4f, 0x50,
-	0x45, 0x4e, 0x53, 0x48, 0x49, 0x46, 0x54, 0x34, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55,
-	0x53, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
-	0x56, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x41, 0x4e, 0x5a, 0x55, 0x10, 0x04, 0x12, 0x0b,
-	0x0a, 0x07, 0x41, 0x57, 0x53, 0x5f, 0x45, 0x4b, 0x53, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x41,
-	0x4c, 0x49, 0x59, 0x55, 0x4e, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x48,
-	0x55, 0x41, 0x57, 0x45, 0x49, 0x5f, 0x43, 0x43, 0x45, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x47,
-	0x43, 0x50, 0x5f, 0x47, 0x4b, 0x45, 0x10, 0x08, 0x2a, 0x9b, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x56, 0x49, 0x44, 0x49, 0x41,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x54, 0x41, 0x58, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x43, 0x41, 0x4d, 0x42, 0x52, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
-	0x4d, 0x4f, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x53, 0x10, 0x04, 0x12,
-	0x12, 0x0a, 0x0e, 0x49, 0x4c, 0x55, 0x56, 0x41, 0x54, 0x41, 0x52, 0x5f, 0x43, 0x4f, 0x52, 0x45,
-	0x58, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x59, 0x47, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45,
-	0x55, 0x52, 0x4f, 0x4e, 0x10, 0x08, 0x2a, 0x39, 0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10,
-	0x02, 0x2a, 0x57, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x7c, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd5,
+	0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f,
+	0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6b, 0x61, 0x6e, 0x74, 0x61, 0x6c, 0x6f, 0x75, 0x70, 0x65, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x61, 0x2e, 0x61, 0x69, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0xb5, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c,
+	0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x44,
+	0x48, 0x41, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x53, 0x48, 0x49, 0x46, 0x54, 0x34, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x52,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x41, 0x4e,
+	0x5a, 0x55, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x57, 0x53, 0x5f, 0x45, 0x4b, 0x53, 0x10,
+	0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x4c, 0x49, 0x59, 0x55, 0x4e, 0x5f, 0x41, 0x43, 0x4b, 0x10,
+	0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x55, 0x41, 0x57, 0x45, 0x49, 0x5f, 0x43, 0x43, 0x45, 0x10,
+	0x07, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x43, 0x50, 0x5f, 0x47, 0x4b, 0x45, 0x10, 0x08, 0x2a, 0x9b,
+	0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x4e, 0x56, 0x49, 0x44, 0x49, 0x41, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x54, 0x41,
+	0x58, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4d, 0x42, 0x52, 0x49, 0x43, 0x4f, 0x4e,
+	0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45,
+	0x41, 0x44, 0x53, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4c, 0x55, 0x56, 0x41, 0x54, 0x41,
+	0x52, 0x5f, 0x43, 0x4f, 0x52, 0x45, 0x58, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x59, 0x47,
+	0x4f, 0x4e, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x10, 0x07,
+	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x55, 0x52, 0x4f, 0x4e, 0x10, 0x08, 0x2a, 0x4a, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f,
+	0x0a, 0x1b, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x52, 0x41,
+	0x49, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x49, 0x42,
+	0x45, 0x52, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x47, 0x52,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x4a, 0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10,
+	0x03, 0x2a, 0x57, 0x0a, 0x0a, 0x52, 0x61, 0x6e, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x1b, 0x0a, 0x17, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
 	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
 	0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x4d, 0x4f,
@@ -2583,84 +3563,111 @@ func file_api_clusters_v1alpha1_cluster_proto_rawDescGZIP() []byte {
 	return file_api_clusters_v1alpha1_cluster_proto_rawDescData
 }
 
-var file_api_clusters_v1alpha1_cluster_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_api_clusters_v1alpha1_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_clusters_v1alpha1_cluster_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_clusters_v1alpha1_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_api_clusters_v1alpha1_cluster_proto_goTypes = []interface{}{
 	(ClusterProvider)(0),                      // 0: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
 	(ClusterType)(0),                          // 1: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
-	(ClusterState)(0),                         // 2: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
-	(RankOption)(0),                           // 3: kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption
-	(KantaloupePluginName)(0),                 // 4: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName
-	(*Cluster)(nil),                           // 5: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster
-	(*ClusterSpec)(nil),                       // 6: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec
-	(*ClusterStatus)(nil),                     // 7: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus
-	(*ResourceSummary)(nil),                   // 8: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
-	(*PlatformSummury)(nil),                   // 9: kantaloupe.dynamia.ai.api.clusters.v1alpha1.PlatformSummury
-	(*AcceleratorCardSummury)(nil),            // 10: kantaloupe.dynamia.ai.api.clusters.v1alpha1.AcceleratorCardSummury
-	(*GetPlatformSummuryRequest)(nil),         // 11: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformSummuryRequest
-	(*ListClustersRequest)(nil),               // 12: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest
-	(*ListClustersResponse)(nil),              // 13: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse
-	(*IntegrateClusterRequest)(nil),           // 14: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest
-	(*DeleteClusterRequest)(nil),              // 15: kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeleteClusterRequest
-	(*GetClusterRequest)(nil),                 // 16: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterRequest
-	(*ValidateKubeconfigRequest)(nil),         // 17: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ValidateKubeconfigRequest
-	(*ValidateKubeconfigResponse)(nil),        // 18: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ValidateKubeconfigResponse
-	(*UpdateClusterRequest)(nil),              // 19: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest
-	(*ListClusterVersionsResponse)(nil),       // 20: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClusterVersionsResponse
-	(*GPUSummary)(nil),                        // 21: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GPUSummary
-	(*GetPlatformGPUTopRequest)(nil),          // 22: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopRequest
-	(*GetPlatformGPUTopResponse)(nil),         // 23: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopResponse
-	(*KantaloupePlugin)(nil),                  // 24: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin
-	(*GetClusterPluginsRequest)(nil),          // 25: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsRequest
-	(*GetClusterPluginsResponse)(nil),         // 26: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsResponse
-	(*ResourceName)(nil),                      // 27: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceName
-	(*CardRequestType)(nil),                   // 28: kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType
-	(*GetClusterCardRequestTypeRequest)(nil),  // 29: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeRequest
-	(*GetClusterCardRequestTypeResponse)(nil), // 30: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeResponse
-	nil,                      // 31: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.LabelsEntry
-	nil,                      // 32: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.AnnotationsEntry
-	nil,                      // 33: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.LabelsEntry
-	nil,                      // 34: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.AnnotationsEntry
-	(*types.ObjectMeta)(nil), // 35: kantaloupe.dynamia.ai.api.types.ObjectMeta
-	(*types.Condition)(nil),  // 36: kantaloupe.dynamia.ai.api.types.Condition
-	(*types.SortOption)(nil), // 37: kantaloupe.dynamia.ai.api.types.SortOption
-	(*types.Pagination)(nil), // 38: kantaloupe.dynamia.ai.api.types.Pagination
+	(DeletionPolicy)(0),                       // 2: kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy
+	(DrainStrategy)(0),                        // 3: kantaloupe.dynamia.ai.api.clusters.v1alpha1.DrainStrategy
+	(ClusterState)(0),                         // 4: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
+	(RankOption)(0),                           // 5: kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption
+	(KantaloupePluginName)(0),                 // 6: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName
+	(*Cluster)(nil),                           // 7: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster
+	(*ClusterSpec)(nil),                       // 8: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec
+	(*MaintenancePolicy)(nil),                 // 9: kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenancePolicy
+	(*MaintenanceStatus)(nil),                 // 10: kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenanceStatus
+	(*ClusterStatus)(nil),                     // 11: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus
+	(*ResourceSummary)(nil),                   // 12: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
+	(*PlatformSummury)(nil),                   // 13: kantaloupe.dynamia.ai.api.clusters.v1alpha1.PlatformSummury
+	(*AcceleratorCardSummury)(nil),            // 14: kantaloupe.dynamia.ai.api.clusters.v1alpha1.AcceleratorCardSummury
+	(*GetPlatformSummuryRequest)(nil),         // 15: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformSummuryRequest
+	(*ListClustersRequest)(nil),               // 16: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest
+	(*ListClustersResponse)(nil),              // 17: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse
+	(*IntegrateClusterRequest)(nil),           // 18: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest
+	(*DeleteClusterRequest)(nil),              // 19: kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeleteClusterRequest
+	(*GetClusterRequest)(nil),                 // 20: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterRequest
+	(*ValidateKubeconfigRequest)(nil),         // 21: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ValidateKubeconfigRequest
+	(*ValidateKubeconfigResponse)(nil),        // 22: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ValidateKubeconfigResponse
+	(*UpdateClusterRequest)(nil),              // 23: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest
+	(*UpdateClusterMaintenanceRequest)(nil),   // 24: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterMaintenanceRequest
+	(*ListClusterVersionsResponse)(nil),       // 25: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClusterVersionsResponse
+	(*GetClusterCapabilitiesRequest)(nil),     // 26: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCapabilitiesRequest
+	(*RouteKind)(nil),                         // 27: kantaloupe.dynamia.ai.api.clusters.v1alpha1.RouteKind
+	(*ClusterCapabilities)(nil),               // 28: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterCapabilities
+	(*GPUSummary)(nil),                        // 29: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GPUSummary
+	(*GetPlatformGPUTopRequest)(nil),          // 30: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopRequest
+	(*GetPlatformGPUTopResponse)(nil),         // 31: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopResponse
+	(*KantaloupePlugin)(nil),                  // 32: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin
+	(*GetClusterPluginsRequest)(nil),          // 33: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsRequest
+	(*GetClusterPluginsResponse)(nil),         // 34: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsResponse
+	(*ResourceName)(nil),                      // 35: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceName
+	(*CardRequestType)(nil),                   // 36: kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType
+	(*GetClusterCardRequestTypeRequest)(nil),  // 37: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeRequest
+	(*GetClusterCardRequestTypeResponse)(nil), // 38: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeResponse
+	(*WatchClustersRequest)(nil),              // 39: kantaloupe.dynamia.ai.api.clusters.v1alpha1.WatchClustersRequest
+	(*WatchClustersResponse)(nil),             // 40: kantaloupe.dynamia.ai.api.clusters.v1alpha1.WatchClustersResponse
+	nil,                                       // 41: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.LabelsEntry
+	nil,                                       // 42: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.AnnotationsEntry
+	nil,                                       // 43: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.LabelsEntry
+	nil,                                       // 44: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.AnnotationsEntry
+	(*types.ObjectMeta)(nil),                  // 45: kantaloupe.dynamia.ai.api.types.ObjectMeta
+	(*types.Condition)(nil),                   // 46: kantaloupe.dynamia.ai.api.types.Condition
+	(*types.ClusterError)(nil),                // 47: kantaloupe.dynamia.ai.api.types.ClusterError
+	(*types.SortOption)(nil),                  // 48: kantaloupe.dynamia.ai.api.types.SortOption
+	(*types.Pagination)(nil),                  // 49: kantaloupe.dynamia.ai.api.types.Pagination
+	(types.WatchEventType)(0),                 // 50: kantaloupe.dynamia.ai.api.types.WatchEventType
 }
 var file_api_clusters_v1alpha1_cluster_proto_depIdxs = []int32{
-	35, // 0: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.metadata:type_name -> kantaloupe.dynamia.ai.api.types.ObjectMeta
-	6,  // 1: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.spec:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec
-	7,  // 2: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.status:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus
+	45, // 0: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.metadata:type_name -> kantaloupe.dynamia.ai.api.types.ObjectMeta
+	8,  // 1: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.spec:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec
+	11, // 2: kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster.status:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus
 	0,  // 3: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
 	1,  // 4: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec.type:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
-	8,  // 5: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.node_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
-	8,  // 6: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.pod_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
-	8,  // 7: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.kantaloupeflow_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
-	2,  // 8: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.state:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
-	36, // 9: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.conditions:type_name -> kantaloupe.dynamia.ai.api.types.Condition
-	10, // 10: kantaloupe.dynamia.ai.api.clusters.v1alpha1.PlatformSummury.accelerator_card_summury:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.AcceleratorCardSummury
-	1,  // 11: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.type:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
-	0,  // 12: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
-	2,  // 13: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.state:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
-	37, // 14: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.sort_option:type_name -> kantaloupe.dynamia.ai.api.types.SortOption
-	5,  // 15: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse.items:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster
-	38, // 16: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse.pagination:type_name -> kantaloupe.dynamia.ai.api.types.Pagination
-	0,  // 17: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
-	31, // 18: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.labels:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.LabelsEntry
-	32, // 19: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.annotations:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.AnnotationsEntry
-	1,  // 20: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.type:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
-	33, // 21: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.labels:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.LabelsEntry
-	34, // 22: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.annotations:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.AnnotationsEntry
-	3,  // 23: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopRequest.rank_option:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption
-	21, // 24: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopResponse.gpus:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.GPUSummary
-	4,  // 25: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin.name:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName
-	24, // 26: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsResponse.plugins:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin
-	27, // 27: kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType.resource_names:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceName
-	28, // 28: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeResponse.request_types:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	9,  // 5: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec.maintenance_policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenancePolicy
+	2,  // 6: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterSpec.deletion_policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy
+	3,  // 7: kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenancePolicy.drain_strategy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.DrainStrategy
+	12, // 8: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.node_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
+	12, // 9: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.pod_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
+	12, // 10: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.kantaloupeflow_summary:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceSummary
+	4,  // 11: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.state:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
+	46, // 12: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.conditions:type_name -> kantaloupe.dynamia.ai.api.types.Condition
+	10, // 13: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.maintenance:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenanceStatus
+	0,  // 14: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
+	1,  // 15: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterStatus.accelerator_types:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
+	14, // 16: kantaloupe.dynamia.ai.api.clusters.v1alpha1.PlatformSummury.accelerator_card_summury:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.AcceleratorCardSummury
+	47, // 17: kantaloupe.dynamia.ai.api.clusters.v1alpha1.PlatformSummury.cluster_errors:type_name -> kantaloupe.dynamia.ai.api.types.ClusterError
+	1,  // 18: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.type:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
+	0,  // 19: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
+	4,  // 20: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.state:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterState
+	48, // 21: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersRequest.sort_option:type_name -> kantaloupe.dynamia.ai.api.types.SortOption
+	7,  // 22: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse.items:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster
+	49, // 23: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ListClustersResponse.pagination:type_name -> kantaloupe.dynamia.ai.api.types.Pagination
+	0,  // 24: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.provider:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterProvider
+	41, // 25: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.labels:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.LabelsEntry
+	42, // 26: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.annotations:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.AnnotationsEntry
+	1,  // 27: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.type:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterType
+	2,  // 28: kantaloupe.dynamia.ai.api.clusters.v1alpha1.IntegrateClusterRequest.deletion_policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy
+	2,  // 29: kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeleteClusterRequest.deletion_policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy
+	43, // 30: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.labels:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.LabelsEntry
+	44, // 31: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.annotations:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.AnnotationsEntry
+	2,  // 32: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterRequest.deletion_policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.DeletionPolicy
+	9,  // 33: kantaloupe.dynamia.ai.api.clusters.v1alpha1.UpdateClusterMaintenanceRequest.policy:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.MaintenancePolicy
+	27, // 34: kantaloupe.dynamia.ai.api.clusters.v1alpha1.ClusterCapabilities.route_kinds:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.RouteKind
+	5,  // 35: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopRequest.rank_option:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.RankOption
+	29, // 36: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopResponse.gpus:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.GPUSummary
+	47, // 37: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetPlatformGPUTopResponse.cluster_errors:type_name -> kantaloupe.dynamia.ai.api.types.ClusterError
+	6,  // 38: kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin.name:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePluginName
+	32, // 39: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterPluginsResponse.plugins:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.KantaloupePlugin
+	35, // 40: kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType.resource_names:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.ResourceName
+	36, // 41: kantaloupe.dynamia.ai.api.clusters.v1alpha1.GetClusterCardRequestTypeResponse.request_types:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.CardRequestType
+	50, // 42: kantaloupe.dynamia.ai.api.clusters.v1alpha1.WatchClustersResponse.type:type_name -> kantaloupe.dynamia.ai.api.types.WatchEventType
+	7,  // 43: kantaloupe.dynamia.ai.api.clusters.v1alpha1.WatchClustersResponse.object:type_name -> kantaloupe.dynamia.ai.api.clusters.v1alpha1.Cluster
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_api_clusters_v1alpha1_cluster_proto_init() }
@@ -2694,7 +3701,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatus); i {
+			switch v := v.(*MaintenancePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2706,7 +3713,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceSummary); i {
+			switch v := v.(*MaintenanceStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,7 +3725,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlatformSummury); i {
+			switch v := v.(*ClusterStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,7 +3737,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceleratorCardSummury); i {
+			switch v := v.(*ResourceSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,7 +3749,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlatformSummuryRequest); i {
+			switch v := v.(*PlatformSummury); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,7 +3761,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListClustersRequest); i {
+			switch v := v.(*AcceleratorCardSummury); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,7 +3773,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListClustersResponse); i {
+			switch v := v.(*GetPlatformSummuryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,7 +3785,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntegrateClusterRequest); i {
+			switch v := v.(*ListClustersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,7 +3797,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteClusterRequest); i {
+			switch v := v.(*ListClustersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,7 +3809,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterRequest); i {
+			switch v := v.(*IntegrateClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,7 +3821,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateKubeconfigRequest); i {
+			switch v := v.(*DeleteClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2826,7 +3833,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateKubeconfigResponse); i {
+			switch v := v.(*GetClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2838,7 +3845,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateClusterRequest); i {
+			switch v := v.(*ValidateKubeconfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2850,7 +3857,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListClusterVersionsResponse); i {
+			switch v := v.(*ValidateKubeconfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2862,7 +3869,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GPUSummary); i {
+			switch v := v.(*UpdateClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2874,7 +3881,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlatformGPUTopRequest); i {
+			switch v := v.(*UpdateClusterMaintenanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2886,7 +3893,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlatformGPUTopResponse); i {
+			switch v := v.(*ListClusterVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2898,7 +3905,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KantaloupePlugin); i {
+			switch v := v.(*GetClusterCapabilitiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2910,7 +3917,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterPluginsRequest); i {
+			switch v := v.(*RouteKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2922,7 +3929,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterPluginsResponse); i {
+			switch v := v.(*ClusterCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2934,7 +3941,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceName); i {
+			switch v := v.(*GPUSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2946,7 +3953,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardRequestType); i {
+			switch v := v.(*GetPlatformGPUTopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2958,7 +3965,7 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterCardRequestTypeRequest); i {
+			switch v := v.(*GetPlatformGPUTopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2970,6 +3977,78 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 			}
 		}
 		file_api_clusters_v1alpha1_cluster_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KantaloupePlugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterPluginsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterPluginsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRequestType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterCardRequestTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetClusterCardRequestTypeResponse); i {
 			case 0:
 				return &v.state
@@ -2981,14 +4060,38 @@ func file_api_clusters_v1alpha1_cluster_proto_init() {
 				return nil
 			}
 		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_clusters_v1alpha1_cluster_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_clusters_v1alpha1_cluster_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   30,
+			NumEnums:      7,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
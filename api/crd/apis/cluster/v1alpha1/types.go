@@ -38,7 +38,8 @@ type ClusterSpec struct {
 	// +required
 	Provider string `json:"provider"`
 
-	// Type represents the type of the ai cluster.
+	// Type represents the type of the ai cluster, the accelerator types detected from the
+	// nodes are reported in the status if it is not specified.
 	// +required
 	Type string `json:"type"`
 
@@ -62,6 +63,23 @@ type ClusterSpec struct {
 	// +optional
 	GatewayAddress string `json:"gatewayAddress,omitempty"`
 
+	// Maintenance indicates that the member cluster is in maintenance mode,
+	// no new kantaloupeflows are accepted for a cluster in maintenance.
+	// +optional
+	Maintenance bool `json:"maintenance,omitempty"`
+
+	// MaintenancePolicy describes how the member cluster is drained in maintenance mode.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+
+	// DeletionPolicy describes what happens to the resources installed in the member
+	// cluster when the cluster is deleted, defaults to Orphan. The ServiceMonitors are
+	// removed by both policies.
+	// +kubebuilder:validation:Enum=Orphan;Cleanup
+	// +kubebuilder:default=Orphan
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	// ClusterID represents the uuid of the cluster.
 	ClusterId string `json:"clusterId"`
 }
@@ -78,6 +96,53 @@ type LocalSecretReference struct {
 	Name string `json:"name"`
 }
 
+// DrainStrategy describes how the kantaloupeflows are drained from a cluster in maintenance.
+type DrainStrategy string
+
+const (
+	// DrainStrategyNone keeps the kantaloupeflows running in the cluster.
+	DrainStrategyNone DrainStrategy = "None"
+	// DrainStrategyHibernate scales the kantaloupeflows to zero replicas,
+	// they are restored once the maintenance is over.
+	DrainStrategyHibernate DrainStrategy = "Hibernate"
+	// DrainStrategyMigrate recreates the kantaloupeflows in the target cluster.
+	DrainStrategyMigrate DrainStrategy = "Migrate"
+)
+
+// MaintenancePolicy describes how a member cluster is drained in maintenance mode.
+type MaintenancePolicy struct {
+	// CordonNodes indicates whether all nodes of the cluster are marked unschedulable.
+	// +optional
+	CordonNodes bool `json:"cordonNodes,omitempty"`
+
+	// DrainStrategy describes how the kantaloupeflows are drained, defaults to None.
+	// +kubebuilder:validation:Enum=None;Hibernate;Migrate
+	// +optional
+	DrainStrategy DrainStrategy `json:"drainStrategy,omitempty"`
+
+	// TargetCluster is the cluster the kantaloupeflows are migrated to,
+	// it is required by the Migrate drain strategy.
+	// +optional
+	TargetCluster string `json:"targetCluster,omitempty"`
+
+	// Reason is a human readable message of why the cluster is in maintenance.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// DeletionPolicy describes what happens to the resources installed in a member cluster
+// when the cluster is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyOrphan keeps the kantaloupeflows, the kantaloupe CRDs and the gateway
+	// listeners installed in the member cluster, only the ServiceMonitors are removed.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyCleanup removes the kantaloupeflows, the kantaloupe CRDs, the gateway
+	// listeners and the ServiceMonitors installed in the member cluster.
+	DeletionPolicyCleanup DeletionPolicy = "Cleanup"
+)
+
 // Define valid conditions of a member cluster.
 const (
 	// ClusterConditionReady means the cluster is healthy and ready to accept workloads.
@@ -85,6 +150,8 @@ const (
 	// ClusterConditionModuleReady means the cluster moduler is healthy and ready to accept workloads.
 	// e.g: gpu-operator and hami.
 	ClusterConditionModuleReady = "ModuleReady"
+	// ClusterConditionMaintenance means the cluster is in maintenance mode.
+	ClusterConditionMaintenance = "Maintenance"
 )
 
 // ClusterStatus is the status for a Cluster resource
@@ -97,6 +164,15 @@ type ClusterStatus struct {
 	// +optional
 	KubeSystemID string `json:"kubeSystemId,omitempty"`
 
+	// Provider represents the cloud provider detected from the node labels of the member cluster.
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// AcceleratorTypes represents the accelerator types of all vendors detected from the nodes
+	// of the member cluster, e.g. NVIDIA, METAX, ASCEND or NEURON.
+	// +optional
+	AcceleratorTypes []string `json:"acceleratorTypes,omitempty"`
+
 	// NodeSummary represents the summary of nodes status in the member cluster.
 	// +optional
 	NodeSummary *ResourceSummary `json:"nodeSummary,omitempty"`
@@ -110,6 +186,23 @@ type ClusterStatus struct {
 	// +optional
 	ResourceSummary *ClusterResourceSummary `json:"resourceSummary,omitempty"`
 
+	// GPUSummary represents the accelerator resources registered by HAMi in the member cluster.
+	// +optional
+	GPUSummary *GPUSummary `json:"gpuSummary,omitempty"`
+
+	// Capabilities represents the versions of the components installed in the member cluster
+	// and the KantaloupeFlow features they enable.
+	// +optional
+	Capabilities *ClusterCapabilities `json:"capabilities,omitempty"`
+
+	// Maintenance represents the progress of the maintenance of the member cluster.
+	// +optional
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+
+	// CleanupReport represents the progress of the cleanup of a cluster being deleted.
+	// +optional
+	CleanupReport *CleanupReport `json:"cleanupReport,omitempty"`
+
 	// Conditions is an array of current conditions
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -140,6 +233,167 @@ type ClusterResourceSummary struct {
 	Allocated corev1.ResourceList `json:"allocated,omitempty"`
 }
 
+// GPUSummary represents the summary of accelerator resources in the member cluster.
+type GPUSummary struct {
+	// Total is the number of physical accelerator cards of all vendors.
+	// +optional
+	Total int32 `json:"total,omitempty"`
+	// Vendors holds the accelerator totals of each vendor present in the cluster.
+	// +optional
+	Vendors []VendorGPUSummary `json:"vendors,omitempty"`
+}
+
+// VendorGPUSummary represents the accelerator totals of a single vendor.
+type VendorGPUSummary struct {
+	// Vendor is the accelerator vendor, e.g. NVIDIA, METAX, ASCEND or NEURON.
+	Vendor string `json:"vendor"`
+	// Cards is the number of physical accelerator cards.
+	// +optional
+	Cards int32 `json:"cards,omitempty"`
+	// VGPUSlots is the number of virtual devices the cards can be split into.
+	// +optional
+	VGPUSlots int32 `json:"vgpuSlots,omitempty"`
+	// Memory is the total device memory in MiB.
+	// +optional
+	Memory int64 `json:"memory,omitempty"`
+	// Cores is the total device cores, every card reports 100 cores for HAMi managed devices.
+	// +optional
+	Cores int32 `json:"cores,omitempty"`
+	// Models is the sorted list of card models of the vendor.
+	// +optional
+	Models []string `json:"models,omitempty"`
+}
+
+// KantaloupeFlowFeature is a feature of KantaloupeFlow depending on the components installed
+// in the member cluster.
+type KantaloupeFlowFeature string
+
+const (
+	// KantaloupeFlowFeatureHTTPRoute means the httproute networking is usable, it requires the HTTPRoute of gateway-api.
+	KantaloupeFlowFeatureHTTPRoute KantaloupeFlowFeature = "httproute"
+	// KantaloupeFlowFeatureTCPRoute means the tcproute networking is usable, it requires the TCPRoute of gateway-api.
+	KantaloupeFlowFeatureTCPRoute KantaloupeFlowFeature = "tcproute"
+	// KantaloupeFlowFeatureGPUSharing means the accelerators can be shared by the vGPU resources, it requires HAMi.
+	KantaloupeFlowFeatureGPUSharing KantaloupeFlowFeature = "gpu-sharing"
+)
+
+// ClusterCapabilities represents the versions of the components installed in the member cluster.
+type ClusterCapabilities struct {
+	// KubernetesVersion is the version of Kubernetes.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// HAMiVersion is the version of HAMi, it is empty if HAMi is not installed.
+	// +optional
+	HAMiVersion string `json:"hamiVersion,omitempty"`
+	// GatewayAPIVersion is the bundle version of the gateway-api CRDs, it is empty if gateway-api is not installed.
+	// +optional
+	GatewayAPIVersion string `json:"gatewayAPIVersion,omitempty"`
+	// RouteKinds holds the route kinds of gateway-api served by the member cluster.
+	// +optional
+	RouteKinds []RouteKind `json:"routeKinds,omitempty"`
+	// PrometheusVersion is the version of the Prometheus of the member cluster.
+	// +optional
+	PrometheusVersion string `json:"prometheusVersion,omitempty"`
+	// KantaloupeFlowFeatures holds the KantaloupeFlow features usable in the member cluster.
+	// +optional
+	KantaloupeFlowFeatures []KantaloupeFlowFeature `json:"kantaloupeFlowFeatures,omitempty"`
+}
+
+// RouteKind represents a route kind of gateway-api.
+type RouteKind struct {
+	// Kind is the kind of the route, e.g. HTTPRoute or TCPRoute.
+	Kind string `json:"kind"`
+	// Version is the preferred API version the route is served with, e.g. v1 or v1alpha2.
+	Version string `json:"version"`
+}
+
+// MaintenancePhase is the phase of the maintenance of a member cluster.
+type MaintenancePhase string
+
+const (
+	// MaintenancePhaseCordoning means the nodes of the cluster are being cordoned.
+	MaintenancePhaseCordoning MaintenancePhase = "Cordoning"
+	// MaintenancePhaseDraining means the kantaloupeflows of the cluster are being drained.
+	MaintenancePhaseDraining MaintenancePhase = "Draining"
+	// MaintenancePhaseCompleted means the cluster is ready for maintenance.
+	MaintenancePhaseCompleted MaintenancePhase = "Completed"
+)
+
+// MaintenanceStatus represents the progress of the maintenance of a member cluster.
+type MaintenanceStatus struct {
+	// Phase is the current phase of the maintenance.
+	// +optional
+	Phase MaintenancePhase `json:"phase,omitempty"`
+	// StartTime is the time the cluster entered maintenance mode.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CordonedNodes holds the nodes cordoned by the maintenance,
+	// only these nodes are uncordoned once the maintenance is over.
+	// +optional
+	CordonedNodes []string `json:"cordonedNodes,omitempty"`
+	// TotalFlows is the number of kantaloupeflows to drain.
+	// +optional
+	TotalFlows int32 `json:"totalFlows,omitempty"`
+	// DrainedFlows is the number of kantaloupeflows that have been drained.
+	// +optional
+	DrainedFlows int32 `json:"drainedFlows,omitempty"`
+	// FailedFlows is the number of kantaloupeflows failed to drain in the last attempt.
+	// +optional
+	FailedFlows int32 `json:"failedFlows,omitempty"`
+	// Message is a human readable message of the last drain attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CleanupResult is the result of the cleanup of a resource.
+type CleanupResult string
+
+const (
+	// CleanupResultDeleted means the resource has been deleted.
+	CleanupResultDeleted CleanupResult = "Deleted"
+	// CleanupResultPending means the resource is still being deleted.
+	CleanupResultPending CleanupResult = "Pending"
+	// CleanupResultOrphaned means the resource is kept by the deletion policy.
+	CleanupResultOrphaned CleanupResult = "Orphaned"
+	// CleanupResultSkipped means the resource is skipped since the member cluster is unreachable.
+	CleanupResultSkipped CleanupResult = "Skipped"
+	// CleanupResultFailed means the resource failed to be deleted.
+	CleanupResultFailed CleanupResult = "Failed"
+)
+
+// CleanupReport represents the cleanup of the resources of a cluster being deleted.
+type CleanupReport struct {
+	// Policy is the deletion policy the cleanup follows.
+	// +optional
+	Policy DeletionPolicy `json:"policy,omitempty"`
+	// StartTime is the time the cleanup started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Forced indicates that the cluster is removed without finishing the cleanup,
+	// since the member cluster is unreachable after the cleanup timeout.
+	// +optional
+	Forced bool `json:"forced,omitempty"`
+	// Resources holds the cleanup result of every resource.
+	// +optional
+	Resources []CleanupResource `json:"resources,omitempty"`
+}
+
+// CleanupResource represents the cleanup result of a single resource.
+type CleanupResource struct {
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Result is the result of the cleanup.
+	Result CleanupResult `json:"result"`
+	// Message is a human readable message of the result.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ClusterList contains a list of container instances.
@@ -1 +1,14 @@
 package v1alpha1
+
+// Vendor returns the accelerator summary of the given vendor, nil if the vendor is absent.
+func (s *GPUSummary) Vendor(vendor string) *VendorGPUSummary {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Vendors {
+		if s.Vendors[i].Vendor == vendor {
+			return &s.Vendors[i]
+		}
+	}
+	return nil
+}
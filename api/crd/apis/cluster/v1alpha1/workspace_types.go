@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// WorkspaceResourceKind is the kind for the Workspace resource
+	WorkspaceResourceKind = "Workspace"
+	// RoleAssignmentResourceKind is the kind for the RoleAssignment resource
+	RoleAssignmentResourceKind = "RoleAssignment"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Workspace is a tenant of the platform, it groups the namespaces of the member clusters
+// the tenant owns.
+type Workspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of Workspace.
+	Spec WorkspaceSpec `json:"spec"`
+}
+
+// WorkspaceSpec is the spec for a Workspace resource
+type WorkspaceSpec struct {
+	// Description is a human readable description of the workspace.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Namespaces holds the namespaces of the member clusters that belong to the workspace.
+	// +optional
+	Namespaces []WorkspaceNamespace `json:"namespaces,omitempty"`
+}
+
+// WorkspaceNamespace is a namespace of a member cluster.
+type WorkspaceNamespace struct {
+	// Cluster is the name of the member cluster.
+	Cluster string `json:"cluster"`
+	// Namespace is the name of the namespace in the member cluster.
+	Namespace string `json:"namespace"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkspaceList contains a list of workspaces.
+type WorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Workspace `json:"items"`
+}
+
+// Role is a set of permissions granted to the subjects of a RoleAssignment.
+type Role string
+
+const (
+	// RolePlatformAdmin manages everything of the platform, including the member clusters.
+	RolePlatformAdmin Role = "platform-admin"
+	// RoleClusterAdmin manages all resources of a member cluster, except integrating and
+	// deleting the cluster.
+	RoleClusterAdmin Role = "cluster-admin"
+	// RoleWorkspaceAdmin manages all resources in the namespaces of a workspace.
+	RoleWorkspaceAdmin Role = "workspace-admin"
+	// RoleMember views the resources and manages the kantaloupeflows in the namespaces of
+	// a workspace, the secrets and credentials are not visible to it.
+	RoleMember Role = "member"
+)
+
+// SubjectKind is the kind of a subject.
+type SubjectKind string
+
+const (
+	// SubjectKindUser means the subject is a user.
+	SubjectKindUser SubjectKind = "User"
+	// SubjectKindGroup means the subject is a group of users.
+	SubjectKindGroup SubjectKind = "Group"
+)
+
+// Subject is a user or a group the role is granted to.
+type Subject struct {
+	// Kind is the kind of the subject.
+	// +kubebuilder:validation:Enum=User;Group
+	Kind SubjectKind `json:"kind"`
+	// Name is the name of the user or the group.
+	Name string `json:"name"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:printcolumn:JSONPath=`.spec.role`,name="Role",type=string
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RoleAssignment grants a role to users and groups, in the scope of the platform, a member
+// cluster or a workspace depending on the role.
+type RoleAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of RoleAssignment.
+	Spec RoleAssignmentSpec `json:"spec"`
+}
+
+// RoleAssignmentSpec is the spec for a RoleAssignment resource
+type RoleAssignmentSpec struct {
+	// Role is the role granted to the subjects.
+	// +kubebuilder:validation:Enum=platform-admin;cluster-admin;workspace-admin;member
+	Role Role `json:"role"`
+
+	// Subjects holds the users and groups the role is granted to.
+	Subjects []Subject `json:"subjects"`
+
+	// Cluster is the member cluster the role is granted in, it is required by cluster-admin.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Workspace is the workspace the role is granted in, it is required by workspace-admin and member.
+	// +optional
+	Workspace string `json:"workspace,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RoleAssignmentList contains a list of role assignments.
+type RoleAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RoleAssignment `json:"items"`
+}
@@ -7,7 +7,7 @@ set -o pipefail
 # This script holds common bash variables and utility functions.
 
 function util::get_api_dirs {
-  dirs=( "types" "clusters" "kantaloupeflow" "nodes" "v1" "core" "monitoring" "credentials" "quotas" "storage" "acceleratorcard")
+  dirs=( "types" "clusters" "kantaloupeflow" "nodes" "v1" "core" "monitoring" "credentials" "quotas" "storage" "acceleratorcard" "audit" "apply" "operations")
   echo "${dirs[@]}"
   return $?
 }
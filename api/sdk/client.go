@@ -0,0 +1,186 @@
+// Package sdk is the Go client of the kantaloupe apiserver. It wraps the gRPC services of
+// kantaloupe.v1 with the bearer token authentication, the retries of the idempotent calls,
+// the typed errors, the iterators of the List RPCs and the helpers of the common workflows.
+//
+//	client, err := sdk.New("kantaloupe-apiserver:8000", sdk.WithToken(token), sdk.WithInsecure())
+//	if err != nil {
+//		return err
+//	}
+//	defer client.Close()
+//	for cluster, err := range client.ListClusters(ctx, &clustersv1alpha1.ListClustersRequest{}) {
+//		...
+//	}
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+)
+
+// Client is the client of the kantaloupe apiserver, the clients of the services share the
+// connection of it.
+type Client struct {
+	conn *grpc.ClientConn
+
+	Cluster         kantaloupeapi.ClusterClient
+	Core            kantaloupeapi.CoreClient
+	Monitoring      kantaloupeapi.MonitoringClient
+	Kantaloupeflow  kantaloupeapi.KantaloupeflowClient
+	Credential      kantaloupeapi.CredentialClient
+	Quota           kantaloupeapi.QuotaClient
+	Storage         kantaloupeapi.StorageClient
+	AcceleratorCard kantaloupeapi.AcceleratorCardClient
+	Audit           kantaloupeapi.AuditClient
+	Apply           kantaloupeapi.ApplyClient
+	Operations      kantaloupeapi.OperationsClient
+}
+
+// TokenSource returns the bearer token of the calls, e.g. to refresh the expiring tokens.
+type TokenSource func(ctx context.Context) (string, error)
+
+type options struct {
+	tokenSource TokenSource
+	insecure    bool
+	tlsConfig   *tls.Config
+	retry       RetryPolicy
+	dialOptions []grpc.DialOption
+}
+
+// Option configures the client.
+type Option func(*options)
+
+// WithToken authenticates the calls with the static bearer token.
+func WithToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// WithTokenSource authenticates the calls with the bearer tokens returned by source.
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithInsecure connects to the insecure port of the apiserver without TLS.
+func WithInsecure() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
+// WithTLSConfig connects to the secure port of the apiserver with the TLS config, e.g. with
+// the CA bundle of the apiserver or the client certificate. The system roots are used by
+// default.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithRetryPolicy replaces the DefaultRetryPolicy of the idempotent calls.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithDialOptions appends the options of the gRPC connection, e.g. more interceptors.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// New returns the client of the apiserver at target, e.g. kantaloupe-apiserver:8000. The
+// gRPC services are served on the same ports as the HTTP APIs.
+func New(target string, opts ...Option) (*Client, error) {
+	o := &options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if !o.insecure {
+		config := o.tlsConfig
+		if config == nil {
+			config = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		transportCredentials = credentials.NewTLS(config)
+	}
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		// the errors are typed after the retries, so the retries see the gRPC statuses.
+		grpc.WithChainUnaryInterceptor(errorUnaryInterceptor, o.retry.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(errorStreamInterceptor),
+	}
+	if o.tokenSource != nil {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(&tokenCredentials{source: o.tokenSource, insecure: o.insecure}))
+	}
+	dialOptions = append(dialOptions, o.dialOptions...)
+
+	conn, err := grpc.NewClient(target, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", target, err)
+	}
+	return NewFromConn(conn), nil
+}
+
+// NewFromConn returns the client of the connection, the connection is configured by the
+// caller, e.g. with the interceptors of the package.
+func NewFromConn(conn *grpc.ClientConn) *Client {
+	return &Client{
+		conn:            conn,
+		Cluster:         kantaloupeapi.NewClusterClient(conn),
+		Core:            kantaloupeapi.NewCoreClient(conn),
+		Monitoring:      kantaloupeapi.NewMonitoringClient(conn),
+		Kantaloupeflow:  kantaloupeapi.NewKantaloupeflowClient(conn),
+		Credential:      kantaloupeapi.NewCredentialClient(conn),
+		Quota:           kantaloupeapi.NewQuotaClient(conn),
+		Storage:         kantaloupeapi.NewStorageClient(conn),
+		AcceleratorCard: kantaloupeapi.NewAcceleratorCardClient(conn),
+		Audit:           kantaloupeapi.NewAuditClient(conn),
+		Apply:           kantaloupeapi.NewApplyClient(conn),
+		Operations:      kantaloupeapi.NewOperationsClient(conn),
+	}
+}
+
+// Close closes the connection of the client.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// tokenCredentials sends the bearer token in the authorization metadata of the calls.
+type tokenCredentials struct {
+	source   TokenSource
+	insecure bool
+}
+
+var _ credentials.PerRPCCredentials = (*tokenCredentials)(nil)
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := t.source(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the token: %w", err)
+	}
+	if token == "" {
+		return nil, errors.New("the token is empty")
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials, the tokens are sent
+// over the insecure connections only if they are configured explicitly.
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return !t.insecure
+}
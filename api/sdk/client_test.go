@@ -0,0 +1,373 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+)
+
+type fakeClusterServer struct {
+	kantaloupeapi.UnimplementedClusterServer
+
+	lock    sync.Mutex
+	calls   map[string]int
+	tokens  []string
+	getErrs []error
+}
+
+func (s *fakeClusterServer) record(ctx context.Context, method string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls[method]++
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.tokens = append(s.tokens, md.Get("authorization")...)
+}
+
+func (s *fakeClusterServer) GetCluster(ctx context.Context, req *clustersv1alpha1.GetClusterRequest) (*clustersv1alpha1.Cluster, error) {
+	s.record(ctx, "GetCluster")
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.getErrs) > 0 {
+		err := s.getErrs[0]
+		s.getErrs = s.getErrs[1:]
+		return nil, err
+	}
+	return &clustersv1alpha1.Cluster{Metadata: &types.ObjectMeta{Name: req.GetName()}}, nil
+}
+
+func (s *fakeClusterServer) DeleteCluster(ctx context.Context, _ *clustersv1alpha1.DeleteClusterRequest) (*emptypb.Empty, error) {
+	s.record(ctx, "DeleteCluster")
+	return nil, status.Error(codes.Unavailable, "unavailable")
+}
+
+// ListClusters returns the pages of 10 clusters by the page numbers.
+func (s *fakeClusterServer) ListClusters(ctx context.Context, req *clustersv1alpha1.ListClustersRequest) (*clustersv1alpha1.ListClustersResponse, error) {
+	s.record(ctx, "ListClusters")
+	page := max(req.GetPage(), 1)
+	resp := &clustersv1alpha1.ListClustersResponse{Pagination: &types.Pagination{Total: 10, Page: page, PageSize: 4, Pages: 3}}
+	for i := (page - 1) * 4; i < min(page*4, 10); i++ {
+		resp.Items = append(resp.Items, &clustersv1alpha1.Cluster{Metadata: &types.ObjectMeta{Name: strconv.Itoa(int(i))}})
+	}
+	return resp, nil
+}
+
+type fakeKantaloupeflowServer struct {
+	kantaloupeapi.UnimplementedKantaloupeflowServer
+
+	lock   sync.Mutex
+	states []flowv1alpha1.KantaloupeflowState
+}
+
+// ListKantaloupeflows returns the pages of 5 kantaloupeflows by the continue cursors.
+func (s *fakeKantaloupeflowServer) ListKantaloupeflows(_ context.Context, req *flowv1alpha1.ListKantaloupeflowsRequest) (*flowv1alpha1.ListKantaloupeflowsResponse, error) {
+	start := 0
+	if req.GetContinue() != "" {
+		start, _ = strconv.Atoi(req.GetContinue())
+	}
+	resp := &flowv1alpha1.ListKantaloupeflowsResponse{Pagination: &types.Pagination{Total: 5, Page: 1, PageSize: 2, Pages: 3}}
+	for i := start; i < min(start+2, 5); i++ {
+		resp.Items = append(resp.Items, &flowv1alpha1.Kantaloupeflow{Metadata: &types.ObjectMeta{Name: strconv.Itoa(i)}})
+	}
+	if start+2 < 5 {
+		resp.Pagination.Continue = strconv.Itoa(start + 2)
+	}
+	return resp, nil
+}
+
+func (s *fakeKantaloupeflowServer) CreateKantaloupeflow(_ context.Context, req *flowv1alpha1.CreateKantaloupeflowRequest) (*flowv1alpha1.Kantaloupeflow, error) {
+	return req.GetData(), nil
+}
+
+// GetKantaloupeflow returns the states in order, the kantaloupeflow is not found before.
+func (s *fakeKantaloupeflowServer) GetKantaloupeflow(_ context.Context, req *flowv1alpha1.GetKantaloupeflowRequest) (*flowv1alpha1.GetKantaloupeflowResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.states) == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	state := s.states[0]
+	if len(s.states) > 1 {
+		s.states = s.states[1:]
+	}
+	return &flowv1alpha1.GetKantaloupeflowResponse{Kantaloupeflow: &flowv1alpha1.Kantaloupeflow{
+		Metadata: &types.ObjectMeta{Namespace: req.GetNamespace(), Name: req.GetName()},
+		Status: &flowv1alpha1.KantaloupeflowStatus{
+			State:      state,
+			Conditions: []*types.Condition{{Type: "Available", Status: "False", Message: "image pull failed"}},
+		},
+	}}, nil
+}
+
+// newTestClient returns the client of the fake servers, register registers the other services.
+func newTestClient(t *testing.T, clusters *fakeClusterServer, flows *fakeKantaloupeflowServer, register ...func(*grpc.Server)) *Client {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	kantaloupeapi.RegisterClusterServer(server, clusters)
+	kantaloupeapi.RegisterKantaloupeflowServer(server, flows)
+	for _, r := range register {
+		r(server)
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := New("passthrough:///bufnet",
+		WithInsecure(),
+		WithToken("token"),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestClientRetry(t *testing.T) {
+	clusters := &fakeClusterServer{calls: map[string]int{}, getErrs: []error{
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.Aborted, "aborted"),
+	}}
+	client := newTestClient(t, clusters, &fakeKantaloupeflowServer{})
+	ctx := context.Background()
+
+	// the idempotent calls are retried.
+	cluster, err := client.Cluster.GetCluster(ctx, &clustersv1alpha1.GetClusterRequest{Name: "c1"})
+	if err != nil || cluster.GetMetadata().GetName() != "c1" {
+		t.Fatalf("GetCluster() = %v, %v", cluster, err)
+	}
+	if clusters.calls["GetCluster"] != 3 {
+		t.Errorf("GetCluster is called %d times, want 3", clusters.calls["GetCluster"])
+	}
+
+	// the others are not.
+	_, err = client.Cluster.DeleteCluster(ctx, &clustersv1alpha1.DeleteClusterRequest{Name: "c1"})
+	if !errors.Is(err, ErrUnavailable) || status.Code(err) != codes.Unavailable {
+		t.Errorf("DeleteCluster() error = %v, want %v", err, ErrUnavailable)
+	}
+	if clusters.calls["DeleteCluster"] != 1 {
+		t.Errorf("DeleteCluster is called %d times, want 1", clusters.calls["DeleteCluster"])
+	}
+
+	// unless they carry an idempotency key.
+	_, err = client.Cluster.DeleteCluster(WithIdempotencyKey(ctx, "delete-c1"), &clustersv1alpha1.DeleteClusterRequest{Name: "c1"})
+	if !errors.Is(err, ErrUnavailable) || clusters.calls["DeleteCluster"] != 4 {
+		t.Errorf("DeleteCluster() error = %v after %d calls, want retried 3 times", err, clusters.calls["DeleteCluster"])
+	}
+
+	// the calls fail after the attempts.
+	clusters.getErrs = []error{status.Error(codes.Unavailable, "1"), status.Error(codes.Unavailable, "2"), status.Error(codes.Unavailable, "3")}
+	if _, err = client.Cluster.GetCluster(ctx, &clustersv1alpha1.GetClusterRequest{Name: "c1"}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("GetCluster() error = %v, want %v", err, ErrUnavailable)
+	}
+
+	for _, token := range clusters.tokens {
+		if token != "Bearer token" {
+			t.Errorf("authorization = %q, want the bearer token", token)
+		}
+	}
+	if len(clusters.tokens) != 10 {
+		t.Errorf("%d calls are authorized, want 10", len(clusters.tokens))
+	}
+}
+
+func TestFromError(t *testing.T) {
+	st, err := status.New(codes.ResourceExhausted, "too many requests").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(3 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	typed := &Error{}
+	if !errors.As(FromError(st.Err()), &typed) {
+		t.Fatalf("FromError() = %T, want *Error", FromError(st.Err()))
+	}
+	if typed.Code != codes.ResourceExhausted || typed.Message != "too many requests" || typed.RetryAfter != 3*time.Second {
+		t.Errorf("FromError() = %+v", typed)
+	}
+	if !errors.Is(typed, ErrRateLimited) || errors.Is(typed, ErrUnavailable) {
+		t.Errorf("FromError() does not match the sentinels of its code")
+	}
+	if plain := errors.New("plain"); FromError(plain) != plain {
+		t.Errorf("FromError() changes the errors without a status")
+	}
+
+	// the fields owned by another manager conflict, retrying does not resolve it.
+	st, err = status.New(codes.Aborted, "conflict").WithDetails(&types.ErrorDetail{Reason: types.ErrorReason_CONFLICT, Field: "spec.hard"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.As(FromError(st.Err()), &typed) {
+		t.Fatalf("FromError() = %T, want *Error", FromError(st.Err()))
+	}
+	if typed.Reason() != types.ErrorReason_CONFLICT || typed.Detail.GetField() != "spec.hard" || typed.Retryable() {
+		t.Errorf("FromError() = %+v, want the detail of the conflict", typed)
+	}
+	if !FromError(status.Error(codes.Aborted, "aborted")).(*Error).Retryable() {
+		t.Errorf("the Aborted errors without a detail are not retryable")
+	}
+}
+
+func TestListAll(t *testing.T) {
+	clusters := &fakeClusterServer{calls: map[string]int{}}
+	client := newTestClient(t, clusters, &fakeKantaloupeflowServer{})
+	ctx := context.Background()
+
+	var names []string
+	for cluster, err := range client.ListClusters(ctx, &clustersv1alpha1.ListClustersRequest{}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, cluster.GetMetadata().GetName())
+	}
+	if len(names) != 10 || names[9] != "9" || clusters.calls["ListClusters"] != 3 {
+		t.Errorf("ListClusters() = %v in %d calls, want 10 clusters in 3 calls", names, clusters.calls["ListClusters"])
+	}
+
+	// the pages are fetched as the items are consumed.
+	clusters.calls["ListClusters"] = 0
+	for range client.ListClusters(ctx, &clustersv1alpha1.ListClustersRequest{}) {
+		break
+	}
+	if clusters.calls["ListClusters"] != 1 {
+		t.Errorf("ListClusters is called %d times, want 1", clusters.calls["ListClusters"])
+	}
+
+	names = nil
+	req := &flowv1alpha1.ListKantaloupeflowsRequest{PageSize: 2}
+	for flow, err := range client.ListKantaloupeflows(ctx, req) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, flow.GetMetadata().GetName())
+	}
+	if len(names) != 5 || names[4] != "4" {
+		t.Errorf("ListKantaloupeflows() = %v, want 5 kantaloupeflows by the cursors", names)
+	}
+	if req.GetContinue() != "" {
+		t.Errorf("ListKantaloupeflows() changes the request")
+	}
+}
+
+func TestCreateKantaloupeflowAndWait(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	flows := &fakeKantaloupeflowServer{}
+	client := newTestClient(t, &fakeClusterServer{calls: map[string]int{}}, flows)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req := &flowv1alpha1.CreateKantaloupeflowRequest{
+		Cluster: "c1",
+		Data:    &flowv1alpha1.Kantaloupeflow{Metadata: &types.ObjectMeta{Namespace: "default", Name: "f1"}},
+	}
+
+	flows.states = []flowv1alpha1.KantaloupeflowState{flowv1alpha1.KantaloupeflowState_Progressing, flowv1alpha1.KantaloupeflowState_Running}
+	flow, err := client.CreateKantaloupeflowAndWait(ctx, req)
+	if err != nil || flow.GetStatus().GetState() != flowv1alpha1.KantaloupeflowState_Running {
+		t.Errorf("CreateKantaloupeflowAndWait() = %v, %v, want the running kantaloupeflow", flow, err)
+	}
+
+	flows.states = []flowv1alpha1.KantaloupeflowState{flowv1alpha1.KantaloupeflowState_Falied}
+	if _, err = client.CreateKantaloupeflowAndWait(ctx, req); !errors.Is(err, ErrKantaloupeflowFailed) {
+		t.Errorf("CreateKantaloupeflowAndWait() error = %v, want %v", err, ErrKantaloupeflowFailed)
+	}
+
+	flows.states = []flowv1alpha1.KantaloupeflowState{flowv1alpha1.KantaloupeflowState_Progressing}
+	shortCtx, shortCancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer shortCancel()
+	if _, err = client.CreateKantaloupeflowAndWait(shortCtx, req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CreateKantaloupeflowAndWait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+type fakeOperationsServer struct {
+	kantaloupeapi.UnimplementedOperationsServer
+
+	lock       sync.Mutex
+	operations []*operationsv1alpha1.Operation
+}
+
+// GetOperation returns the operations in order, the last one is returned again.
+func (s *fakeOperationsServer) GetOperation(_ context.Context, req *operationsv1alpha1.GetOperationRequest) (*operationsv1alpha1.Operation, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.operations) == 0 {
+		return nil, status.Errorf(codes.NotFound, "operation %s is not found", req.GetId())
+	}
+	op := s.operations[0]
+	if len(s.operations) > 1 {
+		s.operations = s.operations[1:]
+	}
+	return op, nil
+}
+
+func TestWaitOperation(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	operations := &fakeOperationsServer{}
+	client := newTestClient(t, &fakeClusterServer{calls: map[string]int{}}, &fakeKantaloupeflowServer{}, func(s *grpc.Server) {
+		kantaloupeapi.RegisterOperationsServer(s, operations)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := anypb.New(&clustersv1alpha1.Cluster{Metadata: &types.ObjectMeta{Name: "c1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	running := &operationsv1alpha1.Operation{Id: "op", State: operationsv1alpha1.OperationState_RUNNING, Progress: 50}
+	operations.operations = []*operationsv1alpha1.Operation{running, {
+		Id: "op", State: operationsv1alpha1.OperationState_SUCCEEDED, Progress: 100, Done: true, Result: result,
+	}}
+	op, err := client.WaitOperation(ctx, "op")
+	cluster := &clustersv1alpha1.Cluster{}
+	if err != nil || op.GetState() != operationsv1alpha1.OperationState_SUCCEEDED || op.GetResult().UnmarshalTo(cluster) != nil || cluster.GetMetadata().GetName() != "c1" {
+		t.Errorf("WaitOperation() = %v, %v, want the succeeded operation with the cluster", op, err)
+	}
+
+	operations.operations = []*operationsv1alpha1.Operation{{
+		Id: "op", State: operationsv1alpha1.OperationState_FAILED, Done: true,
+		Error: &operationsv1alpha1.OperationError{
+			Code:    int32(codes.AlreadyExists),
+			Message: "cluster c1 already exists",
+			Detail:  &types.ErrorDetail{Reason: types.ErrorReason_ALREADY_EXISTS},
+		},
+	}}
+	_, err = client.WaitOperation(ctx, "op")
+	var typed *Error
+	if !errors.Is(err, ErrAlreadyExists) || !errors.As(err, &typed) || typed.Reason() != types.ErrorReason_ALREADY_EXISTS {
+		t.Errorf("WaitOperation() error = %v, want %v with the reason", err, ErrAlreadyExists)
+	}
+
+	operations.operations = []*operationsv1alpha1.Operation{running}
+	shortCtx, shortCancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer shortCancel()
+	if op, err = client.WaitOperation(shortCtx, "op"); !errors.Is(err, context.DeadlineExceeded) || op.GetProgress() != 50 {
+		t.Errorf("WaitOperation() = %v, %v, want the running operation and %v", op, err, context.DeadlineExceeded)
+	}
+}
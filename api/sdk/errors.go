@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+// The sentinel errors of the gRPC codes, the errors returned by the client match them with
+// errors.Is, e.g. errors.Is(err, sdk.ErrNotFound).
+var (
+	ErrCanceled           = &Error{Code: codes.Canceled}
+	ErrInvalidArgument    = &Error{Code: codes.InvalidArgument}
+	ErrDeadlineExceeded   = &Error{Code: codes.DeadlineExceeded}
+	ErrNotFound           = &Error{Code: codes.NotFound}
+	ErrAlreadyExists      = &Error{Code: codes.AlreadyExists}
+	ErrPermissionDenied   = &Error{Code: codes.PermissionDenied}
+	ErrRateLimited        = &Error{Code: codes.ResourceExhausted}
+	ErrFailedPrecondition = &Error{Code: codes.FailedPrecondition}
+	ErrAborted            = &Error{Code: codes.Aborted}
+	ErrUnimplemented      = &Error{Code: codes.Unimplemented}
+	ErrInternal           = &Error{Code: codes.Internal}
+	ErrUnavailable        = &Error{Code: codes.Unavailable}
+	ErrUnauthenticated    = &Error{Code: codes.Unauthenticated}
+)
+
+// Error is the error of a call returned by the apiserver.
+type Error struct {
+	// Code is the gRPC code of the error.
+	Code codes.Code
+	// Message is the message of the apiserver.
+	Message string
+	// RetryAfter is the delay the apiserver asks to wait before retrying, e.g. when the
+	// call is rate limited, it is zero if there is none.
+	RetryAfter time.Duration
+	// Detail is the machine-readable detail of the error, e.g. its reason and the invalid
+	// field, it is nil if the error did not come from the apiserver.
+	Detail *types.ErrorDetail
+
+	status *status.Status
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return "kantaloupe: " + e.Code.String()
+	}
+	return "kantaloupe: " + e.Code.String() + ": " + e.Message
+}
+
+// Is matches the errors of the same code, so the sentinel errors match the errors of the
+// calls.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// GRPCStatus returns the gRPC status of the error, so status.FromError and status.Code
+// keep working on the errors of the client.
+func (e *Error) GRPCStatus() *status.Status {
+	if e.status == nil {
+		return status.New(e.Code, e.Message)
+	}
+	return e.status
+}
+
+// Reason returns the reason of the error, e.g. types.ErrorReason_QUOTA_EXCEEDED.
+func (e *Error) Reason() types.ErrorReason {
+	return e.Detail.GetReason()
+}
+
+// Retryable returns whether the same call may succeed if it is retried later, the errors
+// without a detail are retryable if they are Unavailable, ResourceExhausted or Aborted.
+func (e *Error) Retryable() bool {
+	if e.Detail != nil {
+		return e.Detail.GetRetryable()
+	}
+	switch e.Code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Details returns the details of the error, e.g. *types.ErrorDetail.
+func (e *Error) Details() []interface{} {
+	return e.GRPCStatus().Details()
+}
+
+// FromError returns the typed error of the gRPC error, the errors without a gRPC status,
+// e.g. io.EOF, are returned as they are.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var typed *Error
+	if errors.As(err, &typed) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	typed = &Error{Code: st.Code(), Message: st.Message(), status: st}
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.RetryInfo:
+			if detail.GetRetryDelay() != nil {
+				typed.RetryAfter = detail.GetRetryDelay().AsDuration()
+			}
+		case *types.ErrorDetail:
+			typed.Detail = detail
+		}
+	}
+	return typed
+}
+
+func errorUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return FromError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func errorStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, FromError(err)
+	}
+	return &errorClientStream{ClientStream: stream}, nil
+}
+
+// errorClientStream types the errors of the messages of the stream, io.EOF still ends it.
+type errorClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorClientStream) SendMsg(m interface{}) error {
+	if err := s.ClientStream.SendMsg(m); err != io.EOF {
+		return FromError(err)
+	}
+	return io.EOF
+}
+
+func (s *errorClientStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != io.EOF {
+		return FromError(err)
+	}
+	return io.EOF
+}
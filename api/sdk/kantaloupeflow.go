@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+)
+
+// pollInterval is how often the workflows poll the state of the resources.
+var pollInterval = 2 * time.Second
+
+// ErrKantaloupeflowFailed is returned when the kantaloupeflow waited for fails.
+var ErrKantaloupeflowFailed = errors.New("kantaloupeflow failed")
+
+// CreateKantaloupeflowAndWait creates the kantaloupeflow and waits until it is ready, see
+// WaitForKantaloupeflowReady.
+func (c *Client) CreateKantaloupeflowAndWait(ctx context.Context, req *flowv1alpha1.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*flowv1alpha1.Kantaloupeflow, error) {
+	created, err := c.Kantaloupeflow.CreateKantaloupeflow(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForKantaloupeflowReady(ctx, &flowv1alpha1.GetKantaloupeflowRequest{
+		Cluster:   req.GetCluster(),
+		Namespace: created.GetMetadata().GetNamespace(),
+		Name:      created.GetMetadata().GetName(),
+	}, opts...)
+}
+
+// WaitForKantaloupeflowReady polls the kantaloupeflow every 2 seconds until it is
+// running, i.e. its replicas are available. It returns ErrKantaloupeflowFailed with the
+// messages of the conditions if the kantaloupeflow fails, or the error of ctx if it is done
+// first, so the wait is bounded by the deadline of ctx.
+func (c *Client) WaitForKantaloupeflowReady(ctx context.Context, req *flowv1alpha1.GetKantaloupeflowRequest, opts ...grpc.CallOption) (*flowv1alpha1.Kantaloupeflow, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var flow *flowv1alpha1.Kantaloupeflow
+	for {
+		resp, err := c.Kantaloupeflow.GetKantaloupeflow(ctx, req, opts...)
+		// the kantaloupeflow may not be cached by the apiserver right after its creation.
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			if ctx.Err() != nil {
+				return flow, fmt.Errorf("waiting for kantaloupeflow %s/%s to be ready: %w", req.GetNamespace(), req.GetName(), ctx.Err())
+			}
+			return flow, err
+		}
+		if err == nil {
+			flow = resp.GetKantaloupeflow()
+			switch flow.GetStatus().GetState() {
+			case flowv1alpha1.KantaloupeflowState_Running:
+				return flow, nil
+			case flowv1alpha1.KantaloupeflowState_Falied:
+				return flow, fmt.Errorf("%w: %s/%s: %s", ErrKantaloupeflowFailed, req.GetNamespace(), req.GetName(), conditionMessages(flow))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return flow, fmt.Errorf("waiting for kantaloupeflow %s/%s to be ready: %w", req.GetNamespace(), req.GetName(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func conditionMessages(flow *flowv1alpha1.Kantaloupeflow) string {
+	var messages []string
+	for _, condition := range flow.GetStatus().GetConditions() {
+		if condition.GetMessage() != "" {
+			messages = append(messages, condition.GetType()+": "+condition.GetMessage())
+		}
+	}
+	if len(messages) == 0 {
+		return "no condition message"
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	acceleratorcardv1alpha1 "github.com/dynamia-ai/kantaloupe/api/acceleratorcard/v1alpha1"
+	auditv1alpha1 "github.com/dynamia-ai/kantaloupe/api/audit/v1alpha1"
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	credentialv1alpha1 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	quotav1alpha1 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
+	storagev1alpha1 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+// listResponse is the response of the List RPCs returning the pages of the items.
+type listResponse[T any] interface {
+	GetItems() []T
+	GetPagination() *types.Pagination
+}
+
+// listAll iterates the items of all the pages of the List RPC from the page of req, the pages
+// are fetched lazily as the items are consumed. The pages are followed by the continue cursors
+// if the request has the continue field, so the items added or removed meanwhile do not shift
+// the pages, or by the page numbers otherwise. The iteration ends after the first error.
+func listAll[Req proto.Message, Resp listResponse[T], T any](ctx context.Context, req Req, list func(context.Context, Req, ...grpc.CallOption) (Resp, error), opts ...grpc.CallOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		// the request of the caller is not changed.
+		req = proto.Clone(req).(Req)
+		message := req.ProtoReflect()
+		fields := message.Descriptor().Fields()
+		pageField, continueField := fields.ByName("page"), fields.ByName("continue")
+
+		for {
+			resp, err := list(ctx, req, opts...)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			items := resp.GetItems()
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			pagination := resp.GetPagination()
+			switch {
+			case len(items) == 0 || pagination == nil:
+				return
+			case continueField != nil && pagination.GetContinue() != "":
+				message.Set(continueField, protoreflect.ValueOfString(pagination.GetContinue()))
+			case continueField != nil && message.Get(continueField).String() != "":
+				// the last page of the cursors.
+				return
+			case pageField != nil && pagination.GetPage() < pagination.GetPages():
+				message.Set(pageField, protoreflect.ValueOfInt32(pagination.GetPage()+1))
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListClusters iterates the clusters of all the pages from the page of req.
+func (c *Client) ListClusters(ctx context.Context, req *clustersv1alpha1.ListClustersRequest, opts ...grpc.CallOption) iter.Seq2[*clustersv1alpha1.Cluster, error] {
+	return listAll(ctx, req, c.Cluster.ListClusters, opts...)
+}
+
+// ListKantaloupeflows iterates the kantaloupeflows of all the pages from the page of req.
+func (c *Client) ListKantaloupeflows(ctx context.Context, req *flowv1alpha1.ListKantaloupeflowsRequest, opts ...grpc.CallOption) iter.Seq2[*flowv1alpha1.Kantaloupeflow, error] {
+	return listAll(ctx, req, c.Kantaloupeflow.ListKantaloupeflows, opts...)
+}
+
+// ListNodes iterates the nodes of all the pages from the page of req.
+func (c *Client) ListNodes(ctx context.Context, req *corev1alpha1.ListNodesRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.Node, error] {
+	return listAll(ctx, req, c.Core.ListNodes, opts...)
+}
+
+// ListClusterNamespaces iterates the namespaces of all the pages from the page of req.
+func (c *Client) ListClusterNamespaces(ctx context.Context, req *corev1alpha1.ListClusterNamespacesRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.Namespace, error] {
+	return listAll(ctx, req, c.Core.ListClusterNamespaces, opts...)
+}
+
+// ListPersistentVolumes iterates the persistent volumes of all the pages from the page of req.
+func (c *Client) ListPersistentVolumes(ctx context.Context, req *corev1alpha1.ListPersistentVolumesRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.PersistentVolume, error] {
+	return listAll(ctx, req, c.Core.ListPersistentVolumes, opts...)
+}
+
+// ListSecrets iterates the secrets of all the pages from the page of req.
+func (c *Client) ListSecrets(ctx context.Context, req *corev1alpha1.ListSecretsRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.Secret, error] {
+	return listAll(ctx, req, c.Core.ListSecrets, opts...)
+}
+
+// ListEvents iterates the events of all the pages from the page of req.
+func (c *Client) ListEvents(ctx context.Context, req *corev1alpha1.ListEventsRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.Event, error] {
+	return listAll(ctx, req, c.Core.ListEvents, opts...)
+}
+
+// ListClusterEvents iterates the events of the cluster of all the pages from the page of req.
+func (c *Client) ListClusterEvents(ctx context.Context, req *corev1alpha1.ListClusterEventsRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.Event, error] {
+	return listAll(ctx, req, c.Core.ListClusterEvents, opts...)
+}
+
+// ListCredentials iterates the credentials of all the pages from the page of req.
+func (c *Client) ListCredentials(ctx context.Context, req *credentialv1alpha1.ListCredentialsRequest, opts ...grpc.CallOption) iter.Seq2[*credentialv1alpha1.CredentialResponse, error] {
+	return listAll(ctx, req, c.Credential.ListCredentials, opts...)
+}
+
+// ListQuotas iterates the quotas of all the pages from the page of req.
+func (c *Client) ListQuotas(ctx context.Context, req *quotav1alpha1.ListQuotasRequest, opts ...grpc.CallOption) iter.Seq2[*quotav1alpha1.QuotaResponse, error] {
+	return listAll(ctx, req, c.Quota.ListQuotas, opts...)
+}
+
+// ListStorages iterates the storages of all the pages from the page of req.
+func (c *Client) ListStorages(ctx context.Context, req *storagev1alpha1.ListStoragesRequest, opts ...grpc.CallOption) iter.Seq2[*corev1alpha1.PersistentVolumeClaim, error] {
+	return listAll(ctx, req, c.Storage.ListStorages, opts...)
+}
+
+// ListStorageClasses iterates the storage classes of all the pages from the page of req.
+func (c *Client) ListStorageClasses(ctx context.Context, req *storagev1alpha1.ListStorageClassesRequest, opts ...grpc.CallOption) iter.Seq2[*storagev1alpha1.StorageClass, error] {
+	return listAll(ctx, req, c.Storage.ListStorageClasses, opts...)
+}
+
+// ListAcceleratorCards iterates the accelerator cards of all the pages from the page of req.
+func (c *Client) ListAcceleratorCards(ctx context.Context, req *acceleratorcardv1alpha1.ListAcceleratorCardsRequest, opts ...grpc.CallOption) iter.Seq2[*acceleratorcardv1alpha1.AcceleratorCard, error] {
+	return listAll(ctx, req, c.AcceleratorCard.ListAcceleratorCard, opts...)
+}
+
+// ListAuditEvents iterates the audit events of all the pages from the page of req.
+func (c *Client) ListAuditEvents(ctx context.Context, req *auditv1alpha1.ListAuditEventsRequest, opts ...grpc.CallOption) iter.Seq2[*auditv1alpha1.AuditEvent, error] {
+	return listAll(ctx, req, c.Audit.ListAuditEvents, opts...)
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+)
+
+// IdempotencyKeyHeader is the metadata of the idempotency key of a call.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// WithIdempotencyKey returns the context of the calls carrying the idempotency key. The
+// apiserver performs the calls of the same user, method and key once and replays the response
+// of the first successful one, so the calls carrying a key are retried even if they have side
+// effects. Reusing a key with a different request fails with ErrInvalidArgument.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, key)
+}
+
+func hasIdempotencyKey(ctx context.Context) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return len(md.Get(IdempotencyKeyHeader)) > 0
+}
+
+// WaitOperation polls the operation every 2 seconds until it is done, e.g. the one returned
+// by IntegrateClusterAsync. It returns the operation and its error if it failed or was canceled,
+// e.g. errors.Is(err, sdk.ErrCanceled), or the last polled operation and the error of ctx if it
+// is done first. The operation keeps running when the wait is given up, cancel it with
+// Operations.CancelOperation.
+func (c *Client) WaitOperation(ctx context.Context, id string, opts ...grpc.CallOption) (*operationsv1alpha1.Operation, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var op *operationsv1alpha1.Operation
+	for {
+		resp, err := c.Operations.GetOperation(ctx, &operationsv1alpha1.GetOperationRequest{Id: id}, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return op, fmt.Errorf("waiting for operation %s: %w", id, ctx.Err())
+			}
+			return op, err
+		}
+		op = resp
+		if op.GetDone() {
+			return op, OperationError(op)
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, fmt.Errorf("waiting for operation %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// OperationError returns the typed error of the operation, it is nil if the operation is
+// running or succeeded.
+func OperationError(op *operationsv1alpha1.Operation) error {
+	e := op.GetError()
+	if e == nil {
+		return nil
+	}
+	st := status.New(codes.Code(e.GetCode()), e.GetMessage())
+	if e.GetDetail() != nil {
+		if withDetails, err := st.WithDetails(e.GetDetail()); err == nil {
+			st = withDetails
+		}
+	}
+	return FromError(st.Err())
+}
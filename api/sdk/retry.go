@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// idempotentPrefixes are the prefixes of the names of the methods without side effects,
+// they are retried safely.
+var idempotentPrefixes = []string{"Get", "List", "Validate", "Download"}
+
+// RetryPolicy is the policy of retrying the idempotent calls failed transiently, i.e. with
+// the errors the apiserver marks retryable, see Error.Retryable. The calls with side effects,
+// e.g. the creations, are retried only if they carry an idempotency key, see WithIdempotencyKey.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of a call including the first one, the calls
+	// are not retried if it is less than 2.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, it is doubled on every retry up
+	// to MaxBackoff. The delays are jittered by up to 20%.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of the client by default.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// NoRetry disables the retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+func (p RetryPolicy) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if p.MaxAttempts < 2 || (!isIdempotent(method) && !hasIdempotencyKey(ctx)) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil || attempt >= p.MaxAttempts {
+			return err
+		}
+		typed, ok := FromError(err).(*Error)
+		if !ok || !typed.Retryable() {
+			return err
+		}
+
+		delay := jitter(backoff)
+		// the delay asked by the apiserver takes precedence, e.g. the one of the rate limits.
+		if typed.RetryAfter > delay {
+			delay = typed.RetryAfter
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, p.MaxBackoff)
+	}
+}
+
+func isIdempotent(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range idempotentPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d + time.Duration(rand.Int64N(int64(d)/5+1))
+}
@@ -0,0 +1,70 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as KantaloupeDynamiaAiApiClustersV1alpha1Cluster from "../../clusters/v1alpha1/cluster.pb"
+import * as KantaloupeDynamiaAiApiCredentialsV1alpha1Credential from "../../credentials/v1alpha1/credential.pb"
+import * as KantaloupeDynamiaAiApiStorageV1alpha1Storage from "../../storage/v1alpha1/storage.pb"
+
+export enum ApplyAction {
+  APPLY_ACTION_UNSPECIFIED = "APPLY_ACTION_UNSPECIFIED",
+  CREATED = "CREATED",
+  CONFIGURED = "CONFIGURED",
+  UNCHANGED = "UNCHANGED",
+  PRUNED = "PRUNED",
+  PRUNE_SKIPPED = "PRUNE_SKIPPED",
+}
+
+export type ApplyRequest = {
+  manifests?: string
+  fieldManager?: string
+  dryRun?: boolean
+  prune?: boolean
+  force?: boolean
+}
+
+export type ApplyResult = {
+  kind?: string
+  cluster?: string
+  namespace?: string
+  name?: string
+  action?: ApplyAction
+  diff?: string
+  error?: string
+  message?: string
+}
+
+export type ApplyResponse = {
+  results?: ApplyResult[]
+}
+
+export type ClusterSpec = {
+  aliasName?: string
+  description?: string
+  provider?: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ClusterProvider
+  type?: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ClusterType
+  prometheusAddress?: string
+  gatewayAddress?: string
+  deletionPolicy?: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.DeletionPolicy
+}
+
+export type CredentialReferenceSpec = {
+  type?: KantaloupeDynamiaAiApiCredentialsV1alpha1Credential.CredentialType
+}
+
+export type QuotaSpec = {
+  hard?: {[key: string]: string}
+}
+
+export type StorageSpec = {
+  storageType?: KantaloupeDynamiaAiApiStorageV1alpha1Storage.StorageType
+  accessMode?: string
+  storageSize?: string
+  nfsServer?: string
+  dataPath?: string
+  localPath?: string
+  nodeName?: string
+  storageClassName?: string
+}
\ No newline at end of file
@@ -0,0 +1,39 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as KantaloupeDynamiaAiApiTypesPage from "../../types/page.pb"
+export type AuditEvent = {
+  id?: string
+  timestamp?: string
+  user?: string
+  groups?: string[]
+  method?: string
+  verb?: string
+  cluster?: string
+  namespace?: string
+  name?: string
+  request?: string
+  code?: string
+  message?: string
+  latencyMilliseconds?: string
+  replayed?: boolean
+}
+
+export type ListAuditEventsRequest = {
+  user?: string
+  cluster?: string
+  namespace?: string
+  method?: string
+  startTime?: string
+  endTime?: string
+  page?: number
+  pageSize?: number
+}
+
+export type ListAuditEventsResponse = {
+  items?: AuditEvent[]
+  pagination?: KantaloupeDynamiaAiApiTypesPage.Pagination
+}
\ No newline at end of file
@@ -4,8 +4,10 @@
 * This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
 */
 
+import * as KantaloupeDynamiaAiApiTypesErrors from "../../types/errors.pb"
 import * as KantaloupeDynamiaAiApiTypesObjectmeta from "../../types/objectmeta.pb"
 import * as KantaloupeDynamiaAiApiTypesPage from "../../types/page.pb"
+import * as KantaloupeDynamiaAiApiTypesTypes from "../../types/types.pb"
 
 export enum ClusterProvider {
   CLUSTER_PROVIDER_UNSPECIFIED = "CLUSTER_PROVIDER_UNSPECIFIED",
@@ -31,10 +33,23 @@ export enum ClusterType {
   NEURON = "NEURON",
 }
 
+export enum DeletionPolicy {
+  DELETION_POLICY_UNSPECIFIED = "DELETION_POLICY_UNSPECIFIED",
+  ORPHAN = "ORPHAN",
+  CLEANUP = "CLEANUP",
+}
+
+export enum DrainStrategy {
+  DRAIN_STRATEGY_UNSPECIFIED = "DRAIN_STRATEGY_UNSPECIFIED",
+  HIBERNATE = "HIBERNATE",
+  MIGRATE = "MIGRATE",
+}
+
 export enum ClusterState {
   UNSPECIFED = "UNSPECIFED",
   RUNNING = "RUNNING",
   UNHEALTH = "UNHEALTH",
+  MAINTENANCE = "MAINTENANCE",
 }
 
 export enum RankOption {
@@ -64,6 +79,26 @@ export type ClusterSpec = {
   description?: string
   prometheusAddress?: string
   gatewayAddress?: string
+  maintenance?: boolean
+  maintenancePolicy?: MaintenancePolicy
+  deletionPolicy?: DeletionPolicy
+}
+
+export type MaintenancePolicy = {
+  cordonNodes?: boolean
+  drainStrategy?: DrainStrategy
+  targetCluster?: string
+  reason?: string
+}
+
+export type MaintenanceStatus = {
+  phase?: string
+  startTime?: string
+  cordonedNodes?: number
+  totalFlows?: number
+  drainedFlows?: number
+  failedFlows?: number
+  message?: string
 }
 
 export type ClusterStatus = {
@@ -85,6 +120,9 @@ export type ClusterStatus = {
   gpuMemoryAllocated?: number
   state?: ClusterState
   conditions?: KantaloupeDynamiaAiApiTypesObjectmeta.Condition[]
+  maintenance?: MaintenanceStatus
+  provider?: ClusterProvider
+  acceleratorTypes?: ClusterType[]
 }
 
 export type ResourceSummary = {
@@ -98,6 +136,7 @@ export type PlatformSummury = {
   acceleratorCardNum?: number
   kantaloupeflowNum?: number
   acceleratorCardSummury?: AcceleratorCardSummury[]
+  clusterErrors?: KantaloupeDynamiaAiApiTypesErrors.ClusterError[]
 }
 
 export type AcceleratorCardSummury = {
@@ -137,10 +176,12 @@ export type IntegrateClusterRequest = {
   prometheusAddress?: string
   gatewayAddress?: string
   type?: ClusterType
+  deletionPolicy?: DeletionPolicy
 }
 
 export type DeleteClusterRequest = {
   name?: string
+  deletionPolicy?: DeletionPolicy
 }
 
 export type GetClusterRequest = {
@@ -164,12 +205,39 @@ export type UpdateClusterRequest = {
   prometheusAddress?: string
   gatewayAddress?: string
   kubeConfig?: string
+  deletionPolicy?: DeletionPolicy
+}
+
+export type UpdateClusterMaintenanceRequest = {
+  name?: string
+  maintenance?: boolean
+  policy?: MaintenancePolicy
 }
 
 export type ListClusterVersionsResponse = {
   versions?: string[]
 }
 
+export type GetClusterCapabilitiesRequest = {
+  name?: string
+}
+
+export type RouteKind = {
+  kind?: string
+  version?: string
+  alpha?: boolean
+}
+
+export type ClusterCapabilities = {
+  name?: string
+  kubernetesVersion?: string
+  hamiVersion?: string
+  gatewayApiVersion?: string
+  routeKinds?: RouteKind[]
+  prometheusVersion?: string
+  kantaloupeflowFeatures?: string[]
+}
+
 export type GPUSummary = {
   model?: string
   total?: number
@@ -186,6 +254,7 @@ export type GetPlatformGPUTopRequest = {
 
 export type GetPlatformGPUTopResponse = {
   gpus?: GPUSummary[]
+  clusterErrors?: KantaloupeDynamiaAiApiTypesErrors.ClusterError[]
 }
 
 export type KantaloupePlugin = {
@@ -217,4 +286,16 @@ export type GetClusterCardRequestTypeRequest = {
 
 export type GetClusterCardRequestTypeResponse = {
   requestTypes?: CardRequestType[]
+}
+
+export type WatchClustersRequest = {
+  name?: string
+  labelSelector?: string
+  resourceVersion?: string
+}
+
+export type WatchClustersResponse = {
+  type?: KantaloupeDynamiaAiApiTypesTypes.WatchEventType
+  object?: Cluster
+  resourceVersion?: string
 }
\ No newline at end of file
@@ -5,6 +5,7 @@
 */
 
 import * as KantaloupeDynamiaAiApiTypesPage from "../../types/page.pb"
+import * as KantaloupeDynamiaAiApiTypesTypes from "../../types/types.pb"
 
 export enum EventType {
   EVENT_TYPE_UNSPECIFIED = "EVENT_TYPE_UNSPECIFIED",
@@ -62,6 +63,8 @@ export type ListClusterEventsRequest = {
   kind?: string
   name?: string
   namespace?: string
+  fieldSelector?: string
+  continue?: string
 }
 
 export type ListClusterEventsResponse = {
@@ -90,9 +93,26 @@ export type ListEventsRequest = {
   group?: string
   version?: string
   resource?: string
+  fieldSelector?: string
+  continue?: string
 }
 
 export type ListEventsResponse = {
   items?: Event[]
   pagination?: KantaloupeDynamiaAiApiTypesPage.Pagination
+}
+
+export type WatchEventsRequest = {
+  cluster?: string
+  namespace?: string
+  type?: EventType[]
+  kind?: string
+  name?: string
+  resourceVersion?: string
+}
+
+export type WatchEventsResponse = {
+  type?: KantaloupeDynamiaAiApiTypesTypes.WatchEventType
+  object?: Event
+  resourceVersion?: string
 }
\ No newline at end of file
@@ -103,6 +103,9 @@ export type ListNodesRequest = {
   page?: number
   pageSize?: number
   sortOption?: KantaloupeDynamiaAiApiTypesPage.SortOption
+  labelSelector?: string
+  fieldSelector?: string
+  continue?: string
 }
 
 export type ListNodesResponse = {
@@ -148,4 +151,17 @@ export type UpdateNodeAnnotationsResponse = {
 export type ScheduleNodeRequest = {
   cluster?: string
   node?: string
+}
+
+export type WatchNodesRequest = {
+  cluster?: string
+  name?: string
+  labelSelector?: string
+  resourceVersion?: string
+}
+
+export type WatchNodesResponse = {
+  type?: KantaloupeDynamiaAiApiTypesTypes.WatchEventType
+  object?: Node
+  resourceVersion?: string
 }
\ No newline at end of file
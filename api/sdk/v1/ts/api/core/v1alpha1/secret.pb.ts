@@ -26,6 +26,7 @@ export type ListSecretsRequest = {
   type?: string
   onlyMetadata?: boolean
   fuzzyName?: string
+  continue?: string
 }
 
 export type ListSecretsResponse = {
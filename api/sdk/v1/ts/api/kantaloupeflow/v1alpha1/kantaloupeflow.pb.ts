@@ -4,8 +4,10 @@
 * This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
 */
 
+import * as KantaloupeDynamiaAiApiTypesErrors from "../../types/errors.pb"
 import * as KantaloupeDynamiaAiApiTypesObjectmeta from "../../types/objectmeta.pb"
 import * as KantaloupeDynamiaAiApiTypesPage from "../../types/page.pb"
+import * as KantaloupeDynamiaAiApiTypesTypes from "../../types/types.pb"
 
 export enum PluginType {
   PLUGIN_TYPE_UNSPECIFIED = "PLUGIN_TYPE_UNSPECIFIED",
@@ -158,6 +160,7 @@ export type ConfigMapVolumeSource = {
 
 export type KantaloupeTree = {
   data?: KantaloupeTreeNode[]
+  clusterErrors?: KantaloupeDynamiaAiApiTypesErrors.ClusterError[]
 }
 
 export type KantaloupeTreeNode = {
@@ -186,6 +189,10 @@ export type ListKantaloupeflowsRequest = {
   pageSize?: number
   sortBy?: KantaloupeDynamiaAiApiTypesPage.SortBy
   sortDir?: KantaloupeDynamiaAiApiTypesPage.SortDir
+  labelSelector?: string
+  fieldSelector?: string
+  sortOption?: KantaloupeDynamiaAiApiTypesPage.SortOption
+  continue?: string
 }
 
 export type ListKantaloupeflowsResponse = {
@@ -232,4 +239,38 @@ export type ConditionStrings = {
 
 export type GetKantaloupeflowConditionsResponse = {
   conditions?: ConditionStrings[]
+}
+
+export type WatchKantaloupeflowsRequest = {
+  cluster?: string
+  namespace?: string
+  name?: string
+  labelSelector?: string
+  resourceVersion?: string
+}
+
+export type WatchKantaloupeflowsResponse = {
+  type?: KantaloupeDynamiaAiApiTypesTypes.WatchEventType
+  object?: Kantaloupeflow
+  resourceVersion?: string
+}
+
+export type GetKantaloupeflowLogsRequest = {
+  cluster?: string
+  namespace?: string
+  name?: string
+  pod?: string
+  container?: string
+  follow?: boolean
+  tailLines?: string
+  sinceSeconds?: string
+  sinceTime?: string
+  previous?: boolean
+  timestamps?: boolean
+}
+
+export type GetKantaloupeflowLogsResponse = {
+  pod?: string
+  container?: string
+  content?: string
 }
\ No newline at end of file
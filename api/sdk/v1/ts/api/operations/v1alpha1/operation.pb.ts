@@ -0,0 +1,45 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as GoogleProtobufAny from "../../../google/protobuf/any.pb"
+import * as KantaloupeDynamiaAiApiTypesErrors from "../../types/errors.pb"
+
+export enum OperationState {
+  OPERATION_STATE_UNSPECIFIED = "OPERATION_STATE_UNSPECIFIED",
+  RUNNING = "RUNNING",
+  SUCCEEDED = "SUCCEEDED",
+  FAILED = "FAILED",
+  CANCELED = "CANCELED",
+}
+
+export type OperationError = {
+  code?: number
+  message?: string
+  detail?: KantaloupeDynamiaAiApiTypesErrors.ErrorDetail
+}
+
+export type Operation = {
+  id?: string
+  method?: string
+  user?: string
+  cluster?: string
+  state?: OperationState
+  progress?: number
+  message?: string
+  createTime?: string
+  updateTime?: string
+  done?: boolean
+  result?: GoogleProtobufAny.Any
+  error?: OperationError
+}
+
+export type GetOperationRequest = {
+  id?: string
+}
+
+export type CancelOperationRequest = {
+  id?: string
+}
\ No newline at end of file
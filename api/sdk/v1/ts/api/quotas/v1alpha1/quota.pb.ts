@@ -39,6 +39,9 @@ export type ListQuotasRequest = {
   pageSize?: number
   cluster?: string
   sortOption?: KantaloupeDynamiaAiApiTypesPage.SortOption
+  labelSelector?: string
+  fieldSelector?: string
+  continue?: string
 }
 
 export type ListQuotasResponse = {
@@ -55,6 +55,7 @@ export type ListStoragesRequest = {
   fuzzyName?: string
   isManage?: boolean
   storageType?: StorageType
+  continue?: string
 }
 
 export type ListStoragesResponse = {
@@ -41,6 +41,7 @@ export type ListStorageClassesRequest = {
   provisioner?: string
   reclaimPolicy?: string
   fuzzyName?: string
+  continue?: string
 }
 
 export type ListStorageClassesResponse = {
@@ -0,0 +1,45 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+export enum ErrorReason {
+  ERROR_REASON_UNSPECIFIED = "ERROR_REASON_UNSPECIFIED",
+  NOT_FOUND = "NOT_FOUND",
+  ALREADY_EXISTS = "ALREADY_EXISTS",
+  CONFLICT = "CONFLICT",
+  FORBIDDEN = "FORBIDDEN",
+  UNAUTHORIZED = "UNAUTHORIZED",
+  QUOTA_EXCEEDED = "QUOTA_EXCEEDED",
+  INVALID = "INVALID",
+  FAILED_PRECONDITION = "FAILED_PRECONDITION",
+  TIMEOUT = "TIMEOUT",
+  TOO_MANY_REQUESTS = "TOO_MANY_REQUESTS",
+  UNAVAILABLE = "UNAVAILABLE",
+  UNIMPLEMENTED = "UNIMPLEMENTED",
+  CANCELED = "CANCELED",
+  INTERNAL = "INTERNAL",
+}
+
+export type ObjectReference = {
+  group?: string
+  kind?: string
+  namespace?: string
+  name?: string
+}
+
+export type ErrorDetail = {
+  reason?: ErrorReason
+  field?: string
+  cluster?: string
+  object?: ObjectReference
+  retryable?: boolean
+}
+
+export type ClusterError = {
+  cluster?: string
+  code?: number
+  message?: string
+  detail?: ErrorDetail
+}
\ No newline at end of file
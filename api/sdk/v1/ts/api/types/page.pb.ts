@@ -20,6 +20,7 @@ export type Pagination = {
   page?: number
   pageSize?: number
   pages?: number
+  continue?: string
 }
 
 export type SortOption = {
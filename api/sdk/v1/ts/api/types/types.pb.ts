@@ -9,4 +9,12 @@ export enum ConditionStatus {
   True = "True",
   False = "False",
   Unknown = "Unknown",
+}
+
+export enum WatchEventType {
+  WATCH_EVENT_TYPE_UNSPECIFIED = "WATCH_EVENT_TYPE_UNSPECIFIED",
+  ADDED = "ADDED",
+  MODIFIED = "MODIFIED",
+  DELETED = "DELETED",
+  BOOKMARK = "BOOKMARK",
 }
\ No newline at end of file
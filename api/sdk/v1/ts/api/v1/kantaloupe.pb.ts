@@ -6,7 +6,10 @@
 
 import * as fm from "../../fetch.pb"
 import * as GoogleProtobufEmpty from "../../google/api/empty.pb"
+import * as GoogleApiHttpbody from "../../google/api/httpbody.pb"
 import * as KantaloupeDynamiaAiApiAcceleratorcardV1alpha1Acceleratorcard from "../acceleratorcard/v1alpha1/acceleratorcard.pb"
+import * as KantaloupeDynamiaAiApiApplyV1alpha1Apply from "../apply/v1alpha1/apply.pb"
+import * as KantaloupeDynamiaAiApiAuditV1alpha1Audit from "../audit/v1alpha1/audit.pb"
 import * as KantaloupeDynamiaAiApiClustersV1alpha1Cluster from "../clusters/v1alpha1/cluster.pb"
 import * as KantaloupeDynamiaAiApiCoreV1alpha1Configmap from "../core/v1alpha1/configmap.pb"
 import * as KantaloupeDynamiaAiApiCoreV1alpha1Event from "../core/v1alpha1/event.pb"
@@ -18,6 +21,7 @@ import * as KantaloupeDynamiaAiApiCoreV1alpha1Secret from "../core/v1alpha1/secr
 import * as KantaloupeDynamiaAiApiCredentialsV1alpha1Credential from "../credentials/v1alpha1/credential.pb"
 import * as KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow from "../kantaloupeflow/v1alpha1/kantaloupeflow.pb"
 import * as KantaloupeDynamiaAiApiMonitoringV1alpha1Monitoring from "../monitoring/v1alpha1/monitoring.pb"
+import * as KantaloupeDynamiaAiApiOperationsV1alpha1Operation from "../operations/v1alpha1/operation.pb"
 import * as KantaloupeDynamiaAiApiQuotasV1alpha1Quota from "../quotas/v1alpha1/quota.pb"
 import * as KantaloupeDynamiaAiApiStorageV1alpha1Storage from "../storage/v1alpha1/storage.pb"
 import * as KantaloupeDynamiaAiApiStorageV1alpha1Storageclass from "../storage/v1alpha1/storageclass.pb"
@@ -25,9 +29,15 @@ export class Cluster {
   static ListClusters(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ListClustersRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ListClustersResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ListClustersRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ListClustersResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static WatchClusters(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.WatchClustersRequest, entityNotifier?: fm.NotifyStreamEntityArrival<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.WatchClustersResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.WatchClustersRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.WatchClustersResponse>(`/apis/kantaloupe.dynamia.ai/v1/watch/clusters?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
   static IntegrateCluster(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.IntegrateClusterRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.IntegrateClusterRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster>(`/apis/kantaloupe.dynamia.ai/v1/clusters`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static IntegrateClusterAsync(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.IntegrateClusterRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.IntegrateClusterRequest, KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation>(`/apis/kantaloupe.dynamia.ai/v1/clusters:integrateAsync`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static GetCluster(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
   }
@@ -37,6 +47,9 @@ export class Cluster {
   static DeleteCluster(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.DeleteClusterRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.DeleteClusterRequest, GoogleProtobufEmpty.Empty>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["name"]}`, {...initReq, method: "DELETE", body: JSON.stringify(req, fm.replacer)})
   }
+  static UpdateClusterMaintenance(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.UpdateClusterMaintenanceRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.UpdateClusterMaintenanceRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.Cluster>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["name"]}/maintenance`, {...initReq, method: "PUT", body: JSON.stringify(req, fm.replacer)})
+  }
   static ValidateKubeconfig(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ValidateKubeconfigRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ValidateKubeconfigResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ValidateKubeconfigRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ValidateKubeconfigResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/kubeconfig:validate`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -58,6 +71,9 @@ export class Cluster {
   static GetClusterCardRequestType(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCardRequestTypeRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCardRequestTypeResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCardRequestTypeRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCardRequestTypeResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["name"]}/requesttype?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
   }
+  static GetClusterCapabilities(req: KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCapabilitiesRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ClusterCapabilities> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiClustersV1alpha1Cluster.GetClusterCapabilitiesRequest, KantaloupeDynamiaAiApiClustersV1alpha1Cluster.ClusterCapabilities>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["name"]}/capabilities?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
 }
 export class Core {
   static ListPersistentVolumes(req: KantaloupeDynamiaAiApiCoreV1alpha1Persistentvolume.ListPersistentVolumesRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiCoreV1alpha1Persistentvolume.ListPersistentVolumesResponse> {
@@ -99,12 +115,18 @@ export class Core {
   static ListClusterEvents(req: KantaloupeDynamiaAiApiCoreV1alpha1Event.ListClusterEventsRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiCoreV1alpha1Event.ListClusterEventsResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiCoreV1alpha1Event.ListClusterEventsRequest, KantaloupeDynamiaAiApiCoreV1alpha1Event.ListClusterEventsResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/events?${fm.renderURLSearchParams(req, ["cluster"])}`, {...initReq, method: "GET"})
   }
+  static WatchEvents(req: KantaloupeDynamiaAiApiCoreV1alpha1Event.WatchEventsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<KantaloupeDynamiaAiApiCoreV1alpha1Event.WatchEventsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiCoreV1alpha1Event.WatchEventsRequest, KantaloupeDynamiaAiApiCoreV1alpha1Event.WatchEventsResponse>(`/apis/kantaloupe.dynamia.ai/v1/watch/clusters/${req["cluster"]}/events?${fm.renderURLSearchParams(req, ["cluster"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
   static ListEvents(req: KantaloupeDynamiaAiApiCoreV1alpha1Event.ListEventsRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiCoreV1alpha1Event.ListEventsResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiCoreV1alpha1Event.ListEventsRequest, KantaloupeDynamiaAiApiCoreV1alpha1Event.ListEventsResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/namespaces/${req["namespace"]}/events?${fm.renderURLSearchParams(req, ["cluster", "namespace"])}`, {...initReq, method: "GET"})
   }
   static ListNodes(req: KantaloupeDynamiaAiApiCoreV1alpha1Node.ListNodesRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiCoreV1alpha1Node.ListNodesResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiCoreV1alpha1Node.ListNodesRequest, KantaloupeDynamiaAiApiCoreV1alpha1Node.ListNodesResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/nodes?${fm.renderURLSearchParams(req, ["cluster"])}`, {...initReq, method: "GET"})
   }
+  static WatchNodes(req: KantaloupeDynamiaAiApiCoreV1alpha1Node.WatchNodesRequest, entityNotifier?: fm.NotifyStreamEntityArrival<KantaloupeDynamiaAiApiCoreV1alpha1Node.WatchNodesResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiCoreV1alpha1Node.WatchNodesRequest, KantaloupeDynamiaAiApiCoreV1alpha1Node.WatchNodesResponse>(`/apis/kantaloupe.dynamia.ai/v1/watch/clusters/${req["cluster"]}/nodes?${fm.renderURLSearchParams(req, ["cluster"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
   static GetNode(req: KantaloupeDynamiaAiApiCoreV1alpha1Node.GetNodeRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiCoreV1alpha1Node.Node> {
     return fm.fetchReq<KantaloupeDynamiaAiApiCoreV1alpha1Node.GetNodeRequest, KantaloupeDynamiaAiApiCoreV1alpha1Node.Node>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/nodes/${req["name"]}?${fm.renderURLSearchParams(req, ["cluster", "name"])}`, {...initReq, method: "GET"})
   }
@@ -175,6 +197,9 @@ export class Kantaloupeflow {
   static CreateKantaloupeflow(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.CreateKantaloupeflowRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.Kantaloupeflow> {
     return fm.fetchReq<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.CreateKantaloupeflowRequest, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.Kantaloupeflow>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/kantaloupeflows`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateKantaloupeflowAsync(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.CreateKantaloupeflowRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.CreateKantaloupeflowRequest, KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/kantaloupeflows:createAsync`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static GetKantaloupeflow(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowRequest, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/namespaces/${req["namespace"]}/kantaloupeflows/${req["name"]}?${fm.renderURLSearchParams(req, ["cluster", "namespace", "name"])}`, {...initReq, method: "GET"})
   }
@@ -184,6 +209,15 @@ export class Kantaloupeflow {
   static ListKantaloupeflows(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.ListKantaloupeflowsRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.ListKantaloupeflowsResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.ListKantaloupeflowsRequest, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.ListKantaloupeflowsResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/namespaces/${req["namespace"]}/kantaloupeflows?${fm.renderURLSearchParams(req, ["cluster", "namespace"])}`, {...initReq, method: "GET"})
   }
+  static WatchKantaloupeflows(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.WatchKantaloupeflowsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.WatchKantaloupeflowsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.WatchKantaloupeflowsRequest, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.WatchKantaloupeflowsResponse>(`/apis/kantaloupe.dynamia.ai/v1/watch/clusters/${req["cluster"]}/kantaloupeflows?${fm.renderURLSearchParams(req, ["cluster"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static GetKantaloupeflowLogs(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsRequest, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/namespaces/${req["namespace"]}/kantaloupeflows/${req["name"]}/logs?${fm.renderURLSearchParams(req, ["cluster", "namespace", "name"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static DownloadKantaloupeflowLogs(req: KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GoogleApiHttpbody.HttpBody>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.GetKantaloupeflowLogsRequest, GoogleApiHttpbody.HttpBody>(`/kantaloupev1.Kantaloupeflow/DownloadKantaloupeflowLogs`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static GetKantaloupeTree(req: GoogleProtobufEmpty.Empty, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.KantaloupeTree> {
     return fm.fetchReq<GoogleProtobufEmpty.Empty, KantaloupeDynamiaAiApiKantaloupeflowV1alpha1Kantaloupeflow.KantaloupeTree>(`/apis/kantaloupe.dynamia.ai/v1/platform/kantaloupeflows/tree?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -249,4 +283,22 @@ export class AcceleratorCard {
   static ListModelNames(req: KantaloupeDynamiaAiApiAcceleratorcardV1alpha1Acceleratorcard.ListModelNamesRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiAcceleratorcardV1alpha1Acceleratorcard.ListModelNamesResponse> {
     return fm.fetchReq<KantaloupeDynamiaAiApiAcceleratorcardV1alpha1Acceleratorcard.ListModelNamesRequest, KantaloupeDynamiaAiApiAcceleratorcardV1alpha1Acceleratorcard.ListModelNamesResponse>(`/apis/kantaloupe.dynamia.ai/v1/clusters/${req["cluster"]}/acceleratorcards/modelnames?${fm.renderURLSearchParams(req, ["cluster"])}`, {...initReq, method: "GET"})
   }
+}
+export class Audit {
+  static ListAuditEvents(req: KantaloupeDynamiaAiApiAuditV1alpha1Audit.ListAuditEventsRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiAuditV1alpha1Audit.ListAuditEventsResponse> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiAuditV1alpha1Audit.ListAuditEventsRequest, KantaloupeDynamiaAiApiAuditV1alpha1Audit.ListAuditEventsResponse>(`/apis/kantaloupe.dynamia.ai/v1/auditevents?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+}
+export class Apply {
+  static Apply(req: KantaloupeDynamiaAiApiApplyV1alpha1Apply.ApplyRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiApplyV1alpha1Apply.ApplyResponse> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiApplyV1alpha1Apply.ApplyRequest, KantaloupeDynamiaAiApiApplyV1alpha1Apply.ApplyResponse>(`/apis/kantaloupe.dynamia.ai/v1/apply`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+}
+export class Operations {
+  static GetOperation(req: KantaloupeDynamiaAiApiOperationsV1alpha1Operation.GetOperationRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.GetOperationRequest, KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation>(`/apis/kantaloupe.dynamia.ai/v1/operations/${req["id"]}?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
+  static CancelOperation(req: KantaloupeDynamiaAiApiOperationsV1alpha1Operation.CancelOperationRequest, initReq?: fm.InitReq): Promise<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation> {
+    return fm.fetchReq<KantaloupeDynamiaAiApiOperationsV1alpha1Operation.CancelOperationRequest, KantaloupeDynamiaAiApiOperationsV1alpha1Operation.Operation>(`/apis/kantaloupe.dynamia.ai/v1/operations/${req["id"]}:cancel`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
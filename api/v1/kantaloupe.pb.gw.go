@@ -15,13 +15,16 @@ import (
 	"net/http"
 
 	"github.com/dynamia-ai/kantaloupe/api/acceleratorcard/v1alpha1"
-	v1alpha1_0 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
-	v1alpha1_1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
-	v1alpha1_2 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
-	v1alpha1_3 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
-	v1alpha1_4 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
-	v1alpha1_5 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
-	v1alpha1_6 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	v1alpha1_0 "github.com/dynamia-ai/kantaloupe/api/apply/v1alpha1"
+	v1alpha1_1 "github.com/dynamia-ai/kantaloupe/api/audit/v1alpha1"
+	v1alpha1_2 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	v1alpha1_3 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	v1alpha1_4 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
+	v1alpha1_5 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	v1alpha1_6 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
+	v1alpha1_7 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	v1alpha1_8 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
+	v1alpha1_9 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
 	"google.golang.org/grpc"
@@ -48,7 +51,7 @@ var filter_Cluster_ListClusters_0 = &utilities.DoubleArray{Encoding: map[string]
 
 func request_Cluster_ListClusters_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.ListClustersRequest
+		protoReq v1alpha1_2.ListClustersRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -63,7 +66,7 @@ func request_Cluster_ListClusters_0(ctx context.Context, marshaler runtime.Marsh
 
 func local_request_Cluster_ListClusters_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.ListClustersRequest
+		protoReq v1alpha1_2.ListClustersRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -76,9 +79,34 @@ func local_request_Cluster_ListClusters_0(ctx context.Context, marshaler runtime
 	return msg, metadata, err
 }
 
+var filter_Cluster_WatchClusters_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Cluster_WatchClusters_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (Cluster_WatchClustersClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.WatchClustersRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Cluster_WatchClusters_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.WatchClusters(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Cluster_IntegrateCluster_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.IntegrateClusterRequest
+		protoReq v1alpha1_2.IntegrateClusterRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
@@ -90,7 +118,7 @@ func request_Cluster_IntegrateCluster_0(ctx context.Context, marshaler runtime.M
 
 func local_request_Cluster_IntegrateCluster_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.IntegrateClusterRequest
+		protoReq v1alpha1_2.IntegrateClusterRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
@@ -100,9 +128,33 @@ func local_request_Cluster_IntegrateCluster_0(ctx context.Context, marshaler run
 	return msg, metadata, err
 }
 
+func request_Cluster_IntegrateClusterAsync_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.IntegrateClusterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.IntegrateClusterAsync(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Cluster_IntegrateClusterAsync_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.IntegrateClusterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.IntegrateClusterAsync(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Cluster_GetCluster_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterRequest
+		protoReq v1alpha1_2.GetClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -120,7 +172,7 @@ func request_Cluster_GetCluster_0(ctx context.Context, marshaler runtime.Marshal
 
 func local_request_Cluster_GetCluster_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterRequest
+		protoReq v1alpha1_2.GetClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -138,7 +190,7 @@ func local_request_Cluster_GetCluster_0(ctx context.Context, marshaler runtime.M
 
 func request_Cluster_UpdateCluster_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.UpdateClusterRequest
+		protoReq v1alpha1_2.UpdateClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -159,7 +211,7 @@ func request_Cluster_UpdateCluster_0(ctx context.Context, marshaler runtime.Mars
 
 func local_request_Cluster_UpdateCluster_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.UpdateClusterRequest
+		protoReq v1alpha1_2.UpdateClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -180,7 +232,7 @@ func local_request_Cluster_UpdateCluster_0(ctx context.Context, marshaler runtim
 
 func request_Cluster_DeleteCluster_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.DeleteClusterRequest
+		protoReq v1alpha1_2.DeleteClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -201,7 +253,7 @@ func request_Cluster_DeleteCluster_0(ctx context.Context, marshaler runtime.Mars
 
 func local_request_Cluster_DeleteCluster_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.DeleteClusterRequest
+		protoReq v1alpha1_2.DeleteClusterRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -220,9 +272,51 @@ func local_request_Cluster_DeleteCluster_0(ctx context.Context, marshaler runtim
 	return msg, metadata, err
 }
 
+func request_Cluster_UpdateClusterMaintenance_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.UpdateClusterMaintenanceRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.UpdateClusterMaintenance(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Cluster_UpdateClusterMaintenance_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.UpdateClusterMaintenanceRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.UpdateClusterMaintenance(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Cluster_ValidateKubeconfig_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.ValidateKubeconfigRequest
+		protoReq v1alpha1_2.ValidateKubeconfigRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
@@ -234,7 +328,7 @@ func request_Cluster_ValidateKubeconfig_0(ctx context.Context, marshaler runtime
 
 func local_request_Cluster_ValidateKubeconfig_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.ValidateKubeconfigRequest
+		protoReq v1alpha1_2.ValidateKubeconfigRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
@@ -248,7 +342,7 @@ var filter_Cluster_GetPlatformSummury_0 = &utilities.DoubleArray{Encoding: map[s
 
 func request_Cluster_GetPlatformSummury_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetPlatformSummuryRequest
+		protoReq v1alpha1_2.GetPlatformSummuryRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -263,7 +357,7 @@ func request_Cluster_GetPlatformSummury_0(ctx context.Context, marshaler runtime
 
 func local_request_Cluster_GetPlatformSummury_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetPlatformSummuryRequest
+		protoReq v1alpha1_2.GetPlatformSummuryRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -316,7 +410,7 @@ var filter_Cluster_GetPlatformGPUTop_0 = &utilities.DoubleArray{Encoding: map[st
 
 func request_Cluster_GetPlatformGPUTop_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetPlatformGPUTopRequest
+		protoReq v1alpha1_2.GetPlatformGPUTopRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -331,7 +425,7 @@ func request_Cluster_GetPlatformGPUTop_0(ctx context.Context, marshaler runtime.
 
 func local_request_Cluster_GetPlatformGPUTop_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetPlatformGPUTopRequest
+		protoReq v1alpha1_2.GetPlatformGPUTopRequest
 		metadata runtime.ServerMetadata
 	)
 	if err := req.ParseForm(); err != nil {
@@ -346,7 +440,7 @@ func local_request_Cluster_GetPlatformGPUTop_0(ctx context.Context, marshaler ru
 
 func request_Cluster_GetClusterPlugins_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterPluginsRequest
+		protoReq v1alpha1_2.GetClusterPluginsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -364,7 +458,7 @@ func request_Cluster_GetClusterPlugins_0(ctx context.Context, marshaler runtime.
 
 func local_request_Cluster_GetClusterPlugins_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterPluginsRequest
+		protoReq v1alpha1_2.GetClusterPluginsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -382,7 +476,7 @@ func local_request_Cluster_GetClusterPlugins_0(ctx context.Context, marshaler ru
 
 func request_Cluster_GetClusterCardRequestType_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterCardRequestTypeRequest
+		protoReq v1alpha1_2.GetClusterCardRequestTypeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -400,7 +494,7 @@ func request_Cluster_GetClusterCardRequestType_0(ctx context.Context, marshaler
 
 func local_request_Cluster_GetClusterCardRequestType_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_0.GetClusterCardRequestTypeRequest
+		protoReq v1alpha1_2.GetClusterCardRequestTypeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -416,11 +510,47 @@ func local_request_Cluster_GetClusterCardRequestType_0(ctx context.Context, mars
 	return msg, metadata, err
 }
 
+func request_Cluster_GetClusterCapabilities_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.GetClusterCapabilitiesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := client.GetClusterCapabilities(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Cluster_GetClusterCapabilities_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_2.GetClusterCapabilitiesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	msg, err := server.GetClusterCapabilities(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 var filter_Core_ListPersistentVolumes_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 
 func request_Core_ListPersistentVolumes_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListPersistentVolumesRequest
+		protoReq v1alpha1_3.ListPersistentVolumesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -444,7 +574,7 @@ func request_Core_ListPersistentVolumes_0(ctx context.Context, marshaler runtime
 
 func local_request_Core_ListPersistentVolumes_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListPersistentVolumesRequest
+		protoReq v1alpha1_3.ListPersistentVolumesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -468,7 +598,7 @@ func local_request_Core_ListPersistentVolumes_0(ctx context.Context, marshaler r
 
 func request_Core_GetPersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetPersistentVolumeRequest
+		protoReq v1alpha1_3.GetPersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -494,7 +624,7 @@ func request_Core_GetPersistentVolume_0(ctx context.Context, marshaler runtime.M
 
 func local_request_Core_GetPersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetPersistentVolumeRequest
+		protoReq v1alpha1_3.GetPersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -520,7 +650,7 @@ func local_request_Core_GetPersistentVolume_0(ctx context.Context, marshaler run
 
 func request_Core_GetPersistentVolumeJSON_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetPersistentVolumeJSONRequest
+		protoReq v1alpha1_3.GetPersistentVolumeJSONRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -546,7 +676,7 @@ func request_Core_GetPersistentVolumeJSON_0(ctx context.Context, marshaler runti
 
 func local_request_Core_GetPersistentVolumeJSON_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetPersistentVolumeJSONRequest
+		protoReq v1alpha1_3.GetPersistentVolumeJSONRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -572,7 +702,7 @@ func local_request_Core_GetPersistentVolumeJSON_0(ctx context.Context, marshaler
 
 func request_Core_CreatePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.CreatePersistentVolumeRequest
+		protoReq v1alpha1_3.CreatePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -593,7 +723,7 @@ func request_Core_CreatePersistentVolume_0(ctx context.Context, marshaler runtim
 
 func local_request_Core_CreatePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.CreatePersistentVolumeRequest
+		protoReq v1alpha1_3.CreatePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -614,7 +744,7 @@ func local_request_Core_CreatePersistentVolume_0(ctx context.Context, marshaler
 
 func request_Core_UpdatePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdatePersistentVolumeRequest
+		protoReq v1alpha1_3.UpdatePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -643,7 +773,7 @@ func request_Core_UpdatePersistentVolume_0(ctx context.Context, marshaler runtim
 
 func local_request_Core_UpdatePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdatePersistentVolumeRequest
+		protoReq v1alpha1_3.UpdatePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -672,7 +802,7 @@ func local_request_Core_UpdatePersistentVolume_0(ctx context.Context, marshaler
 
 func request_Core_DeletePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.DeletePersistentVolumeRequest
+		protoReq v1alpha1_3.DeletePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -698,7 +828,7 @@ func request_Core_DeletePersistentVolume_0(ctx context.Context, marshaler runtim
 
 func local_request_Core_DeletePersistentVolume_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.DeletePersistentVolumeRequest
+		protoReq v1alpha1_3.DeletePersistentVolumeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -724,7 +854,7 @@ func local_request_Core_DeletePersistentVolume_0(ctx context.Context, marshaler
 
 func request_Core_DeleteSecret_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.DeleteSecretRequest
+		protoReq v1alpha1_3.DeleteSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -758,7 +888,7 @@ func request_Core_DeleteSecret_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Core_DeleteSecret_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.DeleteSecretRequest
+		protoReq v1alpha1_3.DeleteSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -792,7 +922,7 @@ func local_request_Core_DeleteSecret_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Core_GetSecret_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetSecretRequest
+		protoReq v1alpha1_3.GetSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -826,7 +956,7 @@ func request_Core_GetSecret_0(ctx context.Context, marshaler runtime.Marshaler,
 
 func local_request_Core_GetSecret_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetSecretRequest
+		protoReq v1alpha1_3.GetSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -862,7 +992,7 @@ var filter_Core_ListSecrets_0 = &utilities.DoubleArray{Encoding: map[string]int{
 
 func request_Core_ListSecrets_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListSecretsRequest
+		protoReq v1alpha1_3.ListSecretsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -886,7 +1016,7 @@ func request_Core_ListSecrets_0(ctx context.Context, marshaler runtime.Marshaler
 
 func local_request_Core_ListSecrets_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListSecretsRequest
+		protoReq v1alpha1_3.ListSecretsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -910,7 +1040,7 @@ func local_request_Core_ListSecrets_0(ctx context.Context, marshaler runtime.Mar
 
 func request_Core_CreateSecret_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.CreateSecretRequest
+		protoReq v1alpha1_3.CreateSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -939,7 +1069,7 @@ func request_Core_CreateSecret_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Core_CreateSecret_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.CreateSecretRequest
+		protoReq v1alpha1_3.CreateSecretRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -970,7 +1100,7 @@ var filter_Core_ListClusterNamespaces_0 = &utilities.DoubleArray{Encoding: map[s
 
 func request_Core_ListClusterNamespaces_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterNamespacesRequest
+		protoReq v1alpha1_3.ListClusterNamespacesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -994,7 +1124,7 @@ func request_Core_ListClusterNamespaces_0(ctx context.Context, marshaler runtime
 
 func local_request_Core_ListClusterNamespaces_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterNamespacesRequest
+		protoReq v1alpha1_3.ListClusterNamespacesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1018,7 +1148,7 @@ func local_request_Core_ListClusterNamespaces_0(ctx context.Context, marshaler r
 
 func request_Core_ListClusterGPUSummary_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterGPUSummaryRequest
+		protoReq v1alpha1_3.ListClusterGPUSummaryRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1036,7 +1166,7 @@ func request_Core_ListClusterGPUSummary_0(ctx context.Context, marshaler runtime
 
 func local_request_Core_ListClusterGPUSummary_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterGPUSummaryRequest
+		protoReq v1alpha1_3.ListClusterGPUSummaryRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1056,7 +1186,7 @@ var filter_Core_ListClusterEvents_0 = &utilities.DoubleArray{Encoding: map[strin
 
 func request_Core_ListClusterEvents_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterEventsRequest
+		protoReq v1alpha1_3.ListClusterEventsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1080,7 +1210,7 @@ func request_Core_ListClusterEvents_0(ctx context.Context, marshaler runtime.Mar
 
 func local_request_Core_ListClusterEvents_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListClusterEventsRequest
+		protoReq v1alpha1_3.ListClusterEventsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1102,11 +1232,45 @@ func local_request_Core_ListClusterEvents_0(ctx context.Context, marshaler runti
 	return msg, metadata, err
 }
 
+var filter_Core_WatchEvents_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Core_WatchEvents_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (Core_WatchEventsClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_3.WatchEventsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Core_WatchEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.WatchEvents(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 var filter_Core_ListEvents_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0, "namespace": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
 
 func request_Core_ListEvents_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListEventsRequest
+		protoReq v1alpha1_3.ListEventsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1138,7 +1302,7 @@ func request_Core_ListEvents_0(ctx context.Context, marshaler runtime.Marshaler,
 
 func local_request_Core_ListEvents_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListEventsRequest
+		protoReq v1alpha1_3.ListEventsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1172,7 +1336,7 @@ var filter_Core_ListNodes_0 = &utilities.DoubleArray{Encoding: map[string]int{"c
 
 func request_Core_ListNodes_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListNodesRequest
+		protoReq v1alpha1_3.ListNodesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1196,7 +1360,7 @@ func request_Core_ListNodes_0(ctx context.Context, marshaler runtime.Marshaler,
 
 func local_request_Core_ListNodes_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ListNodesRequest
+		protoReq v1alpha1_3.ListNodesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1218,9 +1382,43 @@ func local_request_Core_ListNodes_0(ctx context.Context, marshaler runtime.Marsh
 	return msg, metadata, err
 }
 
+var filter_Core_WatchNodes_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Core_WatchNodes_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (Core_WatchNodesClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_3.WatchNodesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Core_WatchNodes_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.WatchNodes(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Core_GetNode_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetNodeRequest
+		protoReq v1alpha1_3.GetNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1246,7 +1444,7 @@ func request_Core_GetNode_0(ctx context.Context, marshaler runtime.Marshaler, cl
 
 func local_request_Core_GetNode_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetNodeRequest
+		protoReq v1alpha1_3.GetNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1272,7 +1470,7 @@ func local_request_Core_GetNode_0(ctx context.Context, marshaler runtime.Marshal
 
 func request_Core_PutNodeLabels_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.PutNodeLabelsRequest
+		protoReq v1alpha1_3.PutNodeLabelsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1301,7 +1499,7 @@ func request_Core_PutNodeLabels_0(ctx context.Context, marshaler runtime.Marshal
 
 func local_request_Core_PutNodeLabels_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.PutNodeLabelsRequest
+		protoReq v1alpha1_3.PutNodeLabelsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1330,7 +1528,7 @@ func local_request_Core_PutNodeLabels_0(ctx context.Context, marshaler runtime.M
 
 func request_Core_PutNodeTaints_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.PutNodeTaintsRequest
+		protoReq v1alpha1_3.PutNodeTaintsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1359,7 +1557,7 @@ func request_Core_PutNodeTaints_0(ctx context.Context, marshaler runtime.Marshal
 
 func local_request_Core_PutNodeTaints_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.PutNodeTaintsRequest
+		protoReq v1alpha1_3.PutNodeTaintsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1388,7 +1586,7 @@ func local_request_Core_PutNodeTaints_0(ctx context.Context, marshaler runtime.M
 
 func request_Core_UpdateNodeAnnotations_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdateNodeAnnotationsRequest
+		protoReq v1alpha1_3.UpdateNodeAnnotationsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1417,7 +1615,7 @@ func request_Core_UpdateNodeAnnotations_0(ctx context.Context, marshaler runtime
 
 func local_request_Core_UpdateNodeAnnotations_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdateNodeAnnotationsRequest
+		protoReq v1alpha1_3.UpdateNodeAnnotationsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1446,7 +1644,7 @@ func local_request_Core_UpdateNodeAnnotations_0(ctx context.Context, marshaler r
 
 func request_Core_UnScheduleNode_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ScheduleNodeRequest
+		protoReq v1alpha1_3.ScheduleNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1472,7 +1670,7 @@ func request_Core_UnScheduleNode_0(ctx context.Context, marshaler runtime.Marsha
 
 func local_request_Core_UnScheduleNode_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ScheduleNodeRequest
+		protoReq v1alpha1_3.ScheduleNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1498,7 +1696,7 @@ func local_request_Core_UnScheduleNode_0(ctx context.Context, marshaler runtime.
 
 func request_Core_ScheduleNode_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ScheduleNodeRequest
+		protoReq v1alpha1_3.ScheduleNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1524,7 +1722,7 @@ func request_Core_ScheduleNode_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Core_ScheduleNode_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.ScheduleNodeRequest
+		protoReq v1alpha1_3.ScheduleNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1550,7 +1748,7 @@ func local_request_Core_ScheduleNode_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Core_GetConfigMap_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetConfigMapRequest
+		protoReq v1alpha1_3.GetConfigMapRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1584,7 +1782,7 @@ func request_Core_GetConfigMap_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Core_GetConfigMap_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetConfigMapRequest
+		protoReq v1alpha1_3.GetConfigMapRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1618,7 +1816,7 @@ func local_request_Core_GetConfigMap_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Core_GetConfigMapJSON_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetConfigMapJSONRequest
+		protoReq v1alpha1_3.GetConfigMapJSONRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1652,7 +1850,7 @@ func request_Core_GetConfigMapJSON_0(ctx context.Context, marshaler runtime.Mars
 
 func local_request_Core_GetConfigMapJSON_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.GetConfigMapJSONRequest
+		protoReq v1alpha1_3.GetConfigMapJSONRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1686,7 +1884,7 @@ func local_request_Core_GetConfigMapJSON_0(ctx context.Context, marshaler runtim
 
 func request_Core_UpdateConfigMap_0(ctx context.Context, marshaler runtime.Marshaler, client CoreClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdateConfigMapRequest
+		protoReq v1alpha1_3.UpdateConfigMapRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1723,7 +1921,7 @@ func request_Core_UpdateConfigMap_0(ctx context.Context, marshaler runtime.Marsh
 
 func local_request_Core_UpdateConfigMap_0(ctx context.Context, marshaler runtime.Marshaler, server CoreServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_1.UpdateConfigMapRequest
+		protoReq v1alpha1_3.UpdateConfigMapRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1762,7 +1960,7 @@ var filter_Monitoring_ListAllPodsGPUUtilization_0 = &utilities.DoubleArray{Encod
 
 func request_Monitoring_ListAllPodsGPUUtilization_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ListMonitoringsRequest
+		protoReq v1alpha1_6.ListMonitoringsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1786,7 +1984,7 @@ func request_Monitoring_ListAllPodsGPUUtilization_0(ctx context.Context, marshal
 
 func local_request_Monitoring_ListAllPodsGPUUtilization_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ListMonitoringsRequest
+		protoReq v1alpha1_6.ListMonitoringsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1812,7 +2010,7 @@ var filter_Monitoring_GetResourceTrend_0 = &utilities.DoubleArray{Encoding: map[
 
 func request_Monitoring_GetResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ResourceTrendRequest
+		protoReq v1alpha1_6.ResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1836,7 +2034,7 @@ func request_Monitoring_GetResourceTrend_0(ctx context.Context, marshaler runtim
 
 func local_request_Monitoring_GetResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ResourceTrendRequest
+		protoReq v1alpha1_6.ResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1862,7 +2060,7 @@ var filter_Monitoring_GetNodeResourceTrend_0 = &utilities.DoubleArray{Encoding:
 
 func request_Monitoring_GetNodeResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.NodeResourceTrendRequest
+		protoReq v1alpha1_6.NodeResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1894,7 +2092,7 @@ func request_Monitoring_GetNodeResourceTrend_0(ctx context.Context, marshaler ru
 
 func local_request_Monitoring_GetNodeResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.NodeResourceTrendRequest
+		protoReq v1alpha1_6.NodeResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1928,7 +2126,7 @@ var filter_Monitoring_GetGpuResourceTrend_0 = &utilities.DoubleArray{Encoding: m
 
 func request_Monitoring_GetGpuResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.GpuResourceTrendRequest
+		protoReq v1alpha1_6.GpuResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -1968,7 +2166,7 @@ func request_Monitoring_GetGpuResourceTrend_0(ctx context.Context, marshaler run
 
 func local_request_Monitoring_GetGpuResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.GpuResourceTrendRequest
+		protoReq v1alpha1_6.GpuResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2010,7 +2208,7 @@ var filter_Monitoring_GetKantaloupeflowResourceTrend_0 = &utilities.DoubleArray{
 
 func request_Monitoring_GetKantaloupeflowResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.KantaloupeflowResourceTrendRequest
+		protoReq v1alpha1_6.KantaloupeflowResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2050,7 +2248,7 @@ func request_Monitoring_GetKantaloupeflowResourceTrend_0(ctx context.Context, ma
 
 func local_request_Monitoring_GetKantaloupeflowResourceTrend_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.KantaloupeflowResourceTrendRequest
+		protoReq v1alpha1_6.KantaloupeflowResourceTrendRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2090,7 +2288,7 @@ func local_request_Monitoring_GetKantaloupeflowResourceTrend_0(ctx context.Conte
 
 func request_Monitoring_GetNodeWorkloadDistribution_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.NodeWorkloadDistributionRequest
+		protoReq v1alpha1_6.NodeWorkloadDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2116,7 +2314,7 @@ func request_Monitoring_GetNodeWorkloadDistribution_0(ctx context.Context, marsh
 
 func local_request_Monitoring_GetNodeWorkloadDistribution_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.NodeWorkloadDistributionRequest
+		protoReq v1alpha1_6.NodeWorkloadDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2142,7 +2340,7 @@ func local_request_Monitoring_GetNodeWorkloadDistribution_0(ctx context.Context,
 
 func request_Monitoring_GetClusterWorkloadDistribution_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ClusterWorkloadDistributionRequest
+		protoReq v1alpha1_6.ClusterWorkloadDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2160,7 +2358,7 @@ func request_Monitoring_GetClusterWorkloadDistribution_0(ctx context.Context, ma
 
 func local_request_Monitoring_GetClusterWorkloadDistribution_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.ClusterWorkloadDistributionRequest
+		protoReq v1alpha1_6.ClusterWorkloadDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2180,7 +2378,7 @@ var filter_Monitoring_GetTopNodes_0 = &utilities.DoubleArray{Encoding: map[strin
 
 func request_Monitoring_GetTopNodes_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.TopNodeRequest
+		protoReq v1alpha1_6.TopNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2204,7 +2402,7 @@ func request_Monitoring_GetTopNodes_0(ctx context.Context, marshaler runtime.Mar
 
 func local_request_Monitoring_GetTopNodes_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.TopNodeRequest
+		protoReq v1alpha1_6.TopNodeRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2230,7 +2428,7 @@ var filter_Monitoring_GetTopNodeWorkloads_0 = &utilities.DoubleArray{Encoding: m
 
 func request_Monitoring_GetTopNodeWorkloads_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.TopNodeWorkloadRequest
+		protoReq v1alpha1_6.TopNodeWorkloadRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2254,7 +2452,7 @@ func request_Monitoring_GetTopNodeWorkloads_0(ctx context.Context, marshaler run
 
 func local_request_Monitoring_GetTopNodeWorkloads_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.TopNodeWorkloadRequest
+		protoReq v1alpha1_6.TopNodeWorkloadRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2278,7 +2476,7 @@ func local_request_Monitoring_GetTopNodeWorkloads_0(ctx context.Context, marshal
 
 func request_Monitoring_GetKantaloupeflowMemoryDistribution_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.MemoryDistributionRequest
+		protoReq v1alpha1_6.MemoryDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2312,7 +2510,7 @@ func request_Monitoring_GetKantaloupeflowMemoryDistribution_0(ctx context.Contex
 
 func local_request_Monitoring_GetKantaloupeflowMemoryDistribution_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.MemoryDistributionRequest
+		protoReq v1alpha1_6.MemoryDistributionRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2348,7 +2546,7 @@ var filter_Monitoring_GetCardTopWorkloads_0 = &utilities.DoubleArray{Encoding: m
 
 func request_Monitoring_GetCardTopWorkloads_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.CardTopWorkloadsRequest
+		protoReq v1alpha1_6.CardTopWorkloadsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2388,7 +2586,7 @@ func request_Monitoring_GetCardTopWorkloads_0(ctx context.Context, marshaler run
 
 func local_request_Monitoring_GetCardTopWorkloads_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.CardTopWorkloadsRequest
+		protoReq v1alpha1_6.CardTopWorkloadsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2430,7 +2628,7 @@ var filter_Monitoring_GetClusterWorkloadsTop_0 = &utilities.DoubleArray{Encoding
 
 func request_Monitoring_GetClusterWorkloadsTop_0(ctx context.Context, marshaler runtime.Marshaler, client MonitoringClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.GetClusterWorkloadsTopRequest
+		protoReq v1alpha1_6.GetClusterWorkloadsTopRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2454,7 +2652,7 @@ func request_Monitoring_GetClusterWorkloadsTop_0(ctx context.Context, marshaler
 
 func local_request_Monitoring_GetClusterWorkloadsTop_0(ctx context.Context, marshaler runtime.Marshaler, server MonitoringServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_4.GetClusterWorkloadsTopRequest
+		protoReq v1alpha1_6.GetClusterWorkloadsTopRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2478,7 +2676,7 @@ func local_request_Monitoring_GetClusterWorkloadsTop_0(ctx context.Context, mars
 
 func request_Kantaloupeflow_CreateKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.CreateKantaloupeflowRequest
+		protoReq v1alpha1_5.CreateKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2499,7 +2697,7 @@ func request_Kantaloupeflow_CreateKantaloupeflow_0(ctx context.Context, marshale
 
 func local_request_Kantaloupeflow_CreateKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.CreateKantaloupeflowRequest
+		protoReq v1alpha1_5.CreateKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2518,9 +2716,51 @@ func local_request_Kantaloupeflow_CreateKantaloupeflow_0(ctx context.Context, ma
 	return msg, metadata, err
 }
 
+func request_Kantaloupeflow_CreateKantaloupeflowAsync_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_5.CreateKantaloupeflowRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	msg, err := client.CreateKantaloupeflowAsync(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Kantaloupeflow_CreateKantaloupeflowAsync_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_5.CreateKantaloupeflowRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	msg, err := server.CreateKantaloupeflowAsync(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Kantaloupeflow_GetKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.GetKantaloupeflowRequest
+		protoReq v1alpha1_5.GetKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2554,7 +2794,7 @@ func request_Kantaloupeflow_GetKantaloupeflow_0(ctx context.Context, marshaler r
 
 func local_request_Kantaloupeflow_GetKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.GetKantaloupeflowRequest
+		protoReq v1alpha1_5.GetKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2588,7 +2828,7 @@ func local_request_Kantaloupeflow_GetKantaloupeflow_0(ctx context.Context, marsh
 
 func request_Kantaloupeflow_DeleteKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.DeleteKantaloupeflowRequest
+		protoReq v1alpha1_5.DeleteKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2622,7 +2862,7 @@ func request_Kantaloupeflow_DeleteKantaloupeflow_0(ctx context.Context, marshale
 
 func local_request_Kantaloupeflow_DeleteKantaloupeflow_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.DeleteKantaloupeflowRequest
+		protoReq v1alpha1_5.DeleteKantaloupeflowRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2658,7 +2898,7 @@ var filter_Kantaloupeflow_ListKantaloupeflows_0 = &utilities.DoubleArray{Encodin
 
 func request_Kantaloupeflow_ListKantaloupeflows_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.ListKantaloupeflowsRequest
+		protoReq v1alpha1_5.ListKantaloupeflowsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2690,7 +2930,7 @@ func request_Kantaloupeflow_ListKantaloupeflows_0(ctx context.Context, marshaler
 
 func local_request_Kantaloupeflow_ListKantaloupeflows_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.ListKantaloupeflowsRequest
+		protoReq v1alpha1_5.ListKantaloupeflowsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2720,6 +2960,90 @@ func local_request_Kantaloupeflow_ListKantaloupeflows_0(ctx context.Context, mar
 	return msg, metadata, err
 }
 
+var filter_Kantaloupeflow_WatchKantaloupeflows_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Kantaloupeflow_WatchKantaloupeflows_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (Kantaloupeflow_WatchKantaloupeflowsClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_5.WatchKantaloupeflowsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Kantaloupeflow_WatchKantaloupeflows_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.WatchKantaloupeflows(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
+var filter_Kantaloupeflow_GetKantaloupeflowLogs_0 = &utilities.DoubleArray{Encoding: map[string]int{"cluster": 0, "namespace": 1, "name": 2}, Base: []int{1, 1, 2, 3, 0, 0, 0}, Check: []int{0, 1, 1, 1, 2, 3, 4}}
+
+func request_Kantaloupeflow_GetKantaloupeflowLogs_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (Kantaloupeflow_GetKantaloupeflowLogsClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_5.GetKantaloupeflowLogsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["cluster"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "cluster")
+	}
+	protoReq.Cluster, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "cluster", err)
+	}
+	val, ok = pathParams["namespace"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "namespace")
+	}
+	protoReq.Namespace, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "namespace", err)
+	}
+	val, ok = pathParams["name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "name")
+	}
+	protoReq.Name, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Kantaloupeflow_GetKantaloupeflowLogs_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.GetKantaloupeflowLogs(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Kantaloupeflow_GetKantaloupeTree_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq emptypb.Empty
@@ -2740,7 +3064,7 @@ func local_request_Kantaloupeflow_GetKantaloupeTree_0(ctx context.Context, marsh
 
 func request_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.UpdateKantaloupeflowGPUMemoryRequest
+		protoReq v1alpha1_5.UpdateKantaloupeflowGPUMemoryRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2777,7 +3101,7 @@ func request_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0(ctx context.Context,
 
 func local_request_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.UpdateKantaloupeflowGPUMemoryRequest
+		protoReq v1alpha1_5.UpdateKantaloupeflowGPUMemoryRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2814,7 +3138,7 @@ func local_request_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0(ctx context.Co
 
 func request_Kantaloupeflow_GetKantaloupeflowConditions_0(ctx context.Context, marshaler runtime.Marshaler, client KantaloupeflowClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.GetKantaloupeflowConditionsRequest
+		protoReq v1alpha1_5.GetKantaloupeflowConditionsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2848,7 +3172,7 @@ func request_Kantaloupeflow_GetKantaloupeflowConditions_0(ctx context.Context, m
 
 func local_request_Kantaloupeflow_GetKantaloupeflowConditions_0(ctx context.Context, marshaler runtime.Marshaler, server KantaloupeflowServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_3.GetKantaloupeflowConditionsRequest
+		protoReq v1alpha1_5.GetKantaloupeflowConditionsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2884,7 +3208,7 @@ var filter_Credential_ListCredentials_0 = &utilities.DoubleArray{Encoding: map[s
 
 func request_Credential_ListCredentials_0(ctx context.Context, marshaler runtime.Marshaler, client CredentialClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.ListCredentialsRequest
+		protoReq v1alpha1_4.ListCredentialsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2908,7 +3232,7 @@ func request_Credential_ListCredentials_0(ctx context.Context, marshaler runtime
 
 func local_request_Credential_ListCredentials_0(ctx context.Context, marshaler runtime.Marshaler, server CredentialServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.ListCredentialsRequest
+		protoReq v1alpha1_4.ListCredentialsRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2934,7 +3258,7 @@ var filter_Credential_DeleteCredential_0 = &utilities.DoubleArray{Encoding: map[
 
 func request_Credential_DeleteCredential_0(ctx context.Context, marshaler runtime.Marshaler, client CredentialClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.DeleteCredentialRequest
+		protoReq v1alpha1_4.DeleteCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2966,7 +3290,7 @@ func request_Credential_DeleteCredential_0(ctx context.Context, marshaler runtim
 
 func local_request_Credential_DeleteCredential_0(ctx context.Context, marshaler runtime.Marshaler, server CredentialServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.DeleteCredentialRequest
+		protoReq v1alpha1_4.DeleteCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -2998,7 +3322,7 @@ func local_request_Credential_DeleteCredential_0(ctx context.Context, marshaler
 
 func request_Credential_CreateCredential_0(ctx context.Context, marshaler runtime.Marshaler, client CredentialClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.CreateCredentialRequest
+		protoReq v1alpha1_4.CreateCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3019,7 +3343,7 @@ func request_Credential_CreateCredential_0(ctx context.Context, marshaler runtim
 
 func local_request_Credential_CreateCredential_0(ctx context.Context, marshaler runtime.Marshaler, server CredentialServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.CreateCredentialRequest
+		protoReq v1alpha1_4.CreateCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3040,7 +3364,7 @@ func local_request_Credential_CreateCredential_0(ctx context.Context, marshaler
 
 func request_Credential_UpdateCredential_0(ctx context.Context, marshaler runtime.Marshaler, client CredentialClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.UpdateCredentialRequest
+		protoReq v1alpha1_4.UpdateCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3069,7 +3393,7 @@ func request_Credential_UpdateCredential_0(ctx context.Context, marshaler runtim
 
 func local_request_Credential_UpdateCredential_0(ctx context.Context, marshaler runtime.Marshaler, server CredentialServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_2.UpdateCredentialRequest
+		protoReq v1alpha1_4.UpdateCredentialRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3100,7 +3424,7 @@ var filter_Quota_ListQuotas_0 = &utilities.DoubleArray{Encoding: map[string]int{
 
 func request_Quota_ListQuotas_0(ctx context.Context, marshaler runtime.Marshaler, client QuotaClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.ListQuotasRequest
+		protoReq v1alpha1_8.ListQuotasRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3124,7 +3448,7 @@ func request_Quota_ListQuotas_0(ctx context.Context, marshaler runtime.Marshaler
 
 func local_request_Quota_ListQuotas_0(ctx context.Context, marshaler runtime.Marshaler, server QuotaServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.ListQuotasRequest
+		protoReq v1alpha1_8.ListQuotasRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3148,7 +3472,7 @@ func local_request_Quota_ListQuotas_0(ctx context.Context, marshaler runtime.Mar
 
 func request_Quota_DeleteQuota_0(ctx context.Context, marshaler runtime.Marshaler, client QuotaClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.DeleteQuotaRequest
+		protoReq v1alpha1_8.DeleteQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3182,7 +3506,7 @@ func request_Quota_DeleteQuota_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Quota_DeleteQuota_0(ctx context.Context, marshaler runtime.Marshaler, server QuotaServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.DeleteQuotaRequest
+		protoReq v1alpha1_8.DeleteQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3216,7 +3540,7 @@ func local_request_Quota_DeleteQuota_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Quota_CreateQuota_0(ctx context.Context, marshaler runtime.Marshaler, client QuotaClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.CreateQuotaRequest
+		protoReq v1alpha1_8.CreateQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3245,7 +3569,7 @@ func request_Quota_CreateQuota_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Quota_CreateQuota_0(ctx context.Context, marshaler runtime.Marshaler, server QuotaServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.CreateQuotaRequest
+		protoReq v1alpha1_8.CreateQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3274,7 +3598,7 @@ func local_request_Quota_CreateQuota_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Quota_UpdateQuota_0(ctx context.Context, marshaler runtime.Marshaler, client QuotaClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.UpdateQuotaRequest
+		protoReq v1alpha1_8.UpdateQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3311,7 +3635,7 @@ func request_Quota_UpdateQuota_0(ctx context.Context, marshaler runtime.Marshale
 
 func local_request_Quota_UpdateQuota_0(ctx context.Context, marshaler runtime.Marshaler, server QuotaServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.UpdateQuotaRequest
+		protoReq v1alpha1_8.UpdateQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3348,7 +3672,7 @@ func local_request_Quota_UpdateQuota_0(ctx context.Context, marshaler runtime.Ma
 
 func request_Quota_GetQuota_0(ctx context.Context, marshaler runtime.Marshaler, client QuotaClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.GetQuotaRequest
+		protoReq v1alpha1_8.GetQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3382,7 +3706,7 @@ func request_Quota_GetQuota_0(ctx context.Context, marshaler runtime.Marshaler,
 
 func local_request_Quota_GetQuota_0(ctx context.Context, marshaler runtime.Marshaler, server QuotaServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_5.GetQuotaRequest
+		protoReq v1alpha1_8.GetQuotaRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3418,7 +3742,7 @@ var filter_Storage_ListStorageClasses_0 = &utilities.DoubleArray{Encoding: map[s
 
 func request_Storage_ListStorageClasses_0(ctx context.Context, marshaler runtime.Marshaler, client StorageClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.ListStorageClassesRequest
+		protoReq v1alpha1_9.ListStorageClassesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3442,7 +3766,7 @@ func request_Storage_ListStorageClasses_0(ctx context.Context, marshaler runtime
 
 func local_request_Storage_ListStorageClasses_0(ctx context.Context, marshaler runtime.Marshaler, server StorageServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.ListStorageClassesRequest
+		protoReq v1alpha1_9.ListStorageClassesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3466,7 +3790,7 @@ func local_request_Storage_ListStorageClasses_0(ctx context.Context, marshaler r
 
 func request_Storage_CreateStorage_0(ctx context.Context, marshaler runtime.Marshaler, client StorageClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.CreateStorageRequest
+		protoReq v1alpha1_9.CreateStorageRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3495,7 +3819,7 @@ func request_Storage_CreateStorage_0(ctx context.Context, marshaler runtime.Mars
 
 func local_request_Storage_CreateStorage_0(ctx context.Context, marshaler runtime.Marshaler, server StorageServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.CreateStorageRequest
+		protoReq v1alpha1_9.CreateStorageRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3524,7 +3848,7 @@ func local_request_Storage_CreateStorage_0(ctx context.Context, marshaler runtim
 
 func request_Storage_DeleteStorage_0(ctx context.Context, marshaler runtime.Marshaler, client StorageClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.DeleteStorageRequest
+		protoReq v1alpha1_9.DeleteStorageRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3558,7 +3882,7 @@ func request_Storage_DeleteStorage_0(ctx context.Context, marshaler runtime.Mars
 
 func local_request_Storage_DeleteStorage_0(ctx context.Context, marshaler runtime.Marshaler, server StorageServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.DeleteStorageRequest
+		protoReq v1alpha1_9.DeleteStorageRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3594,7 +3918,7 @@ var filter_Storage_ListStorages_0 = &utilities.DoubleArray{Encoding: map[string]
 
 func request_Storage_ListStorages_0(ctx context.Context, marshaler runtime.Marshaler, client StorageClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.ListStoragesRequest
+		protoReq v1alpha1_9.ListStoragesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3618,7 +3942,7 @@ func request_Storage_ListStorages_0(ctx context.Context, marshaler runtime.Marsh
 
 func local_request_Storage_ListStorages_0(ctx context.Context, marshaler runtime.Marshaler, server StorageServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq v1alpha1_6.ListStoragesRequest
+		protoReq v1alpha1_9.ListStoragesRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
@@ -3792,15 +4116,149 @@ func local_request_AcceleratorCard_ListModelNames_0(ctx context.Context, marshal
 	return msg, metadata, err
 }
 
-// RegisterClusterHandlerServer registers the http handlers for service Cluster to "mux".
-// UnaryRPC     :call ClusterServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterClusterHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, server ClusterServer) error {
-	mux.Handle(http.MethodGet, pattern_Cluster_ListClusters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
+var filter_Audit_ListAuditEvents_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Audit_ListAuditEvents_0(ctx context.Context, marshaler runtime.Marshaler, client AuditClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_1.ListAuditEventsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Audit_ListAuditEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListAuditEvents(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Audit_ListAuditEvents_0(ctx context.Context, marshaler runtime.Marshaler, server AuditServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_1.ListAuditEventsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Audit_ListAuditEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListAuditEvents(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Apply_Apply_0(ctx context.Context, marshaler runtime.Marshaler, client ApplyClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_0.ApplyRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Apply(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Apply_Apply_0(ctx context.Context, marshaler runtime.Marshaler, server ApplyServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_0.ApplyRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Apply(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Operations_GetOperation_0(ctx context.Context, marshaler runtime.Marshaler, client OperationsClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_7.GetOperationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.GetOperation(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Operations_GetOperation_0(ctx context.Context, marshaler runtime.Marshaler, server OperationsServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_7.GetOperationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.GetOperation(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Operations_CancelOperation_0(ctx context.Context, marshaler runtime.Marshaler, client OperationsClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_7.CancelOperationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.CancelOperation(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Operations_CancelOperation_0(ctx context.Context, marshaler runtime.Marshaler, server OperationsServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq v1alpha1_7.CancelOperationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.CancelOperation(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+// RegisterClusterHandlerServer registers the http handlers for service Cluster to "mux".
+// UnaryRPC     :call ClusterServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterClusterHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, server ClusterServer) error {
+	mux.Handle(http.MethodGet, pattern_Cluster_ListClusters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
@@ -3818,6 +4276,13 @@ func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Cluster_ListClusters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodGet, pattern_Cluster_WatchClusters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodPost, pattern_Cluster_IntegrateCluster_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -3838,6 +4303,26 @@ func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Cluster_IntegrateCluster_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Cluster_IntegrateClusterAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Cluster/IntegrateClusterAsync", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters:integrateAsync"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Cluster_IntegrateClusterAsync_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_IntegrateClusterAsync_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Cluster_GetCluster_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -3898,6 +4383,26 @@ func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Cluster_DeleteCluster_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPut, pattern_Cluster_UpdateClusterMaintenance_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Cluster/UpdateClusterMaintenance", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{name}/maintenance"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Cluster_UpdateClusterMaintenance_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_UpdateClusterMaintenance_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Cluster_ValidateKubeconfig_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -4038,6 +4543,26 @@ func RegisterClusterHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Cluster_GetClusterCardRequestType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Cluster_GetClusterCapabilities_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Cluster/GetClusterCapabilities", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{name}/capabilities"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Cluster_GetClusterCapabilities_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_GetClusterCapabilities_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -4308,6 +4833,13 @@ func RegisterCoreHandlerServer(ctx context.Context, mux *runtime.ServeMux, serve
 		}
 		forward_Core_ListClusterEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodGet, pattern_Core_WatchEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodGet, pattern_Core_ListEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -4348,6 +4880,13 @@ func RegisterCoreHandlerServer(ctx context.Context, mux *runtime.ServeMux, serve
 		}
 		forward_Core_ListNodes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodGet, pattern_Core_WatchNodes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodGet, pattern_Core_GetNode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -4808,6 +5347,26 @@ func RegisterKantaloupeflowHandlerServer(ctx context.Context, mux *runtime.Serve
 		}
 		forward_Kantaloupeflow_CreateKantaloupeflow_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Kantaloupeflow_CreateKantaloupeflowAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Kantaloupeflow/CreateKantaloupeflowAsync", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/kantaloupeflows:createAsync"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Kantaloupeflow_CreateKantaloupeflowAsync_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Kantaloupeflow_CreateKantaloupeflowAsync_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeflow_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -4868,6 +5427,20 @@ func RegisterKantaloupeflowHandlerServer(ctx context.Context, mux *runtime.Serve
 		}
 		forward_Kantaloupeflow_ListKantaloupeflows_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_WatchKantaloupeflows_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeflowLogs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeTree_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -5292,6 +5865,116 @@ func RegisterAcceleratorCardHandlerServer(ctx context.Context, mux *runtime.Serv
 	return nil
 }
 
+// RegisterAuditHandlerServer registers the http handlers for service Audit to "mux".
+// UnaryRPC     :call AuditServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterAuditHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterAuditHandlerServer(ctx context.Context, mux *runtime.ServeMux, server AuditServer) error {
+	mux.Handle(http.MethodGet, pattern_Audit_ListAuditEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Audit/ListAuditEvents", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/auditevents"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Audit_ListAuditEvents_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Audit_ListAuditEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterApplyHandlerServer registers the http handlers for service Apply to "mux".
+// UnaryRPC     :call ApplyServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterApplyHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterApplyHandlerServer(ctx context.Context, mux *runtime.ServeMux, server ApplyServer) error {
+	mux.Handle(http.MethodPost, pattern_Apply_Apply_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Apply/Apply", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/apply"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Apply_Apply_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Apply_Apply_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterOperationsHandlerServer registers the http handlers for service Operations to "mux".
+// UnaryRPC     :call OperationsServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterOperationsHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterOperationsHandlerServer(ctx context.Context, mux *runtime.ServeMux, server OperationsServer) error {
+	mux.Handle(http.MethodGet, pattern_Operations_GetOperation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Operations/GetOperation", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/operations/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Operations_GetOperation_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Operations_GetOperation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Operations_CancelOperation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/kantaloupev1.Operations/CancelOperation", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/operations/{id}:cancel"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Operations_CancelOperation_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Operations_CancelOperation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
 // RegisterClusterHandlerFromEndpoint is same as RegisterClusterHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterClusterHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -5332,35 +6015,69 @@ func RegisterClusterHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/ListClusters", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/ListClusters", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Cluster_ListClusters_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_ListClusters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Cluster_WatchClusters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/WatchClusters", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/watch/clusters"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Cluster_WatchClusters_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_WatchClusters_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Cluster_IntegrateCluster_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/IntegrateCluster", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Cluster_ListClusters_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Cluster_IntegrateCluster_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Cluster_ListClusters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Cluster_IntegrateCluster_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPost, pattern_Cluster_IntegrateCluster_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPost, pattern_Cluster_IntegrateClusterAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/IntegrateCluster", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/IntegrateClusterAsync", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters:integrateAsync"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Cluster_IntegrateCluster_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Cluster_IntegrateClusterAsync_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Cluster_IntegrateCluster_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Cluster_IntegrateClusterAsync_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	mux.Handle(http.MethodGet, pattern_Cluster_GetCluster_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
@@ -5413,6 +6130,23 @@ func RegisterClusterHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 		}
 		forward_Cluster_DeleteCluster_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPut, pattern_Cluster_UpdateClusterMaintenance_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/UpdateClusterMaintenance", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{name}/maintenance"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Cluster_UpdateClusterMaintenance_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_UpdateClusterMaintenance_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Cluster_ValidateKubeconfig_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -5532,15 +6266,35 @@ func RegisterClusterHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 		}
 		forward_Cluster_GetClusterCardRequestType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Cluster_GetClusterCapabilities_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Cluster/GetClusterCapabilities", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{name}/capabilities"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Cluster_GetClusterCapabilities_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Cluster_GetClusterCapabilities_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
 	pattern_Cluster_ListClusters_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters"}, ""))
+	pattern_Cluster_WatchClusters_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "watch", "clusters"}, ""))
 	pattern_Cluster_IntegrateCluster_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters"}, ""))
+	pattern_Cluster_IntegrateClusterAsync_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters"}, "integrateAsync"))
 	pattern_Cluster_GetCluster_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name"}, ""))
 	pattern_Cluster_UpdateCluster_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name"}, ""))
 	pattern_Cluster_DeleteCluster_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name"}, ""))
+	pattern_Cluster_UpdateClusterMaintenance_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name", "maintenance"}, ""))
 	pattern_Cluster_ValidateKubeconfig_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "kubeconfig"}, "validate"))
 	pattern_Cluster_GetPlatformSummury_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "platform", "summury"}, ""))
 	pattern_Cluster_ListClusterVersions_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "versions"}, ""))
@@ -5548,14 +6302,18 @@ var (
 	pattern_Cluster_GetPlatformGPUTop_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "platform", "gpu", "top"}, ""))
 	pattern_Cluster_GetClusterPlugins_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name", "plugins"}, ""))
 	pattern_Cluster_GetClusterCardRequestType_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name", "requesttype"}, ""))
+	pattern_Cluster_GetClusterCapabilities_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "name", "capabilities"}, ""))
 )
 
 var (
 	forward_Cluster_ListClusters_0              = runtime.ForwardResponseMessage
+	forward_Cluster_WatchClusters_0             = runtime.ForwardResponseStream
 	forward_Cluster_IntegrateCluster_0          = runtime.ForwardResponseMessage
+	forward_Cluster_IntegrateClusterAsync_0     = runtime.ForwardResponseMessage
 	forward_Cluster_GetCluster_0                = runtime.ForwardResponseMessage
 	forward_Cluster_UpdateCluster_0             = runtime.ForwardResponseMessage
 	forward_Cluster_DeleteCluster_0             = runtime.ForwardResponseMessage
+	forward_Cluster_UpdateClusterMaintenance_0  = runtime.ForwardResponseMessage
 	forward_Cluster_ValidateKubeconfig_0        = runtime.ForwardResponseMessage
 	forward_Cluster_GetPlatformSummury_0        = runtime.ForwardResponseMessage
 	forward_Cluster_ListClusterVersions_0       = runtime.ForwardResponseMessage
@@ -5563,6 +6321,7 @@ var (
 	forward_Cluster_GetPlatformGPUTop_0         = runtime.ForwardResponseMessage
 	forward_Cluster_GetClusterPlugins_0         = runtime.ForwardResponseMessage
 	forward_Cluster_GetClusterCardRequestType_0 = runtime.ForwardResponseMessage
+	forward_Cluster_GetClusterCapabilities_0    = runtime.ForwardResponseMessage
 )
 
 // RegisterCoreHandlerFromEndpoint is same as RegisterCoreHandler but
@@ -5822,6 +6581,23 @@ func RegisterCoreHandlerClient(ctx context.Context, mux *runtime.ServeMux, clien
 		}
 		forward_Core_ListClusterEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Core_WatchEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Core/WatchEvents", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/watch/clusters/{cluster}/events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Core_WatchEvents_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Core_WatchEvents_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Core_ListEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -5856,6 +6632,23 @@ func RegisterCoreHandlerClient(ctx context.Context, mux *runtime.ServeMux, clien
 		}
 		forward_Core_ListNodes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Core_WatchNodes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Core/WatchNodes", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/watch/clusters/{cluster}/nodes"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Core_WatchNodes_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Core_WatchNodes_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Core_GetNode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -6026,8 +6819,10 @@ var (
 	pattern_Core_ListClusterNamespaces_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces"}, ""))
 	pattern_Core_ListClusterGPUSummary_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "gpusummary"}, ""))
 	pattern_Core_ListClusterEvents_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "events"}, ""))
+	pattern_Core_WatchEvents_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "watch", "clusters", "cluster", "events"}, ""))
 	pattern_Core_ListEvents_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "events"}, ""))
 	pattern_Core_ListNodes_0               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "nodes"}, ""))
+	pattern_Core_WatchNodes_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "watch", "clusters", "cluster", "nodes"}, ""))
 	pattern_Core_GetNode_0                 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "nodes", "name"}, ""))
 	pattern_Core_PutNodeLabels_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "nodes", "node", "labels"}, ""))
 	pattern_Core_PutNodeTaints_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "nodes", "node", "taints"}, ""))
@@ -6053,8 +6848,10 @@ var (
 	forward_Core_ListClusterNamespaces_0   = runtime.ForwardResponseMessage
 	forward_Core_ListClusterGPUSummary_0   = runtime.ForwardResponseMessage
 	forward_Core_ListClusterEvents_0       = runtime.ForwardResponseMessage
+	forward_Core_WatchEvents_0             = runtime.ForwardResponseStream
 	forward_Core_ListEvents_0              = runtime.ForwardResponseMessage
 	forward_Core_ListNodes_0               = runtime.ForwardResponseMessage
+	forward_Core_WatchNodes_0              = runtime.ForwardResponseStream
 	forward_Core_GetNode_0                 = runtime.ForwardResponseMessage
 	forward_Core_PutNodeLabels_0           = runtime.ForwardResponseMessage
 	forward_Core_PutNodeTaints_0           = runtime.ForwardResponseMessage
@@ -6392,6 +7189,23 @@ func RegisterKantaloupeflowHandlerClient(ctx context.Context, mux *runtime.Serve
 		}
 		forward_Kantaloupeflow_CreateKantaloupeflow_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Kantaloupeflow_CreateKantaloupeflowAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Kantaloupeflow/CreateKantaloupeflowAsync", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/kantaloupeflows:createAsync"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Kantaloupeflow_CreateKantaloupeflowAsync_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Kantaloupeflow_CreateKantaloupeflowAsync_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeflow_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -6443,6 +7257,40 @@ func RegisterKantaloupeflowHandlerClient(ctx context.Context, mux *runtime.Serve
 		}
 		forward_Kantaloupeflow_ListKantaloupeflows_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_WatchKantaloupeflows_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Kantaloupeflow/WatchKantaloupeflows", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/watch/clusters/{cluster}/kantaloupeflows"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Kantaloupeflow_WatchKantaloupeflows_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Kantaloupeflow_WatchKantaloupeflows_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeflowLogs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Kantaloupeflow/GetKantaloupeflowLogs", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/namespaces/{namespace}/kantaloupeflows/{name}/logs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Kantaloupeflow_GetKantaloupeflowLogs_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Kantaloupeflow_GetKantaloupeflowLogs_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Kantaloupeflow_GetKantaloupeTree_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -6499,9 +7347,12 @@ func RegisterKantaloupeflowHandlerClient(ctx context.Context, mux *runtime.Serve
 
 var (
 	pattern_Kantaloupeflow_CreateKantaloupeflow_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "kantaloupeflows"}, ""))
+	pattern_Kantaloupeflow_CreateKantaloupeflowAsync_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "kantaloupeflows"}, "createAsync"))
 	pattern_Kantaloupeflow_GetKantaloupeflow_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows", "name"}, ""))
 	pattern_Kantaloupeflow_DeleteKantaloupeflow_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows", "name"}, ""))
 	pattern_Kantaloupeflow_ListKantaloupeflows_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows"}, ""))
+	pattern_Kantaloupeflow_WatchKantaloupeflows_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "watch", "clusters", "cluster", "kantaloupeflows"}, ""))
+	pattern_Kantaloupeflow_GetKantaloupeflowLogs_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8, 2, 9}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows", "name", "logs"}, ""))
 	pattern_Kantaloupeflow_GetKantaloupeTree_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 2, 5}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "platform", "kantaloupeflows", "tree"}, ""))
 	pattern_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8, 2, 9}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows", "name", "gpumemory"}, ""))
 	pattern_Kantaloupeflow_GetKantaloupeflowConditions_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8, 2, 9}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "clusters", "cluster", "namespaces", "namespace", "kantaloupeflows", "name", "conditions"}, ""))
@@ -6509,9 +7360,12 @@ var (
 
 var (
 	forward_Kantaloupeflow_CreateKantaloupeflow_0          = runtime.ForwardResponseMessage
+	forward_Kantaloupeflow_CreateKantaloupeflowAsync_0     = runtime.ForwardResponseMessage
 	forward_Kantaloupeflow_GetKantaloupeflow_0             = runtime.ForwardResponseMessage
 	forward_Kantaloupeflow_DeleteKantaloupeflow_0          = runtime.ForwardResponseMessage
 	forward_Kantaloupeflow_ListKantaloupeflows_0           = runtime.ForwardResponseMessage
+	forward_Kantaloupeflow_WatchKantaloupeflows_0          = runtime.ForwardResponseStream
+	forward_Kantaloupeflow_GetKantaloupeflowLogs_0         = runtime.ForwardResponseStream
 	forward_Kantaloupeflow_GetKantaloupeTree_0             = runtime.ForwardResponseMessage
 	forward_Kantaloupeflow_UpdateKantaloupeflowGPUMemory_0 = runtime.ForwardResponseMessage
 	forward_Kantaloupeflow_GetKantaloupeflowConditions_0   = runtime.ForwardResponseMessage
@@ -7000,3 +7854,214 @@ var (
 	forward_AcceleratorCard_GetAcceleratorCard_0  = runtime.ForwardResponseMessage
 	forward_AcceleratorCard_ListModelNames_0      = runtime.ForwardResponseMessage
 )
+
+// RegisterAuditHandlerFromEndpoint is same as RegisterAuditHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterAuditHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterAuditHandler(ctx, mux, conn)
+}
+
+// RegisterAuditHandler registers the http handlers for service Audit to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterAuditHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterAuditHandlerClient(ctx, mux, NewAuditClient(conn))
+}
+
+// RegisterAuditHandlerClient registers the http handlers for service Audit
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "AuditClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "AuditClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "AuditClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterAuditHandlerClient(ctx context.Context, mux *runtime.ServeMux, client AuditClient) error {
+	mux.Handle(http.MethodGet, pattern_Audit_ListAuditEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Audit/ListAuditEvents", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/auditevents"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Audit_ListAuditEvents_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Audit_ListAuditEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_Audit_ListAuditEvents_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "auditevents"}, ""))
+)
+
+var (
+	forward_Audit_ListAuditEvents_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterApplyHandlerFromEndpoint is same as RegisterApplyHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterApplyHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterApplyHandler(ctx, mux, conn)
+}
+
+// RegisterApplyHandler registers the http handlers for service Apply to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterApplyHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterApplyHandlerClient(ctx, mux, NewApplyClient(conn))
+}
+
+// RegisterApplyHandlerClient registers the http handlers for service Apply
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "ApplyClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "ApplyClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "ApplyClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterApplyHandlerClient(ctx context.Context, mux *runtime.ServeMux, client ApplyClient) error {
+	mux.Handle(http.MethodPost, pattern_Apply_Apply_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Apply/Apply", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/apply"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Apply_Apply_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Apply_Apply_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_Apply_Apply_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "apply"}, ""))
+)
+
+var (
+	forward_Apply_Apply_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterOperationsHandlerFromEndpoint is same as RegisterOperationsHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterOperationsHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterOperationsHandler(ctx, mux, conn)
+}
+
+// RegisterOperationsHandler registers the http handlers for service Operations to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterOperationsHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterOperationsHandlerClient(ctx, mux, NewOperationsClient(conn))
+}
+
+// RegisterOperationsHandlerClient registers the http handlers for service Operations
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "OperationsClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "OperationsClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "OperationsClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterOperationsHandlerClient(ctx context.Context, mux *runtime.ServeMux, client OperationsClient) error {
+	mux.Handle(http.MethodGet, pattern_Operations_GetOperation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Operations/GetOperation", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/operations/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Operations_GetOperation_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Operations_GetOperation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Operations_CancelOperation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/kantaloupev1.Operations/CancelOperation", runtime.WithHTTPPathPattern("/apis/kantaloupe.dynamia.ai/v1/operations/{id}:cancel"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Operations_CancelOperation_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Operations_CancelOperation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_Operations_GetOperation_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "operations", "id"}, ""))
+	pattern_Operations_CancelOperation_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"apis", "kantaloupe.dynamia.ai", "v1", "operations", "id"}, "cancel"))
+)
+
+var (
+	forward_Operations_GetOperation_0    = runtime.ForwardResponseMessage
+	forward_Operations_CancelOperation_0 = runtime.ForwardResponseMessage
+)
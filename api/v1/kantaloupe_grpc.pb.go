@@ -8,14 +8,18 @@ package v1
 
 import (
 	context "context"
-	v1alpha17 "github.com/dynamia-ai/kantaloupe/api/acceleratorcard/v1alpha1"
+	v1alpha18 "github.com/dynamia-ai/kantaloupe/api/acceleratorcard/v1alpha1"
+	v1alpha110 "github.com/dynamia-ai/kantaloupe/api/apply/v1alpha1"
+	v1alpha19 "github.com/dynamia-ai/kantaloupe/api/audit/v1alpha1"
 	v1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
-	v1alpha12 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
-	v1alpha14 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
-	v1alpha13 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
-	v1alpha11 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
-	v1alpha15 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
-	v1alpha16 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	v1alpha13 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	v1alpha15 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
+	v1alpha14 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	v1alpha12 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
+	v1alpha11 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	v1alpha16 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
+	v1alpha17 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -29,10 +33,13 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Cluster_ListClusters_FullMethodName              = "/kantaloupev1.Cluster/ListClusters"
+	Cluster_WatchClusters_FullMethodName             = "/kantaloupev1.Cluster/WatchClusters"
 	Cluster_IntegrateCluster_FullMethodName          = "/kantaloupev1.Cluster/IntegrateCluster"
+	Cluster_IntegrateClusterAsync_FullMethodName     = "/kantaloupev1.Cluster/IntegrateClusterAsync"
 	Cluster_GetCluster_FullMethodName                = "/kantaloupev1.Cluster/GetCluster"
 	Cluster_UpdateCluster_FullMethodName             = "/kantaloupev1.Cluster/UpdateCluster"
 	Cluster_DeleteCluster_FullMethodName             = "/kantaloupev1.Cluster/DeleteCluster"
+	Cluster_UpdateClusterMaintenance_FullMethodName  = "/kantaloupev1.Cluster/UpdateClusterMaintenance"
 	Cluster_ValidateKubeconfig_FullMethodName        = "/kantaloupev1.Cluster/ValidateKubeconfig"
 	Cluster_GetPlatformSummury_FullMethodName        = "/kantaloupev1.Cluster/GetPlatformSummury"
 	Cluster_ListClusterVersions_FullMethodName       = "/kantaloupev1.Cluster/ListClusterVersions"
@@ -40,6 +47,7 @@ const (
 	Cluster_GetPlatformGPUTop_FullMethodName         = "/kantaloupev1.Cluster/GetPlatformGPUTop"
 	Cluster_GetClusterPlugins_FullMethodName         = "/kantaloupev1.Cluster/GetClusterPlugins"
 	Cluster_GetClusterCardRequestType_FullMethodName = "/kantaloupev1.Cluster/GetClusterCardRequestType"
+	Cluster_GetClusterCapabilities_FullMethodName    = "/kantaloupev1.Cluster/GetClusterCapabilities"
 )
 
 // ClusterClient is the client API for Cluster service.
@@ -48,24 +56,34 @@ const (
 type ClusterClient interface {
 	// ListClusters lists cluster proto resources.
 	ListClusters(ctx context.Context, in *v1alpha1.ListClustersRequest, opts ...grpc.CallOption) (*v1alpha1.ListClustersResponse, error)
+	// WatchClusters streams the changes of the clusters.
+	WatchClusters(ctx context.Context, in *v1alpha1.WatchClustersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha1.WatchClustersResponse], error)
 	// IntegrateCluster integrates a cluster.
 	IntegrateCluster(ctx context.Context, in *v1alpha1.IntegrateClusterRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// IntegrateClusterAsync integrates a cluster in an operation, the result of the operation
+	// is the Cluster. The cluster is not integrated if the operation fails or is canceled.
+	IntegrateClusterAsync(ctx context.Context, in *v1alpha1.IntegrateClusterRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error)
 	// GetCluster gets the details of the specified cluster.
 	GetCluster(ctx context.Context, in *v1alpha1.GetClusterRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// UpdateCluster updates the specified cluster.
 	UpdateCluster(ctx context.Context, in *v1alpha1.UpdateClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteCluster deletes the specified cluster.
 	DeleteCluster(ctx context.Context, in *v1alpha1.DeleteClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateClusterMaintenance makes the specified cluster enter or leave maintenance mode.
+	UpdateClusterMaintenance(ctx context.Context, in *v1alpha1.UpdateClusterMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// ValidateKubeconfig validats whether the config is valid.
 	ValidateKubeconfig(ctx context.Context, in *v1alpha1.ValidateKubeconfigRequest, opts ...grpc.CallOption) (*v1alpha1.ValidateKubeconfigResponse, error)
 	// GetPlatformSummury gets platform summury.
 	GetPlatformSummury(ctx context.Context, in *v1alpha1.GetPlatformSummuryRequest, opts ...grpc.CallOption) (*v1alpha1.PlatformSummury, error)
 	// ListClusterVersions lists cluster versions.
 	ListClusterVersions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha1.ListClusterVersionsResponse, error)
-	GetPlatformResourceTrend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error)
+	GetPlatformResourceTrend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error)
 	GetPlatformGPUTop(ctx context.Context, in *v1alpha1.GetPlatformGPUTopRequest, opts ...grpc.CallOption) (*v1alpha1.GetPlatformGPUTopResponse, error)
 	GetClusterPlugins(ctx context.Context, in *v1alpha1.GetClusterPluginsRequest, opts ...grpc.CallOption) (*v1alpha1.GetClusterPluginsResponse, error)
 	GetClusterCardRequestType(ctx context.Context, in *v1alpha1.GetClusterCardRequestTypeRequest, opts ...grpc.CallOption) (*v1alpha1.GetClusterCardRequestTypeResponse, error)
+	// GetClusterCapabilities gets the versions of the components installed in the
+	// cluster and the kantaloupeflow features usable there.
+	GetClusterCapabilities(ctx context.Context, in *v1alpha1.GetClusterCapabilitiesRequest, opts ...grpc.CallOption) (*v1alpha1.ClusterCapabilities, error)
 }
 
 type clusterClient struct {
@@ -86,6 +104,25 @@ func (c *clusterClient) ListClusters(ctx context.Context, in *v1alpha1.ListClust
 	return out, nil
 }
 
+func (c *clusterClient) WatchClusters(ctx context.Context, in *v1alpha1.WatchClustersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha1.WatchClustersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[0], Cluster_WatchClusters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha1.WatchClustersRequest, v1alpha1.WatchClustersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_WatchClustersClient = grpc.ServerStreamingClient[v1alpha1.WatchClustersResponse]
+
 func (c *clusterClient) IntegrateCluster(ctx context.Context, in *v1alpha1.IntegrateClusterRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1alpha1.Cluster)
@@ -96,6 +133,16 @@ func (c *clusterClient) IntegrateCluster(ctx context.Context, in *v1alpha1.Integ
 	return out, nil
 }
 
+func (c *clusterClient) IntegrateClusterAsync(ctx context.Context, in *v1alpha1.IntegrateClusterRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha11.Operation)
+	err := c.cc.Invoke(ctx, Cluster_IntegrateClusterAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterClient) GetCluster(ctx context.Context, in *v1alpha1.GetClusterRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1alpha1.Cluster)
@@ -126,6 +173,16 @@ func (c *clusterClient) DeleteCluster(ctx context.Context, in *v1alpha1.DeleteCl
 	return out, nil
 }
 
+func (c *clusterClient) UpdateClusterMaintenance(ctx context.Context, in *v1alpha1.UpdateClusterMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.Cluster)
+	err := c.cc.Invoke(ctx, Cluster_UpdateClusterMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterClient) ValidateKubeconfig(ctx context.Context, in *v1alpha1.ValidateKubeconfigRequest, opts ...grpc.CallOption) (*v1alpha1.ValidateKubeconfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1alpha1.ValidateKubeconfigResponse)
@@ -156,9 +213,9 @@ func (c *clusterClient) ListClusterVersions(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
-func (c *clusterClient) GetPlatformResourceTrend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error) {
+func (c *clusterClient) GetPlatformResourceTrend(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ResourceTrendResponse)
+	out := new(v1alpha12.ResourceTrendResponse)
 	err := c.cc.Invoke(ctx, Cluster_GetPlatformResourceTrend_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -196,30 +253,50 @@ func (c *clusterClient) GetClusterCardRequestType(ctx context.Context, in *v1alp
 	return out, nil
 }
 
+func (c *clusterClient) GetClusterCapabilities(ctx context.Context, in *v1alpha1.GetClusterCapabilitiesRequest, opts ...grpc.CallOption) (*v1alpha1.ClusterCapabilities, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.ClusterCapabilities)
+	err := c.cc.Invoke(ctx, Cluster_GetClusterCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 // All implementations must embed UnimplementedClusterServer
 // for forward compatibility.
 type ClusterServer interface {
 	// ListClusters lists cluster proto resources.
 	ListClusters(context.Context, *v1alpha1.ListClustersRequest) (*v1alpha1.ListClustersResponse, error)
+	// WatchClusters streams the changes of the clusters.
+	WatchClusters(*v1alpha1.WatchClustersRequest, grpc.ServerStreamingServer[v1alpha1.WatchClustersResponse]) error
 	// IntegrateCluster integrates a cluster.
 	IntegrateCluster(context.Context, *v1alpha1.IntegrateClusterRequest) (*v1alpha1.Cluster, error)
+	// IntegrateClusterAsync integrates a cluster in an operation, the result of the operation
+	// is the Cluster. The cluster is not integrated if the operation fails or is canceled.
+	IntegrateClusterAsync(context.Context, *v1alpha1.IntegrateClusterRequest) (*v1alpha11.Operation, error)
 	// GetCluster gets the details of the specified cluster.
 	GetCluster(context.Context, *v1alpha1.GetClusterRequest) (*v1alpha1.Cluster, error)
 	// UpdateCluster updates the specified cluster.
 	UpdateCluster(context.Context, *v1alpha1.UpdateClusterRequest) (*emptypb.Empty, error)
 	// DeleteCluster deletes the specified cluster.
 	DeleteCluster(context.Context, *v1alpha1.DeleteClusterRequest) (*emptypb.Empty, error)
+	// UpdateClusterMaintenance makes the specified cluster enter or leave maintenance mode.
+	UpdateClusterMaintenance(context.Context, *v1alpha1.UpdateClusterMaintenanceRequest) (*v1alpha1.Cluster, error)
 	// ValidateKubeconfig validats whether the config is valid.
 	ValidateKubeconfig(context.Context, *v1alpha1.ValidateKubeconfigRequest) (*v1alpha1.ValidateKubeconfigResponse, error)
 	// GetPlatformSummury gets platform summury.
 	GetPlatformSummury(context.Context, *v1alpha1.GetPlatformSummuryRequest) (*v1alpha1.PlatformSummury, error)
 	// ListClusterVersions lists cluster versions.
 	ListClusterVersions(context.Context, *emptypb.Empty) (*v1alpha1.ListClusterVersionsResponse, error)
-	GetPlatformResourceTrend(context.Context, *emptypb.Empty) (*v1alpha11.ResourceTrendResponse, error)
+	GetPlatformResourceTrend(context.Context, *emptypb.Empty) (*v1alpha12.ResourceTrendResponse, error)
 	GetPlatformGPUTop(context.Context, *v1alpha1.GetPlatformGPUTopRequest) (*v1alpha1.GetPlatformGPUTopResponse, error)
 	GetClusterPlugins(context.Context, *v1alpha1.GetClusterPluginsRequest) (*v1alpha1.GetClusterPluginsResponse, error)
 	GetClusterCardRequestType(context.Context, *v1alpha1.GetClusterCardRequestTypeRequest) (*v1alpha1.GetClusterCardRequestTypeResponse, error)
+	// GetClusterCapabilities gets the versions of the components installed in the
+	// cluster and the kantaloupeflow features usable there.
+	GetClusterCapabilities(context.Context, *v1alpha1.GetClusterCapabilitiesRequest) (*v1alpha1.ClusterCapabilities, error)
 	mustEmbedUnimplementedClusterServer()
 }
 
@@ -233,9 +310,15 @@ type UnimplementedClusterServer struct{}
 func (UnimplementedClusterServer) ListClusters(context.Context, *v1alpha1.ListClustersRequest) (*v1alpha1.ListClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
 }
+func (UnimplementedClusterServer) WatchClusters(*v1alpha1.WatchClustersRequest, grpc.ServerStreamingServer[v1alpha1.WatchClustersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClusters not implemented")
+}
 func (UnimplementedClusterServer) IntegrateCluster(context.Context, *v1alpha1.IntegrateClusterRequest) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IntegrateCluster not implemented")
 }
+func (UnimplementedClusterServer) IntegrateClusterAsync(context.Context, *v1alpha1.IntegrateClusterRequest) (*v1alpha11.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrateClusterAsync not implemented")
+}
 func (UnimplementedClusterServer) GetCluster(context.Context, *v1alpha1.GetClusterRequest) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
 }
@@ -245,6 +328,9 @@ func (UnimplementedClusterServer) UpdateCluster(context.Context, *v1alpha1.Updat
 func (UnimplementedClusterServer) DeleteCluster(context.Context, *v1alpha1.DeleteClusterRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
+func (UnimplementedClusterServer) UpdateClusterMaintenance(context.Context, *v1alpha1.UpdateClusterMaintenanceRequest) (*v1alpha1.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterMaintenance not implemented")
+}
 func (UnimplementedClusterServer) ValidateKubeconfig(context.Context, *v1alpha1.ValidateKubeconfigRequest) (*v1alpha1.ValidateKubeconfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateKubeconfig not implemented")
 }
@@ -254,7 +340,7 @@ func (UnimplementedClusterServer) GetPlatformSummury(context.Context, *v1alpha1.
 func (UnimplementedClusterServer) ListClusterVersions(context.Context, *emptypb.Empty) (*v1alpha1.ListClusterVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusterVersions not implemented")
 }
-func (UnimplementedClusterServer) GetPlatformResourceTrend(context.Context, *emptypb.Empty) (*v1alpha11.ResourceTrendResponse, error) {
+func (UnimplementedClusterServer) GetPlatformResourceTrend(context.Context, *emptypb.Empty) (*v1alpha12.ResourceTrendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformResourceTrend not implemented")
 }
 func (UnimplementedClusterServer) GetPlatformGPUTop(context.Context, *v1alpha1.GetPlatformGPUTopRequest) (*v1alpha1.GetPlatformGPUTopResponse, error) {
@@ -266,6 +352,9 @@ func (UnimplementedClusterServer) GetClusterPlugins(context.Context, *v1alpha1.G
 func (UnimplementedClusterServer) GetClusterCardRequestType(context.Context, *v1alpha1.GetClusterCardRequestTypeRequest) (*v1alpha1.GetClusterCardRequestTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClusterCardRequestType not implemented")
 }
+func (UnimplementedClusterServer) GetClusterCapabilities(context.Context, *v1alpha1.GetClusterCapabilitiesRequest) (*v1alpha1.ClusterCapabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterCapabilities not implemented")
+}
 func (UnimplementedClusterServer) mustEmbedUnimplementedClusterServer() {}
 func (UnimplementedClusterServer) testEmbeddedByValue()                 {}
 
@@ -305,6 +394,17 @@ func _Cluster_ListClusters_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_WatchClusters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha1.WatchClustersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).WatchClusters(m, &grpc.GenericServerStream[v1alpha1.WatchClustersRequest, v1alpha1.WatchClustersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cluster_WatchClustersServer = grpc.ServerStreamingServer[v1alpha1.WatchClustersResponse]
+
 func _Cluster_IntegrateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.IntegrateClusterRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +423,24 @@ func _Cluster_IntegrateCluster_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_IntegrateClusterAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.IntegrateClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).IntegrateClusterAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_IntegrateClusterAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).IntegrateClusterAsync(ctx, req.(*v1alpha1.IntegrateClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cluster_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetClusterRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +495,24 @@ func _Cluster_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_UpdateClusterMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.UpdateClusterMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).UpdateClusterMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_UpdateClusterMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).UpdateClusterMaintenance(ctx, req.(*v1alpha1.UpdateClusterMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cluster_ValidateKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.ValidateKubeconfigRequest)
 	if err := dec(in); err != nil {
@@ -503,6 +639,24 @@ func _Cluster_GetClusterCardRequestType_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_GetClusterCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetClusterCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetClusterCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetClusterCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetClusterCapabilities(ctx, req.(*v1alpha1.GetClusterCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -518,6 +672,10 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IntegrateCluster",
 			Handler:    _Cluster_IntegrateCluster_Handler,
 		},
+		{
+			MethodName: "IntegrateClusterAsync",
+			Handler:    _Cluster_IntegrateClusterAsync_Handler,
+		},
 		{
 			MethodName: "GetCluster",
 			Handler:    _Cluster_GetCluster_Handler,
@@ -530,6 +688,10 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCluster",
 			Handler:    _Cluster_DeleteCluster_Handler,
 		},
+		{
+			MethodName: "UpdateClusterMaintenance",
+			Handler:    _Cluster_UpdateClusterMaintenance_Handler,
+		},
 		{
 			MethodName: "ValidateKubeconfig",
 			Handler:    _Cluster_ValidateKubeconfig_Handler,
@@ -558,8 +720,18 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterCardRequestType",
 			Handler:    _Cluster_GetClusterCardRequestType_Handler,
 		},
+		{
+			MethodName: "GetClusterCapabilities",
+			Handler:    _Cluster_GetClusterCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchClusters",
+			Handler:       _Cluster_WatchClusters_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/kantaloupe.proto",
 }
 
@@ -577,8 +749,10 @@ const (
 	Core_ListClusterNamespaces_FullMethodName   = "/kantaloupev1.Core/ListClusterNamespaces"
 	Core_ListClusterGPUSummary_FullMethodName   = "/kantaloupev1.Core/ListClusterGPUSummary"
 	Core_ListClusterEvents_FullMethodName       = "/kantaloupev1.Core/ListClusterEvents"
+	Core_WatchEvents_FullMethodName             = "/kantaloupev1.Core/WatchEvents"
 	Core_ListEvents_FullMethodName              = "/kantaloupev1.Core/ListEvents"
 	Core_ListNodes_FullMethodName               = "/kantaloupev1.Core/ListNodes"
+	Core_WatchNodes_FullMethodName              = "/kantaloupev1.Core/WatchNodes"
 	Core_GetNode_FullMethodName                 = "/kantaloupev1.Core/GetNode"
 	Core_PutNodeLabels_FullMethodName           = "/kantaloupev1.Core/PutNodeLabels"
 	Core_PutNodeTaints_FullMethodName           = "/kantaloupev1.Core/PutNodeTaints"
@@ -595,54 +769,58 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CoreClient interface {
 	// ListPersistentVolumes lists persistentvolumes in the specified cluster
-	ListPersistentVolumes(ctx context.Context, in *v1alpha12.ListPersistentVolumesRequest, opts ...grpc.CallOption) (*v1alpha12.ListPersistentVolumesResponse, error)
+	ListPersistentVolumes(ctx context.Context, in *v1alpha13.ListPersistentVolumesRequest, opts ...grpc.CallOption) (*v1alpha13.ListPersistentVolumesResponse, error)
 	// GetPersistentVolume gets a persistentvolume from the system by given persistentvolume name
-	GetPersistentVolume(ctx context.Context, in *v1alpha12.GetPersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.GetPersistentVolumeResponse, error)
+	GetPersistentVolume(ctx context.Context, in *v1alpha13.GetPersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.GetPersistentVolumeResponse, error)
 	// GetPersistentVolumeJSON gets a persistentvolume json from the system by given persistentvolume name
-	GetPersistentVolumeJSON(ctx context.Context, in *v1alpha12.GetPersistentVolumeJSONRequest, opts ...grpc.CallOption) (*v1alpha12.GetPersistentVolumeResponse, error)
+	GetPersistentVolumeJSON(ctx context.Context, in *v1alpha13.GetPersistentVolumeJSONRequest, opts ...grpc.CallOption) (*v1alpha13.GetPersistentVolumeResponse, error)
 	// CreatePersistentVolume creates a persistentvolume to the system by given persistentvolume data
-	CreatePersistentVolume(ctx context.Context, in *v1alpha12.CreatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.CreatePersistentVolumeResponse, error)
+	CreatePersistentVolume(ctx context.Context, in *v1alpha13.CreatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.CreatePersistentVolumeResponse, error)
 	// UpdatePersistentVolume updates a persistentvolume from the system by given persistentvolume name
-	UpdatePersistentVolume(ctx context.Context, in *v1alpha12.UpdatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.UpdatePersistentVolumeResponse, error)
+	UpdatePersistentVolume(ctx context.Context, in *v1alpha13.UpdatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.UpdatePersistentVolumeResponse, error)
 	// DeletePersistentVolume deletes a persistentvolume from the system by given persistentvolume name
-	DeletePersistentVolume(ctx context.Context, in *v1alpha12.DeletePersistentVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeletePersistentVolume(ctx context.Context, in *v1alpha13.DeletePersistentVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteSecret deletes a secret under the namespaces of a specific cluster
-	DeleteSecret(ctx context.Context, in *v1alpha12.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteSecret(ctx context.Context, in *v1alpha13.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetClusterSecret gets a secret under the namespaces of a specific cluster
-	GetSecret(ctx context.Context, in *v1alpha12.GetSecretRequest, opts ...grpc.CallOption) (*v1alpha12.Secret, error)
+	GetSecret(ctx context.Context, in *v1alpha13.GetSecretRequest, opts ...grpc.CallOption) (*v1alpha13.Secret, error)
 	// ListSecrets lists a secret under the namespaces of a specific cluster
-	ListSecrets(ctx context.Context, in *v1alpha12.ListSecretsRequest, opts ...grpc.CallOption) (*v1alpha12.ListSecretsResponse, error)
+	ListSecrets(ctx context.Context, in *v1alpha13.ListSecretsRequest, opts ...grpc.CallOption) (*v1alpha13.ListSecretsResponse, error)
 	// CreateSecret creates a secret under the namespaces of a specific cluster
-	CreateSecret(ctx context.Context, in *v1alpha12.CreateSecretRequest, opts ...grpc.CallOption) (*v1alpha12.CreateSecretResponse, error)
+	CreateSecret(ctx context.Context, in *v1alpha13.CreateSecretRequest, opts ...grpc.CallOption) (*v1alpha13.CreateSecretResponse, error)
 	// ListClusterNamespaces gets all the namespaces of given cluster
-	ListClusterNamespaces(ctx context.Context, in *v1alpha12.ListClusterNamespacesRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterNamespacesResponse, error)
+	ListClusterNamespaces(ctx context.Context, in *v1alpha13.ListClusterNamespacesRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterNamespacesResponse, error)
 	// ListClusterGPUSummary lists gpu summary of all nodes of the specified cluster.
-	ListClusterGPUSummary(ctx context.Context, in *v1alpha12.ListClusterGPUSummaryRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterGPUSummaryResponse, error)
+	ListClusterGPUSummary(ctx context.Context, in *v1alpha13.ListClusterGPUSummaryRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterGPUSummaryResponse, error)
 	// ListClusterEvents lists all events in the specified cluster,
 	// regardless of namespace.
-	ListClusterEvents(ctx context.Context, in *v1alpha12.ListClusterEventsRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterEventsResponse, error)
+	ListClusterEvents(ctx context.Context, in *v1alpha13.ListClusterEventsRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterEventsResponse, error)
+	// WatchEvents streams the changes of the events of a cluster.
+	WatchEvents(ctx context.Context, in *v1alpha13.WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha13.WatchEventsResponse], error)
 	// ListEvents lists events under the namespaces of a specific cluster
-	ListEvents(ctx context.Context, in *v1alpha12.ListEventsRequest, opts ...grpc.CallOption) (*v1alpha12.ListEventsResponse, error)
+	ListEvents(ctx context.Context, in *v1alpha13.ListEventsRequest, opts ...grpc.CallOption) (*v1alpha13.ListEventsResponse, error)
 	// ListNodes lists nodes under the specific node
-	ListNodes(ctx context.Context, in *v1alpha12.ListNodesRequest, opts ...grpc.CallOption) (*v1alpha12.ListNodesResponse, error)
+	ListNodes(ctx context.Context, in *v1alpha13.ListNodesRequest, opts ...grpc.CallOption) (*v1alpha13.ListNodesResponse, error)
+	// WatchNodes streams the changes of the nodes of a cluster.
+	WatchNodes(ctx context.Context, in *v1alpha13.WatchNodesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha13.WatchNodesResponse], error)
 	// GetNode gets the details of the specified node
-	GetNode(ctx context.Context, in *v1alpha12.GetNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error)
+	GetNode(ctx context.Context, in *v1alpha13.GetNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error)
 	// PutNodeLabels puts the specified node's labels
-	PutNodeLabels(ctx context.Context, in *v1alpha12.PutNodeLabelsRequest, opts ...grpc.CallOption) (*v1alpha12.PutNodeLabelsResponse, error)
+	PutNodeLabels(ctx context.Context, in *v1alpha13.PutNodeLabelsRequest, opts ...grpc.CallOption) (*v1alpha13.PutNodeLabelsResponse, error)
 	// PutNodeTaints puts a node's taints in a specific cluster
-	PutNodeTaints(ctx context.Context, in *v1alpha12.PutNodeTaintsRequest, opts ...grpc.CallOption) (*v1alpha12.PutNodeTaintsResponse, error)
+	PutNodeTaints(ctx context.Context, in *v1alpha13.PutNodeTaintsRequest, opts ...grpc.CallOption) (*v1alpha13.PutNodeTaintsResponse, error)
 	// UpdateNodeAnnotations edits annotations of specified node.
-	UpdateNodeAnnotations(ctx context.Context, in *v1alpha12.UpdateNodeAnnotationsRequest, opts ...grpc.CallOption) (*v1alpha12.UpdateNodeAnnotationsResponse, error)
+	UpdateNodeAnnotations(ctx context.Context, in *v1alpha13.UpdateNodeAnnotationsRequest, opts ...grpc.CallOption) (*v1alpha13.UpdateNodeAnnotationsResponse, error)
 	// UnScheduleNode makes the specified node to unschedulable.
-	UnScheduleNode(ctx context.Context, in *v1alpha12.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error)
+	UnScheduleNode(ctx context.Context, in *v1alpha13.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error)
 	// ScheduleNode makes the specified node to schedulable.
-	ScheduleNode(ctx context.Context, in *v1alpha12.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error)
+	ScheduleNode(ctx context.Context, in *v1alpha13.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error)
 	// GetConfigMap gets a configMap under the namespaces of a specific cluster
-	GetConfigMap(ctx context.Context, in *v1alpha12.GetConfigMapRequest, opts ...grpc.CallOption) (*v1alpha12.ConfigMap, error)
+	GetConfigMap(ctx context.Context, in *v1alpha13.GetConfigMapRequest, opts ...grpc.CallOption) (*v1alpha13.ConfigMap, error)
 	// GetConfigMapJSON gets a configMap json under the namespaces of a specific cluster
-	GetConfigMapJSON(ctx context.Context, in *v1alpha12.GetConfigMapJSONRequest, opts ...grpc.CallOption) (*v1alpha12.GetConfigMapJSONResponse, error)
+	GetConfigMapJSON(ctx context.Context, in *v1alpha13.GetConfigMapJSONRequest, opts ...grpc.CallOption) (*v1alpha13.GetConfigMapJSONResponse, error)
 	// UpdateConfigMap updates a configMap under the namespaces of a specific cluster
-	UpdateConfigMap(ctx context.Context, in *v1alpha12.UpdateConfigMapRequest, opts ...grpc.CallOption) (*v1alpha12.UpdateConfigMapResponse, error)
+	UpdateConfigMap(ctx context.Context, in *v1alpha13.UpdateConfigMapRequest, opts ...grpc.CallOption) (*v1alpha13.UpdateConfigMapResponse, error)
 }
 
 type coreClient struct {
@@ -653,9 +831,9 @@ func NewCoreClient(cc grpc.ClientConnInterface) CoreClient {
 	return &coreClient{cc}
 }
 
-func (c *coreClient) ListPersistentVolumes(ctx context.Context, in *v1alpha12.ListPersistentVolumesRequest, opts ...grpc.CallOption) (*v1alpha12.ListPersistentVolumesResponse, error) {
+func (c *coreClient) ListPersistentVolumes(ctx context.Context, in *v1alpha13.ListPersistentVolumesRequest, opts ...grpc.CallOption) (*v1alpha13.ListPersistentVolumesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListPersistentVolumesResponse)
+	out := new(v1alpha13.ListPersistentVolumesResponse)
 	err := c.cc.Invoke(ctx, Core_ListPersistentVolumes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -663,9 +841,9 @@ func (c *coreClient) ListPersistentVolumes(ctx context.Context, in *v1alpha12.Li
 	return out, nil
 }
 
-func (c *coreClient) GetPersistentVolume(ctx context.Context, in *v1alpha12.GetPersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.GetPersistentVolumeResponse, error) {
+func (c *coreClient) GetPersistentVolume(ctx context.Context, in *v1alpha13.GetPersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.GetPersistentVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.GetPersistentVolumeResponse)
+	out := new(v1alpha13.GetPersistentVolumeResponse)
 	err := c.cc.Invoke(ctx, Core_GetPersistentVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -673,9 +851,9 @@ func (c *coreClient) GetPersistentVolume(ctx context.Context, in *v1alpha12.GetP
 	return out, nil
 }
 
-func (c *coreClient) GetPersistentVolumeJSON(ctx context.Context, in *v1alpha12.GetPersistentVolumeJSONRequest, opts ...grpc.CallOption) (*v1alpha12.GetPersistentVolumeResponse, error) {
+func (c *coreClient) GetPersistentVolumeJSON(ctx context.Context, in *v1alpha13.GetPersistentVolumeJSONRequest, opts ...grpc.CallOption) (*v1alpha13.GetPersistentVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.GetPersistentVolumeResponse)
+	out := new(v1alpha13.GetPersistentVolumeResponse)
 	err := c.cc.Invoke(ctx, Core_GetPersistentVolumeJSON_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -683,9 +861,9 @@ func (c *coreClient) GetPersistentVolumeJSON(ctx context.Context, in *v1alpha12.
 	return out, nil
 }
 
-func (c *coreClient) CreatePersistentVolume(ctx context.Context, in *v1alpha12.CreatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.CreatePersistentVolumeResponse, error) {
+func (c *coreClient) CreatePersistentVolume(ctx context.Context, in *v1alpha13.CreatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.CreatePersistentVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.CreatePersistentVolumeResponse)
+	out := new(v1alpha13.CreatePersistentVolumeResponse)
 	err := c.cc.Invoke(ctx, Core_CreatePersistentVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -693,9 +871,9 @@ func (c *coreClient) CreatePersistentVolume(ctx context.Context, in *v1alpha12.C
 	return out, nil
 }
 
-func (c *coreClient) UpdatePersistentVolume(ctx context.Context, in *v1alpha12.UpdatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha12.UpdatePersistentVolumeResponse, error) {
+func (c *coreClient) UpdatePersistentVolume(ctx context.Context, in *v1alpha13.UpdatePersistentVolumeRequest, opts ...grpc.CallOption) (*v1alpha13.UpdatePersistentVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.UpdatePersistentVolumeResponse)
+	out := new(v1alpha13.UpdatePersistentVolumeResponse)
 	err := c.cc.Invoke(ctx, Core_UpdatePersistentVolume_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -703,7 +881,7 @@ func (c *coreClient) UpdatePersistentVolume(ctx context.Context, in *v1alpha12.U
 	return out, nil
 }
 
-func (c *coreClient) DeletePersistentVolume(ctx context.Context, in *v1alpha12.DeletePersistentVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *coreClient) DeletePersistentVolume(ctx context.Context, in *v1alpha13.DeletePersistentVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Core_DeletePersistentVolume_FullMethodName, in, out, cOpts...)
@@ -713,7 +891,7 @@ func (c *coreClient) DeletePersistentVolume(ctx context.Context, in *v1alpha12.D
 	return out, nil
 }
 
-func (c *coreClient) DeleteSecret(ctx context.Context, in *v1alpha12.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *coreClient) DeleteSecret(ctx context.Context, in *v1alpha13.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Core_DeleteSecret_FullMethodName, in, out, cOpts...)
@@ -723,9 +901,9 @@ func (c *coreClient) DeleteSecret(ctx context.Context, in *v1alpha12.DeleteSecre
 	return out, nil
 }
 
-func (c *coreClient) GetSecret(ctx context.Context, in *v1alpha12.GetSecretRequest, opts ...grpc.CallOption) (*v1alpha12.Secret, error) {
+func (c *coreClient) GetSecret(ctx context.Context, in *v1alpha13.GetSecretRequest, opts ...grpc.CallOption) (*v1alpha13.Secret, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.Secret)
+	out := new(v1alpha13.Secret)
 	err := c.cc.Invoke(ctx, Core_GetSecret_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -733,9 +911,9 @@ func (c *coreClient) GetSecret(ctx context.Context, in *v1alpha12.GetSecretReque
 	return out, nil
 }
 
-func (c *coreClient) ListSecrets(ctx context.Context, in *v1alpha12.ListSecretsRequest, opts ...grpc.CallOption) (*v1alpha12.ListSecretsResponse, error) {
+func (c *coreClient) ListSecrets(ctx context.Context, in *v1alpha13.ListSecretsRequest, opts ...grpc.CallOption) (*v1alpha13.ListSecretsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListSecretsResponse)
+	out := new(v1alpha13.ListSecretsResponse)
 	err := c.cc.Invoke(ctx, Core_ListSecrets_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -743,9 +921,9 @@ func (c *coreClient) ListSecrets(ctx context.Context, in *v1alpha12.ListSecretsR
 	return out, nil
 }
 
-func (c *coreClient) CreateSecret(ctx context.Context, in *v1alpha12.CreateSecretRequest, opts ...grpc.CallOption) (*v1alpha12.CreateSecretResponse, error) {
+func (c *coreClient) CreateSecret(ctx context.Context, in *v1alpha13.CreateSecretRequest, opts ...grpc.CallOption) (*v1alpha13.CreateSecretResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.CreateSecretResponse)
+	out := new(v1alpha13.CreateSecretResponse)
 	err := c.cc.Invoke(ctx, Core_CreateSecret_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -753,9 +931,9 @@ func (c *coreClient) CreateSecret(ctx context.Context, in *v1alpha12.CreateSecre
 	return out, nil
 }
 
-func (c *coreClient) ListClusterNamespaces(ctx context.Context, in *v1alpha12.ListClusterNamespacesRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterNamespacesResponse, error) {
+func (c *coreClient) ListClusterNamespaces(ctx context.Context, in *v1alpha13.ListClusterNamespacesRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterNamespacesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListClusterNamespacesResponse)
+	out := new(v1alpha13.ListClusterNamespacesResponse)
 	err := c.cc.Invoke(ctx, Core_ListClusterNamespaces_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -763,9 +941,9 @@ func (c *coreClient) ListClusterNamespaces(ctx context.Context, in *v1alpha12.Li
 	return out, nil
 }
 
-func (c *coreClient) ListClusterGPUSummary(ctx context.Context, in *v1alpha12.ListClusterGPUSummaryRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterGPUSummaryResponse, error) {
+func (c *coreClient) ListClusterGPUSummary(ctx context.Context, in *v1alpha13.ListClusterGPUSummaryRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterGPUSummaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListClusterGPUSummaryResponse)
+	out := new(v1alpha13.ListClusterGPUSummaryResponse)
 	err := c.cc.Invoke(ctx, Core_ListClusterGPUSummary_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -773,9 +951,9 @@ func (c *coreClient) ListClusterGPUSummary(ctx context.Context, in *v1alpha12.Li
 	return out, nil
 }
 
-func (c *coreClient) ListClusterEvents(ctx context.Context, in *v1alpha12.ListClusterEventsRequest, opts ...grpc.CallOption) (*v1alpha12.ListClusterEventsResponse, error) {
+func (c *coreClient) ListClusterEvents(ctx context.Context, in *v1alpha13.ListClusterEventsRequest, opts ...grpc.CallOption) (*v1alpha13.ListClusterEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListClusterEventsResponse)
+	out := new(v1alpha13.ListClusterEventsResponse)
 	err := c.cc.Invoke(ctx, Core_ListClusterEvents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -783,9 +961,28 @@ func (c *coreClient) ListClusterEvents(ctx context.Context, in *v1alpha12.ListCl
 	return out, nil
 }
 
-func (c *coreClient) ListEvents(ctx context.Context, in *v1alpha12.ListEventsRequest, opts ...grpc.CallOption) (*v1alpha12.ListEventsResponse, error) {
+func (c *coreClient) WatchEvents(ctx context.Context, in *v1alpha13.WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha13.WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[0], Core_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha13.WatchEventsRequest, v1alpha13.WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchEventsClient = grpc.ServerStreamingClient[v1alpha13.WatchEventsResponse]
+
+func (c *coreClient) ListEvents(ctx context.Context, in *v1alpha13.ListEventsRequest, opts ...grpc.CallOption) (*v1alpha13.ListEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListEventsResponse)
+	out := new(v1alpha13.ListEventsResponse)
 	err := c.cc.Invoke(ctx, Core_ListEvents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -793,9 +990,9 @@ func (c *coreClient) ListEvents(ctx context.Context, in *v1alpha12.ListEventsReq
 	return out, nil
 }
 
-func (c *coreClient) ListNodes(ctx context.Context, in *v1alpha12.ListNodesRequest, opts ...grpc.CallOption) (*v1alpha12.ListNodesResponse, error) {
+func (c *coreClient) ListNodes(ctx context.Context, in *v1alpha13.ListNodesRequest, opts ...grpc.CallOption) (*v1alpha13.ListNodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ListNodesResponse)
+	out := new(v1alpha13.ListNodesResponse)
 	err := c.cc.Invoke(ctx, Core_ListNodes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -803,9 +1000,28 @@ func (c *coreClient) ListNodes(ctx context.Context, in *v1alpha12.ListNodesReque
 	return out, nil
 }
 
-func (c *coreClient) GetNode(ctx context.Context, in *v1alpha12.GetNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error) {
+func (c *coreClient) WatchNodes(ctx context.Context, in *v1alpha13.WatchNodesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha13.WatchNodesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[1], Core_WatchNodes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha13.WatchNodesRequest, v1alpha13.WatchNodesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchNodesClient = grpc.ServerStreamingClient[v1alpha13.WatchNodesResponse]
+
+func (c *coreClient) GetNode(ctx context.Context, in *v1alpha13.GetNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.Node)
+	out := new(v1alpha13.Node)
 	err := c.cc.Invoke(ctx, Core_GetNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -813,9 +1029,9 @@ func (c *coreClient) GetNode(ctx context.Context, in *v1alpha12.GetNodeRequest,
 	return out, nil
 }
 
-func (c *coreClient) PutNodeLabels(ctx context.Context, in *v1alpha12.PutNodeLabelsRequest, opts ...grpc.CallOption) (*v1alpha12.PutNodeLabelsResponse, error) {
+func (c *coreClient) PutNodeLabels(ctx context.Context, in *v1alpha13.PutNodeLabelsRequest, opts ...grpc.CallOption) (*v1alpha13.PutNodeLabelsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.PutNodeLabelsResponse)
+	out := new(v1alpha13.PutNodeLabelsResponse)
 	err := c.cc.Invoke(ctx, Core_PutNodeLabels_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -823,9 +1039,9 @@ func (c *coreClient) PutNodeLabels(ctx context.Context, in *v1alpha12.PutNodeLab
 	return out, nil
 }
 
-func (c *coreClient) PutNodeTaints(ctx context.Context, in *v1alpha12.PutNodeTaintsRequest, opts ...grpc.CallOption) (*v1alpha12.PutNodeTaintsResponse, error) {
+func (c *coreClient) PutNodeTaints(ctx context.Context, in *v1alpha13.PutNodeTaintsRequest, opts ...grpc.CallOption) (*v1alpha13.PutNodeTaintsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.PutNodeTaintsResponse)
+	out := new(v1alpha13.PutNodeTaintsResponse)
 	err := c.cc.Invoke(ctx, Core_PutNodeTaints_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -833,9 +1049,9 @@ func (c *coreClient) PutNodeTaints(ctx context.Context, in *v1alpha12.PutNodeTai
 	return out, nil
 }
 
-func (c *coreClient) UpdateNodeAnnotations(ctx context.Context, in *v1alpha12.UpdateNodeAnnotationsRequest, opts ...grpc.CallOption) (*v1alpha12.UpdateNodeAnnotationsResponse, error) {
+func (c *coreClient) UpdateNodeAnnotations(ctx context.Context, in *v1alpha13.UpdateNodeAnnotationsRequest, opts ...grpc.CallOption) (*v1alpha13.UpdateNodeAnnotationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.UpdateNodeAnnotationsResponse)
+	out := new(v1alpha13.UpdateNodeAnnotationsResponse)
 	err := c.cc.Invoke(ctx, Core_UpdateNodeAnnotations_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -843,9 +1059,9 @@ func (c *coreClient) UpdateNodeAnnotations(ctx context.Context, in *v1alpha12.Up
 	return out, nil
 }
 
-func (c *coreClient) UnScheduleNode(ctx context.Context, in *v1alpha12.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error) {
+func (c *coreClient) UnScheduleNode(ctx context.Context, in *v1alpha13.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.Node)
+	out := new(v1alpha13.Node)
 	err := c.cc.Invoke(ctx, Core_UnScheduleNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -853,9 +1069,9 @@ func (c *coreClient) UnScheduleNode(ctx context.Context, in *v1alpha12.ScheduleN
 	return out, nil
 }
 
-func (c *coreClient) ScheduleNode(ctx context.Context, in *v1alpha12.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha12.Node, error) {
+func (c *coreClient) ScheduleNode(ctx context.Context, in *v1alpha13.ScheduleNodeRequest, opts ...grpc.CallOption) (*v1alpha13.Node, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.Node)
+	out := new(v1alpha13.Node)
 	err := c.cc.Invoke(ctx, Core_ScheduleNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -863,9 +1079,9 @@ func (c *coreClient) ScheduleNode(ctx context.Context, in *v1alpha12.ScheduleNod
 	return out, nil
 }
 
-func (c *coreClient) GetConfigMap(ctx context.Context, in *v1alpha12.GetConfigMapRequest, opts ...grpc.CallOption) (*v1alpha12.ConfigMap, error) {
+func (c *coreClient) GetConfigMap(ctx context.Context, in *v1alpha13.GetConfigMapRequest, opts ...grpc.CallOption) (*v1alpha13.ConfigMap, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.ConfigMap)
+	out := new(v1alpha13.ConfigMap)
 	err := c.cc.Invoke(ctx, Core_GetConfigMap_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -873,9 +1089,9 @@ func (c *coreClient) GetConfigMap(ctx context.Context, in *v1alpha12.GetConfigMa
 	return out, nil
 }
 
-func (c *coreClient) GetConfigMapJSON(ctx context.Context, in *v1alpha12.GetConfigMapJSONRequest, opts ...grpc.CallOption) (*v1alpha12.GetConfigMapJSONResponse, error) {
+func (c *coreClient) GetConfigMapJSON(ctx context.Context, in *v1alpha13.GetConfigMapJSONRequest, opts ...grpc.CallOption) (*v1alpha13.GetConfigMapJSONResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.GetConfigMapJSONResponse)
+	out := new(v1alpha13.GetConfigMapJSONResponse)
 	err := c.cc.Invoke(ctx, Core_GetConfigMapJSON_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -883,9 +1099,9 @@ func (c *coreClient) GetConfigMapJSON(ctx context.Context, in *v1alpha12.GetConf
 	return out, nil
 }
 
-func (c *coreClient) UpdateConfigMap(ctx context.Context, in *v1alpha12.UpdateConfigMapRequest, opts ...grpc.CallOption) (*v1alpha12.UpdateConfigMapResponse, error) {
+func (c *coreClient) UpdateConfigMap(ctx context.Context, in *v1alpha13.UpdateConfigMapRequest, opts ...grpc.CallOption) (*v1alpha13.UpdateConfigMapResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha12.UpdateConfigMapResponse)
+	out := new(v1alpha13.UpdateConfigMapResponse)
 	err := c.cc.Invoke(ctx, Core_UpdateConfigMap_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -898,54 +1114,58 @@ func (c *coreClient) UpdateConfigMap(ctx context.Context, in *v1alpha12.UpdateCo
 // for forward compatibility.
 type CoreServer interface {
 	// ListPersistentVolumes lists persistentvolumes in the specified cluster
-	ListPersistentVolumes(context.Context, *v1alpha12.ListPersistentVolumesRequest) (*v1alpha12.ListPersistentVolumesResponse, error)
+	ListPersistentVolumes(context.Context, *v1alpha13.ListPersistentVolumesRequest) (*v1alpha13.ListPersistentVolumesResponse, error)
 	// GetPersistentVolume gets a persistentvolume from the system by given persistentvolume name
-	GetPersistentVolume(context.Context, *v1alpha12.GetPersistentVolumeRequest) (*v1alpha12.GetPersistentVolumeResponse, error)
+	GetPersistentVolume(context.Context, *v1alpha13.GetPersistentVolumeRequest) (*v1alpha13.GetPersistentVolumeResponse, error)
 	// GetPersistentVolumeJSON gets a persistentvolume json from the system by given persistentvolume name
-	GetPersistentVolumeJSON(context.Context, *v1alpha12.GetPersistentVolumeJSONRequest) (*v1alpha12.GetPersistentVolumeResponse, error)
+	GetPersistentVolumeJSON(context.Context, *v1alpha13.GetPersistentVolumeJSONRequest) (*v1alpha13.GetPersistentVolumeResponse, error)
 	// CreatePersistentVolume creates a persistentvolume to the system by given persistentvolume data
-	CreatePersistentVolume(context.Context, *v1alpha12.CreatePersistentVolumeRequest) (*v1alpha12.CreatePersistentVolumeResponse, error)
+	CreatePersistentVolume(context.Context, *v1alpha13.CreatePersistentVolumeRequest) (*v1alpha13.CreatePersistentVolumeResponse, error)
 	// UpdatePersistentVolume updates a persistentvolume from the system by given persistentvolume name
-	UpdatePersistentVolume(context.Context, *v1alpha12.UpdatePersistentVolumeRequest) (*v1alpha12.UpdatePersistentVolumeResponse, error)
+	UpdatePersistentVolume(context.Context, *v1alpha13.UpdatePersistentVolumeRequest) (*v1alpha13.UpdatePersistentVolumeResponse, error)
 	// DeletePersistentVolume deletes a persistentvolume from the system by given persistentvolume name
-	DeletePersistentVolume(context.Context, *v1alpha12.DeletePersistentVolumeRequest) (*emptypb.Empty, error)
+	DeletePersistentVolume(context.Context, *v1alpha13.DeletePersistentVolumeRequest) (*emptypb.Empty, error)
 	// DeleteSecret deletes a secret under the namespaces of a specific cluster
-	DeleteSecret(context.Context, *v1alpha12.DeleteSecretRequest) (*emptypb.Empty, error)
+	DeleteSecret(context.Context, *v1alpha13.DeleteSecretRequest) (*emptypb.Empty, error)
 	// GetClusterSecret gets a secret under the namespaces of a specific cluster
-	GetSecret(context.Context, *v1alpha12.GetSecretRequest) (*v1alpha12.Secret, error)
+	GetSecret(context.Context, *v1alpha13.GetSecretRequest) (*v1alpha13.Secret, error)
 	// ListSecrets lists a secret under the namespaces of a specific cluster
-	ListSecrets(context.Context, *v1alpha12.ListSecretsRequest) (*v1alpha12.ListSecretsResponse, error)
+	ListSecrets(context.Context, *v1alpha13.ListSecretsRequest) (*v1alpha13.ListSecretsResponse, error)
 	// CreateSecret creates a secret under the namespaces of a specific cluster
-	CreateSecret(context.Context, *v1alpha12.CreateSecretRequest) (*v1alpha12.CreateSecretResponse, error)
+	CreateSecret(context.Context, *v1alpha13.CreateSecretRequest) (*v1alpha13.CreateSecretResponse, error)
 	// ListClusterNamespaces gets all the namespaces of given cluster
-	ListClusterNamespaces(context.Context, *v1alpha12.ListClusterNamespacesRequest) (*v1alpha12.ListClusterNamespacesResponse, error)
+	ListClusterNamespaces(context.Context, *v1alpha13.ListClusterNamespacesRequest) (*v1alpha13.ListClusterNamespacesResponse, error)
 	// ListClusterGPUSummary lists gpu summary of all nodes of the specified cluster.
-	ListClusterGPUSummary(context.Context, *v1alpha12.ListClusterGPUSummaryRequest) (*v1alpha12.ListClusterGPUSummaryResponse, error)
+	ListClusterGPUSummary(context.Context, *v1alpha13.ListClusterGPUSummaryRequest) (*v1alpha13.ListClusterGPUSummaryResponse, error)
 	// ListClusterEvents lists all events in the specified cluster,
 	// regardless of namespace.
-	ListClusterEvents(context.Context, *v1alpha12.ListClusterEventsRequest) (*v1alpha12.ListClusterEventsResponse, error)
+	ListClusterEvents(context.Context, *v1alpha13.ListClusterEventsRequest) (*v1alpha13.ListClusterEventsResponse, error)
+	// WatchEvents streams the changes of the events of a cluster.
+	WatchEvents(*v1alpha13.WatchEventsRequest, grpc.ServerStreamingServer[v1alpha13.WatchEventsResponse]) error
 	// ListEvents lists events under the namespaces of a specific cluster
-	ListEvents(context.Context, *v1alpha12.ListEventsRequest) (*v1alpha12.ListEventsResponse, error)
+	ListEvents(context.Context, *v1alpha13.ListEventsRequest) (*v1alpha13.ListEventsResponse, error)
 	// ListNodes lists nodes under the specific node
-	ListNodes(context.Context, *v1alpha12.ListNodesRequest) (*v1alpha12.ListNodesResponse, error)
+	ListNodes(context.Context, *v1alpha13.ListNodesRequest) (*v1alpha13.ListNodesResponse, error)
+	// WatchNodes streams the changes of the nodes of a cluster.
+	WatchNodes(*v1alpha13.WatchNodesRequest, grpc.ServerStreamingServer[v1alpha13.WatchNodesResponse]) error
 	// GetNode gets the details of the specified node
-	GetNode(context.Context, *v1alpha12.GetNodeRequest) (*v1alpha12.Node, error)
+	GetNode(context.Context, *v1alpha13.GetNodeRequest) (*v1alpha13.Node, error)
 	// PutNodeLabels puts the specified node's labels
-	PutNodeLabels(context.Context, *v1alpha12.PutNodeLabelsRequest) (*v1alpha12.PutNodeLabelsResponse, error)
+	PutNodeLabels(context.Context, *v1alpha13.PutNodeLabelsRequest) (*v1alpha13.PutNodeLabelsResponse, error)
 	// PutNodeTaints puts a node's taints in a specific cluster
-	PutNodeTaints(context.Context, *v1alpha12.PutNodeTaintsRequest) (*v1alpha12.PutNodeTaintsResponse, error)
+	PutNodeTaints(context.Context, *v1alpha13.PutNodeTaintsRequest) (*v1alpha13.PutNodeTaintsResponse, error)
 	// UpdateNodeAnnotations edits annotations of specified node.
-	UpdateNodeAnnotations(context.Context, *v1alpha12.UpdateNodeAnnotationsRequest) (*v1alpha12.UpdateNodeAnnotationsResponse, error)
+	UpdateNodeAnnotations(context.Context, *v1alpha13.UpdateNodeAnnotationsRequest) (*v1alpha13.UpdateNodeAnnotationsResponse, error)
 	// UnScheduleNode makes the specified node to unschedulable.
-	UnScheduleNode(context.Context, *v1alpha12.ScheduleNodeRequest) (*v1alpha12.Node, error)
+	UnScheduleNode(context.Context, *v1alpha13.ScheduleNodeRequest) (*v1alpha13.Node, error)
 	// ScheduleNode makes the specified node to schedulable.
-	ScheduleNode(context.Context, *v1alpha12.ScheduleNodeRequest) (*v1alpha12.Node, error)
+	ScheduleNode(context.Context, *v1alpha13.ScheduleNodeRequest) (*v1alpha13.Node, error)
 	// GetConfigMap gets a configMap under the namespaces of a specific cluster
-	GetConfigMap(context.Context, *v1alpha12.GetConfigMapRequest) (*v1alpha12.ConfigMap, error)
+	GetConfigMap(context.Context, *v1alpha13.GetConfigMapRequest) (*v1alpha13.ConfigMap, error)
 	// GetConfigMapJSON gets a configMap json under the namespaces of a specific cluster
-	GetConfigMapJSON(context.Context, *v1alpha12.GetConfigMapJSONRequest) (*v1alpha12.GetConfigMapJSONResponse, error)
+	GetConfigMapJSON(context.Context, *v1alpha13.GetConfigMapJSONRequest) (*v1alpha13.GetConfigMapJSONResponse, error)
 	// UpdateConfigMap updates a configMap under the namespaces of a specific cluster
-	UpdateConfigMap(context.Context, *v1alpha12.UpdateConfigMapRequest) (*v1alpha12.UpdateConfigMapResponse, error)
+	UpdateConfigMap(context.Context, *v1alpha13.UpdateConfigMapRequest) (*v1alpha13.UpdateConfigMapResponse, error)
 	mustEmbedUnimplementedCoreServer()
 }
 
@@ -956,76 +1176,82 @@ type CoreServer interface {
 // pointer dereference when methods are called.
 type UnimplementedCoreServer struct{}
 
-func (UnimplementedCoreServer) ListPersistentVolumes(context.Context, *v1alpha12.ListPersistentVolumesRequest) (*v1alpha12.ListPersistentVolumesResponse, error) {
+func (UnimplementedCoreServer) ListPersistentVolumes(context.Context, *v1alpha13.ListPersistentVolumesRequest) (*v1alpha13.ListPersistentVolumesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPersistentVolumes not implemented")
 }
-func (UnimplementedCoreServer) GetPersistentVolume(context.Context, *v1alpha12.GetPersistentVolumeRequest) (*v1alpha12.GetPersistentVolumeResponse, error) {
+func (UnimplementedCoreServer) GetPersistentVolume(context.Context, *v1alpha13.GetPersistentVolumeRequest) (*v1alpha13.GetPersistentVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentVolume not implemented")
 }
-func (UnimplementedCoreServer) GetPersistentVolumeJSON(context.Context, *v1alpha12.GetPersistentVolumeJSONRequest) (*v1alpha12.GetPersistentVolumeResponse, error) {
+func (UnimplementedCoreServer) GetPersistentVolumeJSON(context.Context, *v1alpha13.GetPersistentVolumeJSONRequest) (*v1alpha13.GetPersistentVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentVolumeJSON not implemented")
 }
-func (UnimplementedCoreServer) CreatePersistentVolume(context.Context, *v1alpha12.CreatePersistentVolumeRequest) (*v1alpha12.CreatePersistentVolumeResponse, error) {
+func (UnimplementedCoreServer) CreatePersistentVolume(context.Context, *v1alpha13.CreatePersistentVolumeRequest) (*v1alpha13.CreatePersistentVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePersistentVolume not implemented")
 }
-func (UnimplementedCoreServer) UpdatePersistentVolume(context.Context, *v1alpha12.UpdatePersistentVolumeRequest) (*v1alpha12.UpdatePersistentVolumeResponse, error) {
+func (UnimplementedCoreServer) UpdatePersistentVolume(context.Context, *v1alpha13.UpdatePersistentVolumeRequest) (*v1alpha13.UpdatePersistentVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePersistentVolume not implemented")
 }
-func (UnimplementedCoreServer) DeletePersistentVolume(context.Context, *v1alpha12.DeletePersistentVolumeRequest) (*emptypb.Empty, error) {
+func (UnimplementedCoreServer) DeletePersistentVolume(context.Context, *v1alpha13.DeletePersistentVolumeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePersistentVolume not implemented")
 }
-func (UnimplementedCoreServer) DeleteSecret(context.Context, *v1alpha12.DeleteSecretRequest) (*emptypb.Empty, error) {
+func (UnimplementedCoreServer) DeleteSecret(context.Context, *v1alpha13.DeleteSecretRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
-func (UnimplementedCoreServer) GetSecret(context.Context, *v1alpha12.GetSecretRequest) (*v1alpha12.Secret, error) {
+func (UnimplementedCoreServer) GetSecret(context.Context, *v1alpha13.GetSecretRequest) (*v1alpha13.Secret, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
 }
-func (UnimplementedCoreServer) ListSecrets(context.Context, *v1alpha12.ListSecretsRequest) (*v1alpha12.ListSecretsResponse, error) {
+func (UnimplementedCoreServer) ListSecrets(context.Context, *v1alpha13.ListSecretsRequest) (*v1alpha13.ListSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
 }
-func (UnimplementedCoreServer) CreateSecret(context.Context, *v1alpha12.CreateSecretRequest) (*v1alpha12.CreateSecretResponse, error) {
+func (UnimplementedCoreServer) CreateSecret(context.Context, *v1alpha13.CreateSecretRequest) (*v1alpha13.CreateSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
 }
-func (UnimplementedCoreServer) ListClusterNamespaces(context.Context, *v1alpha12.ListClusterNamespacesRequest) (*v1alpha12.ListClusterNamespacesResponse, error) {
+func (UnimplementedCoreServer) ListClusterNamespaces(context.Context, *v1alpha13.ListClusterNamespacesRequest) (*v1alpha13.ListClusterNamespacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusterNamespaces not implemented")
 }
-func (UnimplementedCoreServer) ListClusterGPUSummary(context.Context, *v1alpha12.ListClusterGPUSummaryRequest) (*v1alpha12.ListClusterGPUSummaryResponse, error) {
+func (UnimplementedCoreServer) ListClusterGPUSummary(context.Context, *v1alpha13.ListClusterGPUSummaryRequest) (*v1alpha13.ListClusterGPUSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusterGPUSummary not implemented")
 }
-func (UnimplementedCoreServer) ListClusterEvents(context.Context, *v1alpha12.ListClusterEventsRequest) (*v1alpha12.ListClusterEventsResponse, error) {
+func (UnimplementedCoreServer) ListClusterEvents(context.Context, *v1alpha13.ListClusterEventsRequest) (*v1alpha13.ListClusterEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusterEvents not implemented")
 }
-func (UnimplementedCoreServer) ListEvents(context.Context, *v1alpha12.ListEventsRequest) (*v1alpha12.ListEventsResponse, error) {
+func (UnimplementedCoreServer) WatchEvents(*v1alpha13.WatchEventsRequest, grpc.ServerStreamingServer[v1alpha13.WatchEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedCoreServer) ListEvents(context.Context, *v1alpha13.ListEventsRequest) (*v1alpha13.ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
-func (UnimplementedCoreServer) ListNodes(context.Context, *v1alpha12.ListNodesRequest) (*v1alpha12.ListNodesResponse, error) {
+func (UnimplementedCoreServer) ListNodes(context.Context, *v1alpha13.ListNodesRequest) (*v1alpha13.ListNodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNodes not implemented")
 }
-func (UnimplementedCoreServer) GetNode(context.Context, *v1alpha12.GetNodeRequest) (*v1alpha12.Node, error) {
+func (UnimplementedCoreServer) WatchNodes(*v1alpha13.WatchNodesRequest, grpc.ServerStreamingServer[v1alpha13.WatchNodesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNodes not implemented")
+}
+func (UnimplementedCoreServer) GetNode(context.Context, *v1alpha13.GetNodeRequest) (*v1alpha13.Node, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
 }
-func (UnimplementedCoreServer) PutNodeLabels(context.Context, *v1alpha12.PutNodeLabelsRequest) (*v1alpha12.PutNodeLabelsResponse, error) {
+func (UnimplementedCoreServer) PutNodeLabels(context.Context, *v1alpha13.PutNodeLabelsRequest) (*v1alpha13.PutNodeLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutNodeLabels not implemented")
 }
-func (UnimplementedCoreServer) PutNodeTaints(context.Context, *v1alpha12.PutNodeTaintsRequest) (*v1alpha12.PutNodeTaintsResponse, error) {
+func (UnimplementedCoreServer) PutNodeTaints(context.Context, *v1alpha13.PutNodeTaintsRequest) (*v1alpha13.PutNodeTaintsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutNodeTaints not implemented")
 }
-func (UnimplementedCoreServer) UpdateNodeAnnotations(context.Context, *v1alpha12.UpdateNodeAnnotationsRequest) (*v1alpha12.UpdateNodeAnnotationsResponse, error) {
+func (UnimplementedCoreServer) UpdateNodeAnnotations(context.Context, *v1alpha13.UpdateNodeAnnotationsRequest) (*v1alpha13.UpdateNodeAnnotationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeAnnotations not implemented")
 }
-func (UnimplementedCoreServer) UnScheduleNode(context.Context, *v1alpha12.ScheduleNodeRequest) (*v1alpha12.Node, error) {
+func (UnimplementedCoreServer) UnScheduleNode(context.Context, *v1alpha13.ScheduleNodeRequest) (*v1alpha13.Node, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnScheduleNode not implemented")
 }
-func (UnimplementedCoreServer) ScheduleNode(context.Context, *v1alpha12.ScheduleNodeRequest) (*v1alpha12.Node, error) {
+func (UnimplementedCoreServer) ScheduleNode(context.Context, *v1alpha13.ScheduleNodeRequest) (*v1alpha13.Node, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleNode not implemented")
 }
-func (UnimplementedCoreServer) GetConfigMap(context.Context, *v1alpha12.GetConfigMapRequest) (*v1alpha12.ConfigMap, error) {
+func (UnimplementedCoreServer) GetConfigMap(context.Context, *v1alpha13.GetConfigMapRequest) (*v1alpha13.ConfigMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigMap not implemented")
 }
-func (UnimplementedCoreServer) GetConfigMapJSON(context.Context, *v1alpha12.GetConfigMapJSONRequest) (*v1alpha12.GetConfigMapJSONResponse, error) {
+func (UnimplementedCoreServer) GetConfigMapJSON(context.Context, *v1alpha13.GetConfigMapJSONRequest) (*v1alpha13.GetConfigMapJSONResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigMapJSON not implemented")
 }
-func (UnimplementedCoreServer) UpdateConfigMap(context.Context, *v1alpha12.UpdateConfigMapRequest) (*v1alpha12.UpdateConfigMapResponse, error) {
+func (UnimplementedCoreServer) UpdateConfigMap(context.Context, *v1alpha13.UpdateConfigMapRequest) (*v1alpha13.UpdateConfigMapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigMap not implemented")
 }
 func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
@@ -1050,7 +1276,7 @@ func RegisterCoreServer(s grpc.ServiceRegistrar, srv CoreServer) {
 }
 
 func _Core_ListPersistentVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListPersistentVolumesRequest)
+	in := new(v1alpha13.ListPersistentVolumesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1062,13 +1288,13 @@ func _Core_ListPersistentVolumes_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Core_ListPersistentVolumes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListPersistentVolumes(ctx, req.(*v1alpha12.ListPersistentVolumesRequest))
+		return srv.(CoreServer).ListPersistentVolumes(ctx, req.(*v1alpha13.ListPersistentVolumesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_GetPersistentVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetPersistentVolumeRequest)
+	in := new(v1alpha13.GetPersistentVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1080,13 +1306,13 @@ func _Core_GetPersistentVolume_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: Core_GetPersistentVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPersistentVolume(ctx, req.(*v1alpha12.GetPersistentVolumeRequest))
+		return srv.(CoreServer).GetPersistentVolume(ctx, req.(*v1alpha13.GetPersistentVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_GetPersistentVolumeJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetPersistentVolumeJSONRequest)
+	in := new(v1alpha13.GetPersistentVolumeJSONRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1098,13 +1324,13 @@ func _Core_GetPersistentVolumeJSON_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Core_GetPersistentVolumeJSON_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPersistentVolumeJSON(ctx, req.(*v1alpha12.GetPersistentVolumeJSONRequest))
+		return srv.(CoreServer).GetPersistentVolumeJSON(ctx, req.(*v1alpha13.GetPersistentVolumeJSONRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_CreatePersistentVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.CreatePersistentVolumeRequest)
+	in := new(v1alpha13.CreatePersistentVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1116,13 +1342,13 @@ func _Core_CreatePersistentVolume_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Core_CreatePersistentVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreatePersistentVolume(ctx, req.(*v1alpha12.CreatePersistentVolumeRequest))
+		return srv.(CoreServer).CreatePersistentVolume(ctx, req.(*v1alpha13.CreatePersistentVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_UpdatePersistentVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.UpdatePersistentVolumeRequest)
+	in := new(v1alpha13.UpdatePersistentVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1134,13 +1360,13 @@ func _Core_UpdatePersistentVolume_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Core_UpdatePersistentVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdatePersistentVolume(ctx, req.(*v1alpha12.UpdatePersistentVolumeRequest))
+		return srv.(CoreServer).UpdatePersistentVolume(ctx, req.(*v1alpha13.UpdatePersistentVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_DeletePersistentVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.DeletePersistentVolumeRequest)
+	in := new(v1alpha13.DeletePersistentVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1152,13 +1378,13 @@ func _Core_DeletePersistentVolume_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Core_DeletePersistentVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeletePersistentVolume(ctx, req.(*v1alpha12.DeletePersistentVolumeRequest))
+		return srv.(CoreServer).DeletePersistentVolume(ctx, req.(*v1alpha13.DeletePersistentVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.DeleteSecretRequest)
+	in := new(v1alpha13.DeleteSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1170,13 +1396,13 @@ func _Core_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Core_DeleteSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteSecret(ctx, req.(*v1alpha12.DeleteSecretRequest))
+		return srv.(CoreServer).DeleteSecret(ctx, req.(*v1alpha13.DeleteSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetSecretRequest)
+	in := new(v1alpha13.GetSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1188,13 +1414,13 @@ func _Core_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(inte
 		FullMethod: Core_GetSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetSecret(ctx, req.(*v1alpha12.GetSecretRequest))
+		return srv.(CoreServer).GetSecret(ctx, req.(*v1alpha13.GetSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListSecretsRequest)
+	in := new(v1alpha13.ListSecretsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1206,13 +1432,13 @@ func _Core_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: Core_ListSecrets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListSecrets(ctx, req.(*v1alpha12.ListSecretsRequest))
+		return srv.(CoreServer).ListSecrets(ctx, req.(*v1alpha13.ListSecretsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.CreateSecretRequest)
+	in := new(v1alpha13.CreateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1224,13 +1450,13 @@ func _Core_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Core_CreateSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateSecret(ctx, req.(*v1alpha12.CreateSecretRequest))
+		return srv.(CoreServer).CreateSecret(ctx, req.(*v1alpha13.CreateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ListClusterNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListClusterNamespacesRequest)
+	in := new(v1alpha13.ListClusterNamespacesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1242,13 +1468,13 @@ func _Core_ListClusterNamespaces_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Core_ListClusterNamespaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListClusterNamespaces(ctx, req.(*v1alpha12.ListClusterNamespacesRequest))
+		return srv.(CoreServer).ListClusterNamespaces(ctx, req.(*v1alpha13.ListClusterNamespacesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ListClusterGPUSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListClusterGPUSummaryRequest)
+	in := new(v1alpha13.ListClusterGPUSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1260,13 +1486,13 @@ func _Core_ListClusterGPUSummary_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Core_ListClusterGPUSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListClusterGPUSummary(ctx, req.(*v1alpha12.ListClusterGPUSummaryRequest))
+		return srv.(CoreServer).ListClusterGPUSummary(ctx, req.(*v1alpha13.ListClusterGPUSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ListClusterEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListClusterEventsRequest)
+	in := new(v1alpha13.ListClusterEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1278,13 +1504,24 @@ func _Core_ListClusterEvents_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: Core_ListClusterEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListClusterEvents(ctx, req.(*v1alpha12.ListClusterEventsRequest))
+		return srv.(CoreServer).ListClusterEvents(ctx, req.(*v1alpha13.ListClusterEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha13.WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).WatchEvents(m, &grpc.GenericServerStream[v1alpha13.WatchEventsRequest, v1alpha13.WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchEventsServer = grpc.ServerStreamingServer[v1alpha13.WatchEventsResponse]
+
 func _Core_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListEventsRequest)
+	in := new(v1alpha13.ListEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1296,13 +1533,13 @@ func _Core_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(int
 		FullMethod: Core_ListEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListEvents(ctx, req.(*v1alpha12.ListEventsRequest))
+		return srv.(CoreServer).ListEvents(ctx, req.(*v1alpha13.ListEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ListNodesRequest)
+	in := new(v1alpha13.ListNodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1314,13 +1551,24 @@ func _Core_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(inte
 		FullMethod: Core_ListNodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListNodes(ctx, req.(*v1alpha12.ListNodesRequest))
+		return srv.(CoreServer).ListNodes(ctx, req.(*v1alpha13.ListNodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_WatchNodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha13.WatchNodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).WatchNodes(m, &grpc.GenericServerStream[v1alpha13.WatchNodesRequest, v1alpha13.WatchNodesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchNodesServer = grpc.ServerStreamingServer[v1alpha13.WatchNodesResponse]
+
 func _Core_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetNodeRequest)
+	in := new(v1alpha13.GetNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1332,13 +1580,13 @@ func _Core_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interf
 		FullMethod: Core_GetNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetNode(ctx, req.(*v1alpha12.GetNodeRequest))
+		return srv.(CoreServer).GetNode(ctx, req.(*v1alpha13.GetNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_PutNodeLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.PutNodeLabelsRequest)
+	in := new(v1alpha13.PutNodeLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1350,13 +1598,13 @@ func _Core_PutNodeLabels_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: Core_PutNodeLabels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).PutNodeLabels(ctx, req.(*v1alpha12.PutNodeLabelsRequest))
+		return srv.(CoreServer).PutNodeLabels(ctx, req.(*v1alpha13.PutNodeLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_PutNodeTaints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.PutNodeTaintsRequest)
+	in := new(v1alpha13.PutNodeTaintsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1368,13 +1616,13 @@ func _Core_PutNodeTaints_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: Core_PutNodeTaints_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).PutNodeTaints(ctx, req.(*v1alpha12.PutNodeTaintsRequest))
+		return srv.(CoreServer).PutNodeTaints(ctx, req.(*v1alpha13.PutNodeTaintsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_UpdateNodeAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.UpdateNodeAnnotationsRequest)
+	in := new(v1alpha13.UpdateNodeAnnotationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1386,13 +1634,13 @@ func _Core_UpdateNodeAnnotations_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Core_UpdateNodeAnnotations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateNodeAnnotations(ctx, req.(*v1alpha12.UpdateNodeAnnotationsRequest))
+		return srv.(CoreServer).UpdateNodeAnnotations(ctx, req.(*v1alpha13.UpdateNodeAnnotationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_UnScheduleNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ScheduleNodeRequest)
+	in := new(v1alpha13.ScheduleNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1404,13 +1652,13 @@ func _Core_UnScheduleNode_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: Core_UnScheduleNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UnScheduleNode(ctx, req.(*v1alpha12.ScheduleNodeRequest))
+		return srv.(CoreServer).UnScheduleNode(ctx, req.(*v1alpha13.ScheduleNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_ScheduleNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.ScheduleNodeRequest)
+	in := new(v1alpha13.ScheduleNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1422,13 +1670,13 @@ func _Core_ScheduleNode_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Core_ScheduleNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ScheduleNode(ctx, req.(*v1alpha12.ScheduleNodeRequest))
+		return srv.(CoreServer).ScheduleNode(ctx, req.(*v1alpha13.ScheduleNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_GetConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetConfigMapRequest)
+	in := new(v1alpha13.GetConfigMapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1440,13 +1688,13 @@ func _Core_GetConfigMap_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Core_GetConfigMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetConfigMap(ctx, req.(*v1alpha12.GetConfigMapRequest))
+		return srv.(CoreServer).GetConfigMap(ctx, req.(*v1alpha13.GetConfigMapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_GetConfigMapJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.GetConfigMapJSONRequest)
+	in := new(v1alpha13.GetConfigMapJSONRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1458,13 +1706,13 @@ func _Core_GetConfigMapJSON_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: Core_GetConfigMapJSON_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetConfigMapJSON(ctx, req.(*v1alpha12.GetConfigMapJSONRequest))
+		return srv.(CoreServer).GetConfigMapJSON(ctx, req.(*v1alpha13.GetConfigMapJSONRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Core_UpdateConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha12.UpdateConfigMapRequest)
+	in := new(v1alpha13.UpdateConfigMapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1476,7 +1724,7 @@ func _Core_UpdateConfigMap_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: Core_UpdateConfigMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateConfigMap(ctx, req.(*v1alpha12.UpdateConfigMapRequest))
+		return srv.(CoreServer).UpdateConfigMap(ctx, req.(*v1alpha13.UpdateConfigMapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1585,7 +1833,18 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Core_UpdateConfigMap_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Core_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNodes",
+			Handler:       _Core_WatchNodes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/v1/kantaloupe.proto",
 }
 
@@ -1608,26 +1867,26 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MonitoringClient interface {
-	ListAllPodsGPUUtilization(ctx context.Context, in *v1alpha11.ListMonitoringsRequest, opts ...grpc.CallOption) (*v1alpha11.ListMonitoringsResponse, error)
+	ListAllPodsGPUUtilization(ctx context.Context, in *v1alpha12.ListMonitoringsRequest, opts ...grpc.CallOption) (*v1alpha12.ListMonitoringsResponse, error)
 	// GetResourceTrend returns the resource utilization trend for a specific resource type
-	GetResourceTrend(ctx context.Context, in *v1alpha11.ResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error)
+	GetResourceTrend(ctx context.Context, in *v1alpha12.ResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error)
 	// GetNodeResourceTrend returns the resource utilization trend for a specific node
-	GetNodeResourceTrend(ctx context.Context, in *v1alpha11.NodeResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error)
+	GetNodeResourceTrend(ctx context.Context, in *v1alpha12.NodeResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error)
 	// GetGpuResourceTrend returns the resource utilization trend and status for a specific GPU
-	GetGpuResourceTrend(ctx context.Context, in *v1alpha11.GpuResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error)
+	GetGpuResourceTrend(ctx context.Context, in *v1alpha12.GpuResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error)
 	// GetKantaloupeflowResourceTrend returns the resource utilization trend for a specific kantalouepflow
-	GetKantaloupeflowResourceTrend(ctx context.Context, in *v1alpha11.KantaloupeflowResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error)
+	GetKantaloupeflowResourceTrend(ctx context.Context, in *v1alpha12.KantaloupeflowResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error)
 	// GetNodeWorkloadDistribution returns the workload distribution for a specific node
-	GetNodeWorkloadDistribution(ctx context.Context, in *v1alpha11.NodeWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.WorkloadDistributionResponse, error)
+	GetNodeWorkloadDistribution(ctx context.Context, in *v1alpha12.NodeWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.WorkloadDistributionResponse, error)
 	// GetClusterWorkloadDistribution returns the workload distribution across the cluster
-	GetClusterWorkloadDistribution(ctx context.Context, in *v1alpha11.ClusterWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.WorkloadDistributionResponse, error)
+	GetClusterWorkloadDistribution(ctx context.Context, in *v1alpha12.ClusterWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.WorkloadDistributionResponse, error)
 	// Get top K nodes by resource usage
-	GetTopNodes(ctx context.Context, in *v1alpha11.TopNodeRequest, opts ...grpc.CallOption) (*v1alpha11.TopNodeResponse, error)
+	GetTopNodes(ctx context.Context, in *v1alpha12.TopNodeRequest, opts ...grpc.CallOption) (*v1alpha12.TopNodeResponse, error)
 	// Get top K nodes by workload count
-	GetTopNodeWorkloads(ctx context.Context, in *v1alpha11.TopNodeWorkloadRequest, opts ...grpc.CallOption) (*v1alpha11.TopNodeResponse, error)
-	GetKantaloupeflowMemoryDistribution(ctx context.Context, in *v1alpha11.MemoryDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.MemoryDistributionResponse, error)
-	GetCardTopWorkloads(ctx context.Context, in *v1alpha11.CardTopWorkloadsRequest, opts ...grpc.CallOption) (*v1alpha11.CardTopWorkloadsResponse, error)
-	GetClusterWorkloadsTop(ctx context.Context, in *v1alpha11.GetClusterWorkloadsTopRequest, opts ...grpc.CallOption) (*v1alpha11.GetClusterWorkloadsTopResponse, error)
+	GetTopNodeWorkloads(ctx context.Context, in *v1alpha12.TopNodeWorkloadRequest, opts ...grpc.CallOption) (*v1alpha12.TopNodeResponse, error)
+	GetKantaloupeflowMemoryDistribution(ctx context.Context, in *v1alpha12.MemoryDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.MemoryDistributionResponse, error)
+	GetCardTopWorkloads(ctx context.Context, in *v1alpha12.CardTopWorkloadsRequest, opts ...grpc.CallOption) (*v1alpha12.CardTopWorkloadsResponse, error)
+	GetClusterWorkloadsTop(ctx context.Context, in *v1alpha12.GetClusterWorkloadsTopRequest, opts ...grpc.CallOption) (*v1alpha12.GetClusterWorkloadsTopResponse, error)
 }
 
 type monitoringClient struct {
@@ -1638,9 +1897,9 @@ func NewMonitoringClient(cc grpc.ClientConnInterface) MonitoringClient {
 	return &monitoringClient{cc}
 }
 
-func (c *monitoringClient) ListAllPodsGPUUtilization(ctx context.Context, in *v1alpha11.ListMonitoringsRequest, opts ...grpc.CallOption) (*v1alpha11.ListMonitoringsResponse, error) {
+func (c *monitoringClient) ListAllPodsGPUUtilization(ctx context.Context, in *v1alpha12.ListMonitoringsRequest, opts ...grpc.CallOption) (*v1alpha12.ListMonitoringsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ListMonitoringsResponse)
+	out := new(v1alpha12.ListMonitoringsResponse)
 	err := c.cc.Invoke(ctx, Monitoring_ListAllPodsGPUUtilization_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1648,9 +1907,9 @@ func (c *monitoringClient) ListAllPodsGPUUtilization(ctx context.Context, in *v1
 	return out, nil
 }
 
-func (c *monitoringClient) GetResourceTrend(ctx context.Context, in *v1alpha11.ResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error) {
+func (c *monitoringClient) GetResourceTrend(ctx context.Context, in *v1alpha12.ResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ResourceTrendResponse)
+	out := new(v1alpha12.ResourceTrendResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetResourceTrend_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1658,9 +1917,9 @@ func (c *monitoringClient) GetResourceTrend(ctx context.Context, in *v1alpha11.R
 	return out, nil
 }
 
-func (c *monitoringClient) GetNodeResourceTrend(ctx context.Context, in *v1alpha11.NodeResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error) {
+func (c *monitoringClient) GetNodeResourceTrend(ctx context.Context, in *v1alpha12.NodeResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ResourceTrendResponse)
+	out := new(v1alpha12.ResourceTrendResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetNodeResourceTrend_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1668,9 +1927,9 @@ func (c *monitoringClient) GetNodeResourceTrend(ctx context.Context, in *v1alpha
 	return out, nil
 }
 
-func (c *monitoringClient) GetGpuResourceTrend(ctx context.Context, in *v1alpha11.GpuResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error) {
+func (c *monitoringClient) GetGpuResourceTrend(ctx context.Context, in *v1alpha12.GpuResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ResourceTrendResponse)
+	out := new(v1alpha12.ResourceTrendResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetGpuResourceTrend_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1678,9 +1937,9 @@ func (c *monitoringClient) GetGpuResourceTrend(ctx context.Context, in *v1alpha1
 	return out, nil
 }
 
-func (c *monitoringClient) GetKantaloupeflowResourceTrend(ctx context.Context, in *v1alpha11.KantaloupeflowResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha11.ResourceTrendResponse, error) {
+func (c *monitoringClient) GetKantaloupeflowResourceTrend(ctx context.Context, in *v1alpha12.KantaloupeflowResourceTrendRequest, opts ...grpc.CallOption) (*v1alpha12.ResourceTrendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.ResourceTrendResponse)
+	out := new(v1alpha12.ResourceTrendResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetKantaloupeflowResourceTrend_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1688,9 +1947,9 @@ func (c *monitoringClient) GetKantaloupeflowResourceTrend(ctx context.Context, i
 	return out, nil
 }
 
-func (c *monitoringClient) GetNodeWorkloadDistribution(ctx context.Context, in *v1alpha11.NodeWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.WorkloadDistributionResponse, error) {
+func (c *monitoringClient) GetNodeWorkloadDistribution(ctx context.Context, in *v1alpha12.NodeWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.WorkloadDistributionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.WorkloadDistributionResponse)
+	out := new(v1alpha12.WorkloadDistributionResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetNodeWorkloadDistribution_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1698,9 +1957,9 @@ func (c *monitoringClient) GetNodeWorkloadDistribution(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *monitoringClient) GetClusterWorkloadDistribution(ctx context.Context, in *v1alpha11.ClusterWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.WorkloadDistributionResponse, error) {
+func (c *monitoringClient) GetClusterWorkloadDistribution(ctx context.Context, in *v1alpha12.ClusterWorkloadDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.WorkloadDistributionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.WorkloadDistributionResponse)
+	out := new(v1alpha12.WorkloadDistributionResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetClusterWorkloadDistribution_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1708,9 +1967,9 @@ func (c *monitoringClient) GetClusterWorkloadDistribution(ctx context.Context, i
 	return out, nil
 }
 
-func (c *monitoringClient) GetTopNodes(ctx context.Context, in *v1alpha11.TopNodeRequest, opts ...grpc.CallOption) (*v1alpha11.TopNodeResponse, error) {
+func (c *monitoringClient) GetTopNodes(ctx context.Context, in *v1alpha12.TopNodeRequest, opts ...grpc.CallOption) (*v1alpha12.TopNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.TopNodeResponse)
+	out := new(v1alpha12.TopNodeResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetTopNodes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1718,9 +1977,9 @@ func (c *monitoringClient) GetTopNodes(ctx context.Context, in *v1alpha11.TopNod
 	return out, nil
 }
 
-func (c *monitoringClient) GetTopNodeWorkloads(ctx context.Context, in *v1alpha11.TopNodeWorkloadRequest, opts ...grpc.CallOption) (*v1alpha11.TopNodeResponse, error) {
+func (c *monitoringClient) GetTopNodeWorkloads(ctx context.Context, in *v1alpha12.TopNodeWorkloadRequest, opts ...grpc.CallOption) (*v1alpha12.TopNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.TopNodeResponse)
+	out := new(v1alpha12.TopNodeResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetTopNodeWorkloads_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1728,9 +1987,9 @@ func (c *monitoringClient) GetTopNodeWorkloads(ctx context.Context, in *v1alpha1
 	return out, nil
 }
 
-func (c *monitoringClient) GetKantaloupeflowMemoryDistribution(ctx context.Context, in *v1alpha11.MemoryDistributionRequest, opts ...grpc.CallOption) (*v1alpha11.MemoryDistributionResponse, error) {
+func (c *monitoringClient) GetKantaloupeflowMemoryDistribution(ctx context.Context, in *v1alpha12.MemoryDistributionRequest, opts ...grpc.CallOption) (*v1alpha12.MemoryDistributionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.MemoryDistributionResponse)
+	out := new(v1alpha12.MemoryDistributionResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetKantaloupeflowMemoryDistribution_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1738,9 +1997,9 @@ func (c *monitoringClient) GetKantaloupeflowMemoryDistribution(ctx context.Conte
 	return out, nil
 }
 
-func (c *monitoringClient) GetCardTopWorkloads(ctx context.Context, in *v1alpha11.CardTopWorkloadsRequest, opts ...grpc.CallOption) (*v1alpha11.CardTopWorkloadsResponse, error) {
+func (c *monitoringClient) GetCardTopWorkloads(ctx context.Context, in *v1alpha12.CardTopWorkloadsRequest, opts ...grpc.CallOption) (*v1alpha12.CardTopWorkloadsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.CardTopWorkloadsResponse)
+	out := new(v1alpha12.CardTopWorkloadsResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetCardTopWorkloads_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1748,9 +2007,9 @@ func (c *monitoringClient) GetCardTopWorkloads(ctx context.Context, in *v1alpha1
 	return out, nil
 }
 
-func (c *monitoringClient) GetClusterWorkloadsTop(ctx context.Context, in *v1alpha11.GetClusterWorkloadsTopRequest, opts ...grpc.CallOption) (*v1alpha11.GetClusterWorkloadsTopResponse, error) {
+func (c *monitoringClient) GetClusterWorkloadsTop(ctx context.Context, in *v1alpha12.GetClusterWorkloadsTopRequest, opts ...grpc.CallOption) (*v1alpha12.GetClusterWorkloadsTopResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha11.GetClusterWorkloadsTopResponse)
+	out := new(v1alpha12.GetClusterWorkloadsTopResponse)
 	err := c.cc.Invoke(ctx, Monitoring_GetClusterWorkloadsTop_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1762,26 +2021,26 @@ func (c *monitoringClient) GetClusterWorkloadsTop(ctx context.Context, in *v1alp
 // All implementations must embed UnimplementedMonitoringServer
 // for forward compatibility.
 type MonitoringServer interface {
-	ListAllPodsGPUUtilization(context.Context, *v1alpha11.ListMonitoringsRequest) (*v1alpha11.ListMonitoringsResponse, error)
+	ListAllPodsGPUUtilization(context.Context, *v1alpha12.ListMonitoringsRequest) (*v1alpha12.ListMonitoringsResponse, error)
 	// GetResourceTrend returns the resource utilization trend for a specific resource type
-	GetResourceTrend(context.Context, *v1alpha11.ResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error)
+	GetResourceTrend(context.Context, *v1alpha12.ResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error)
 	// GetNodeResourceTrend returns the resource utilization trend for a specific node
-	GetNodeResourceTrend(context.Context, *v1alpha11.NodeResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error)
+	GetNodeResourceTrend(context.Context, *v1alpha12.NodeResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error)
 	// GetGpuResourceTrend returns the resource utilization trend and status for a specific GPU
-	GetGpuResourceTrend(context.Context, *v1alpha11.GpuResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error)
+	GetGpuResourceTrend(context.Context, *v1alpha12.GpuResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error)
 	// GetKantaloupeflowResourceTrend returns the resource utilization trend for a specific kantalouepflow
-	GetKantaloupeflowResourceTrend(context.Context, *v1alpha11.KantaloupeflowResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error)
+	GetKantaloupeflowResourceTrend(context.Context, *v1alpha12.KantaloupeflowResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error)
 	// GetNodeWorkloadDistribution returns the workload distribution for a specific node
-	GetNodeWorkloadDistribution(context.Context, *v1alpha11.NodeWorkloadDistributionRequest) (*v1alpha11.WorkloadDistributionResponse, error)
+	GetNodeWorkloadDistribution(context.Context, *v1alpha12.NodeWorkloadDistributionRequest) (*v1alpha12.WorkloadDistributionResponse, error)
 	// GetClusterWorkloadDistribution returns the workload distribution across the cluster
-	GetClusterWorkloadDistribution(context.Context, *v1alpha11.ClusterWorkloadDistributionRequest) (*v1alpha11.WorkloadDistributionResponse, error)
+	GetClusterWorkloadDistribution(context.Context, *v1alpha12.ClusterWorkloadDistributionRequest) (*v1alpha12.WorkloadDistributionResponse, error)
 	// Get top K nodes by resource usage
-	GetTopNodes(context.Context, *v1alpha11.TopNodeRequest) (*v1alpha11.TopNodeResponse, error)
+	GetTopNodes(context.Context, *v1alpha12.TopNodeRequest) (*v1alpha12.TopNodeResponse, error)
 	// Get top K nodes by workload count
-	GetTopNodeWorkloads(context.Context, *v1alpha11.TopNodeWorkloadRequest) (*v1alpha11.TopNodeResponse, error)
-	GetKantaloupeflowMemoryDistribution(context.Context, *v1alpha11.MemoryDistributionRequest) (*v1alpha11.MemoryDistributionResponse, error)
-	GetCardTopWorkloads(context.Context, *v1alpha11.CardTopWorkloadsRequest) (*v1alpha11.CardTopWorkloadsResponse, error)
-	GetClusterWorkloadsTop(context.Context, *v1alpha11.GetClusterWorkloadsTopRequest) (*v1alpha11.GetClusterWorkloadsTopResponse, error)
+	GetTopNodeWorkloads(context.Context, *v1alpha12.TopNodeWorkloadRequest) (*v1alpha12.TopNodeResponse, error)
+	GetKantaloupeflowMemoryDistribution(context.Context, *v1alpha12.MemoryDistributionRequest) (*v1alpha12.MemoryDistributionResponse, error)
+	GetCardTopWorkloads(context.Context, *v1alpha12.CardTopWorkloadsRequest) (*v1alpha12.CardTopWorkloadsResponse, error)
+	GetClusterWorkloadsTop(context.Context, *v1alpha12.GetClusterWorkloadsTopRequest) (*v1alpha12.GetClusterWorkloadsTopResponse, error)
 	mustEmbedUnimplementedMonitoringServer()
 }
 
@@ -1792,40 +2051,40 @@ type MonitoringServer interface {
 // pointer dereference when methods are called.
 type UnimplementedMonitoringServer struct{}
 
-func (UnimplementedMonitoringServer) ListAllPodsGPUUtilization(context.Context, *v1alpha11.ListMonitoringsRequest) (*v1alpha11.ListMonitoringsResponse, error) {
+func (UnimplementedMonitoringServer) ListAllPodsGPUUtilization(context.Context, *v1alpha12.ListMonitoringsRequest) (*v1alpha12.ListMonitoringsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllPodsGPUUtilization not implemented")
 }
-func (UnimplementedMonitoringServer) GetResourceTrend(context.Context, *v1alpha11.ResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error) {
+func (UnimplementedMonitoringServer) GetResourceTrend(context.Context, *v1alpha12.ResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourceTrend not implemented")
 }
-func (UnimplementedMonitoringServer) GetNodeResourceTrend(context.Context, *v1alpha11.NodeResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error) {
+func (UnimplementedMonitoringServer) GetNodeResourceTrend(context.Context, *v1alpha12.NodeResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeResourceTrend not implemented")
 }
-func (UnimplementedMonitoringServer) GetGpuResourceTrend(context.Context, *v1alpha11.GpuResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error) {
+func (UnimplementedMonitoringServer) GetGpuResourceTrend(context.Context, *v1alpha12.GpuResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGpuResourceTrend not implemented")
 }
-func (UnimplementedMonitoringServer) GetKantaloupeflowResourceTrend(context.Context, *v1alpha11.KantaloupeflowResourceTrendRequest) (*v1alpha11.ResourceTrendResponse, error) {
+func (UnimplementedMonitoringServer) GetKantaloupeflowResourceTrend(context.Context, *v1alpha12.KantaloupeflowResourceTrendRequest) (*v1alpha12.ResourceTrendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKantaloupeflowResourceTrend not implemented")
 }
-func (UnimplementedMonitoringServer) GetNodeWorkloadDistribution(context.Context, *v1alpha11.NodeWorkloadDistributionRequest) (*v1alpha11.WorkloadDistributionResponse, error) {
+func (UnimplementedMonitoringServer) GetNodeWorkloadDistribution(context.Context, *v1alpha12.NodeWorkloadDistributionRequest) (*v1alpha12.WorkloadDistributionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeWorkloadDistribution not implemented")
 }
-func (UnimplementedMonitoringServer) GetClusterWorkloadDistribution(context.Context, *v1alpha11.ClusterWorkloadDistributionRequest) (*v1alpha11.WorkloadDistributionResponse, error) {
+func (UnimplementedMonitoringServer) GetClusterWorkloadDistribution(context.Context, *v1alpha12.ClusterWorkloadDistributionRequest) (*v1alpha12.WorkloadDistributionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClusterWorkloadDistribution not implemented")
 }
-func (UnimplementedMonitoringServer) GetTopNodes(context.Context, *v1alpha11.TopNodeRequest) (*v1alpha11.TopNodeResponse, error) {
+func (UnimplementedMonitoringServer) GetTopNodes(context.Context, *v1alpha12.TopNodeRequest) (*v1alpha12.TopNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopNodes not implemented")
 }
-func (UnimplementedMonitoringServer) GetTopNodeWorkloads(context.Context, *v1alpha11.TopNodeWorkloadRequest) (*v1alpha11.TopNodeResponse, error) {
+func (UnimplementedMonitoringServer) GetTopNodeWorkloads(context.Context, *v1alpha12.TopNodeWorkloadRequest) (*v1alpha12.TopNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopNodeWorkloads not implemented")
 }
-func (UnimplementedMonitoringServer) GetKantaloupeflowMemoryDistribution(context.Context, *v1alpha11.MemoryDistributionRequest) (*v1alpha11.MemoryDistributionResponse, error) {
+func (UnimplementedMonitoringServer) GetKantaloupeflowMemoryDistribution(context.Context, *v1alpha12.MemoryDistributionRequest) (*v1alpha12.MemoryDistributionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKantaloupeflowMemoryDistribution not implemented")
 }
-func (UnimplementedMonitoringServer) GetCardTopWorkloads(context.Context, *v1alpha11.CardTopWorkloadsRequest) (*v1alpha11.CardTopWorkloadsResponse, error) {
+func (UnimplementedMonitoringServer) GetCardTopWorkloads(context.Context, *v1alpha12.CardTopWorkloadsRequest) (*v1alpha12.CardTopWorkloadsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCardTopWorkloads not implemented")
 }
-func (UnimplementedMonitoringServer) GetClusterWorkloadsTop(context.Context, *v1alpha11.GetClusterWorkloadsTopRequest) (*v1alpha11.GetClusterWorkloadsTopResponse, error) {
+func (UnimplementedMonitoringServer) GetClusterWorkloadsTop(context.Context, *v1alpha12.GetClusterWorkloadsTopRequest) (*v1alpha12.GetClusterWorkloadsTopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClusterWorkloadsTop not implemented")
 }
 func (UnimplementedMonitoringServer) mustEmbedUnimplementedMonitoringServer() {}
@@ -1850,7 +2109,7 @@ func RegisterMonitoringServer(s grpc.ServiceRegistrar, srv MonitoringServer) {
 }
 
 func _Monitoring_ListAllPodsGPUUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.ListMonitoringsRequest)
+	in := new(v1alpha12.ListMonitoringsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1862,13 +2121,13 @@ func _Monitoring_ListAllPodsGPUUtilization_Handler(srv interface{}, ctx context.
 		FullMethod: Monitoring_ListAllPodsGPUUtilization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).ListAllPodsGPUUtilization(ctx, req.(*v1alpha11.ListMonitoringsRequest))
+		return srv.(MonitoringServer).ListAllPodsGPUUtilization(ctx, req.(*v1alpha12.ListMonitoringsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetResourceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.ResourceTrendRequest)
+	in := new(v1alpha12.ResourceTrendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1880,13 +2139,13 @@ func _Monitoring_GetResourceTrend_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Monitoring_GetResourceTrend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetResourceTrend(ctx, req.(*v1alpha11.ResourceTrendRequest))
+		return srv.(MonitoringServer).GetResourceTrend(ctx, req.(*v1alpha12.ResourceTrendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetNodeResourceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.NodeResourceTrendRequest)
+	in := new(v1alpha12.NodeResourceTrendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1898,13 +2157,13 @@ func _Monitoring_GetNodeResourceTrend_Handler(srv interface{}, ctx context.Conte
 		FullMethod: Monitoring_GetNodeResourceTrend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetNodeResourceTrend(ctx, req.(*v1alpha11.NodeResourceTrendRequest))
+		return srv.(MonitoringServer).GetNodeResourceTrend(ctx, req.(*v1alpha12.NodeResourceTrendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetGpuResourceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.GpuResourceTrendRequest)
+	in := new(v1alpha12.GpuResourceTrendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1916,13 +2175,13 @@ func _Monitoring_GetGpuResourceTrend_Handler(srv interface{}, ctx context.Contex
 		FullMethod: Monitoring_GetGpuResourceTrend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetGpuResourceTrend(ctx, req.(*v1alpha11.GpuResourceTrendRequest))
+		return srv.(MonitoringServer).GetGpuResourceTrend(ctx, req.(*v1alpha12.GpuResourceTrendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetKantaloupeflowResourceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.KantaloupeflowResourceTrendRequest)
+	in := new(v1alpha12.KantaloupeflowResourceTrendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1934,13 +2193,13 @@ func _Monitoring_GetKantaloupeflowResourceTrend_Handler(srv interface{}, ctx con
 		FullMethod: Monitoring_GetKantaloupeflowResourceTrend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetKantaloupeflowResourceTrend(ctx, req.(*v1alpha11.KantaloupeflowResourceTrendRequest))
+		return srv.(MonitoringServer).GetKantaloupeflowResourceTrend(ctx, req.(*v1alpha12.KantaloupeflowResourceTrendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetNodeWorkloadDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.NodeWorkloadDistributionRequest)
+	in := new(v1alpha12.NodeWorkloadDistributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1952,13 +2211,13 @@ func _Monitoring_GetNodeWorkloadDistribution_Handler(srv interface{}, ctx contex
 		FullMethod: Monitoring_GetNodeWorkloadDistribution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetNodeWorkloadDistribution(ctx, req.(*v1alpha11.NodeWorkloadDistributionRequest))
+		return srv.(MonitoringServer).GetNodeWorkloadDistribution(ctx, req.(*v1alpha12.NodeWorkloadDistributionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetClusterWorkloadDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.ClusterWorkloadDistributionRequest)
+	in := new(v1alpha12.ClusterWorkloadDistributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1970,13 +2229,13 @@ func _Monitoring_GetClusterWorkloadDistribution_Handler(srv interface{}, ctx con
 		FullMethod: Monitoring_GetClusterWorkloadDistribution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetClusterWorkloadDistribution(ctx, req.(*v1alpha11.ClusterWorkloadDistributionRequest))
+		return srv.(MonitoringServer).GetClusterWorkloadDistribution(ctx, req.(*v1alpha12.ClusterWorkloadDistributionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetTopNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.TopNodeRequest)
+	in := new(v1alpha12.TopNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1988,13 +2247,13 @@ func _Monitoring_GetTopNodes_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: Monitoring_GetTopNodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetTopNodes(ctx, req.(*v1alpha11.TopNodeRequest))
+		return srv.(MonitoringServer).GetTopNodes(ctx, req.(*v1alpha12.TopNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetTopNodeWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.TopNodeWorkloadRequest)
+	in := new(v1alpha12.TopNodeWorkloadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2006,13 +2265,13 @@ func _Monitoring_GetTopNodeWorkloads_Handler(srv interface{}, ctx context.Contex
 		FullMethod: Monitoring_GetTopNodeWorkloads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetTopNodeWorkloads(ctx, req.(*v1alpha11.TopNodeWorkloadRequest))
+		return srv.(MonitoringServer).GetTopNodeWorkloads(ctx, req.(*v1alpha12.TopNodeWorkloadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetKantaloupeflowMemoryDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.MemoryDistributionRequest)
+	in := new(v1alpha12.MemoryDistributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2024,13 +2283,13 @@ func _Monitoring_GetKantaloupeflowMemoryDistribution_Handler(srv interface{}, ct
 		FullMethod: Monitoring_GetKantaloupeflowMemoryDistribution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetKantaloupeflowMemoryDistribution(ctx, req.(*v1alpha11.MemoryDistributionRequest))
+		return srv.(MonitoringServer).GetKantaloupeflowMemoryDistribution(ctx, req.(*v1alpha12.MemoryDistributionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetCardTopWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.CardTopWorkloadsRequest)
+	in := new(v1alpha12.CardTopWorkloadsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2042,13 +2301,13 @@ func _Monitoring_GetCardTopWorkloads_Handler(srv interface{}, ctx context.Contex
 		FullMethod: Monitoring_GetCardTopWorkloads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetCardTopWorkloads(ctx, req.(*v1alpha11.CardTopWorkloadsRequest))
+		return srv.(MonitoringServer).GetCardTopWorkloads(ctx, req.(*v1alpha12.CardTopWorkloadsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Monitoring_GetClusterWorkloadsTop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha11.GetClusterWorkloadsTopRequest)
+	in := new(v1alpha12.GetClusterWorkloadsTopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2060,7 +2319,7 @@ func _Monitoring_GetClusterWorkloadsTop_Handler(srv interface{}, ctx context.Con
 		FullMethod: Monitoring_GetClusterWorkloadsTop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MonitoringServer).GetClusterWorkloadsTop(ctx, req.(*v1alpha11.GetClusterWorkloadsTopRequest))
+		return srv.(MonitoringServer).GetClusterWorkloadsTop(ctx, req.(*v1alpha12.GetClusterWorkloadsTopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2127,9 +2386,13 @@ var Monitoring_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	Kantaloupeflow_CreateKantaloupeflow_FullMethodName          = "/kantaloupev1.Kantaloupeflow/CreateKantaloupeflow"
+	Kantaloupeflow_CreateKantaloupeflowAsync_FullMethodName     = "/kantaloupev1.Kantaloupeflow/CreateKantaloupeflowAsync"
 	Kantaloupeflow_GetKantaloupeflow_FullMethodName             = "/kantaloupev1.Kantaloupeflow/GetKantaloupeflow"
 	Kantaloupeflow_DeleteKantaloupeflow_FullMethodName          = "/kantaloupev1.Kantaloupeflow/DeleteKantaloupeflow"
 	Kantaloupeflow_ListKantaloupeflows_FullMethodName           = "/kantaloupev1.Kantaloupeflow/ListKantaloupeflows"
+	Kantaloupeflow_WatchKantaloupeflows_FullMethodName          = "/kantaloupev1.Kantaloupeflow/WatchKantaloupeflows"
+	Kantaloupeflow_GetKantaloupeflowLogs_FullMethodName         = "/kantaloupev1.Kantaloupeflow/GetKantaloupeflowLogs"
+	Kantaloupeflow_DownloadKantaloupeflowLogs_FullMethodName    = "/kantaloupev1.Kantaloupeflow/DownloadKantaloupeflowLogs"
 	Kantaloupeflow_GetKantaloupeTree_FullMethodName             = "/kantaloupev1.Kantaloupeflow/GetKantaloupeTree"
 	Kantaloupeflow_UpdateKantaloupeflowGPUMemory_FullMethodName = "/kantaloupev1.Kantaloupeflow/UpdateKantaloupeflowGPUMemory"
 	Kantaloupeflow_GetKantaloupeflowConditions_FullMethodName   = "/kantaloupev1.Kantaloupeflow/GetKantaloupeflowConditions"
@@ -2140,17 +2403,30 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KantaloupeflowClient interface {
 	// CreateKantaloupeflow create a kantaloupeflow
-	CreateKantaloupeflow(ctx context.Context, in *v1alpha13.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha13.Kantaloupeflow, error)
+	CreateKantaloupeflow(ctx context.Context, in *v1alpha14.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha14.Kantaloupeflow, error)
+	// CreateKantaloupeflowAsync creates a kantaloupeflow in an operation done once it is running,
+	// the result of the operation is the Kantaloupeflow. The kantaloupeflow is deleted if the
+	// operation fails or is canceled.
+	CreateKantaloupeflowAsync(ctx context.Context, in *v1alpha14.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error)
 	// GetKantaloupeflow get a specified Kantaloupeflow
-	GetKantaloupeflow(ctx context.Context, in *v1alpha13.GetKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha13.GetKantaloupeflowResponse, error)
+	GetKantaloupeflow(ctx context.Context, in *v1alpha14.GetKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha14.GetKantaloupeflowResponse, error)
 	// DeleteKantaloupeflow delete a specified Kantaloupeflow
-	DeleteKantaloupeflow(ctx context.Context, in *v1alpha13.DeleteKantaloupeflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteKantaloupeflow(ctx context.Context, in *v1alpha14.DeleteKantaloupeflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListKantaloupeflows lists Kantaloupeflow
-	ListKantaloupeflows(ctx context.Context, in *v1alpha13.ListKantaloupeflowsRequest, opts ...grpc.CallOption) (*v1alpha13.ListKantaloupeflowsResponse, error)
-	GetKantaloupeTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha13.KantaloupeTree, error)
+	ListKantaloupeflows(ctx context.Context, in *v1alpha14.ListKantaloupeflowsRequest, opts ...grpc.CallOption) (*v1alpha14.ListKantaloupeflowsResponse, error)
+	// WatchKantaloupeflows streams the changes of the kantaloupeflows of a cluster.
+	WatchKantaloupeflows(ctx context.Context, in *v1alpha14.WatchKantaloupeflowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha14.WatchKantaloupeflowsResponse], error)
+	// GetKantaloupeflowLogs streams the logs of the containers of the replicas of a kantaloupeflow.
+	GetKantaloupeflowLogs(ctx context.Context, in *v1alpha14.GetKantaloupeflowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha14.GetKantaloupeflowLogsResponse], error)
+	// DownloadKantaloupeflowLogs streams a tar.gz archive of the logs of all containers of the
+	// replicas of a kantaloupeflow. It is served at
+	// /apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/namespaces/{namespace}/kantaloupeflows/{name}/logs/download
+	// by the apiserver rather than grpc-gateway, which delimits the chunks of the streams.
+	DownloadKantaloupeflowLogs(ctx context.Context, in *v1alpha14.GetKantaloupeflowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	GetKantaloupeTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha14.KantaloupeTree, error)
 	// UpdateKantaloupeflowGPUMemory update Kantaloupeflow gpu memory. Unit is MB.
-	UpdateKantaloupeflowGPUMemory(ctx context.Context, in *v1alpha13.UpdateKantaloupeflowGPUMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetKantaloupeflowConditions(ctx context.Context, in *v1alpha13.GetKantaloupeflowConditionsRequest, opts ...grpc.CallOption) (*v1alpha13.GetKantaloupeflowConditionsResponse, error)
+	UpdateKantaloupeflowGPUMemory(ctx context.Context, in *v1alpha14.UpdateKantaloupeflowGPUMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetKantaloupeflowConditions(ctx context.Context, in *v1alpha14.GetKantaloupeflowConditionsRequest, opts ...grpc.CallOption) (*v1alpha14.GetKantaloupeflowConditionsResponse, error)
 }
 
 type kantaloupeflowClient struct {
@@ -2161,9 +2437,9 @@ func NewKantaloupeflowClient(cc grpc.ClientConnInterface) KantaloupeflowClient {
 	return &kantaloupeflowClient{cc}
 }
 
-func (c *kantaloupeflowClient) CreateKantaloupeflow(ctx context.Context, in *v1alpha13.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha13.Kantaloupeflow, error) {
+func (c *kantaloupeflowClient) CreateKantaloupeflow(ctx context.Context, in *v1alpha14.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha14.Kantaloupeflow, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha13.Kantaloupeflow)
+	out := new(v1alpha14.Kantaloupeflow)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_CreateKantaloupeflow_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2171,9 +2447,19 @@ func (c *kantaloupeflowClient) CreateKantaloupeflow(ctx context.Context, in *v1a
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) GetKantaloupeflow(ctx context.Context, in *v1alpha13.GetKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha13.GetKantaloupeflowResponse, error) {
+func (c *kantaloupeflowClient) CreateKantaloupeflowAsync(ctx context.Context, in *v1alpha14.CreateKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha13.GetKantaloupeflowResponse)
+	out := new(v1alpha11.Operation)
+	err := c.cc.Invoke(ctx, Kantaloupeflow_CreateKantaloupeflowAsync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kantaloupeflowClient) GetKantaloupeflow(ctx context.Context, in *v1alpha14.GetKantaloupeflowRequest, opts ...grpc.CallOption) (*v1alpha14.GetKantaloupeflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha14.GetKantaloupeflowResponse)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_GetKantaloupeflow_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2181,7 +2467,7 @@ func (c *kantaloupeflowClient) GetKantaloupeflow(ctx context.Context, in *v1alph
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) DeleteKantaloupeflow(ctx context.Context, in *v1alpha13.DeleteKantaloupeflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *kantaloupeflowClient) DeleteKantaloupeflow(ctx context.Context, in *v1alpha14.DeleteKantaloupeflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_DeleteKantaloupeflow_FullMethodName, in, out, cOpts...)
@@ -2191,9 +2477,9 @@ func (c *kantaloupeflowClient) DeleteKantaloupeflow(ctx context.Context, in *v1a
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) ListKantaloupeflows(ctx context.Context, in *v1alpha13.ListKantaloupeflowsRequest, opts ...grpc.CallOption) (*v1alpha13.ListKantaloupeflowsResponse, error) {
+func (c *kantaloupeflowClient) ListKantaloupeflows(ctx context.Context, in *v1alpha14.ListKantaloupeflowsRequest, opts ...grpc.CallOption) (*v1alpha14.ListKantaloupeflowsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha13.ListKantaloupeflowsResponse)
+	out := new(v1alpha14.ListKantaloupeflowsResponse)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_ListKantaloupeflows_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2201,9 +2487,66 @@ func (c *kantaloupeflowClient) ListKantaloupeflows(ctx context.Context, in *v1al
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) GetKantaloupeTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha13.KantaloupeTree, error) {
+func (c *kantaloupeflowClient) WatchKantaloupeflows(ctx context.Context, in *v1alpha14.WatchKantaloupeflowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha14.WatchKantaloupeflowsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Kantaloupeflow_ServiceDesc.Streams[0], Kantaloupeflow_WatchKantaloupeflows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha14.WatchKantaloupeflowsRequest, v1alpha14.WatchKantaloupeflowsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_WatchKantaloupeflowsClient = grpc.ServerStreamingClient[v1alpha14.WatchKantaloupeflowsResponse]
+
+func (c *kantaloupeflowClient) GetKantaloupeflowLogs(ctx context.Context, in *v1alpha14.GetKantaloupeflowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha14.GetKantaloupeflowLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Kantaloupeflow_ServiceDesc.Streams[1], Kantaloupeflow_GetKantaloupeflowLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha14.GetKantaloupeflowLogsRequest, v1alpha14.GetKantaloupeflowLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_GetKantaloupeflowLogsClient = grpc.ServerStreamingClient[v1alpha14.GetKantaloupeflowLogsResponse]
+
+func (c *kantaloupeflowClient) DownloadKantaloupeflowLogs(ctx context.Context, in *v1alpha14.GetKantaloupeflowLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha13.KantaloupeTree)
+	stream, err := c.cc.NewStream(ctx, &Kantaloupeflow_ServiceDesc.Streams[2], Kantaloupeflow_DownloadKantaloupeflowLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1alpha14.GetKantaloupeflowLogsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_DownloadKantaloupeflowLogsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *kantaloupeflowClient) GetKantaloupeTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1alpha14.KantaloupeTree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha14.KantaloupeTree)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_GetKantaloupeTree_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2211,7 +2554,7 @@ func (c *kantaloupeflowClient) GetKantaloupeTree(ctx context.Context, in *emptyp
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) UpdateKantaloupeflowGPUMemory(ctx context.Context, in *v1alpha13.UpdateKantaloupeflowGPUMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *kantaloupeflowClient) UpdateKantaloupeflowGPUMemory(ctx context.Context, in *v1alpha14.UpdateKantaloupeflowGPUMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_UpdateKantaloupeflowGPUMemory_FullMethodName, in, out, cOpts...)
@@ -2221,9 +2564,9 @@ func (c *kantaloupeflowClient) UpdateKantaloupeflowGPUMemory(ctx context.Context
 	return out, nil
 }
 
-func (c *kantaloupeflowClient) GetKantaloupeflowConditions(ctx context.Context, in *v1alpha13.GetKantaloupeflowConditionsRequest, opts ...grpc.CallOption) (*v1alpha13.GetKantaloupeflowConditionsResponse, error) {
+func (c *kantaloupeflowClient) GetKantaloupeflowConditions(ctx context.Context, in *v1alpha14.GetKantaloupeflowConditionsRequest, opts ...grpc.CallOption) (*v1alpha14.GetKantaloupeflowConditionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha13.GetKantaloupeflowConditionsResponse)
+	out := new(v1alpha14.GetKantaloupeflowConditionsResponse)
 	err := c.cc.Invoke(ctx, Kantaloupeflow_GetKantaloupeflowConditions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2236,17 +2579,30 @@ func (c *kantaloupeflowClient) GetKantaloupeflowConditions(ctx context.Context,
 // for forward compatibility.
 type KantaloupeflowServer interface {
 	// CreateKantaloupeflow create a kantaloupeflow
-	CreateKantaloupeflow(context.Context, *v1alpha13.CreateKantaloupeflowRequest) (*v1alpha13.Kantaloupeflow, error)
+	CreateKantaloupeflow(context.Context, *v1alpha14.CreateKantaloupeflowRequest) (*v1alpha14.Kantaloupeflow, error)
+	// CreateKantaloupeflowAsync creates a kantaloupeflow in an operation done once it is running,
+	// the result of the operation is the Kantaloupeflow. The kantaloupeflow is deleted if the
+	// operation fails or is canceled.
+	CreateKantaloupeflowAsync(context.Context, *v1alpha14.CreateKantaloupeflowRequest) (*v1alpha11.Operation, error)
 	// GetKantaloupeflow get a specified Kantaloupeflow
-	GetKantaloupeflow(context.Context, *v1alpha13.GetKantaloupeflowRequest) (*v1alpha13.GetKantaloupeflowResponse, error)
+	GetKantaloupeflow(context.Context, *v1alpha14.GetKantaloupeflowRequest) (*v1alpha14.GetKantaloupeflowResponse, error)
 	// DeleteKantaloupeflow delete a specified Kantaloupeflow
-	DeleteKantaloupeflow(context.Context, *v1alpha13.DeleteKantaloupeflowRequest) (*emptypb.Empty, error)
+	DeleteKantaloupeflow(context.Context, *v1alpha14.DeleteKantaloupeflowRequest) (*emptypb.Empty, error)
 	// ListKantaloupeflows lists Kantaloupeflow
-	ListKantaloupeflows(context.Context, *v1alpha13.ListKantaloupeflowsRequest) (*v1alpha13.ListKantaloupeflowsResponse, error)
-	GetKantaloupeTree(context.Context, *emptypb.Empty) (*v1alpha13.KantaloupeTree, error)
+	ListKantaloupeflows(context.Context, *v1alpha14.ListKantaloupeflowsRequest) (*v1alpha14.ListKantaloupeflowsResponse, error)
+	// WatchKantaloupeflows streams the changes of the kantaloupeflows of a cluster.
+	WatchKantaloupeflows(*v1alpha14.WatchKantaloupeflowsRequest, grpc.ServerStreamingServer[v1alpha14.WatchKantaloupeflowsResponse]) error
+	// GetKantaloupeflowLogs streams the logs of the containers of the replicas of a kantaloupeflow.
+	GetKantaloupeflowLogs(*v1alpha14.GetKantaloupeflowLogsRequest, grpc.ServerStreamingServer[v1alpha14.GetKantaloupeflowLogsResponse]) error
+	// DownloadKantaloupeflowLogs streams a tar.gz archive of the logs of all containers of the
+	// replicas of a kantaloupeflow. It is served at
+	// /apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/namespaces/{namespace}/kantaloupeflows/{name}/logs/download
+	// by the apiserver rather than grpc-gateway, which delimits the chunks of the streams.
+	DownloadKantaloupeflowLogs(*v1alpha14.GetKantaloupeflowLogsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	GetKantaloupeTree(context.Context, *emptypb.Empty) (*v1alpha14.KantaloupeTree, error)
 	// UpdateKantaloupeflowGPUMemory update Kantaloupeflow gpu memory. Unit is MB.
-	UpdateKantaloupeflowGPUMemory(context.Context, *v1alpha13.UpdateKantaloupeflowGPUMemoryRequest) (*emptypb.Empty, error)
-	GetKantaloupeflowConditions(context.Context, *v1alpha13.GetKantaloupeflowConditionsRequest) (*v1alpha13.GetKantaloupeflowConditionsResponse, error)
+	UpdateKantaloupeflowGPUMemory(context.Context, *v1alpha14.UpdateKantaloupeflowGPUMemoryRequest) (*emptypb.Empty, error)
+	GetKantaloupeflowConditions(context.Context, *v1alpha14.GetKantaloupeflowConditionsRequest) (*v1alpha14.GetKantaloupeflowConditionsResponse, error)
 	mustEmbedUnimplementedKantaloupeflowServer()
 }
 
@@ -2257,25 +2613,37 @@ type KantaloupeflowServer interface {
 // pointer dereference when methods are called.
 type UnimplementedKantaloupeflowServer struct{}
 
-func (UnimplementedKantaloupeflowServer) CreateKantaloupeflow(context.Context, *v1alpha13.CreateKantaloupeflowRequest) (*v1alpha13.Kantaloupeflow, error) {
+func (UnimplementedKantaloupeflowServer) CreateKantaloupeflow(context.Context, *v1alpha14.CreateKantaloupeflowRequest) (*v1alpha14.Kantaloupeflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKantaloupeflow not implemented")
 }
-func (UnimplementedKantaloupeflowServer) GetKantaloupeflow(context.Context, *v1alpha13.GetKantaloupeflowRequest) (*v1alpha13.GetKantaloupeflowResponse, error) {
+func (UnimplementedKantaloupeflowServer) CreateKantaloupeflowAsync(context.Context, *v1alpha14.CreateKantaloupeflowRequest) (*v1alpha11.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKantaloupeflowAsync not implemented")
+}
+func (UnimplementedKantaloupeflowServer) GetKantaloupeflow(context.Context, *v1alpha14.GetKantaloupeflowRequest) (*v1alpha14.GetKantaloupeflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKantaloupeflow not implemented")
 }
-func (UnimplementedKantaloupeflowServer) DeleteKantaloupeflow(context.Context, *v1alpha13.DeleteKantaloupeflowRequest) (*emptypb.Empty, error) {
+func (UnimplementedKantaloupeflowServer) DeleteKantaloupeflow(context.Context, *v1alpha14.DeleteKantaloupeflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKantaloupeflow not implemented")
 }
-func (UnimplementedKantaloupeflowServer) ListKantaloupeflows(context.Context, *v1alpha13.ListKantaloupeflowsRequest) (*v1alpha13.ListKantaloupeflowsResponse, error) {
+func (UnimplementedKantaloupeflowServer) ListKantaloupeflows(context.Context, *v1alpha14.ListKantaloupeflowsRequest) (*v1alpha14.ListKantaloupeflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListKantaloupeflows not implemented")
 }
-func (UnimplementedKantaloupeflowServer) GetKantaloupeTree(context.Context, *emptypb.Empty) (*v1alpha13.KantaloupeTree, error) {
+func (UnimplementedKantaloupeflowServer) WatchKantaloupeflows(*v1alpha14.WatchKantaloupeflowsRequest, grpc.ServerStreamingServer[v1alpha14.WatchKantaloupeflowsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchKantaloupeflows not implemented")
+}
+func (UnimplementedKantaloupeflowServer) GetKantaloupeflowLogs(*v1alpha14.GetKantaloupeflowLogsRequest, grpc.ServerStreamingServer[v1alpha14.GetKantaloupeflowLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetKantaloupeflowLogs not implemented")
+}
+func (UnimplementedKantaloupeflowServer) DownloadKantaloupeflowLogs(*v1alpha14.GetKantaloupeflowLogsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadKantaloupeflowLogs not implemented")
+}
+func (UnimplementedKantaloupeflowServer) GetKantaloupeTree(context.Context, *emptypb.Empty) (*v1alpha14.KantaloupeTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKantaloupeTree not implemented")
 }
-func (UnimplementedKantaloupeflowServer) UpdateKantaloupeflowGPUMemory(context.Context, *v1alpha13.UpdateKantaloupeflowGPUMemoryRequest) (*emptypb.Empty, error) {
+func (UnimplementedKantaloupeflowServer) UpdateKantaloupeflowGPUMemory(context.Context, *v1alpha14.UpdateKantaloupeflowGPUMemoryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateKantaloupeflowGPUMemory not implemented")
 }
-func (UnimplementedKantaloupeflowServer) GetKantaloupeflowConditions(context.Context, *v1alpha13.GetKantaloupeflowConditionsRequest) (*v1alpha13.GetKantaloupeflowConditionsResponse, error) {
+func (UnimplementedKantaloupeflowServer) GetKantaloupeflowConditions(context.Context, *v1alpha14.GetKantaloupeflowConditionsRequest) (*v1alpha14.GetKantaloupeflowConditionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKantaloupeflowConditions not implemented")
 }
 func (UnimplementedKantaloupeflowServer) mustEmbedUnimplementedKantaloupeflowServer() {}
@@ -2300,7 +2668,7 @@ func RegisterKantaloupeflowServer(s grpc.ServiceRegistrar, srv KantaloupeflowSer
 }
 
 func _Kantaloupeflow_CreateKantaloupeflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.CreateKantaloupeflowRequest)
+	in := new(v1alpha14.CreateKantaloupeflowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2312,13 +2680,31 @@ func _Kantaloupeflow_CreateKantaloupeflow_Handler(srv interface{}, ctx context.C
 		FullMethod: Kantaloupeflow_CreateKantaloupeflow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).CreateKantaloupeflow(ctx, req.(*v1alpha13.CreateKantaloupeflowRequest))
+		return srv.(KantaloupeflowServer).CreateKantaloupeflow(ctx, req.(*v1alpha14.CreateKantaloupeflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kantaloupeflow_CreateKantaloupeflowAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha14.CreateKantaloupeflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KantaloupeflowServer).CreateKantaloupeflowAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Kantaloupeflow_CreateKantaloupeflowAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KantaloupeflowServer).CreateKantaloupeflowAsync(ctx, req.(*v1alpha14.CreateKantaloupeflowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Kantaloupeflow_GetKantaloupeflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.GetKantaloupeflowRequest)
+	in := new(v1alpha14.GetKantaloupeflowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2330,13 +2716,13 @@ func _Kantaloupeflow_GetKantaloupeflow_Handler(srv interface{}, ctx context.Cont
 		FullMethod: Kantaloupeflow_GetKantaloupeflow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).GetKantaloupeflow(ctx, req.(*v1alpha13.GetKantaloupeflowRequest))
+		return srv.(KantaloupeflowServer).GetKantaloupeflow(ctx, req.(*v1alpha14.GetKantaloupeflowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Kantaloupeflow_DeleteKantaloupeflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.DeleteKantaloupeflowRequest)
+	in := new(v1alpha14.DeleteKantaloupeflowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2348,13 +2734,13 @@ func _Kantaloupeflow_DeleteKantaloupeflow_Handler(srv interface{}, ctx context.C
 		FullMethod: Kantaloupeflow_DeleteKantaloupeflow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).DeleteKantaloupeflow(ctx, req.(*v1alpha13.DeleteKantaloupeflowRequest))
+		return srv.(KantaloupeflowServer).DeleteKantaloupeflow(ctx, req.(*v1alpha14.DeleteKantaloupeflowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Kantaloupeflow_ListKantaloupeflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.ListKantaloupeflowsRequest)
+	in := new(v1alpha14.ListKantaloupeflowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2366,11 +2752,44 @@ func _Kantaloupeflow_ListKantaloupeflows_Handler(srv interface{}, ctx context.Co
 		FullMethod: Kantaloupeflow_ListKantaloupeflows_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).ListKantaloupeflows(ctx, req.(*v1alpha13.ListKantaloupeflowsRequest))
+		return srv.(KantaloupeflowServer).ListKantaloupeflows(ctx, req.(*v1alpha14.ListKantaloupeflowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Kantaloupeflow_WatchKantaloupeflows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha14.WatchKantaloupeflowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KantaloupeflowServer).WatchKantaloupeflows(m, &grpc.GenericServerStream[v1alpha14.WatchKantaloupeflowsRequest, v1alpha14.WatchKantaloupeflowsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_WatchKantaloupeflowsServer = grpc.ServerStreamingServer[v1alpha14.WatchKantaloupeflowsResponse]
+
+func _Kantaloupeflow_GetKantaloupeflowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha14.GetKantaloupeflowLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KantaloupeflowServer).GetKantaloupeflowLogs(m, &grpc.GenericServerStream[v1alpha14.GetKantaloupeflowLogsRequest, v1alpha14.GetKantaloupeflowLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_GetKantaloupeflowLogsServer = grpc.ServerStreamingServer[v1alpha14.GetKantaloupeflowLogsResponse]
+
+func _Kantaloupeflow_DownloadKantaloupeflowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha14.GetKantaloupeflowLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KantaloupeflowServer).DownloadKantaloupeflowLogs(m, &grpc.GenericServerStream[v1alpha14.GetKantaloupeflowLogsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kantaloupeflow_DownloadKantaloupeflowLogsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _Kantaloupeflow_GetKantaloupeTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2390,7 +2809,7 @@ func _Kantaloupeflow_GetKantaloupeTree_Handler(srv interface{}, ctx context.Cont
 }
 
 func _Kantaloupeflow_UpdateKantaloupeflowGPUMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.UpdateKantaloupeflowGPUMemoryRequest)
+	in := new(v1alpha14.UpdateKantaloupeflowGPUMemoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2402,13 +2821,13 @@ func _Kantaloupeflow_UpdateKantaloupeflowGPUMemory_Handler(srv interface{}, ctx
 		FullMethod: Kantaloupeflow_UpdateKantaloupeflowGPUMemory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).UpdateKantaloupeflowGPUMemory(ctx, req.(*v1alpha13.UpdateKantaloupeflowGPUMemoryRequest))
+		return srv.(KantaloupeflowServer).UpdateKantaloupeflowGPUMemory(ctx, req.(*v1alpha14.UpdateKantaloupeflowGPUMemoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Kantaloupeflow_GetKantaloupeflowConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha13.GetKantaloupeflowConditionsRequest)
+	in := new(v1alpha14.GetKantaloupeflowConditionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2420,7 +2839,7 @@ func _Kantaloupeflow_GetKantaloupeflowConditions_Handler(srv interface{}, ctx co
 		FullMethod: Kantaloupeflow_GetKantaloupeflowConditions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KantaloupeflowServer).GetKantaloupeflowConditions(ctx, req.(*v1alpha13.GetKantaloupeflowConditionsRequest))
+		return srv.(KantaloupeflowServer).GetKantaloupeflowConditions(ctx, req.(*v1alpha14.GetKantaloupeflowConditionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2436,6 +2855,10 @@ var Kantaloupeflow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateKantaloupeflow",
 			Handler:    _Kantaloupeflow_CreateKantaloupeflow_Handler,
 		},
+		{
+			MethodName: "CreateKantaloupeflowAsync",
+			Handler:    _Kantaloupeflow_CreateKantaloupeflowAsync_Handler,
+		},
 		{
 			MethodName: "GetKantaloupeflow",
 			Handler:    _Kantaloupeflow_GetKantaloupeflow_Handler,
@@ -2461,7 +2884,23 @@ var Kantaloupeflow_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Kantaloupeflow_GetKantaloupeflowConditions_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchKantaloupeflows",
+			Handler:       _Kantaloupeflow_WatchKantaloupeflows_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetKantaloupeflowLogs",
+			Handler:       _Kantaloupeflow_GetKantaloupeflowLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadKantaloupeflowLogs",
+			Handler:       _Kantaloupeflow_DownloadKantaloupeflowLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/v1/kantaloupe.proto",
 }
 
@@ -2477,13 +2916,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CredentialClient interface {
 	// ListCredentials lists credentials.
-	ListCredentials(ctx context.Context, in *v1alpha14.ListCredentialsRequest, opts ...grpc.CallOption) (*v1alpha14.ListCredentialsResponse, error)
+	ListCredentials(ctx context.Context, in *v1alpha15.ListCredentialsRequest, opts ...grpc.CallOption) (*v1alpha15.ListCredentialsResponse, error)
 	// DeleteCredential deletes a credential.
-	DeleteCredential(ctx context.Context, in *v1alpha14.DeleteCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCredential(ctx context.Context, in *v1alpha15.DeleteCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateCredential creates a credential.
-	CreateCredential(ctx context.Context, in *v1alpha14.CreateCredentialRequest, opts ...grpc.CallOption) (*v1alpha14.CredentialResponse, error)
+	CreateCredential(ctx context.Context, in *v1alpha15.CreateCredentialRequest, opts ...grpc.CallOption) (*v1alpha15.CredentialResponse, error)
 	// UpdateCredential updates a credential.
-	UpdateCredential(ctx context.Context, in *v1alpha14.UpdateCredentialRequest, opts ...grpc.CallOption) (*v1alpha14.CredentialResponse, error)
+	UpdateCredential(ctx context.Context, in *v1alpha15.UpdateCredentialRequest, opts ...grpc.CallOption) (*v1alpha15.CredentialResponse, error)
 }
 
 type credentialClient struct {
@@ -2494,9 +2933,9 @@ func NewCredentialClient(cc grpc.ClientConnInterface) CredentialClient {
 	return &credentialClient{cc}
 }
 
-func (c *credentialClient) ListCredentials(ctx context.Context, in *v1alpha14.ListCredentialsRequest, opts ...grpc.CallOption) (*v1alpha14.ListCredentialsResponse, error) {
+func (c *credentialClient) ListCredentials(ctx context.Context, in *v1alpha15.ListCredentialsRequest, opts ...grpc.CallOption) (*v1alpha15.ListCredentialsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha14.ListCredentialsResponse)
+	out := new(v1alpha15.ListCredentialsResponse)
 	err := c.cc.Invoke(ctx, Credential_ListCredentials_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2504,7 +2943,7 @@ func (c *credentialClient) ListCredentials(ctx context.Context, in *v1alpha14.Li
 	return out, nil
 }
 
-func (c *credentialClient) DeleteCredential(ctx context.Context, in *v1alpha14.DeleteCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *credentialClient) DeleteCredential(ctx context.Context, in *v1alpha15.DeleteCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Credential_DeleteCredential_FullMethodName, in, out, cOpts...)
@@ -2514,9 +2953,9 @@ func (c *credentialClient) DeleteCredential(ctx context.Context, in *v1alpha14.D
 	return out, nil
 }
 
-func (c *credentialClient) CreateCredential(ctx context.Context, in *v1alpha14.CreateCredentialRequest, opts ...grpc.CallOption) (*v1alpha14.CredentialResponse, error) {
+func (c *credentialClient) CreateCredential(ctx context.Context, in *v1alpha15.CreateCredentialRequest, opts ...grpc.CallOption) (*v1alpha15.CredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha14.CredentialResponse)
+	out := new(v1alpha15.CredentialResponse)
 	err := c.cc.Invoke(ctx, Credential_CreateCredential_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2524,9 +2963,9 @@ func (c *credentialClient) CreateCredential(ctx context.Context, in *v1alpha14.C
 	return out, nil
 }
 
-func (c *credentialClient) UpdateCredential(ctx context.Context, in *v1alpha14.UpdateCredentialRequest, opts ...grpc.CallOption) (*v1alpha14.CredentialResponse, error) {
+func (c *credentialClient) UpdateCredential(ctx context.Context, in *v1alpha15.UpdateCredentialRequest, opts ...grpc.CallOption) (*v1alpha15.CredentialResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha14.CredentialResponse)
+	out := new(v1alpha15.CredentialResponse)
 	err := c.cc.Invoke(ctx, Credential_UpdateCredential_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2539,13 +2978,13 @@ func (c *credentialClient) UpdateCredential(ctx context.Context, in *v1alpha14.U
 // for forward compatibility.
 type CredentialServer interface {
 	// ListCredentials lists credentials.
-	ListCredentials(context.Context, *v1alpha14.ListCredentialsRequest) (*v1alpha14.ListCredentialsResponse, error)
+	ListCredentials(context.Context, *v1alpha15.ListCredentialsRequest) (*v1alpha15.ListCredentialsResponse, error)
 	// DeleteCredential deletes a credential.
-	DeleteCredential(context.Context, *v1alpha14.DeleteCredentialRequest) (*emptypb.Empty, error)
+	DeleteCredential(context.Context, *v1alpha15.DeleteCredentialRequest) (*emptypb.Empty, error)
 	// CreateCredential creates a credential.
-	CreateCredential(context.Context, *v1alpha14.CreateCredentialRequest) (*v1alpha14.CredentialResponse, error)
+	CreateCredential(context.Context, *v1alpha15.CreateCredentialRequest) (*v1alpha15.CredentialResponse, error)
 	// UpdateCredential updates a credential.
-	UpdateCredential(context.Context, *v1alpha14.UpdateCredentialRequest) (*v1alpha14.CredentialResponse, error)
+	UpdateCredential(context.Context, *v1alpha15.UpdateCredentialRequest) (*v1alpha15.CredentialResponse, error)
 	mustEmbedUnimplementedCredentialServer()
 }
 
@@ -2556,16 +2995,16 @@ type CredentialServer interface {
 // pointer dereference when methods are called.
 type UnimplementedCredentialServer struct{}
 
-func (UnimplementedCredentialServer) ListCredentials(context.Context, *v1alpha14.ListCredentialsRequest) (*v1alpha14.ListCredentialsResponse, error) {
+func (UnimplementedCredentialServer) ListCredentials(context.Context, *v1alpha15.ListCredentialsRequest) (*v1alpha15.ListCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
 }
-func (UnimplementedCredentialServer) DeleteCredential(context.Context, *v1alpha14.DeleteCredentialRequest) (*emptypb.Empty, error) {
+func (UnimplementedCredentialServer) DeleteCredential(context.Context, *v1alpha15.DeleteCredentialRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
-func (UnimplementedCredentialServer) CreateCredential(context.Context, *v1alpha14.CreateCredentialRequest) (*v1alpha14.CredentialResponse, error) {
+func (UnimplementedCredentialServer) CreateCredential(context.Context, *v1alpha15.CreateCredentialRequest) (*v1alpha15.CredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
-func (UnimplementedCredentialServer) UpdateCredential(context.Context, *v1alpha14.UpdateCredentialRequest) (*v1alpha14.CredentialResponse, error) {
+func (UnimplementedCredentialServer) UpdateCredential(context.Context, *v1alpha15.UpdateCredentialRequest) (*v1alpha15.CredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
 }
 func (UnimplementedCredentialServer) mustEmbedUnimplementedCredentialServer() {}
@@ -2590,7 +3029,7 @@ func RegisterCredentialServer(s grpc.ServiceRegistrar, srv CredentialServer) {
 }
 
 func _Credential_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha14.ListCredentialsRequest)
+	in := new(v1alpha15.ListCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2602,13 +3041,13 @@ func _Credential_ListCredentials_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Credential_ListCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CredentialServer).ListCredentials(ctx, req.(*v1alpha14.ListCredentialsRequest))
+		return srv.(CredentialServer).ListCredentials(ctx, req.(*v1alpha15.ListCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Credential_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha14.DeleteCredentialRequest)
+	in := new(v1alpha15.DeleteCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2620,13 +3059,13 @@ func _Credential_DeleteCredential_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Credential_DeleteCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CredentialServer).DeleteCredential(ctx, req.(*v1alpha14.DeleteCredentialRequest))
+		return srv.(CredentialServer).DeleteCredential(ctx, req.(*v1alpha15.DeleteCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Credential_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha14.CreateCredentialRequest)
+	in := new(v1alpha15.CreateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2638,13 +3077,13 @@ func _Credential_CreateCredential_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Credential_CreateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CredentialServer).CreateCredential(ctx, req.(*v1alpha14.CreateCredentialRequest))
+		return srv.(CredentialServer).CreateCredential(ctx, req.(*v1alpha15.CreateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Credential_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha14.UpdateCredentialRequest)
+	in := new(v1alpha15.UpdateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2656,7 +3095,7 @@ func _Credential_UpdateCredential_Handler(srv interface{}, ctx context.Context,
 		FullMethod: Credential_UpdateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CredentialServer).UpdateCredential(ctx, req.(*v1alpha14.UpdateCredentialRequest))
+		return srv.(CredentialServer).UpdateCredential(ctx, req.(*v1alpha15.UpdateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2702,14 +3141,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QuotaClient interface {
 	// ListQuotas lists resource quotas.
-	ListQuotas(ctx context.Context, in *v1alpha15.ListQuotasRequest, opts ...grpc.CallOption) (*v1alpha15.ListQuotasResponse, error)
+	ListQuotas(ctx context.Context, in *v1alpha16.ListQuotasRequest, opts ...grpc.CallOption) (*v1alpha16.ListQuotasResponse, error)
 	// DeleteQuota deletes a resource quota.
-	DeleteQuota(ctx context.Context, in *v1alpha15.DeleteQuotaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteQuota(ctx context.Context, in *v1alpha16.DeleteQuotaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateQuota creates a resource quota.
-	CreateQuota(ctx context.Context, in *v1alpha15.CreateQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error)
+	CreateQuota(ctx context.Context, in *v1alpha16.CreateQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error)
 	// UpdateQuota updates a resource quota.
-	UpdateQuota(ctx context.Context, in *v1alpha15.UpdateQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error)
-	GetQuota(ctx context.Context, in *v1alpha15.GetQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error)
+	UpdateQuota(ctx context.Context, in *v1alpha16.UpdateQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error)
+	GetQuota(ctx context.Context, in *v1alpha16.GetQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error)
 }
 
 type quotaClient struct {
@@ -2720,9 +3159,9 @@ func NewQuotaClient(cc grpc.ClientConnInterface) QuotaClient {
 	return &quotaClient{cc}
 }
 
-func (c *quotaClient) ListQuotas(ctx context.Context, in *v1alpha15.ListQuotasRequest, opts ...grpc.CallOption) (*v1alpha15.ListQuotasResponse, error) {
+func (c *quotaClient) ListQuotas(ctx context.Context, in *v1alpha16.ListQuotasRequest, opts ...grpc.CallOption) (*v1alpha16.ListQuotasResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha15.ListQuotasResponse)
+	out := new(v1alpha16.ListQuotasResponse)
 	err := c.cc.Invoke(ctx, Quota_ListQuotas_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2730,7 +3169,7 @@ func (c *quotaClient) ListQuotas(ctx context.Context, in *v1alpha15.ListQuotasRe
 	return out, nil
 }
 
-func (c *quotaClient) DeleteQuota(ctx context.Context, in *v1alpha15.DeleteQuotaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *quotaClient) DeleteQuota(ctx context.Context, in *v1alpha16.DeleteQuotaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Quota_DeleteQuota_FullMethodName, in, out, cOpts...)
@@ -2740,9 +3179,9 @@ func (c *quotaClient) DeleteQuota(ctx context.Context, in *v1alpha15.DeleteQuota
 	return out, nil
 }
 
-func (c *quotaClient) CreateQuota(ctx context.Context, in *v1alpha15.CreateQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error) {
+func (c *quotaClient) CreateQuota(ctx context.Context, in *v1alpha16.CreateQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha15.QuotaResponse)
+	out := new(v1alpha16.QuotaResponse)
 	err := c.cc.Invoke(ctx, Quota_CreateQuota_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2750,9 +3189,9 @@ func (c *quotaClient) CreateQuota(ctx context.Context, in *v1alpha15.CreateQuota
 	return out, nil
 }
 
-func (c *quotaClient) UpdateQuota(ctx context.Context, in *v1alpha15.UpdateQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error) {
+func (c *quotaClient) UpdateQuota(ctx context.Context, in *v1alpha16.UpdateQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha15.QuotaResponse)
+	out := new(v1alpha16.QuotaResponse)
 	err := c.cc.Invoke(ctx, Quota_UpdateQuota_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2760,9 +3199,9 @@ func (c *quotaClient) UpdateQuota(ctx context.Context, in *v1alpha15.UpdateQuota
 	return out, nil
 }
 
-func (c *quotaClient) GetQuota(ctx context.Context, in *v1alpha15.GetQuotaRequest, opts ...grpc.CallOption) (*v1alpha15.QuotaResponse, error) {
+func (c *quotaClient) GetQuota(ctx context.Context, in *v1alpha16.GetQuotaRequest, opts ...grpc.CallOption) (*v1alpha16.QuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha15.QuotaResponse)
+	out := new(v1alpha16.QuotaResponse)
 	err := c.cc.Invoke(ctx, Quota_GetQuota_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2775,14 +3214,14 @@ func (c *quotaClient) GetQuota(ctx context.Context, in *v1alpha15.GetQuotaReques
 // for forward compatibility.
 type QuotaServer interface {
 	// ListQuotas lists resource quotas.
-	ListQuotas(context.Context, *v1alpha15.ListQuotasRequest) (*v1alpha15.ListQuotasResponse, error)
+	ListQuotas(context.Context, *v1alpha16.ListQuotasRequest) (*v1alpha16.ListQuotasResponse, error)
 	// DeleteQuota deletes a resource quota.
-	DeleteQuota(context.Context, *v1alpha15.DeleteQuotaRequest) (*emptypb.Empty, error)
+	DeleteQuota(context.Context, *v1alpha16.DeleteQuotaRequest) (*emptypb.Empty, error)
 	// CreateQuota creates a resource quota.
-	CreateQuota(context.Context, *v1alpha15.CreateQuotaRequest) (*v1alpha15.QuotaResponse, error)
+	CreateQuota(context.Context, *v1alpha16.CreateQuotaRequest) (*v1alpha16.QuotaResponse, error)
 	// UpdateQuota updates a resource quota.
-	UpdateQuota(context.Context, *v1alpha15.UpdateQuotaRequest) (*v1alpha15.QuotaResponse, error)
-	GetQuota(context.Context, *v1alpha15.GetQuotaRequest) (*v1alpha15.QuotaResponse, error)
+	UpdateQuota(context.Context, *v1alpha16.UpdateQuotaRequest) (*v1alpha16.QuotaResponse, error)
+	GetQuota(context.Context, *v1alpha16.GetQuotaRequest) (*v1alpha16.QuotaResponse, error)
 	mustEmbedUnimplementedQuotaServer()
 }
 
@@ -2793,19 +3232,19 @@ type QuotaServer interface {
 // pointer dereference when methods are called.
 type UnimplementedQuotaServer struct{}
 
-func (UnimplementedQuotaServer) ListQuotas(context.Context, *v1alpha15.ListQuotasRequest) (*v1alpha15.ListQuotasResponse, error) {
+func (UnimplementedQuotaServer) ListQuotas(context.Context, *v1alpha16.ListQuotasRequest) (*v1alpha16.ListQuotasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuotas not implemented")
 }
-func (UnimplementedQuotaServer) DeleteQuota(context.Context, *v1alpha15.DeleteQuotaRequest) (*emptypb.Empty, error) {
+func (UnimplementedQuotaServer) DeleteQuota(context.Context, *v1alpha16.DeleteQuotaRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuota not implemented")
 }
-func (UnimplementedQuotaServer) CreateQuota(context.Context, *v1alpha15.CreateQuotaRequest) (*v1alpha15.QuotaResponse, error) {
+func (UnimplementedQuotaServer) CreateQuota(context.Context, *v1alpha16.CreateQuotaRequest) (*v1alpha16.QuotaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQuota not implemented")
 }
-func (UnimplementedQuotaServer) UpdateQuota(context.Context, *v1alpha15.UpdateQuotaRequest) (*v1alpha15.QuotaResponse, error) {
+func (UnimplementedQuotaServer) UpdateQuota(context.Context, *v1alpha16.UpdateQuotaRequest) (*v1alpha16.QuotaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuota not implemented")
 }
-func (UnimplementedQuotaServer) GetQuota(context.Context, *v1alpha15.GetQuotaRequest) (*v1alpha15.QuotaResponse, error) {
+func (UnimplementedQuotaServer) GetQuota(context.Context, *v1alpha16.GetQuotaRequest) (*v1alpha16.QuotaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
 }
 func (UnimplementedQuotaServer) mustEmbedUnimplementedQuotaServer() {}
@@ -2830,7 +3269,7 @@ func RegisterQuotaServer(s grpc.ServiceRegistrar, srv QuotaServer) {
 }
 
 func _Quota_ListQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha15.ListQuotasRequest)
+	in := new(v1alpha16.ListQuotasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2842,13 +3281,13 @@ func _Quota_ListQuotas_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: Quota_ListQuotas_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuotaServer).ListQuotas(ctx, req.(*v1alpha15.ListQuotasRequest))
+		return srv.(QuotaServer).ListQuotas(ctx, req.(*v1alpha16.ListQuotasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Quota_DeleteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha15.DeleteQuotaRequest)
+	in := new(v1alpha16.DeleteQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2860,13 +3299,13 @@ func _Quota_DeleteQuota_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Quota_DeleteQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuotaServer).DeleteQuota(ctx, req.(*v1alpha15.DeleteQuotaRequest))
+		return srv.(QuotaServer).DeleteQuota(ctx, req.(*v1alpha16.DeleteQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Quota_CreateQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha15.CreateQuotaRequest)
+	in := new(v1alpha16.CreateQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2878,13 +3317,13 @@ func _Quota_CreateQuota_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Quota_CreateQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuotaServer).CreateQuota(ctx, req.(*v1alpha15.CreateQuotaRequest))
+		return srv.(QuotaServer).CreateQuota(ctx, req.(*v1alpha16.CreateQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Quota_UpdateQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha15.UpdateQuotaRequest)
+	in := new(v1alpha16.UpdateQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2896,13 +3335,13 @@ func _Quota_UpdateQuota_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Quota_UpdateQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuotaServer).UpdateQuota(ctx, req.(*v1alpha15.UpdateQuotaRequest))
+		return srv.(QuotaServer).UpdateQuota(ctx, req.(*v1alpha16.UpdateQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Quota_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha15.GetQuotaRequest)
+	in := new(v1alpha16.GetQuotaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2914,7 +3353,7 @@ func _Quota_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(inte
 		FullMethod: Quota_GetQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QuotaServer).GetQuota(ctx, req.(*v1alpha15.GetQuotaRequest))
+		return srv.(QuotaServer).GetQuota(ctx, req.(*v1alpha16.GetQuotaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2963,13 +3402,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StorageClient interface {
 	// ListStorageClasses lists storage classes.
-	ListStorageClasses(ctx context.Context, in *v1alpha16.ListStorageClassesRequest, opts ...grpc.CallOption) (*v1alpha16.ListStorageClassesResponse, error)
+	ListStorageClasses(ctx context.Context, in *v1alpha17.ListStorageClassesRequest, opts ...grpc.CallOption) (*v1alpha17.ListStorageClassesResponse, error)
 	// CreateStorageClass creates a storage
-	CreateStorage(ctx context.Context, in *v1alpha16.CreateStorageRequest, opts ...grpc.CallOption) (*v1alpha16.Storage, error)
+	CreateStorage(ctx context.Context, in *v1alpha17.CreateStorageRequest, opts ...grpc.CallOption) (*v1alpha17.Storage, error)
 	// DeleteStorage deletes a storage
-	DeleteStorage(ctx context.Context, in *v1alpha16.DeleteStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteStorage(ctx context.Context, in *v1alpha17.DeleteStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListStorage lists specific namespace storage
-	ListStorages(ctx context.Context, in *v1alpha16.ListStoragesRequest, opts ...grpc.CallOption) (*v1alpha16.ListStoragesResponse, error)
+	ListStorages(ctx context.Context, in *v1alpha17.ListStoragesRequest, opts ...grpc.CallOption) (*v1alpha17.ListStoragesResponse, error)
 }
 
 type storageClient struct {
@@ -2980,9 +3419,9 @@ func NewStorageClient(cc grpc.ClientConnInterface) StorageClient {
 	return &storageClient{cc}
 }
 
-func (c *storageClient) ListStorageClasses(ctx context.Context, in *v1alpha16.ListStorageClassesRequest, opts ...grpc.CallOption) (*v1alpha16.ListStorageClassesResponse, error) {
+func (c *storageClient) ListStorageClasses(ctx context.Context, in *v1alpha17.ListStorageClassesRequest, opts ...grpc.CallOption) (*v1alpha17.ListStorageClassesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha16.ListStorageClassesResponse)
+	out := new(v1alpha17.ListStorageClassesResponse)
 	err := c.cc.Invoke(ctx, Storage_ListStorageClasses_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2990,9 +3429,9 @@ func (c *storageClient) ListStorageClasses(ctx context.Context, in *v1alpha16.Li
 	return out, nil
 }
 
-func (c *storageClient) CreateStorage(ctx context.Context, in *v1alpha16.CreateStorageRequest, opts ...grpc.CallOption) (*v1alpha16.Storage, error) {
+func (c *storageClient) CreateStorage(ctx context.Context, in *v1alpha17.CreateStorageRequest, opts ...grpc.CallOption) (*v1alpha17.Storage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha16.Storage)
+	out := new(v1alpha17.Storage)
 	err := c.cc.Invoke(ctx, Storage_CreateStorage_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -3000,7 +3439,7 @@ func (c *storageClient) CreateStorage(ctx context.Context, in *v1alpha16.CreateS
 	return out, nil
 }
 
-func (c *storageClient) DeleteStorage(ctx context.Context, in *v1alpha16.DeleteStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *storageClient) DeleteStorage(ctx context.Context, in *v1alpha17.DeleteStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Storage_DeleteStorage_FullMethodName, in, out, cOpts...)
@@ -3010,9 +3449,9 @@ func (c *storageClient) DeleteStorage(ctx context.Context, in *v1alpha16.DeleteS
 	return out, nil
 }
 
-func (c *storageClient) ListStorages(ctx context.Context, in *v1alpha16.ListStoragesRequest, opts ...grpc.CallOption) (*v1alpha16.ListStoragesResponse, error) {
+func (c *storageClient) ListStorages(ctx context.Context, in *v1alpha17.ListStoragesRequest, opts ...grpc.CallOption) (*v1alpha17.ListStoragesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha16.ListStoragesResponse)
+	out := new(v1alpha17.ListStoragesResponse)
 	err := c.cc.Invoke(ctx, Storage_ListStorages_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -3025,13 +3464,13 @@ func (c *storageClient) ListStorages(ctx context.Context, in *v1alpha16.ListStor
 // for forward compatibility.
 type StorageServer interface {
 	// ListStorageClasses lists storage classes.
-	ListStorageClasses(context.Context, *v1alpha16.ListStorageClassesRequest) (*v1alpha16.ListStorageClassesResponse, error)
+	ListStorageClasses(context.Context, *v1alpha17.ListStorageClassesRequest) (*v1alpha17.ListStorageClassesResponse, error)
 	// CreateStorageClass creates a storage
-	CreateStorage(context.Context, *v1alpha16.CreateStorageRequest) (*v1alpha16.Storage, error)
+	CreateStorage(context.Context, *v1alpha17.CreateStorageRequest) (*v1alpha17.Storage, error)
 	// DeleteStorage deletes a storage
-	DeleteStorage(context.Context, *v1alpha16.DeleteStorageRequest) (*emptypb.Empty, error)
+	DeleteStorage(context.Context, *v1alpha17.DeleteStorageRequest) (*emptypb.Empty, error)
 	// ListStorage lists specific namespace storage
-	ListStorages(context.Context, *v1alpha16.ListStoragesRequest) (*v1alpha16.ListStoragesResponse, error)
+	ListStorages(context.Context, *v1alpha17.ListStoragesRequest) (*v1alpha17.ListStoragesResponse, error)
 	mustEmbedUnimplementedStorageServer()
 }
 
@@ -3042,16 +3481,16 @@ type StorageServer interface {
 // pointer dereference when methods are called.
 type UnimplementedStorageServer struct{}
 
-func (UnimplementedStorageServer) ListStorageClasses(context.Context, *v1alpha16.ListStorageClassesRequest) (*v1alpha16.ListStorageClassesResponse, error) {
+func (UnimplementedStorageServer) ListStorageClasses(context.Context, *v1alpha17.ListStorageClassesRequest) (*v1alpha17.ListStorageClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorageClasses not implemented")
 }
-func (UnimplementedStorageServer) CreateStorage(context.Context, *v1alpha16.CreateStorageRequest) (*v1alpha16.Storage, error) {
+func (UnimplementedStorageServer) CreateStorage(context.Context, *v1alpha17.CreateStorageRequest) (*v1alpha17.Storage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStorage not implemented")
 }
-func (UnimplementedStorageServer) DeleteStorage(context.Context, *v1alpha16.DeleteStorageRequest) (*emptypb.Empty, error) {
+func (UnimplementedStorageServer) DeleteStorage(context.Context, *v1alpha17.DeleteStorageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStorage not implemented")
 }
-func (UnimplementedStorageServer) ListStorages(context.Context, *v1alpha16.ListStoragesRequest) (*v1alpha16.ListStoragesResponse, error) {
+func (UnimplementedStorageServer) ListStorages(context.Context, *v1alpha17.ListStoragesRequest) (*v1alpha17.ListStoragesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorages not implemented")
 }
 func (UnimplementedStorageServer) mustEmbedUnimplementedStorageServer() {}
@@ -3076,7 +3515,7 @@ func RegisterStorageServer(s grpc.ServiceRegistrar, srv StorageServer) {
 }
 
 func _Storage_ListStorageClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha16.ListStorageClassesRequest)
+	in := new(v1alpha17.ListStorageClassesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3088,13 +3527,13 @@ func _Storage_ListStorageClasses_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: Storage_ListStorageClasses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServer).ListStorageClasses(ctx, req.(*v1alpha16.ListStorageClassesRequest))
+		return srv.(StorageServer).ListStorageClasses(ctx, req.(*v1alpha17.ListStorageClassesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Storage_CreateStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha16.CreateStorageRequest)
+	in := new(v1alpha17.CreateStorageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3106,13 +3545,13 @@ func _Storage_CreateStorage_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: Storage_CreateStorage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServer).CreateStorage(ctx, req.(*v1alpha16.CreateStorageRequest))
+		return srv.(StorageServer).CreateStorage(ctx, req.(*v1alpha17.CreateStorageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Storage_DeleteStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha16.DeleteStorageRequest)
+	in := new(v1alpha17.DeleteStorageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3124,13 +3563,13 @@ func _Storage_DeleteStorage_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: Storage_DeleteStorage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServer).DeleteStorage(ctx, req.(*v1alpha16.DeleteStorageRequest))
+		return srv.(StorageServer).DeleteStorage(ctx, req.(*v1alpha17.DeleteStorageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Storage_ListStorages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha16.ListStoragesRequest)
+	in := new(v1alpha17.ListStoragesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3142,7 +3581,7 @@ func _Storage_ListStorages_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: Storage_ListStorages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServer).ListStorages(ctx, req.(*v1alpha16.ListStoragesRequest))
+		return srv.(StorageServer).ListStorages(ctx, req.(*v1alpha17.ListStoragesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3185,9 +3624,9 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AcceleratorCardClient interface {
-	ListAcceleratorCard(ctx context.Context, in *v1alpha17.ListAcceleratorCardsRequest, opts ...grpc.CallOption) (*v1alpha17.ListAcceleratorCardsResponse, error)
-	GetAcceleratorCard(ctx context.Context, in *v1alpha17.GetAcceleratorCardRequest, opts ...grpc.CallOption) (*v1alpha17.AcceleratorCard, error)
-	ListModelNames(ctx context.Context, in *v1alpha17.ListModelNamesRequest, opts ...grpc.CallOption) (*v1alpha17.ListModelNamesResponse, error)
+	ListAcceleratorCard(ctx context.Context, in *v1alpha18.ListAcceleratorCardsRequest, opts ...grpc.CallOption) (*v1alpha18.ListAcceleratorCardsResponse, error)
+	GetAcceleratorCard(ctx context.Context, in *v1alpha18.GetAcceleratorCardRequest, opts ...grpc.CallOption) (*v1alpha18.AcceleratorCard, error)
+	ListModelNames(ctx context.Context, in *v1alpha18.ListModelNamesRequest, opts ...grpc.CallOption) (*v1alpha18.ListModelNamesResponse, error)
 }
 
 type acceleratorCardClient struct {
@@ -3198,9 +3637,9 @@ func NewAcceleratorCardClient(cc grpc.ClientConnInterface) AcceleratorCardClient
 	return &acceleratorCardClient{cc}
 }
 
-func (c *acceleratorCardClient) ListAcceleratorCard(ctx context.Context, in *v1alpha17.ListAcceleratorCardsRequest, opts ...grpc.CallOption) (*v1alpha17.ListAcceleratorCardsResponse, error) {
+func (c *acceleratorCardClient) ListAcceleratorCard(ctx context.Context, in *v1alpha18.ListAcceleratorCardsRequest, opts ...grpc.CallOption) (*v1alpha18.ListAcceleratorCardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha17.ListAcceleratorCardsResponse)
+	out := new(v1alpha18.ListAcceleratorCardsResponse)
 	err := c.cc.Invoke(ctx, AcceleratorCard_ListAcceleratorCard_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -3208,9 +3647,9 @@ func (c *acceleratorCardClient) ListAcceleratorCard(ctx context.Context, in *v1a
 	return out, nil
 }
 
-func (c *acceleratorCardClient) GetAcceleratorCard(ctx context.Context, in *v1alpha17.GetAcceleratorCardRequest, opts ...grpc.CallOption) (*v1alpha17.AcceleratorCard, error) {
+func (c *acceleratorCardClient) GetAcceleratorCard(ctx context.Context, in *v1alpha18.GetAcceleratorCardRequest, opts ...grpc.CallOption) (*v1alpha18.AcceleratorCard, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha17.AcceleratorCard)
+	out := new(v1alpha18.AcceleratorCard)
 	err := c.cc.Invoke(ctx, AcceleratorCard_GetAcceleratorCard_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -3218,9 +3657,9 @@ func (c *acceleratorCardClient) GetAcceleratorCard(ctx context.Context, in *v1al
 	return out, nil
 }
 
-func (c *acceleratorCardClient) ListModelNames(ctx context.Context, in *v1alpha17.ListModelNamesRequest, opts ...grpc.CallOption) (*v1alpha17.ListModelNamesResponse, error) {
+func (c *acceleratorCardClient) ListModelNames(ctx context.Context, in *v1alpha18.ListModelNamesRequest, opts ...grpc.CallOption) (*v1alpha18.ListModelNamesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(v1alpha17.ListModelNamesResponse)
+	out := new(v1alpha18.ListModelNamesResponse)
 	err := c.cc.Invoke(ctx, AcceleratorCard_ListModelNames_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -3232,9 +3671,9 @@ func (c *acceleratorCardClient) ListModelNames(ctx context.Context, in *v1alpha1
 // All implementations must embed UnimplementedAcceleratorCardServer
 // for forward compatibility.
 type AcceleratorCardServer interface {
-	ListAcceleratorCard(context.Context, *v1alpha17.ListAcceleratorCardsRequest) (*v1alpha17.ListAcceleratorCardsResponse, error)
-	GetAcceleratorCard(context.Context, *v1alpha17.GetAcceleratorCardRequest) (*v1alpha17.AcceleratorCard, error)
-	ListModelNames(context.Context, *v1alpha17.ListModelNamesRequest) (*v1alpha17.ListModelNamesResponse, error)
+	ListAcceleratorCard(context.Context, *v1alpha18.ListAcceleratorCardsRequest) (*v1alpha18.ListAcceleratorCardsResponse, error)
+	GetAcceleratorCard(context.Context, *v1alpha18.GetAcceleratorCardRequest) (*v1alpha18.AcceleratorCard, error)
+	ListModelNames(context.Context, *v1alpha18.ListModelNamesRequest) (*v1alpha18.ListModelNamesResponse, error)
 	mustEmbedUnimplementedAcceleratorCardServer()
 }
 
@@ -3245,13 +3684,13 @@ type AcceleratorCardServer interface {
 // pointer dereference when methods are called.
 type UnimplementedAcceleratorCardServer struct{}
 
-func (UnimplementedAcceleratorCardServer) ListAcceleratorCard(context.Context, *v1alpha17.ListAcceleratorCardsRequest) (*v1alpha17.ListAcceleratorCardsResponse, error) {
+func (UnimplementedAcceleratorCardServer) ListAcceleratorCard(context.Context, *v1alpha18.ListAcceleratorCardsRequest) (*v1alpha18.ListAcceleratorCardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAcceleratorCard not implemented")
 }
-func (UnimplementedAcceleratorCardServer) GetAcceleratorCard(context.Context, *v1alpha17.GetAcceleratorCardRequest) (*v1alpha17.AcceleratorCard, error) {
+func (UnimplementedAcceleratorCardServer) GetAcceleratorCard(context.Context, *v1alpha18.GetAcceleratorCardRequest) (*v1alpha18.AcceleratorCard, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAcceleratorCard not implemented")
 }
-func (UnimplementedAcceleratorCardServer) ListModelNames(context.Context, *v1alpha17.ListModelNamesRequest) (*v1alpha17.ListModelNamesResponse, error) {
+func (UnimplementedAcceleratorCardServer) ListModelNames(context.Context, *v1alpha18.ListModelNamesRequest) (*v1alpha18.ListModelNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListModelNames not implemented")
 }
 func (UnimplementedAcceleratorCardServer) mustEmbedUnimplementedAcceleratorCardServer() {}
@@ -3276,7 +3715,7 @@ func RegisterAcceleratorCardServer(s grpc.ServiceRegistrar, srv AcceleratorCardS
 }
 
 func _AcceleratorCard_ListAcceleratorCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha17.ListAcceleratorCardsRequest)
+	in := new(v1alpha18.ListAcceleratorCardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3288,13 +3727,13 @@ func _AcceleratorCard_ListAcceleratorCard_Handler(srv interface{}, ctx context.C
 		FullMethod: AcceleratorCard_ListAcceleratorCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AcceleratorCardServer).ListAcceleratorCard(ctx, req.(*v1alpha17.ListAcceleratorCardsRequest))
+		return srv.(AcceleratorCardServer).ListAcceleratorCard(ctx, req.(*v1alpha18.ListAcceleratorCardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AcceleratorCard_GetAcceleratorCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha17.GetAcceleratorCardRequest)
+	in := new(v1alpha18.GetAcceleratorCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3306,13 +3745,13 @@ func _AcceleratorCard_GetAcceleratorCard_Handler(srv interface{}, ctx context.Co
 		FullMethod: AcceleratorCard_GetAcceleratorCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AcceleratorCardServer).GetAcceleratorCard(ctx, req.(*v1alpha17.GetAcceleratorCardRequest))
+		return srv.(AcceleratorCardServer).GetAcceleratorCard(ctx, req.(*v1alpha18.GetAcceleratorCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AcceleratorCard_ListModelNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha17.ListModelNamesRequest)
+	in := new(v1alpha18.ListModelNamesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3324,7 +3763,7 @@ func _AcceleratorCard_ListModelNames_Handler(srv interface{}, ctx context.Contex
 		FullMethod: AcceleratorCard_ListModelNames_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AcceleratorCardServer).ListModelNames(ctx, req.(*v1alpha17.ListModelNamesRequest))
+		return srv.(AcceleratorCardServer).ListModelNames(ctx, req.(*v1alpha18.ListModelNamesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3352,3 +3791,357 @@ var AcceleratorCard_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/kantaloupe.proto",
 }
+
+const (
+	Audit_ListAuditEvents_FullMethodName = "/kantaloupev1.Audit/ListAuditEvents"
+)
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditClient interface {
+	// ListAuditEvents lists the audit events of the mutating calls.
+	ListAuditEvents(ctx context.Context, in *v1alpha19.ListAuditEventsRequest, opts ...grpc.CallOption) (*v1alpha19.ListAuditEventsResponse, error)
+}
+
+type auditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditClient(cc grpc.ClientConnInterface) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) ListAuditEvents(ctx context.Context, in *v1alpha19.ListAuditEventsRequest, opts ...grpc.CallOption) (*v1alpha19.ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha19.ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Audit_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+// All implementations must embed UnimplementedAuditServer
+// for forward compatibility.
+type AuditServer interface {
+	// ListAuditEvents lists the audit events of the mutating calls.
+	ListAuditEvents(context.Context, *v1alpha19.ListAuditEventsRequest) (*v1alpha19.ListAuditEventsResponse, error)
+	mustEmbedUnimplementedAuditServer()
+}
+
+// UnimplementedAuditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServer struct{}
+
+func (UnimplementedAuditServer) ListAuditEvents(context.Context, *v1alpha19.ListAuditEventsRequest) (*v1alpha19.ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAuditServer) mustEmbedUnimplementedAuditServer() {}
+func (UnimplementedAuditServer) testEmbeddedByValue()               {}
+
+// UnsafeAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServer will
+// result in compilation errors.
+type UnsafeAuditServer interface {
+	mustEmbedUnimplementedAuditServer()
+}
+
+func RegisterAuditServer(s grpc.ServiceRegistrar, srv AuditServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Audit_ServiceDesc, srv)
+}
+
+func _Audit_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha19.ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).ListAuditEvents(ctx, req.(*v1alpha19.ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Audit_ServiceDesc is the grpc.ServiceDesc for Audit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kantaloupev1.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Audit_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/kantaloupe.proto",
+}
+
+const (
+	Apply_Apply_FullMethodName = "/kantaloupev1.Apply/Apply"
+)
+
+// ApplyClient is the client API for Apply service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApplyClient interface {
+	// Apply applies a bundle of kantaloupe objects with the server-side apply of their fields.
+	Apply(ctx context.Context, in *v1alpha110.ApplyRequest, opts ...grpc.CallOption) (*v1alpha110.ApplyResponse, error)
+}
+
+type applyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApplyClient(cc grpc.ClientConnInterface) ApplyClient {
+	return &applyClient{cc}
+}
+
+func (c *applyClient) Apply(ctx context.Context, in *v1alpha110.ApplyRequest, opts ...grpc.CallOption) (*v1alpha110.ApplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha110.ApplyResponse)
+	err := c.cc.Invoke(ctx, Apply_Apply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplyServer is the server API for Apply service.
+// All implementations must embed UnimplementedApplyServer
+// for forward compatibility.
+type ApplyServer interface {
+	// Apply applies a bundle of kantaloupe objects with the server-side apply of their fields.
+	Apply(context.Context, *v1alpha110.ApplyRequest) (*v1alpha110.ApplyResponse, error)
+	mustEmbedUnimplementedApplyServer()
+}
+
+// UnimplementedApplyServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApplyServer struct{}
+
+func (UnimplementedApplyServer) Apply(context.Context, *v1alpha110.ApplyRequest) (*v1alpha110.ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (UnimplementedApplyServer) mustEmbedUnimplementedApplyServer() {}
+func (UnimplementedApplyServer) testEmbeddedByValue()               {}
+
+// UnsafeApplyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApplyServer will
+// result in compilation errors.
+type UnsafeApplyServer interface {
+	mustEmbedUnimplementedApplyServer()
+}
+
+func RegisterApplyServer(s grpc.ServiceRegistrar, srv ApplyServer) {
+	// If the following call pancis, it indicates UnimplementedApplyServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Apply_ServiceDesc, srv)
+}
+
+func _Apply_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha110.ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplyServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apply_Apply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplyServer).Apply(ctx, req.(*v1alpha110.ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Apply_ServiceDesc is the grpc.ServiceDesc for Apply service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Apply_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kantaloupev1.Apply",
+	HandlerType: (*ApplyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Apply",
+			Handler:    _Apply_Apply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/kantaloupe.proto",
+}
+
+const (
+	Operations_GetOperation_FullMethodName    = "/kantaloupev1.Operations/GetOperation"
+	Operations_CancelOperation_FullMethodName = "/kantaloupev1.Operations/CancelOperation"
+)
+
+// OperationsClient is the client API for Operations service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OperationsClient interface {
+	// GetOperation gets an operation started by the caller.
+	GetOperation(ctx context.Context, in *v1alpha11.GetOperationRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error)
+	// CancelOperation cancels an operation started by the caller, it returns the operation as
+	// it is if the operation is done.
+	CancelOperation(ctx context.Context, in *v1alpha11.CancelOperationRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error)
+}
+
+type operationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOperationsClient(cc grpc.ClientConnInterface) OperationsClient {
+	return &operationsClient{cc}
+}
+
+func (c *operationsClient) GetOperation(ctx context.Context, in *v1alpha11.GetOperationRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha11.Operation)
+	err := c.cc.Invoke(ctx, Operations_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationsClient) CancelOperation(ctx context.Context, in *v1alpha11.CancelOperationRequest, opts ...grpc.CallOption) (*v1alpha11.Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha11.Operation)
+	err := c.cc.Invoke(ctx, Operations_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperationsServer is the server API for Operations service.
+// All implementations must embed UnimplementedOperationsServer
+// for forward compatibility.
+type OperationsServer interface {
+	// GetOperation gets an operation started by the caller.
+	GetOperation(context.Context, *v1alpha11.GetOperationRequest) (*v1alpha11.Operation, error)
+	// CancelOperation cancels an operation started by the caller, it returns the operation as
+	// it is if the operation is done.
+	CancelOperation(context.Context, *v1alpha11.CancelOperationRequest) (*v1alpha11.Operation, error)
+	mustEmbedUnimplementedOperationsServer()
+}
+
+// UnimplementedOperationsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOperationsServer struct{}
+
+func (UnimplementedOperationsServer) GetOperation(context.Context, *v1alpha11.GetOperationRequest) (*v1alpha11.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedOperationsServer) CancelOperation(context.Context, *v1alpha11.CancelOperationRequest) (*v1alpha11.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedOperationsServer) mustEmbedUnimplementedOperationsServer() {}
+func (UnimplementedOperationsServer) testEmbeddedByValue()                    {}
+
+// UnsafeOperationsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OperationsServer will
+// result in compilation errors.
+type UnsafeOperationsServer interface {
+	mustEmbedUnimplementedOperationsServer()
+}
+
+func RegisterOperationsServer(s grpc.ServiceRegistrar, srv OperationsServer) {
+	// If the following call pancis, it indicates UnimplementedOperationsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Operations_ServiceDesc, srv)
+}
+
+func _Operations_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha11.GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operations_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).GetOperation(ctx, req.(*v1alpha11.GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operations_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha11.CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operations_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServer).CancelOperation(ctx, req.(*v1alpha11.CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Operations_ServiceDesc is the grpc.ServiceDesc for Operations service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Operations_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kantaloupev1.Operations",
+	HandlerType: (*OperationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOperation",
+			Handler:    _Operations_GetOperation_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Operations_CancelOperation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/kantaloupe.proto",
+}
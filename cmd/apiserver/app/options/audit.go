@@ -0,0 +1,80 @@
+package options
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+)
+
+// AuditOptions configures the audit of the mutating calls, the latest events are always
+// kept in memory to serve ListAuditEvents.
+type AuditOptions struct {
+	LogPath       string
+	LogMaxSize    int
+	LogMaxBackups int
+	LogMaxAge     int
+	LogCompress   bool
+
+	WebhookURL string
+
+	// StoreSize is the number of the latest events kept to serve the queries.
+	StoreSize int
+}
+
+func NewAuditOptions() *AuditOptions {
+	return &AuditOptions{
+		LogMaxSize:    100,
+		LogMaxBackups: 10,
+		LogMaxAge:     30,
+		StoreSize:     10000,
+	}
+}
+
+func (o *AuditOptions) AddFlags(fs *pflag.FlagSet, c *AuditOptions) {
+	fs.StringVar(&o.LogPath, "audit-log-path", c.LogPath, "The file the audit events are written to as JSON lines, empty disables it.")
+	fs.IntVar(&o.LogMaxSize, "audit-log-maxsize", c.LogMaxSize, "The maximum size in megabytes of the audit log file before it is rotated.")
+	fs.IntVar(&o.LogMaxBackups, "audit-log-maxbackup", c.LogMaxBackups, "The maximum number of the rotated audit log files to retain.")
+	fs.IntVar(&o.LogMaxAge, "audit-log-maxage", c.LogMaxAge, "The maximum number of days to retain the rotated audit log files.")
+	fs.BoolVar(&o.LogCompress, "audit-log-compress", c.LogCompress, "Compress the rotated audit log files with gzip.")
+	fs.StringVar(&o.WebhookURL, "audit-webhook-url", c.WebhookURL, "The URL the audit events are posted to in batches, empty disables it.")
+	fs.IntVar(&o.StoreSize, "audit-store-size", c.StoreSize, "The number of the latest audit events kept to serve the queries, 0 disables it.")
+}
+
+func (o *AuditOptions) Validate() []error {
+	var errList []error
+
+	if o.LogMaxSize < 0 || o.LogMaxBackups < 0 || o.LogMaxAge < 0 {
+		errList = append(errList, errors.New("--audit-log-maxsize, --audit-log-maxbackup and --audit-log-maxage can not be negative"))
+	}
+	if o.StoreSize < 0 {
+		errList = append(errList, errors.New("--audit-store-size can not be negative"))
+	}
+
+	return errList
+}
+
+// NewAuditor builds the auditor of the apiserver and the store serving the audit events.
+func (o *AuditOptions) NewAuditor() (*audit.Auditor, *audit.Store, error) {
+	store := audit.NewStore(o.StoreSize)
+	sinks := []audit.Sink{store}
+
+	if o.LogPath != "" {
+		if err := store.Load(o.LogPath); err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, audit.NewFileSink(audit.FileConfig{
+			Path:       o.LogPath,
+			MaxSize:    o.LogMaxSize,
+			MaxBackups: o.LogMaxBackups,
+			MaxAge:     o.LogMaxAge,
+			Compress:   o.LogCompress,
+		}))
+	}
+	if o.WebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(o.WebhookURL))
+	}
+
+	return audit.New(sinks...), store, nil
+}
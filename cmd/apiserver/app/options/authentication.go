@@ -0,0 +1,100 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+// AuthenticationOptions configures the authenticators of the apiserver, the requests
+// are served as the anonymous user if none of them is enabled.
+type AuthenticationOptions struct {
+	OIDCIssuerURL      string
+	OIDCClientID       string
+	OIDCUsernameClaim  string
+	OIDCUsernamePrefix string
+	OIDCGroupsClaim    string
+	OIDCGroupsPrefix   string
+	OIDCJWKSFile       string
+
+	TokenAuthFile string
+
+	// TokenReview enables authenticating the service account tokens and the other
+	// tokens known by the global cluster with the Kubernetes TokenReview API.
+	TokenReview bool
+
+	CacheTTL time.Duration
+
+	// Anonymous allows the requests without bearer token when authentication is enabled.
+	Anonymous bool
+}
+
+func NewAuthenticationOptions() *AuthenticationOptions {
+	return &AuthenticationOptions{
+		OIDCUsernameClaim: "sub",
+		CacheTTL:          10 * time.Second,
+	}
+}
+
+func (o *AuthenticationOptions) AddFlags(fs *pflag.FlagSet, c *AuthenticationOptions) {
+	fs.StringVar(&o.OIDCIssuerURL, "oidc-issuer-url", c.OIDCIssuerURL, "The URL of the OIDC issuer, OIDC authentication is enabled if it is set.")
+	fs.StringVar(&o.OIDCClientID, "oidc-client-id", c.OIDCClientID, "The client ID the OIDC tokens must be issued for.")
+	fs.StringVar(&o.OIDCUsernameClaim, "oidc-username-claim", c.OIDCUsernameClaim, "The OIDC claim used as the user name.")
+	fs.StringVar(&o.OIDCUsernamePrefix, "oidc-username-prefix", c.OIDCUsernamePrefix, "The prefix prepended to the OIDC user names.")
+	fs.StringVar(&o.OIDCGroupsClaim, "oidc-groups-claim", c.OIDCGroupsClaim, "The OIDC claim used as the user groups.")
+	fs.StringVar(&o.OIDCGroupsPrefix, "oidc-groups-prefix", c.OIDCGroupsPrefix, "The prefix prepended to the OIDC groups.")
+	fs.StringVar(&o.OIDCJWKSFile, "oidc-jwks-file", c.OIDCJWKSFile, "A local JWKS file to verify the OIDC tokens instead of the keys discovered from the issuer, mainly for testing.")
+	fs.StringVar(&o.TokenAuthFile, "token-auth-file", c.TokenAuthFile, "The static token file in the csv format of token,user,uid,\"group1,group2\".")
+	fs.BoolVar(&o.TokenReview, "authentication-token-review", c.TokenReview, "Authenticate the bearer tokens with the TokenReview API of the global cluster.")
+	fs.DurationVar(&o.CacheTTL, "authentication-token-cache-ttl", c.CacheTTL, "The duration to cache the authentication results, 0 disables the cache.")
+	fs.BoolVar(&o.Anonymous, "anonymous-auth", c.Anonymous, "Serve the requests without bearer token as the anonymous user when authentication is enabled.")
+}
+
+func (o *AuthenticationOptions) Validate() []error {
+	var errList []error
+
+	if o.OIDCIssuerURL != "" && o.OIDCClientID == "" {
+		errList = append(errList, errors.New("--oidc-client-id must be set with --oidc-issuer-url"))
+	}
+	if o.OIDCIssuerURL == "" && o.OIDCJWKSFile != "" {
+		errList = append(errList, errors.New("--oidc-jwks-file must be used with --oidc-issuer-url"))
+	}
+	if o.CacheTTL < 0 {
+		errList = append(errList, errors.New("--authentication-token-cache-ttl can not be negative"))
+	}
+
+	return errList
+}
+
+// NewAuthenticator builds the authenticator of the apiserver.
+func (o *AuthenticationOptions) NewAuthenticator(ctx context.Context) (*authentication.Authenticator, error) {
+	config := authentication.Config{
+		TokenFile: o.TokenAuthFile,
+		CacheTTL:  o.CacheTTL,
+		Anonymous: o.Anonymous,
+	}
+	if o.OIDCIssuerURL != "" {
+		config.OIDC = &authentication.OIDCConfig{
+			IssuerURL:      o.OIDCIssuerURL,
+			ClientID:       o.OIDCClientID,
+			UsernameClaim:  o.OIDCUsernameClaim,
+			UsernamePrefix: o.OIDCUsernamePrefix,
+			GroupsClaim:    o.OIDCGroupsClaim,
+			GroupsPrefix:   o.OIDCGroupsPrefix,
+			JWKSFile:       o.OIDCJWKSFile,
+		}
+	}
+	if o.TokenReview {
+		client, err := engine.NewClientManager().GeteClient(engine.LocalCluster)
+		if err != nil {
+			return nil, err
+		}
+		config.TokenReviewClient = client.AuthenticationV1()
+	}
+	return authentication.New(ctx, config)
+}
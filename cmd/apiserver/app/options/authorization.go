@@ -0,0 +1,70 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+// AuthorizationOptions configures the authorizer of the apiserver.
+type AuthorizationOptions struct {
+	// Mode is AlwaysAllow or RBAC, RBAC authorizes the requests with the roles granted
+	// by the Workspaces and RoleAssignments.
+	Mode string
+
+	PlatformAdminGroups []string
+
+	ResyncPeriod time.Duration
+}
+
+func NewAuthorizationOptions() *AuthorizationOptions {
+	return &AuthorizationOptions{
+		Mode:                authorization.ModeAlwaysAllow,
+		PlatformAdminGroups: []string{user.SystemPrivilegedGroup},
+		ResyncPeriod:        30 * time.Second,
+	}
+}
+
+func (o *AuthorizationOptions) AddFlags(fs *pflag.FlagSet, c *AuthorizationOptions) {
+	fs.StringVar(&o.Mode, "authorization-mode", c.Mode, "The authorization mode, one of AlwaysAllow and RBAC.")
+	fs.StringSliceVar(&o.PlatformAdminGroups, "platform-admin-groups", c.PlatformAdminGroups, "The groups whose users are platform admins without role assignment.")
+	fs.DurationVar(&o.ResyncPeriod, "authorization-resync-period", c.ResyncPeriod, "The period to reload the workspaces and role assignments.")
+}
+
+func (o *AuthorizationOptions) Validate() []error {
+	var errList []error
+
+	if o.Mode != authorization.ModeAlwaysAllow && o.Mode != authorization.ModeRBAC {
+		errList = append(errList, fmt.Errorf("--authorization-mode %q is invalid, must be %s or %s",
+			o.Mode, authorization.ModeAlwaysAllow, authorization.ModeRBAC))
+	}
+	if o.ResyncPeriod <= 0 {
+		errList = append(errList, errors.New("--authorization-resync-period must be positive"))
+	}
+
+	return errList
+}
+
+// NewAuthorizer builds the authorizer of the apiserver.
+func (o *AuthorizationOptions) NewAuthorizer(ctx context.Context) (*authorization.Authorizer, error) {
+	config := authorization.Config{
+		Mode:                o.Mode,
+		PlatformAdminGroups: o.PlatformAdminGroups,
+		ResyncPeriod:        o.ResyncPeriod,
+	}
+	if o.Mode == authorization.ModeRBAC {
+		client, err := engine.NewClientManager().GeteClient(engine.LocalCluster)
+		if err != nil {
+			return nil, err
+		}
+		config.Client = client
+	}
+	return authorization.New(ctx, config)
+}
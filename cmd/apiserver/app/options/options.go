@@ -2,10 +2,13 @@ package options
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,12 +17,25 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/soheilhy/cmux"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 
 	"github.com/dynamia-ai/kantaloupe/pkg/apiserver"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/idempotency"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/ratelimit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+	"github.com/dynamia-ai/kantaloupe/pkg/config"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/profileflag"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/errs"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 type ServerRunOptions struct {
@@ -38,8 +54,27 @@ type ServerRunOptions struct {
 	// tls private key file
 	TLSPrivateKey string
 
+	// client CA file verifying the client certificates of the secure port
+	ClientCAFile string
+
 	// insecure grpc port number
 	InsecureGRPCPort int
+
+	// UserQPS and UserBurst limit the requests of each user, 0 QPS disables it
+	UserQPS   float64
+	UserBurst int
+
+	// MethodRateLimits limit the requests to the RPCs across the users, in the form of method=qps[:burst]
+	MethodRateLimits []string
+
+	// ClusterQPS, ClusterBurst and ClusterMaxInflightRequests limit the requests to each cluster
+	ClusterQPS                 float32
+	ClusterBurst               int
+	ClusterMaxInflightRequests int
+
+	// ShutdownDelay and ShutdownTimeout control the drain of the requests on shutdown
+	ShutdownDelay   time.Duration
+	ShutdownTimeout time.Duration
 }
 
 type PrometheusOptions struct {
@@ -67,25 +102,50 @@ func NewServerRunOptions() *ServerRunOptions {
 		SecurePort:       0,
 		TLSCertFile:      "",
 		TLSPrivateKey:    "",
+		ClusterQPS:       50,
+		ClusterBurst:     100,
+		ShutdownTimeout:  25 * time.Second,
 	}
 
 	return &s
 }
 
+// ReloadableFields are the flags and the environment variables of the configuration file
+// reloaded at runtime.
+var ReloadableFields = []string{
+	config.LogLevelFlag,
+	"rate-limit-user-qps",
+	"rate-limit-user-burst",
+	"rate-limit-methods",
+	constants.EnvLibCudaLogLevel,
+}
+
 type Options struct {
-	ConfigFile        string
-	ServerRunOptions  *ServerRunOptions
-	PrometheusOptions *PrometheusOptions
+	// ConfigFile is the path of the configuration file, see config.Configuration.
+	ConfigFile            string
+	ServerRunOptions      *ServerRunOptions
+	PrometheusOptions     *PrometheusOptions
+	AuthenticationOptions *AuthenticationOptions
+	AuthorizationOptions  *AuthorizationOptions
+	AuditOptions          *AuditOptions
+	TerminalOptions       *TerminalOptions
 	// Debug indicates kantaloupe apiserver mode is debug.
 	Debug bool
+	// Impersonate makes the requests to the clusters impersonate the authenticated users.
+	Impersonate bool
 
 	ProfileOpts profileflag.Options
+	TracingOpts tracing.Options
 }
 
 func NewAPIServerRunOptions() *Options {
 	return &Options{
-		ServerRunOptions:  NewServerRunOptions(),
-		PrometheusOptions: NewPrometheusOptions(),
+		ServerRunOptions:      NewServerRunOptions(),
+		PrometheusOptions:     NewPrometheusOptions(),
+		AuthenticationOptions: NewAuthenticationOptions(),
+		AuthorizationOptions:  NewAuthorizationOptions(),
+		AuditOptions:          NewAuditOptions(),
+		TerminalOptions:       NewTerminalOptions(),
 	}
 }
 
@@ -96,15 +156,78 @@ func (s *ServerRunOptions) AddFlags(fs *pflag.FlagSet, c *ServerRunOptions) {
 	fs.IntVar(&s.InsecureGRPCPort, "insecure-grpc-port", s.InsecureGRPCPort, "insecure grpc port number")
 	fs.StringVar(&s.TLSCertFile, "tls-cert-file", c.TLSCertFile, "tls cert file")
 	fs.StringVar(&s.TLSPrivateKey, "tls-private-key", c.TLSPrivateKey, "tls private key")
+	fs.StringVar(&s.ClientCAFile, "client-ca-file", c.ClientCAFile, "If set, the client certificates of the secure port signed by "+
+		"the CA bundle are authenticated with their common name as the user and organizations as the groups. "+
+		"The certificate and key files are reloaded when they change on disk.")
+	fs.Float64Var(&s.UserQPS, "rate-limit-user-qps", c.UserQPS, "The requests per second allowed for each user, "+
+		"the unauthenticated requests are limited by their client addresses. 0 disables it.")
+	fs.IntVar(&s.UserBurst, "rate-limit-user-burst", c.UserBurst, "The burst of the requests allowed for each user, "+
+		"it defaults to the QPS if it is not positive.")
+	fs.StringSliceVar(&s.MethodRateLimits, "rate-limit-methods", c.MethodRateLimits, "The rate limits of the RPCs across the users "+
+		"in the form of method=qps[:burst], the method is the full method name or the method name, e.g. ListClusters=10:20.")
+	fs.Float32Var(&s.ClusterQPS, "cluster-api-qps", c.ClusterQPS, "The requests per second to the API server of each cluster.")
+	fs.IntVar(&s.ClusterBurst, "cluster-api-burst", c.ClusterBurst, "The burst of the requests to the API server of each cluster.")
+	fs.IntVar(&s.ClusterMaxInflightRequests, "cluster-max-inflight-requests", c.ClusterMaxInflightRequests, "The maximum concurrent "+
+		"requests to the API server of each cluster, the watches, log follows and exec streams are not counted. 0 is unlimited.")
+	fs.DurationVar(&s.ShutdownDelay, "shutdown-delay-duration", c.ShutdownDelay, "The duration the readiness fails before the "+
+		"server stops accepting the connections on shutdown, so the load balancers stop sending the requests first.")
+	fs.DurationVar(&s.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "The duration the in-flight requests and streams "+
+		"are given to finish on shutdown, the remaining ones are closed after it.")
+}
+
+// RateLimitConfig returns the config of the rate limits of the RPCs.
+func (s *ServerRunOptions) RateLimitConfig() (ratelimit.Config, error) {
+	config := ratelimit.Config{
+		User:    ratelimit.Limit{QPS: s.UserQPS, Burst: burstOf(s.UserQPS, s.UserBurst)},
+		Methods: make(map[string]ratelimit.Limit, len(s.MethodRateLimits)),
+	}
+	for _, value := range s.MethodRateLimits {
+		method, limit, ok := strings.Cut(value, "=")
+		if !ok || method == "" {
+			return config, fmt.Errorf("%w: %q", errs.ErrInvalidMethodRateLimit, value)
+		}
+		qps, burst, _ := strings.Cut(limit, ":")
+		l := ratelimit.Limit{}
+		var err error
+		if l.QPS, err = strconv.ParseFloat(qps, 64); err != nil || l.QPS <= 0 {
+			return config, fmt.Errorf("%w: %q", errs.ErrInvalidMethodRateLimit, value)
+		}
+		if burst != "" {
+			if l.Burst, err = strconv.Atoi(burst); err != nil || l.Burst <= 0 {
+				return config, fmt.Errorf("%w: %q", errs.ErrInvalidMethodRateLimit, value)
+			}
+		}
+		l.Burst = burstOf(l.QPS, l.Burst)
+		config.Methods[method] = l
+	}
+	return config, nil
+}
+
+// burstOf returns the burst, it is the QPS rounded up if it is not positive.
+func burstOf(qps float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	return int(math.Ceil(qps))
 }
 
 func (o *Options) Flags() cliflag.NamedFlagSets {
 	fss := cliflag.NamedFlagSets{}
 	fs := fss.FlagSet("generic")
+	fs.StringVar(&o.ConfigFile, config.ConfigFlag, o.ConfigFile, "The versioned YAML configuration file of the flags and the environment "+
+		"variables, the flags set on the command line take precedence over it. The log level and the rate limits of the users and "+
+		"the methods are reloaded when it changes, the other changes take effect after restart.")
 	fs.BoolVar(&o.Debug, "debug", false, "apiserver server mode")
+	fs.BoolVar(&o.Impersonate, "impersonate-users", false, "Impersonate the authenticated users in the requests to the clusters, "+
+		"so the RBAC of the clusters is enforced. The credentials of the clusters must be allowed to impersonate users and groups.")
 	o.ServerRunOptions.AddFlags(fs, o.ServerRunOptions)
 	o.PrometheusOptions.AddFlags(fs, o.PrometheusOptions)
+	o.AuthenticationOptions.AddFlags(fss.FlagSet("authentication"), o.AuthenticationOptions)
+	o.AuthorizationOptions.AddFlags(fss.FlagSet("authorization"), o.AuthorizationOptions)
+	o.AuditOptions.AddFlags(fss.FlagSet("audit"), o.AuditOptions)
+	o.TerminalOptions.AddFlags(fss.FlagSet("terminal"), o.TerminalOptions)
 	o.ProfileOpts.AddFlags(fss.FlagSet("profile"))
+	o.TracingOpts.AddFlags(fss.FlagSet("tracing"))
 	fs = fss.FlagSet("klog")
 	local := flag.NewFlagSet("klog", flag.ExitOnError)
 	klog.InitFlags(local)
@@ -116,33 +239,124 @@ func (o *Options) Flags() cliflag.NamedFlagSets {
 	return fss
 }
 
-func (o *Options) NewAPIServer(_ context.Context) (*apiserver.APIServer, error) {
-	apiServer := &apiserver.APIServer{
-		Debug:          o.Debug,
-		PrometheusAddr: o.PrometheusOptions.Addr,
+// Reload validates the options reloaded from the configuration file and applies the rate
+// limits to the apiserver.
+func (o *Options) Reload(s *apiserver.APIServer) error {
+	if errs := o.Validate(); len(errs) != 0 {
+		return utilerrors.NewAggregate(errs)
 	}
+	rateLimitConfig, err := o.ServerRunOptions.RateLimitConfig()
+	if err != nil {
+		return err
+	}
+	s.RateLimiter.Update(rateLimitConfig)
+	return nil
+}
 
-	// Create the main listener.
-	address := fmt.Sprintf("%s:%d", o.ServerRunOptions.BindAddress, o.ServerRunOptions.InsecurePort)
-	l, err := net.Listen("tcp", address)
+func (o *Options) NewAPIServer(ctx context.Context) (*apiserver.APIServer, error) {
+	// the client manager is a singleton, configure it before anything uses it.
+	engine.NewClientManager(
+		engine.WithImpersonation(o.Impersonate),
+		engine.WithQPS(o.ServerRunOptions.ClusterQPS),
+		engine.WithBurst(o.ServerRunOptions.ClusterBurst),
+		engine.WithMaxInflightRequests(o.ServerRunOptions.ClusterMaxInflightRequests),
+	)
+	metrics.RawMustRegister(metrics.RateLimitCollectors()...)
+	metrics.RawMustRegister(metrics.APIServerCollectors()...)
+
+	authenticator, err := o.AuthenticationOptions.NewAuthenticator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authorizer, err := o.AuthorizationOptions.NewAuthorizer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auditor, auditStore, err := o.AuditOptions.NewAuditor()
+	if err != nil {
+		return nil, err
+	}
+	rateLimitConfig, err := o.ServerRunOptions.RateLimitConfig()
 	if err != nil {
 		return nil, err
 	}
-	// Create a cmux.
-	apiServer.CMux = cmux.New(l)
+	limiter := ratelimit.New(rateLimitConfig)
+	idempotencyCache := idempotency.New(idempotency.DefaultTTL, idempotency.DefaultCapacity)
+
+	apiServer := &apiserver.APIServer{
+		Debug:           o.Debug,
+		PrometheusAddr:  o.PrometheusOptions.Addr,
+		Authenticator:   authenticator,
+		Authorizer:      authorizer,
+		Auditor:         auditor,
+		AuditStore:      auditStore,
+		TerminalConfig:  o.TerminalOptions.Config(),
+		RateLimiter:     limiter,
+		Idempotency:     idempotencyCache,
+		ShutdownDelay:   o.ServerRunOptions.ShutdownDelay,
+		ShutdownTimeout: o.ServerRunOptions.ShutdownTimeout,
+	}
+
+	if o.ServerRunOptions.InsecurePort != 0 {
+		address := net.JoinHostPort(o.ServerRunOptions.BindAddress, strconv.Itoa(o.ServerRunOptions.InsecurePort))
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+		apiServer.CMux = cmux.New(l)
+	}
+
+	if o.ServerRunOptions.SecurePort != 0 {
+		tlsConfig, err := transport.NewTLSConfig(ctx, transport.TLSConfig{
+			CertFile:     o.ServerRunOptions.TLSCertFile,
+			KeyFile:      o.ServerRunOptions.TLSPrivateKey,
+			ClientCAFile: o.ServerRunOptions.ClientCAFile,
+		})
+		if err != nil {
+			return nil, err
+		}
+		address := net.JoinHostPort(o.ServerRunOptions.BindAddress, strconv.Itoa(o.ServerRunOptions.SecurePort))
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+		// the TLS connections are terminated before cmux so it can match the protocols.
+		apiServer.SecureCMux = cmux.New(tls.NewListener(l, tlsConfig))
+	}
+
+	// the grpc-gateway forwards the requests to the gRPC server in process.
+	apiServer.InternalListener = transport.NewInternalListener()
 
 	// Create your protocol servers.
 	apiServer.Server = &http.Server{
-		Addr:              address,
 		ReadHeaderTimeout: 60 * time.Second,
+		ConnContext:       transport.ConnContext,
 	}
 
 	apiServer.GrpcServer = grpc.NewServer(
+		grpc.Creds(transport.ServerCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		// the outermost error interceptors give the errors of the interceptors their details, the
+		// innermost ones map the errors of the handlers before they are measured and audited.
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			apierrors.StreamServerInterceptor(),
+			metrics.StreamServerInterceptor(),
 			grpcrecovery.StreamServerInterceptor(),
+			authenticator.StreamServerInterceptor(),
+			limiter.StreamServerInterceptor(),
+			authorizer.StreamServerInterceptor(),
+			apierrors.StreamServerInterceptor(),
 		)),
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			apierrors.UnaryServerInterceptor(),
+			metrics.UnaryServerInterceptor(),
 			grpcrecovery.UnaryServerInterceptor(),
+			authenticator.UnaryServerInterceptor(),
+			limiter.UnaryServerInterceptor(),
+			auditor.UnaryServerInterceptor(),
+			authorizer.UnaryServerInterceptor(),
+			idempotencyCache.UnaryServerInterceptor(),
+			apierrors.UnaryServerInterceptor(),
 		)))
 
 	marshaler := &runtime.JSONPb{}
@@ -150,6 +364,13 @@ func (o *Options) NewAPIServer(_ context.Context) (*apiserver.APIServer, error)
 	marshaler.EmitUnpopulated = true
 	apiServer.GatewayServerMux = runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaler),
+		runtime.WithMarshalerOption(apiserver.EventStreamContentType, &apiserver.EventStreamMarshaler{Marshaler: marshaler}),
+		runtime.WithMetadata(authentication.GatewayMetadata),
+		runtime.WithIncomingHeaderMatcher(ratelimit.IncomingHeaderMatcher(
+			idempotency.HeaderMatcher(apiserver.EventStreamHeaderMatcher(authentication.IncomingHeaderMatcher)))),
+		runtime.WithOutgoingHeaderMatcher(ratelimit.OutgoingHeaderMatcher),
+		runtime.WithMiddlewares(apiserver.GatewayTracingMiddleware),
+		runtime.WithErrorHandler(apierrors.HTTPErrorHandler),
 	)
 
 	return apiServer, nil
@@ -0,0 +1,46 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/terminal"
+)
+
+// TerminalOptions configures the sessions of the web terminals of the kantaloupeflows.
+type TerminalOptions struct {
+	IdleTimeout        time.Duration
+	MaxSessionDuration time.Duration
+}
+
+func NewTerminalOptions() *TerminalOptions {
+	return &TerminalOptions{
+		IdleTimeout:        15 * time.Minute,
+		MaxSessionDuration: 4 * time.Hour,
+	}
+}
+
+func (o *TerminalOptions) AddFlags(fs *pflag.FlagSet, c *TerminalOptions) {
+	fs.DurationVar(&o.IdleTimeout, "terminal-idle-timeout", c.IdleTimeout, "The web terminal sessions receiving no input for the duration are closed, 0 disables it.")
+	fs.DurationVar(&o.MaxSessionDuration, "terminal-max-session-duration", c.MaxSessionDuration, "The web terminal sessions are closed after the duration, 0 disables it.")
+}
+
+func (o *TerminalOptions) Validate() []error {
+	var errList []error
+
+	if o.IdleTimeout < 0 || o.MaxSessionDuration < 0 {
+		errList = append(errList, errors.New("--terminal-idle-timeout and --terminal-max-session-duration can not be negative"))
+	}
+
+	return errList
+}
+
+// Config returns the config of the web terminals.
+func (o *TerminalOptions) Config() terminal.Config {
+	return terminal.Config{
+		IdleTimeout:        o.IdleTimeout,
+		MaxSessionDuration: o.MaxSessionDuration,
+	}
+}
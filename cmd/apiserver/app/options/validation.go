@@ -10,6 +10,11 @@ func (o *Options) Validate() []error {
 	var errors []error
 
 	errors = append(errors, o.ServerRunOptions.Validate()...)
+	errors = append(errors, o.AuthenticationOptions.Validate()...)
+	errors = append(errors, o.AuthorizationOptions.Validate()...)
+	errors = append(errors, o.AuditOptions.Validate()...)
+	errors = append(errors, o.TerminalOptions.Validate()...)
+	errors = append(errors, o.TracingOpts.Validate()...)
 
 	return errors
 }
@@ -21,5 +26,25 @@ func (s *ServerRunOptions) Validate() []error {
 		errList = append(errList, errs.ErrHTTPServerPortAllDisabled)
 	}
 
+	if s.SecurePort != 0 && (s.TLSCertFile == "" || s.TLSPrivateKey == "") {
+		errList = append(errList, errs.ErrTLSCertificateRequired)
+	}
+
+	if s.ClientCAFile != "" && s.SecurePort == 0 {
+		errList = append(errList, errs.ErrClientCAWithoutSecurePort)
+	}
+
+	if s.UserQPS < 0 || s.UserBurst < 0 || s.ClusterQPS < 0 || s.ClusterBurst < 0 || s.ClusterMaxInflightRequests < 0 {
+		errList = append(errList, errs.ErrNegativeRateLimit)
+	}
+
+	if s.ShutdownDelay < 0 || s.ShutdownTimeout < 0 {
+		errList = append(errList, errs.ErrNegativeShutdownDuration)
+	}
+
+	if _, err := s.RateLimitConfig(); err != nil {
+		errList = append(errList, err)
+	}
+
 	return errList
 }
@@ -9,6 +9,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/dynamia-ai/kantaloupe/cmd/apiserver/app/options"
+	"github.com/dynamia-ai/kantaloupe/pkg/config"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 	"github.com/dynamia-ai/kantaloupe/pkg/version"
 )
 
@@ -19,11 +21,15 @@ func NewAPIServerCommand(ctx context.Context) *cobra.Command {
 		Long: `The kantaloupe API server.`,
 		RunE: func(c *cobra.Command, _ []string) error { //nolint:contextcheck
 			klog.V(2).InfoS("Running kantaloupe-apiserver")
+			loader := config.NewLoader(c.Flags(), s.ConfigFile, config.KindAPIServer, options.ReloadableFields...)
+			if err := loader.Load(); err != nil {
+				return err
+			}
 			if errs := s.Validate(); len(errs) != 0 {
 				return utilerrors.NewAggregate(errs)
 			}
 
-			return Run(c.Context(), s)
+			return Run(c.Context(), s, loader)
 		},
 		SilenceUsage: true,
 	}
@@ -43,10 +49,11 @@ func NewAPIServerCommand(ctx context.Context) *cobra.Command {
 	}
 
 	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(config.NewCommand(config.KindAPIServer, namedFlagSets, &s.ConfigFile))
 	return cmd
 }
 
-func Run(ctx context.Context, opt *options.Options) error {
+func Run(ctx context.Context, opt *options.Options, loader *config.Loader) error {
 	// To help debugging, immediately log version
 	klog.Infof("Version: %+v", version.Get())
 	klog.InfoS("Golang settings",
@@ -54,6 +61,12 @@ func Run(ctx context.Context, opt *options.Options) error {
 		"GOMAXPROCS", os.Getenv("GOMAXPROCS"),
 		"GOTRACEBACK", os.Getenv("GOTRACEBACK"))
 
+	shutdownTracing, err := tracing.Setup(ctx, opt.TracingOpts, "kantaloupe-apiserver")
+	if err != nil {
+		return err
+	}
+	defer tracing.Shutdown(shutdownTracing)
+
 	apiserver, err := opt.NewAPIServer(ctx)
 	if err != nil {
 		return err
@@ -62,5 +75,6 @@ func Run(ctx context.Context, opt *options.Options) error {
 	if err = apiserver.PrepareRun(ctx); err != nil {
 		return err
 	}
+	go loader.Run(ctx, func() error { return opt.Reload(apiserver) })
 	return apiserver.Run(ctx)
 }
@@ -17,12 +17,14 @@ import (
 
 	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/cmd/controller-manager/app/options"
+	"github.com/dynamia-ai/kantaloupe/pkg/config"
 	"github.com/dynamia-ai/kantaloupe/pkg/controllers/cluster"
 	controllerscontext "github.com/dynamia-ai/kantaloupe/pkg/controllers/context"
 	multicontrollers "github.com/dynamia-ai/kantaloupe/pkg/controllers/multicontrollers/controllermanager"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/gclient"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/informermanager"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 	"github.com/dynamia-ai/kantaloupe/pkg/version"
 )
 
@@ -33,11 +35,18 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		Use:  "kantaloupe-controller-manager",
 		Long: `The kantaloupe controller manager runs a bunch of controllers`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			loader := config.NewLoader(cmd.Flags(), opts.ConfigFile, config.KindControllerManager, options.ReloadableFields...)
+			if err := loader.Load(); err != nil {
+				return err
+			}
 			cliflag.PrintFlags(cmd.Flags())
 			// validate options
 			if errs := opts.Validate(); len(errs) != 0 {
 				return errs.ToAggregate()
 			}
+			go loader.Run(ctx, func() error {
+				return opts.Validate().ToAggregate()
+			})
 			return Run(ctx, opts)
 		},
 	}
@@ -59,12 +68,19 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		fs.AddFlagSet(f)
 	}
 	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(config.NewCommand(config.KindControllerManager, namedFlagSets, &opts.ConfigFile))
 	return cmd
 }
 
 // Run runs the controller-manager with options. This should never exit.
 func Run(ctx context.Context, opts *options.Options) error {
 	klog.InfoS("Starting kantaloupe-controller-manager", "version", version.Get())
+	shutdownTracing, err := tracing.Setup(ctx, opts.TracingOpts, "kantaloupe-controller-manager")
+	if err != nil {
+		return err
+	}
+	defer tracing.Shutdown(shutdownTracing)
+
 	config := controllerruntime.GetConfigOrDie()
 	config.QPS, config.Burst = opts.ClusterAPIQPS, opts.ClusterAPIBurst
 	controllerOptions := controllerruntime.Options{
@@ -149,6 +165,7 @@ func startClusterController(ctx controllerscontext.Context) (bool, error) {
 		ConcurrentWorkSyncs:          opts.ConcurrentWorkSyncs,
 		ClusterSuccessThreshold:      opts.ClusterSuccessThreshold,
 		ClusterFailureThreshold:      opts.ClusterFailureThreshold,
+		ClusterCleanupTimeout:        opts.ClusterCleanupTimeout,
 		ClusterDebugMode:             opts.DebugMode,
 	}
 	if err := controller.SetupWithManager(ctx.Mgr); err != nil {
@@ -189,6 +206,7 @@ func setupControllers(ctx context.Context,
 			ClusterStatusUpdateFrequency: opts.ClusterStatusUpdateFrequency,
 			ClusterSuccessThreshold:      opts.ClusterSuccessThreshold,
 			ClusterFailureThreshold:      opts.ClusterFailureThreshold,
+			ClusterCleanupTimeout:        opts.ClusterCleanupTimeout,
 			ClusterAPIQPS:                opts.ClusterAPIQPS,
 			ClusterAPIBurst:              opts.ClusterAPIBurst,
 			ConcurrentWorkSyncs:          opts.ConcurrentWorkSyncs,
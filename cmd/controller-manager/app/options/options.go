@@ -13,11 +13,23 @@ import (
 	componentbaseconfig "k8s.io/component-base/config"
 	"k8s.io/klog/v2"
 
+	"github.com/dynamia-ai/kantaloupe/pkg/config"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	namespaceutil "github.com/dynamia-ai/kantaloupe/pkg/utils/namespace"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
+// ReloadableFields are the flags and the environment variables of the configuration file
+// reloaded at runtime.
+var ReloadableFields = []string{
+	config.LogLevelFlag,
+	constants.CleanupInactiveWorkloadThreshold,
+}
+
 // Options contains everything necessary to create and run controller-manager.
 type Options struct {
+	// ConfigFile is the path of the configuration file, see config.Configuration.
+	ConfigFile string
 	// Controllers is the list of controllers to enable or disable
 	// '*' means "all enabled by default controllers"
 	// 'foo' means "enable 'foo'"
@@ -42,6 +54,9 @@ type Options struct {
 	ClusterSuccessThreshold metav1.Duration
 	// ClusterFailureThreshold is the duration of failure for the cluster to be considered unhealthy.
 	ClusterFailureThreshold metav1.Duration
+	// ClusterCleanupTimeout is the duration to wait for the cleanup of an unreachable cluster
+	// being deleted, the cluster is removed forcibly after the timeout.
+	ClusterCleanupTimeout metav1.Duration
 	// ClusterStatusUpdateFrequency is the frequency that controller computes and report cluster status.
 	// It must work with ClusterMonitorGracePeriod(--cluster-monitor-grace-period) in karmada-controller-manager.
 	ClusterStatusUpdateFrequency metav1.Duration
@@ -59,6 +74,8 @@ type Options struct {
 	ClusterAPIBurst int
 	// Controllermanger in debug mode will using out of cluster kube-apiserver.
 	DebugMode bool
+	// TracingOpts configures the tracing of the reconciles.
+	TracingOpts tracing.Options
 }
 
 // NewOptions builds an empty options.
@@ -75,6 +92,9 @@ func NewOptions() *Options {
 
 // AddFlags adds flags to the specified FlagSet.
 func (o *Options) AddFlags(flags *pflag.FlagSet, allControllers, allMultiControllers, disabledByDefaultControllers []string) {
+	flags.StringVar(&o.ConfigFile, config.ConfigFlag, "", "The versioned YAML configuration file of the flags and the environment "+
+		"variables, the flags set on the command line take precedence over it. The log level and the cleanup threshold of the "+
+		"inactive workloads are reloaded when it changes, the other changes take effect after restart.")
 	flags.StringSliceVar(&o.Controllers,
 		"controllers", []string{"*"}, fmt.Sprintf(
 			"A list of controllers to enable. '*' enables all on-by-default controllers, "+
@@ -113,6 +133,9 @@ func (o *Options) AddFlags(flags *pflag.FlagSet, allControllers, allMultiControl
 	flags.DurationVar(&o.ClusterFailureThreshold.Duration,
 		"cluster-failure-threshold", 30*time.Second,
 		"The duration of failure for the cluster to be considered unhealthy.")
+	flags.DurationVar(&o.ClusterCleanupTimeout.Duration,
+		"cluster-cleanup-timeout", 10*time.Minute,
+		"The duration to wait for the cleanup of a cluster being deleted, the cluster is removed forcibly after the timeout.")
 	flags.IntVar(&o.ConcurrentWorkSyncs,
 		"concurrent-work-syncs", 5,
 		"The number of Works that are allowed to sync concurrently.")
@@ -131,6 +154,7 @@ func (o *Options) Flags() cliflag.NamedFlagSets {
 	fss := cliflag.NamedFlagSets{}
 	fs := fss.FlagSet("generic")
 	o.AddFlags(fs, o.Controllers, o.MultiControllers, o.DefaultDisabledControllers)
+	o.TracingOpts.AddFlags(fss.FlagSet("tracing"))
 
 	fs = fss.FlagSet("klog")
 	local := flag.NewFlagSet("klog", flag.ExitOnError)
@@ -13,6 +13,9 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("ClusterStatusUpdateFrequency"),
 			o.ClusterStatusUpdateFrequency, "must be greater than 0"))
 	}
+	for _, err := range o.TracingOpts.Validate() {
+		errs = append(errs, field.Invalid(newPath.Child("TracingOpts"), o.TracingOpts, err.Error()))
+	}
 
 	return errs
 }
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	applyv1alpha1 "github.com/dynamia-ai/kantaloupe/api/apply/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newApplyCommand(o *globalOptions) *cobra.Command {
+	var filename, output string
+	req := &applyv1alpha1.ApplyRequest{}
+	cmd := &cobra.Command{
+		Use:   "apply -f FILE",
+		Short: "Apply a bundle of the kantaloupe objects",
+		Long: "Apply a multi-document YAML bundle of the clusters, the credential references, the quotas, the " +
+			"storages and the kantaloupeflows, - reads the bundle from the standard input. The objects applied " +
+			"before but missing in the bundle are deleted with --prune.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			if req.Manifests, err = readManifests(cmd, filename); err != nil {
+				return err
+			}
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			resp, err := client.Apply.Apply(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			if err := printer.PrintObject(resp, func() *kantaloupectl.Table {
+				return applyTable(resp.GetResults())
+			}); err != nil {
+				return err
+			}
+			failed := 0
+			for _, result := range resp.GetResults() {
+				if output == kantaloupectl.OutputTable && req.GetDryRun() && result.GetDiff() != "" {
+					fmt.Fprint(cmd.OutOrStdout(), result.GetDiff())
+				}
+				if result.GetError() != "" {
+					failed++
+					fmt.Fprintf(cmd.ErrOrStderr(), "%s %s: %s\n", result.GetKind(), resultPath(result), result.GetError())
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d objects failed to apply", failed, len(resp.GetResults()))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "The file of the bundle, - reads it from the standard input.")
+	cmd.Flags().StringVar(&req.FieldManager, "field-manager", "", "The field manager owning the applied fields, the objects are pruned by it.")
+	cmd.Flags().BoolVar(&req.DryRun, "dry-run", false, "Print the changes without persisting them.")
+	cmd.Flags().BoolVar(&req.Prune, "prune", false, "Delete the objects applied by the field manager but missing in the bundle.")
+	cmd.Flags().BoolVar(&req.Force, "force-conflicts", false, "Take the ownership of the fields owned by the other managers.")
+	_ = cmd.MarkFlagRequired("filename")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+// readManifests reads the bundle from the file or the standard input of the command.
+func readManifests(cmd *cobra.Command, filename string) (string, error) {
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", errors.New("the bundle is empty")
+	}
+	return string(data), nil
+}
+
+func applyTable(results []*applyv1alpha1.ApplyResult) *kantaloupectl.Table {
+	table := &kantaloupectl.Table{Headers: []string{"KIND", "CLUSTER", "NAMESPACE", "NAME", "ACTION"}}
+	for _, result := range results {
+		action := "failed"
+		if result.GetError() == "" {
+			action = strings.ToLower(result.GetAction().String())
+		}
+		table.AddRow(result.GetKind(), kantaloupectl.ValueOrNone(result.GetCluster()),
+			kantaloupectl.ValueOrNone(result.GetNamespace()), result.GetName(), action)
+	}
+	return table
+}
+
+func resultPath(result *applyv1alpha1.ApplyResult) string {
+	var path []string
+	for _, s := range []string{result.GetCluster(), result.GetNamespace(), result.GetName()} {
+		if s != "" {
+			path = append(path, s)
+		}
+	}
+	return strings.Join(path, "/")
+}
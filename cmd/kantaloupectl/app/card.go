@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	acceleratorcardv1alpha1 "github.com/dynamia-ai/kantaloupe/api/acceleratorcard/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+// mebibyte is the unit of the memory of the accelerator cards.
+const mebibyte = 1 << 20
+
+func newCardCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "card",
+		Aliases: []string{"cards", "acceleratorcard", "acceleratorcards"},
+		Short:   "Inspect the accelerator cards of a member cluster",
+	}
+	cmd.AddCommand(newCardListCommand(o))
+	return cmd
+}
+
+func newCardListCommand(o *globalOptions) *cobra.Command {
+	var output string
+	req := &acceleratorcardv1alpha1.ListAcceleratorCardsRequest{PageSize: -1}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the accelerator cards of the cluster",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if req.Cluster, err = cluster(current); err != nil {
+				return err
+			}
+
+			var cards []*acceleratorcardv1alpha1.AcceleratorCard
+			for card, err := range client.ListAcceleratorCards(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				cards = append(cards, card)
+			}
+			return kantaloupectl.PrintList(printer, cards, func() *kantaloupectl.Table {
+				table := &kantaloupectl.Table{Headers: []string{"UUID", "NODE", "MODEL", "STATE", "CORE ALLOCATED", "MEMORY ALLOCATED", "TEMPERATURE", "POWER"}}
+				for _, card := range cards {
+					table.AddRow(
+						card.GetUuid(),
+						card.GetNode(),
+						card.GetModel(),
+						card.GetState().String(),
+						fmt.Sprintf("%d/%d", card.GetGpuCoreAllocated(), card.GetGpuCoreTotal()),
+						fmt.Sprintf("%dMi/%dMi", card.GetGpuMemoryAllocated()/mebibyte, card.GetGpuMemoryTotal()/mebibyte),
+						fmt.Sprintf("%.0fC", card.GetTemperature()),
+						fmt.Sprintf("%.0fW", card.GetPower()),
+					)
+				}
+				return table
+			})
+		},
+	}
+	cmd.Flags().StringVar(&req.Node, "node", "", "Only list the cards of the node.")
+	cmd.Flags().StringVar(&req.Model, "model", "", "Only list the cards of the model.")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
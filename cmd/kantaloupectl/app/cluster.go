@@ -0,0 +1,283 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+// The results of the checks of cluster diagnose.
+const (
+	checkOK   = "OK"
+	checkWarn = "WARN"
+	checkFail = "FAIL"
+)
+
+func newClusterCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cluster",
+		Aliases: []string{"clusters"},
+		Short:   "Manage the member clusters",
+	}
+	cmd.AddCommand(newClusterListCommand(o), newClusterJoinCommand(o), newClusterDiagnoseCommand(o))
+	return cmd
+}
+
+func newClusterListCommand(o *globalOptions) *cobra.Command {
+	var output string
+	req := &clustersv1alpha1.ListClustersRequest{PageSize: -1}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the member clusters",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			var clusters []*clustersv1alpha1.Cluster
+			for cluster, err := range client.ListClusters(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				clusters = append(clusters, cluster)
+			}
+			return kantaloupectl.PrintList(printer, clusters, func() *kantaloupectl.Table {
+				table := &kantaloupectl.Table{Headers: []string{"NAME", "ALIAS", "PROVIDER", "STATE", "KUBERNETES", "NODES", "GPUS", "AGE"}}
+				for _, cluster := range clusters {
+					status := cluster.GetStatus()
+					table.AddRow(
+						cluster.GetMetadata().GetName(),
+						kantaloupectl.ValueOrNone(cluster.GetSpec().GetAliasName()),
+						cluster.GetSpec().GetProvider().String(),
+						status.GetState().String(),
+						kantaloupectl.ValueOrNone(status.GetKubernetesVersion()),
+						fmt.Sprintf("%d/%d", status.GetNodeSummary().GetReadyNum(), status.GetNodeSummary().GetTotalNum()),
+						strconv.Itoa(int(status.GetGpuTotal())),
+						kantaloupectl.Age(cluster.GetMetadata().GetCreationTimestamp()),
+					)
+				}
+				return table
+			})
+		},
+	}
+	cmd.Flags().StringVar(&req.Name, "name", "", "Only list the clusters whose names contain it.")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newClusterJoinCommand(o *globalOptions) *cobra.Command {
+	var (
+		output, kubeconfig, provider, clusterType string
+		skipValidation, async                     bool
+	)
+	req := &clustersv1alpha1.IntegrateClusterRequest{}
+	cmd := &cobra.Command{
+		Use:   "join NAME --kubeconfig FILE",
+		Short: "Join a Kubernetes cluster to the platform as a member cluster",
+		Long: "Join a Kubernetes cluster to the platform as a member cluster, the kubeconfig is validated by " +
+			"the apiserver before the cluster is joined. With --async the command returns once the join is started, " +
+			"follow it with kantaloupectl operation wait.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to read the kubeconfig: %w", err)
+			}
+			req.Name, req.KubeConfig = args[0], string(data)
+			if req.Provider, err = parseEnum[clustersv1alpha1.ClusterProvider](clustersv1alpha1.ClusterProvider_value, provider, "provider"); err != nil {
+				return err
+			}
+			if clusterType != "" {
+				if req.Type, err = parseEnum[clustersv1alpha1.ClusterType](clustersv1alpha1.ClusterType_value, clusterType, "type"); err != nil {
+					return err
+				}
+			}
+
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if !skipValidation {
+				resp, err := client.Cluster.ValidateKubeconfig(cmd.Context(), &clustersv1alpha1.ValidateKubeconfigRequest{Kubeconfig: req.KubeConfig})
+				if err != nil {
+					return err
+				}
+				if !resp.GetValidate() {
+					return errors.New("the kubeconfig is invalid, the apiserver can not access the cluster with it")
+				}
+			}
+			if async {
+				op, err := client.Cluster.IntegrateClusterAsync(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				if output != kantaloupectl.OutputTable {
+					return printer.PrintObject(op, nil)
+				}
+				cmd.Printf("Joining cluster %q, follow it with kantaloupectl operation wait %s.\n", req.GetName(), op.GetId())
+				return nil
+			}
+			cluster, err := client.Cluster.IntegrateCluster(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			if output != kantaloupectl.OutputTable {
+				return printer.PrintObject(cluster, nil)
+			}
+			cmd.Printf("Cluster %q joined, check it with kantaloupectl cluster diagnose %s.\n", cluster.GetMetadata().GetName(), cluster.GetMetadata().GetName())
+			return nil
+		},
+	}
+	fs := cmd.Flags()
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "The kubeconfig file of the cluster.")
+	fs.StringVar(&req.AliasName, "alias", "", "The display name of the cluster.")
+	fs.StringVar(&req.Description, "description", "", "The description of the cluster.")
+	fs.StringVar(&provider, "provider", clustersv1alpha1.ClusterProvider_GENERIC.String(), "The provider of the cluster, e.g. GENERIC, AWS_EKS or ALIYUN_ACK.")
+	fs.StringVar(&clusterType, "type", "", "The type of the accelerators of the cluster, e.g. NVIDIA or ASCEND.")
+	fs.StringVar(&req.PrometheusAddress, "prometheus-address", "", "The address of the Prometheus of the cluster.")
+	fs.StringVar(&req.GatewayAddress, "gateway-address", "", "The address of the gateway exposing the kantaloupeflows of the cluster.")
+	fs.StringToStringVar(&req.Labels, "labels", nil, "The labels of the cluster, e.g. region=east,tier=gpu.")
+	fs.BoolVar(&skipValidation, "skip-validation", false, "Join the cluster without validating the kubeconfig first.")
+	fs.BoolVar(&async, "async", false, "Return the operation joining the cluster instead of waiting for it.")
+	_ = cmd.MarkFlagRequired("kubeconfig")
+	_ = cmd.RegisterFlagCompletionFunc("provider", cobra.FixedCompletions(enumNames(clustersv1alpha1.ClusterProvider_value), cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(enumNames(clustersv1alpha1.ClusterType_value), cobra.ShellCompDirectiveNoFileComp))
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newClusterDiagnoseCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnose NAME",
+		Short: "Check the health and the components of a member cluster",
+		Long: "Check the health and the components of a member cluster, it fails if any check fails, " +
+			"the warnings are the features not available in the cluster.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: o.completeClusters,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			table := &kantaloupectl.Table{Headers: []string{"CHECK", "RESULT", "DETAIL"}}
+			cluster, err := client.Cluster.GetCluster(cmd.Context(), &clustersv1alpha1.GetClusterRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+			diagnoseStatus(table, cluster.GetStatus())
+			capabilities, err := client.Cluster.GetClusterCapabilities(cmd.Context(), &clustersv1alpha1.GetClusterCapabilitiesRequest{Name: args[0]})
+			if err != nil {
+				table.AddRow("Capabilities", checkFail, err.Error())
+			} else {
+				diagnoseCapabilities(table, capabilities)
+			}
+			if err := table.Print(cmd.OutOrStdout()); err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, row := range table.Rows {
+				if row[1] == checkFail {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d checks of cluster %s failed", failed, args[0])
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func diagnoseStatus(table *kantaloupectl.Table, status *clustersv1alpha1.ClusterStatus) {
+	switch state := status.GetState(); state {
+	case clustersv1alpha1.ClusterState_RUNNING:
+		table.AddRow("State", checkOK, state.String())
+	case clustersv1alpha1.ClusterState_MAINTENANCE:
+		table.AddRow("State", checkWarn, "the cluster is in maintenance, the new kantaloupeflows are not scheduled to it")
+	default:
+		table.AddRow("State", checkFail, state.String())
+	}
+	for _, condition := range status.GetConditions() {
+		result := checkOK
+		switch {
+		case condition.GetType() == "Maintenance":
+			if condition.GetStatus() == "True" {
+				result = checkWarn
+			}
+		case condition.GetStatus() != "True":
+			result = checkFail
+		}
+		detail := strings.TrimSpace(condition.GetReason() + " " + condition.GetMessage())
+		table.AddRow("Condition "+condition.GetType(), result, kantaloupectl.ValueOrNone(detail))
+	}
+	nodes := status.GetNodeSummary()
+	if nodes.GetReadyNum() < nodes.GetTotalNum() {
+		table.AddRow("Nodes", checkWarn, fmt.Sprintf("%d of %d nodes are not ready", nodes.GetTotalNum()-nodes.GetReadyNum(), nodes.GetTotalNum()))
+	} else {
+		table.AddRow("Nodes", checkOK, fmt.Sprintf("%d nodes are ready", nodes.GetTotalNum()))
+	}
+}
+
+func diagnoseCapabilities(table *kantaloupectl.Table, capabilities *clustersv1alpha1.ClusterCapabilities) {
+	table.AddRow("Kubernetes", checkOK, kantaloupectl.ValueOrNone(capabilities.GetKubernetesVersion()))
+	if capabilities.GetHamiVersion() == "" {
+		table.AddRow("HAMi", checkFail, "HAMi is not installed, the accelerators can not be shared")
+	} else {
+		table.AddRow("HAMi", checkOK, capabilities.GetHamiVersion())
+	}
+	if capabilities.GetPrometheusVersion() == "" {
+		table.AddRow("Prometheus", checkWarn, "Prometheus is not reachable, the monitoring is not available")
+	} else {
+		table.AddRow("Prometheus", checkOK, capabilities.GetPrometheusVersion())
+	}
+	if capabilities.GetGatewayApiVersion() == "" {
+		table.AddRow("Gateway API", checkWarn, "the Gateway API is not installed, the kantaloupeflows can not be exposed")
+	} else {
+		table.AddRow("Gateway API", checkOK, capabilities.GetGatewayApiVersion())
+	}
+}
+
+// parseEnum returns the value of the name of the proto enum, the names are case insensitive.
+func parseEnum[T ~int32](values map[string]int32, name, flag string) (T, error) {
+	if value, ok := values[strings.ToUpper(name)]; ok {
+		return T(value), nil
+	}
+	return 0, fmt.Errorf("invalid %s %q, it must be one of %s", flag, name, strings.Join(enumNames(values), ", "))
+}
+
+// enumNames returns the names of the proto enum except the unspecified one.
+func enumNames(values map[string]int32) []string {
+	names := make([]string, 0, len(values))
+	for name, value := range values {
+		if value != 0 {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
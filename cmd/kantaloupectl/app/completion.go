@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+// completionTimeout bounds the calls of the shell completions, the shells wait for them.
+const completionTimeout = 5 * time.Second
+
+func (o *globalOptions) completeContexts(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	config, err := kantaloupectl.LoadConfig(o.configPath)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(config.Contexts))
+	for _, named := range config.Contexts {
+		names = append(names, named.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func (o *globalOptions) completeClusters(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	client, _, err := o.client()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	defer client.Close()
+	ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+	defer cancel()
+
+	var names []string
+	for cluster, err := range client.ListClusters(ctx, &clustersv1alpha1.ListClustersRequest{PageSize: -1}) {
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		names = append(names, cluster.GetMetadata().GetName())
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeFlows completes the names of the kantaloupeflows in the cluster and the namespace
+// in use, a single one is completed unless many are accepted.
+func (o *globalOptions) completeFlows(many bool) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 && !many {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client, current, err := o.client()
+		if err != nil || current.Cluster == "" {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		defer client.Close()
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+
+		var names []string
+		req := &flowv1alpha1.ListKantaloupeflowsRequest{Cluster: current.Cluster, Namespace: current.Namespace, PageSize: -1}
+		for flow, err := range client.ListKantaloupeflows(ctx, req) {
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			names = append(names, flow.GetMetadata().GetName())
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newConfigCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the contexts of the kantaloupe endpoints",
+		Long: "Manage the contexts of the context file, a context is the address of a kantaloupe apiserver, " +
+			"the credential to call it and the member cluster and the namespace used by default.",
+	}
+
+	viewCmd := &cobra.Command{
+		Use:   "view",
+		Short: "Print the context file, the tokens are redacted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := kantaloupectl.LoadConfig(o.configPath)
+			if err != nil {
+				return err
+			}
+			for i := range config.Contexts {
+				if config.Contexts[i].Context.Token != "" {
+					config.Contexts[i].Context.Token = "REDACTED"
+				}
+			}
+			data, err := yaml.Marshal(config)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+
+	getContextsCmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := kantaloupectl.LoadConfig(o.configPath)
+			if err != nil {
+				return err
+			}
+			table := &kantaloupectl.Table{Headers: []string{"CURRENT", "NAME", "SERVER", "CLUSTER", "NAMESPACE"}}
+			for _, named := range config.Contexts {
+				current := ""
+				if named.Name == config.CurrentContext {
+					current = "*"
+				}
+				table.AddRow(current, named.Name, named.Context.Server, named.Context.Cluster, named.Context.Namespace)
+			}
+			return table.Print(cmd.OutOrStdout())
+		},
+	}
+
+	currentContextCmd := &cobra.Command{
+		Use:   "current-context",
+		Short: "Print the current context",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := kantaloupectl.LoadConfig(o.configPath)
+			if err != nil {
+				return err
+			}
+			if config.CurrentContext == "" {
+				return errors.New("the current context is not set")
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), config.CurrentContext)
+			return err
+		},
+	}
+
+	useContextCmd := &cobra.Command{
+		Use:               "use-context NAME",
+		Short:             "Set the current context",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: o.completeContexts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.updateConfig(func(config *kantaloupectl.Config) error {
+				if _, err := config.Context(args[0]); err != nil {
+					return err
+				}
+				config.CurrentContext = args[0]
+				cmd.Printf("Switched to context %q.\n", args[0])
+				return nil
+			})
+		},
+	}
+
+	setContextCmd := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Add a context or update it with the endpoint flags",
+		Long: "Add a context or update it with the endpoint flags, e.g.\n\n" +
+			"  kantaloupectl config set-context prod --server kantaloupe.example.com:443 --token-file ~/prod.token --cluster gpu-1",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: o.completeContexts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.updateConfig(func(config *kantaloupectl.Config) error {
+				current, err := config.Context(args[0])
+				if err != nil {
+					current = &kantaloupectl.Context{}
+				}
+				config.SetContext(args[0], o.applyEndpoint(*current))
+				if config.CurrentContext == "" {
+					config.CurrentContext = args[0]
+				}
+				cmd.Printf("Context %q is set.\n", args[0])
+				return nil
+			})
+		},
+	}
+
+	deleteContextCmd := &cobra.Command{
+		Use:               "delete-context NAME",
+		Short:             "Delete a context",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: o.completeContexts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.updateConfig(func(config *kantaloupectl.Config) error {
+				if err := config.DeleteContext(args[0]); err != nil {
+					return err
+				}
+				cmd.Printf("Context %q is deleted.\n", args[0])
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(viewCmd, getContextsCmd, currentContextCmd, useContextCmd, setContextCmd, deleteContextCmd)
+	return cmd
+}
+
+// updateConfig applies update to the context file and saves it.
+func (o *globalOptions) updateConfig(update func(config *kantaloupectl.Config) error) error {
+	config, err := kantaloupectl.LoadConfig(o.configPath)
+	if err != nil {
+		return err
+	}
+	if err := update(config); err != nil {
+		return err
+	}
+	if err := config.Save(o.configPath); err != nil {
+		return fmt.Errorf("failed to save the context file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,410 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newFlowCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "flow",
+		Aliases: []string{"flows", "kantaloupeflow", "kantaloupeflows"},
+		Short:   "Manage the kantaloupeflows of a member cluster",
+	}
+	cmd.AddCommand(
+		newFlowCreateCommand(o),
+		newFlowGetCommand(o),
+		newFlowLogsCommand(o),
+		newFlowExecCommand(o),
+		newFlowSSHCommand(o),
+		newFlowDeleteCommand(o),
+	)
+	return cmd
+}
+
+func newFlowCreateCommand(o *globalOptions) *cobra.Command {
+	var (
+		output, file string
+		wait         bool
+		timeout      time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "create -f FILE",
+		Short: "Create a kantaloupeflow from a file",
+		Long: "Create a kantaloupeflow from a YAML or JSON file of the kantaloupeflow as the HTTP API accepts it, " +
+			"\"-\" reads it from the standard input. With --wait the command returns once the kantaloupeflow is running.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			flow, err := readFlow(cmd, file)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			if flow.Metadata == nil {
+				flow.Metadata = &types.ObjectMeta{}
+			}
+			if flow.Metadata.Namespace == "" {
+				flow.Metadata.Namespace = current.Namespace
+			}
+
+			req := &flowv1alpha1.CreateKantaloupeflowRequest{Cluster: clusterName, Data: flow}
+			if wait {
+				ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+				defer cancel()
+				flow, err = client.CreateKantaloupeflowAndWait(ctx, req)
+			} else {
+				flow, err = client.Kantaloupeflow.CreateKantaloupeflow(cmd.Context(), req)
+			}
+			if err != nil {
+				return err
+			}
+			if output != kantaloupectl.OutputTable {
+				return printer.PrintObject(flow, nil)
+			}
+			state := "created"
+			if wait {
+				state = "running"
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "kantaloupeflow %s/%s %s\n", flow.GetMetadata().GetNamespace(), flow.GetMetadata().GetName(), state)
+			return err
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "The file of the kantaloupeflow, - for the standard input.")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait until the kantaloupeflow is running.")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "The longest time to wait for the kantaloupeflow.")
+	_ = cmd.MarkFlagRequired("filename")
+	_ = cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+// readFlow reads the kantaloupeflow from the YAML or JSON file, - is the standard input.
+func readFlow(cmd *cobra.Command, file string) (*flowv1alpha1.Kantaloupeflow, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the kantaloupeflow: %w", err)
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the kantaloupeflow: %w", err)
+	}
+	flow := &flowv1alpha1.Kantaloupeflow{}
+	if err := protojson.Unmarshal(data, flow); err != nil {
+		return nil, fmt.Errorf("failed to decode the kantaloupeflow: %w", err)
+	}
+	return flow, nil
+}
+
+func newFlowGetCommand(o *globalOptions) *cobra.Command {
+	var (
+		output        string
+		allNamespaces bool
+	)
+	cmd := &cobra.Command{
+		Use:               "get [NAME]",
+		Aliases:           []string{"list", "ls"},
+		Short:             "Get a kantaloupeflow or list the kantaloupeflows of the namespace",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: o.completeFlows(false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				resp, err := client.Kantaloupeflow.GetKantaloupeflow(cmd.Context(), &flowv1alpha1.GetKantaloupeflowRequest{
+					Cluster:   clusterName,
+					Namespace: current.Namespace,
+					Name:      args[0],
+				})
+				if err != nil {
+					return err
+				}
+				flow := resp.GetKantaloupeflow()
+				return printer.PrintObject(flow, func() *kantaloupectl.Table {
+					return flowTable([]*flowv1alpha1.Kantaloupeflow{flow}, false)
+				})
+			}
+
+			req := &flowv1alpha1.ListKantaloupeflowsRequest{Cluster: clusterName, Namespace: current.Namespace, PageSize: -1}
+			if allNamespaces {
+				req.Namespace = ""
+			}
+			var flows []*flowv1alpha1.Kantaloupeflow
+			for flow, err := range client.ListKantaloupeflows(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				flows = append(flows, flow)
+			}
+			return kantaloupectl.PrintList(printer, flows, func() *kantaloupectl.Table {
+				return flowTable(flows, allNamespaces)
+			})
+		},
+	}
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List the kantaloupeflows of all the namespaces.")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func flowTable(flows []*flowv1alpha1.Kantaloupeflow, withNamespace bool) *kantaloupectl.Table {
+	headers := []string{"NAME", "STATE", "READY", "WORKLOAD", "AGE"}
+	if withNamespace {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	table := &kantaloupectl.Table{Headers: headers}
+	for _, flow := range flows {
+		row := []string{
+			flow.GetMetadata().GetName(),
+			flow.GetStatus().GetState().String(),
+			fmt.Sprintf("%d/%d", flow.GetStatus().GetReadyReplicas(), flow.GetStatus().GetReplicas()),
+			flow.GetSpec().GetWorkload().String(),
+			kantaloupectl.Age(flow.GetMetadata().GetCreationTimestamp()),
+		}
+		if withNamespace {
+			row = append([]string{flow.GetMetadata().GetNamespace()}, row...)
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
+
+func newFlowLogsCommand(o *globalOptions) *cobra.Command {
+	var prefix bool
+	req := &flowv1alpha1.GetKantaloupeflowLogsRequest{}
+	cmd := &cobra.Command{
+		Use:               "logs NAME",
+		Short:             "Print the logs of a kantaloupeflow",
+		Long:              "Print the logs of the containers of the replicas of a kantaloupeflow, or of the selected pod and container.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: o.completeFlows(false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if req.Cluster, err = cluster(current); err != nil {
+				return err
+			}
+			req.Namespace, req.Name = current.Namespace, args[0]
+
+			stream, err := client.Kantaloupeflow.GetKantaloupeflowLogs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if prefix {
+					_, err = fmt.Fprintf(out, "[%s/%s] %s\n", resp.GetPod(), resp.GetContainer(), resp.GetContent())
+				} else {
+					_, err = fmt.Fprintln(out, resp.GetContent())
+				}
+				if err != nil {
+					return err
+				}
+			}
+		},
+	}
+	fs := cmd.Flags()
+	fs.StringVar(&req.Pod, "pod", "", "The pod of the replica, defaults to all the replicas.")
+	fs.StringVarP(&req.Container, "container", "c", "", "The container, defaults to all the containers.")
+	fs.BoolVarP(&req.Follow, "follow", "f", false, "Stream the new logs.")
+	fs.Int64Var(&req.TailLines, "tail", 0, "The number of the latest lines to print, defaults to all.")
+	fs.Int64Var(&req.SinceSeconds, "since-seconds", 0, "Only print the logs newer than the seconds.")
+	fs.BoolVarP(&req.Previous, "previous", "p", false, "Print the logs of the previous instances of the containers.")
+	fs.BoolVar(&req.Timestamps, "timestamps", false, "Print the timestamps of the lines.")
+	fs.BoolVar(&prefix, "prefix", false, "Prefix the lines with the pod and the container.")
+	return cmd
+}
+
+func newFlowExecCommand(o *globalOptions) *cobra.Command {
+	opts := kantaloupectl.ExecOptions{}
+	cmd := &cobra.Command{
+		Use:   "exec NAME [-- COMMAND [ARGS...]]",
+		Short: "Open a terminal in a kantaloupeflow",
+		Long: "Open a terminal in a container of a kantaloupeflow through the apiserver, the shell of the " +
+			"container is run without a command.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: o.completeFlows(false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current, err := o.context()
+			if err != nil {
+				return err
+			}
+			if opts.Cluster, err = cluster(current); err != nil {
+				return err
+			}
+			opts.Namespace, opts.Name, opts.Command = current.Namespace, args[0], args[1:]
+			opts.Stdin, opts.Stdout = os.Stdin, os.Stdout
+
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+			if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) { //nolint:gosec
+				state, err := term.MakeRaw(fd)
+				if err != nil {
+					return fmt.Errorf("failed to set the terminal to raw mode: %w", err)
+				}
+				defer func() { _ = term.Restore(fd, state) }()
+				opts.Sizes = kantaloupectl.WatchTerminalSize(ctx, fd)
+			}
+			return current.Exec(ctx, opts)
+		},
+	}
+	cmd.Flags().StringVar(&opts.Pod, "pod", "", "The pod of the replica, defaults to a running one.")
+	cmd.Flags().StringVarP(&opts.Container, "container", "c", "", "The container, defaults to the first one.")
+	return cmd
+}
+
+func newFlowSSHCommand(o *globalOptions) *cobra.Command {
+	var user string
+	cmd := &cobra.Command{
+		Use:   "ssh NAME [-- SSH_ARGS...]",
+		Short: "Connect to a kantaloupeflow with ssh",
+		Long: "Connect to a kantaloupeflow with the local ssh client through the address the gateway exposes " +
+			"its sshd at, the kantaloupeflow must have the ssh plugin.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: o.completeFlows(false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			resp, err := client.Kantaloupeflow.GetKantaloupeflow(cmd.Context(), &flowv1alpha1.GetKantaloupeflowRequest{
+				Cluster:   clusterName,
+				Namespace: current.Namespace,
+				Name:      args[0],
+			})
+			if err != nil {
+				return err
+			}
+			host, port, err := sshAddress(resp.GetKantaloupeflow())
+			if err != nil {
+				return err
+			}
+
+			sshArgs := append([]string{"-p", port, "-l", user}, args[1:]...)
+			sshArgs = append(sshArgs, host)
+			ssh := exec.CommandContext(cmd.Context(), "ssh", sshArgs...)
+			ssh.Stdin, ssh.Stdout, ssh.Stderr = os.Stdin, os.Stdout, os.Stderr
+			if err := ssh.Run(); err != nil {
+				return fmt.Errorf("ssh %s: %w", strings.Join(sshArgs, " "), err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&user, "user", "l", "root", "The user to log in as.")
+	return cmd
+}
+
+// sshAddress returns the host and the port the sshd of the kantaloupeflow is exposed at.
+func sshAddress(flow *flowv1alpha1.Kantaloupeflow) (string, string, error) {
+	for _, network := range flow.GetStatus().GetNetworks() {
+		if network.GetName() != constants.SSHServiceName {
+			continue
+		}
+		if network.GetUrl() == "" {
+			break
+		}
+		host, port, err := net.SplitHostPort(network.GetUrl())
+		if err != nil {
+			return "", "", fmt.Errorf("invalid address %q of the sshd: %w", network.GetUrl(), err)
+		}
+		return host, port, nil
+	}
+	return "", "", fmt.Errorf("the sshd of kantaloupeflow %s is not exposed, it must have the ssh plugin and the gateway address of its cluster", flow.GetMetadata().GetName())
+}
+
+func newFlowDeleteCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete NAME...",
+		Short:             "Delete kantaloupeflows",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: o.completeFlows(true),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			var errs []error
+			for _, name := range args {
+				_, err := client.Kantaloupeflow.DeleteKantaloupeflow(cmd.Context(), &flowv1alpha1.DeleteKantaloupeflowRequest{
+					Cluster:   clusterName,
+					Namespace: current.Namespace,
+					Name:      name,
+				})
+				if err != nil {
+					errs = append(errs, fmt.Errorf("kantaloupeflow %s: %w", name, err))
+					continue
+				}
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "kantaloupeflow %s/%s deleted\n", current.Namespace, name)
+			}
+			return errors.Join(errs...)
+		},
+	}
+	return cmd
+}
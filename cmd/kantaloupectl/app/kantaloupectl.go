@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynamia-ai/kantaloupe/api/sdk"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+	"github.com/dynamia-ai/kantaloupe/pkg/version"
+)
+
+// defaultNamespace is the namespace of the commands if neither the flag nor the context sets it.
+const defaultNamespace = "default"
+
+// globalOptions are the options of all the commands, the endpoint options override the ones
+// of the context in use.
+type globalOptions struct {
+	configPath  string
+	contextName string
+	endpoint    kantaloupectl.Context
+	cmd         *cobra.Command
+}
+
+func NewKantaloupectlCommand(ctx context.Context) *cobra.Command {
+	o := &globalOptions{}
+	cmd := &cobra.Command{
+		Use:   "kantaloupectl",
+		Short: "kantaloupectl controls the kantaloupe platform",
+		Long: "kantaloupectl controls the clusters, the kantaloupeflows, the quotas, the storages and the " +
+			"accelerator cards of the kantaloupe platform through its apiserver, the access to the member " +
+			"clusters is not needed.",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.SetContext(ctx)
+	o.cmd = cmd
+
+	fs := cmd.PersistentFlags()
+	fs.StringVar(&o.configPath, "kantaloupeconfig", kantaloupectl.DefaultConfigPath(), "Path of the context file, $"+kantaloupectl.ConfigEnv+" sets its default.")
+	fs.StringVar(&o.contextName, "context", "", "The context of the context file to use, defaults to the current context.")
+	fs.StringVar(&o.endpoint.Server, "server", "", "The address of the kantaloupe apiserver, e.g. kantaloupe.example.com:443.")
+	fs.StringVar(&o.endpoint.Token, "token", "", "The bearer token to authenticate with.")
+	fs.StringVar(&o.endpoint.TokenFile, "token-file", "", "The file of the bearer token to authenticate with.")
+	fs.StringVar(&o.endpoint.CertificateAuthority, "certificate-authority", "", "The file of the CA bundle of the apiserver.")
+	fs.BoolVar(&o.endpoint.InsecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Skip verifying the certificate of the apiserver, it makes the connections insecure.")
+	fs.BoolVar(&o.endpoint.Plaintext, "plaintext", false, "Connect to the insecure port of the apiserver without TLS.")
+	fs.StringVar(&o.endpoint.Cluster, "cluster", "", "The member cluster, defaults to the one of the context.")
+	fs.StringVarP(&o.endpoint.Namespace, "namespace", "n", "", "The namespace, defaults to the one of the context or default.")
+	_ = cmd.RegisterFlagCompletionFunc("context", o.completeContexts)
+	_ = cmd.RegisterFlagCompletionFunc("cluster", o.completeClusters)
+
+	versionCmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print the version of kantaloupectl",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			cmd.Println(version.Get())
+		},
+	}
+
+	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(newConfigCommand(o))
+	cmd.AddCommand(newClusterCommand(o))
+	cmd.AddCommand(newFlowCommand(o))
+	cmd.AddCommand(newQuotaCommand(o))
+	cmd.AddCommand(newStorageCommand(o))
+	cmd.AddCommand(newCardCommand(o))
+	cmd.AddCommand(newApplyCommand(o))
+	cmd.AddCommand(newOperationCommand(o))
+	return cmd
+}
+
+// context returns the context in use with the endpoint flags applied. The context file is
+// optional if the server is given by the flags.
+func (o *globalOptions) context() (*kantaloupectl.Context, error) {
+	config, err := kantaloupectl.LoadConfig(o.configPath)
+	if err != nil {
+		return nil, err
+	}
+	current, err := config.Context(o.contextName)
+	switch {
+	case errors.Is(err, kantaloupectl.ErrContextNotFound) && o.contextName == "" && o.endpoint.Server != "":
+		current = &kantaloupectl.Context{}
+	case err != nil:
+		return nil, err
+	}
+	merged := o.applyEndpoint(*current)
+	if merged.Namespace == "" {
+		merged.Namespace = defaultNamespace
+	}
+	return &merged, nil
+}
+
+// applyEndpoint returns the context with the endpoint flags set on the command line applied.
+func (o *globalOptions) applyEndpoint(c kantaloupectl.Context) kantaloupectl.Context {
+	fs := o.cmd.PersistentFlags()
+	if fs.Changed("server") {
+		c.Server = o.endpoint.Server
+	}
+	if fs.Changed("token") {
+		c.Token, c.TokenFile = o.endpoint.Token, ""
+	}
+	if fs.Changed("token-file") {
+		c.Token, c.TokenFile = "", o.endpoint.TokenFile
+	}
+	if fs.Changed("certificate-authority") {
+		c.CertificateAuthority = o.endpoint.CertificateAuthority
+	}
+	if fs.Changed("insecure-skip-tls-verify") {
+		c.InsecureSkipTLSVerify = o.endpoint.InsecureSkipTLSVerify
+	}
+	if fs.Changed("plaintext") {
+		c.Plaintext = o.endpoint.Plaintext
+	}
+	if fs.Changed("cluster") {
+		c.Cluster = o.endpoint.Cluster
+	}
+	if fs.Changed("namespace") {
+		c.Namespace = o.endpoint.Namespace
+	}
+	return c
+}
+
+// client returns the client of the apiserver of the context in use.
+func (o *globalOptions) client() (*sdk.Client, *kantaloupectl.Context, error) {
+	current, err := o.context()
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := current.NewClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, current, nil
+}
+
+// cluster returns the member cluster of the commands, it is required.
+func cluster(current *kantaloupectl.Context) (string, error) {
+	if current.Cluster == "" {
+		return "", errors.New("the member cluster is not set, set it with --cluster or the cluster of the context")
+	}
+	return current.Cluster, nil
+}
+
+// addOutputFlag adds the output flag of the commands printing the resources.
+func addOutputFlag(cmd *cobra.Command, output *string) {
+	cmd.Flags().StringVarP(output, "output", "o", kantaloupectl.OutputTable, "The output format, one of table, json and yaml.")
+	_ = cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(kantaloupectl.OutputFormats, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// newPrinter returns the printer of the output format to the output of the command.
+func newPrinter(cmd *cobra.Command, output string) (*kantaloupectl.Printer, error) {
+	if err := kantaloupectl.ValidateOutput(output); err != nil {
+		return nil, err
+	}
+	return &kantaloupectl.Printer{Format: output, Out: cmd.OutOrStdout()}, nil
+}
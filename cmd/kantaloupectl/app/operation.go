@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/sdk"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newOperationCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "operation",
+		Aliases: []string{"operations", "op"},
+		Short:   "Inspect and cancel the operations of the async calls",
+		Long: "Inspect and cancel the operations started by the async calls, e.g. kantaloupectl cluster join --async. " +
+			"The operations are kept by the apiserver for an hour after they are done.",
+	}
+	cmd.AddCommand(newOperationGetCommand(o), newOperationWaitCommand(o), newOperationCancelCommand(o))
+	return cmd
+}
+
+func newOperationGetCommand(o *globalOptions) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "get ID",
+		Short: "Get an operation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			op, err := client.Operations.GetOperation(cmd.Context(), &operationsv1alpha1.GetOperationRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			return printer.PrintObject(op, func() *kantaloupectl.Table { return operationTable(op) })
+		},
+	}
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newOperationWaitCommand(o *globalOptions) *cobra.Command {
+	var (
+		output  string
+		timeout time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "wait ID",
+		Short: "Wait until an operation is done",
+		Long:  "Wait until an operation is done, the command fails with the error of the operation if it fails or is canceled.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+			op, err := client.WaitOperation(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			return printer.PrintObject(op, func() *kantaloupectl.Table { return operationTable(op) })
+		},
+	}
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "The longest time to wait for the operation.")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newOperationCancelCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel ID",
+		Short: "Cancel an operation, its changes are rolled back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			op, err := client.Operations.CancelOperation(cmd.Context(), &operationsv1alpha1.CancelOperationRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			if op.GetDone() {
+				cmd.Printf("Operation %s is already %s.\n", op.GetId(), op.GetState())
+				return nil
+			}
+			cmd.Printf("Operation %s is canceling, wait for it with kantaloupectl operation wait %s.\n", op.GetId(), op.GetId())
+			return nil
+		},
+	}
+	return cmd
+}
+
+func operationTable(op *operationsv1alpha1.Operation) *kantaloupectl.Table {
+	table := &kantaloupectl.Table{Headers: []string{"ID", "METHOD", "CLUSTER", "STATE", "PROGRESS", "MESSAGE", "AGE"}}
+	message := op.GetMessage()
+	if err := sdk.OperationError(op); err != nil {
+		message = err.Error()
+	}
+	table.AddRow(
+		op.GetId(),
+		op.GetMethod(),
+		kantaloupectl.ValueOrNone(op.GetCluster()),
+		op.GetState().String(),
+		fmt.Sprintf("%d%%", op.GetProgress()),
+		kantaloupectl.ValueOrNone(message),
+		kantaloupectl.Age(op.GetCreateTime()/1000),
+	)
+	return table
+}
@@ -0,0 +1,168 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	quotav1alpha1 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newQuotaCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota",
+		Aliases: []string{"quotas"},
+		Short:   "Manage the quotas of the namespaces of a member cluster",
+	}
+	cmd.AddCommand(newQuotaListCommand(o), newQuotaGetCommand(o), newQuotaCreateCommand(o), newQuotaDeleteCommand(o))
+	return cmd
+}
+
+func newQuotaListCommand(o *globalOptions) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the quotas of the namespace",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+
+			var quotas []*quotav1alpha1.QuotaResponse
+			req := &quotav1alpha1.ListQuotasRequest{Cluster: clusterName, Namespace: current.Namespace, PageSize: -1}
+			for quota, err := range client.ListQuotas(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				quotas = append(quotas, quota)
+			}
+			return kantaloupectl.PrintList(printer, quotas, func() *kantaloupectl.Table {
+				return quotaTable(quotas)
+			})
+		},
+	}
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newQuotaGetCommand(o *globalOptions) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "get NAME",
+		Short: "Get a quota of the namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			quota, err := client.Quota.GetQuota(cmd.Context(), &quotav1alpha1.GetQuotaRequest{Cluster: clusterName, Namespace: current.Namespace, Name: args[0]})
+			if err != nil {
+				return err
+			}
+			return printer.PrintObject(quota, func() *kantaloupectl.Table {
+				return quotaTable([]*quotav1alpha1.QuotaResponse{quota})
+			})
+		},
+	}
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newQuotaCreateCommand(o *globalOptions) *cobra.Command {
+	req := &quotav1alpha1.CreateQuotaRequest{}
+	cmd := &cobra.Command{
+		Use:   "create NAME --hard RESOURCE=QUANTITY,...",
+		Short: "Create a quota of the namespace",
+		Long:  "Create a quota of the namespace, e.g. kantaloupectl quota create gpu --hard requests.nvidia.com/gpu=4,limits.memory=64Gi",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(req.Hard) == 0 {
+				return errors.New("the hard limits of the quota are required")
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if req.Cluster, err = cluster(current); err != nil {
+				return err
+			}
+			req.Namespace, req.Name = current.Namespace, args[0]
+			if _, err := client.Quota.CreateQuota(cmd.Context(), req); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "quota %s/%s created\n", req.Namespace, req.Name)
+			return err
+		},
+	}
+	cmd.Flags().StringToStringVar(&req.Hard, "hard", nil, "The hard limits of the resources.")
+	return cmd
+}
+
+func newQuotaDeleteCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a quota of the namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			if _, err := client.Quota.DeleteQuota(cmd.Context(), &quotav1alpha1.DeleteQuotaRequest{Cluster: clusterName, Namespace: current.Namespace, Name: args[0]}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "quota %s/%s deleted\n", current.Namespace, args[0])
+			return err
+		},
+	}
+	return cmd
+}
+
+func quotaTable(quotas []*quotav1alpha1.QuotaResponse) *kantaloupectl.Table {
+	table := &kantaloupectl.Table{Headers: []string{"NAME", "USED/HARD", "MANAGED", "AGE"}}
+	for _, quota := range quotas {
+		resources := make([]string, 0, len(quota.GetHard()))
+		for resource, hard := range quota.GetHard() {
+			used := quota.GetUsed()[resource]
+			if used == "" {
+				used = "0"
+			}
+			resources = append(resources, fmt.Sprintf("%s: %s/%s", resource, used, hard))
+		}
+		slices.Sort(resources)
+		table.AddRow(quota.GetName(), kantaloupectl.ValueOrNone(strings.Join(resources, ", ")), fmt.Sprint(quota.GetIsManaged()), kantaloupectl.Age(quota.GetCreatedTime()))
+	}
+	return table
+}
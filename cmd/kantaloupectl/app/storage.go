@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	storagev1alpha1 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/kantaloupectl"
+)
+
+func newStorageCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storage",
+		Aliases: []string{"storages"},
+		Short:   "Manage the storages of the namespaces of a member cluster",
+	}
+	cmd.AddCommand(newStorageListCommand(o), newStorageDeleteCommand(o))
+	return cmd
+}
+
+func newStorageListCommand(o *globalOptions) *cobra.Command {
+	var output string
+	req := &storagev1alpha1.ListStoragesRequest{PageSize: -1}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the storages of the namespace",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			printer, err := newPrinter(cmd, output)
+			if err != nil {
+				return err
+			}
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if req.Cluster, err = cluster(current); err != nil {
+				return err
+			}
+			req.Namespace = current.Namespace
+
+			var storages []*corev1alpha1.PersistentVolumeClaim
+			for storage, err := range client.ListStorages(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				storages = append(storages, storage)
+			}
+			return kantaloupectl.PrintList(printer, storages, func() *kantaloupectl.Table {
+				table := &kantaloupectl.Table{Headers: []string{"NAME", "STATUS", "VOLUME", "STORAGECLASS", "USED BY", "AGE"}}
+				for _, storage := range storages {
+					table.AddRow(
+						storage.GetMetadata().GetName(),
+						strings.TrimPrefix(storage.GetStatus().GetPhase().String(), "PVC_"),
+						kantaloupectl.ValueOrNone(storage.GetSpec().GetVolumeName()),
+						kantaloupectl.ValueOrNone(storage.GetSpec().GetStorageClassName()),
+						kantaloupectl.ValueOrNone(strings.Join(storage.GetStatus().GetPodName(), ",")),
+						kantaloupectl.Age(storage.GetMetadata().GetCreationTimestamp()),
+					)
+				}
+				return table
+			})
+		},
+	}
+	cmd.Flags().StringVar(&req.FuzzyName, "name", "", "Only list the storages whose names contain it.")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newStorageDeleteCommand(o *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a storage of the namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, current, err := o.client()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			clusterName, err := cluster(current)
+			if err != nil {
+				return err
+			}
+			if _, err := client.Storage.DeleteStorage(cmd.Context(), &storagev1alpha1.DeleteStorageRequest{Cluster: clusterName, Namespace: current.Namespace, Name: args[0]}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "storage %s/%s deleted\n", current.Namespace, args[0])
+			return err
+		},
+	}
+	return cmd
+}
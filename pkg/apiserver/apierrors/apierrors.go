@@ -0,0 +1,266 @@
+// Package apierrors maps the errors of the apiserver to the gRPC statuses with the ErrorDetail,
+// so the clients tell the errors apart by their reasons instead of parsing the messages. The
+// Kubernetes errors returned by the handlers are mapped to their gRPC codes centrally by the
+// interceptors, the handlers only build the errors of their own validations.
+package apierrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+// reasons are the Kubernetes reasons mapped to the gRPC codes and the reasons of the details,
+// the retryable ones may succeed if the same call is retried later.
+var reasons = map[metav1.StatusReason]struct {
+	code      codes.Code
+	reason    types.ErrorReason
+	retryable bool
+}{
+	metav1.StatusReasonNotFound:              {codes.NotFound, types.ErrorReason_NOT_FOUND, false},
+	metav1.StatusReasonAlreadyExists:         {codes.AlreadyExists, types.ErrorReason_ALREADY_EXISTS, false},
+	metav1.StatusReasonConflict:              {codes.Aborted, types.ErrorReason_CONFLICT, true},
+	metav1.StatusReasonForbidden:             {codes.PermissionDenied, types.ErrorReason_FORBIDDEN, false},
+	metav1.StatusReasonUnauthorized:          {codes.Unauthenticated, types.ErrorReason_UNAUTHORIZED, false},
+	metav1.StatusReasonInvalid:               {codes.InvalidArgument, types.ErrorReason_INVALID, false},
+	metav1.StatusReasonBadRequest:            {codes.InvalidArgument, types.ErrorReason_INVALID, false},
+	metav1.StatusReasonRequestEntityTooLarge: {codes.InvalidArgument, types.ErrorReason_INVALID, false},
+	metav1.StatusReasonGone:                  {codes.FailedPrecondition, types.ErrorReason_FAILED_PRECONDITION, false},
+	metav1.StatusReasonExpired:               {codes.FailedPrecondition, types.ErrorReason_FAILED_PRECONDITION, false},
+	metav1.StatusReasonTimeout:               {codes.DeadlineExceeded, types.ErrorReason_TIMEOUT, true},
+	metav1.StatusReasonServerTimeout:         {codes.Unavailable, types.ErrorReason_TIMEOUT, true},
+	metav1.StatusReasonTooManyRequests:       {codes.ResourceExhausted, types.ErrorReason_TOO_MANY_REQUESTS, true},
+	metav1.StatusReasonServiceUnavailable:    {codes.Unavailable, types.ErrorReason_UNAVAILABLE, true},
+	metav1.StatusReasonMethodNotAllowed:      {codes.Unimplemented, types.ErrorReason_UNIMPLEMENTED, false},
+	metav1.StatusReasonNotAcceptable:         {codes.Unimplemented, types.ErrorReason_UNIMPLEMENTED, false},
+	metav1.StatusReasonUnsupportedMediaType:  {codes.Unimplemented, types.ErrorReason_UNIMPLEMENTED, false},
+	metav1.StatusReasonInternalError:         {codes.Internal, types.ErrorReason_INTERNAL, false},
+}
+
+// codeReasons are the reasons of the gRPC errors built without a detail.
+var codeReasons = map[codes.Code]types.ErrorReason{
+	codes.Canceled:           types.ErrorReason_CANCELED,
+	codes.InvalidArgument:    types.ErrorReason_INVALID,
+	codes.OutOfRange:         types.ErrorReason_INVALID,
+	codes.DeadlineExceeded:   types.ErrorReason_TIMEOUT,
+	codes.NotFound:           types.ErrorReason_NOT_FOUND,
+	codes.AlreadyExists:      types.ErrorReason_ALREADY_EXISTS,
+	codes.PermissionDenied:   types.ErrorReason_FORBIDDEN,
+	codes.ResourceExhausted:  types.ErrorReason_TOO_MANY_REQUESTS,
+	codes.FailedPrecondition: types.ErrorReason_FAILED_PRECONDITION,
+	codes.Aborted:            types.ErrorReason_CONFLICT,
+	codes.Unimplemented:      types.ErrorReason_UNIMPLEMENTED,
+	codes.Internal:           types.ErrorReason_INTERNAL,
+	codes.DataLoss:           types.ErrorReason_INTERNAL,
+	codes.Unavailable:        types.ErrorReason_UNAVAILABLE,
+	codes.Unauthenticated:    types.ErrorReason_UNAUTHORIZED,
+}
+
+// New returns the error of the code with the detail.
+func New(code codes.Code, detail *types.ErrorDetail, format string, args ...interface{}) error {
+	st := status.New(code, fmt.Sprintf(format, args...))
+	if withDetails, err := st.WithDetails(detail); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// Invalid returns the InvalidArgument error of the invalid field.
+func Invalid(field, format string, args ...interface{}) error {
+	return New(codes.InvalidArgument, &types.ErrorDetail{Reason: types.ErrorReason_INVALID, Field: field}, format, args...)
+}
+
+// DetailOf returns the ErrorDetail of the status, it is nil if there is none.
+func DetailOf(st *status.Status) *types.ErrorDetail {
+	for _, detail := range st.Details() {
+		if detail, ok := detail.(*types.ErrorDetail); ok {
+			return detail
+		}
+	}
+	return nil
+}
+
+// Convert returns the status of the error with the ErrorDetail, the Kubernetes errors and the
+// errors of the context are mapped to their gRPC codes. The cluster is set on the detail if it
+// does not have one, it is nil if the error is nil.
+func Convert(err error, cluster string) *status.Status {
+	if err == nil {
+		return nil
+	}
+	st, detail := convert(err)
+	if existing := DetailOf(st); existing != nil {
+		if existing.GetCluster() != "" || cluster == "" {
+			return st
+		}
+		detail = proto.Clone(existing).(*types.ErrorDetail)
+	}
+	if detail.GetCluster() == "" {
+		detail.Cluster = cluster
+	}
+
+	// the other details, e.g. the RetryInfo of the rate limits, are kept.
+	packed, err := anypb.New(detail)
+	if err != nil {
+		return st
+	}
+	p := st.Proto()
+	details := []*anypb.Any{packed}
+	for _, other := range p.GetDetails() {
+		if !other.MessageIs(detail) {
+			details = append(details, other)
+		}
+	}
+	p.Details = details
+	return status.FromProto(p)
+}
+
+func convert(err error) (*status.Status, *types.ErrorDetail) {
+	if st, ok := status.FromError(err); ok {
+		code := st.Code()
+		return st, &types.ErrorDetail{
+			Reason:    codeReasons[code],
+			Retryable: code == codes.Unavailable || code == codes.ResourceExhausted || code == codes.Aborted || code == codes.DeadlineExceeded,
+		}
+	}
+
+	var apiStatus k8serrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		return convertAPIStatus(err, apiStatus.Status())
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error()), &types.ErrorDetail{Reason: types.ErrorReason_CANCELED}
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error()), &types.ErrorDetail{Reason: types.ErrorReason_TIMEOUT, Retryable: true}
+	}
+	return status.New(codes.Unknown, err.Error()), &types.ErrorDetail{}
+}
+
+// convertAPIStatus maps the Kubernetes status, the quota exceeded errors are forbidden by
+// Kubernetes, they are given their own code so they are not mistaken for missing permissions.
+func convertAPIStatus(err error, s metav1.Status) (*status.Status, *types.ErrorDetail) {
+	mapped, ok := reasons[s.Reason]
+	if !ok {
+		mapped.code, mapped.reason = codes.Internal, types.ErrorReason_INTERNAL
+		if s.Code == http.StatusServiceUnavailable || s.Code == http.StatusGatewayTimeout {
+			mapped.code, mapped.reason, mapped.retryable = codes.Unavailable, types.ErrorReason_UNAVAILABLE, true
+		}
+	}
+	if s.Reason == metav1.StatusReasonForbidden && strings.Contains(s.Message, "exceeded quota") {
+		mapped.code, mapped.reason = codes.ResourceExhausted, types.ErrorReason_QUOTA_EXCEEDED
+	}
+
+	detail := &types.ErrorDetail{Reason: mapped.reason, Retryable: mapped.retryable}
+	if details := s.Details; details != nil {
+		if details.Kind != "" || details.Name != "" {
+			detail.Object = &types.ObjectReference{Group: details.Group, Kind: details.Kind, Name: details.Name}
+		}
+		for _, cause := range details.Causes {
+			if cause.Type == metav1.CauseTypeFieldManagerConflict {
+				// the fields are owned by another manager, retrying does not take their ownership.
+				detail.Retryable = false
+			}
+			if detail.Field == "" && cause.Field != "" {
+				detail.Field = cause.Field
+			}
+		}
+	}
+	return status.New(mapped.code, err.Error()), detail
+}
+
+// clusterOf returns the cluster of the request, it is empty if the request has none.
+func clusterOf(req interface{}) string {
+	if r, ok := req.(interface{ GetCluster() string }); ok {
+		return r.GetCluster()
+	}
+	return ""
+}
+
+// UnaryServerInterceptor converts the errors of the unary RPCs, the cluster of the request is
+// set on their details.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			st := Convert(err, clusterOf(req))
+			klog.V(4).InfoS("RPC failed", "method", info.FullMethod, "code", st.Code(), "reason", DetailOf(st).GetReason(), "err", err)
+			return resp, st.Err()
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor converts the errors of the streaming RPCs, the cluster of the first
+// message of the stream is set on their details.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := &serverStream{ServerStream: stream}
+		if err := handler(srv, wrapped); err != nil {
+			st := Convert(err, wrapped.cluster)
+			klog.V(4).InfoS("RPC failed", "method", info.FullMethod, "code", st.Code(), "reason", DetailOf(st).GetReason(), "err", err)
+			return st.Err()
+		}
+		return nil
+	}
+}
+
+// serverStream records the cluster of the first message received.
+type serverStream struct {
+	grpc.ServerStream
+	cluster  string
+	received bool
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		s.cluster = clusterOf(m)
+	}
+	return err
+}
+
+// HTTPErrorHandler is the error handler of the grpc-gateway, the errors not returned by the
+// RPCs, e.g. the ones of the routing and the decoding of the bodies, are given their details as
+// well, so all the errors are served as the JSON of the google.rpc.Status with the ErrorDetail.
+func HTTPErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var httpStatus *runtime.HTTPStatusError
+	if errors.As(err, &httpStatus) {
+		httpStatus.Err = Convert(httpStatus.Err, "").Err()
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, httpStatus)
+		return
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, Convert(err, "").Err())
+}
+
+// WriteHTTPError writes the error as the JSON the grpc-gateway serves, it is used by the HTTP
+// middlewares in front of the grpc-gateway.
+func WriteHTTPError(w http.ResponseWriter, err error) {
+	st := Convert(err, "")
+	data, merr := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(st.Proto())
+	if merr != nil {
+		klog.ErrorS(merr, "Failed to marshal the error", "err", err)
+		data = []byte(`{"code": 13, "message": "failed to marshal error message"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	if _, err := w.Write(data); err != nil {
+		klog.V(4).InfoS("Failed to write the error", "err", err)
+	}
+}
@@ -0,0 +1,231 @@
+package apierrors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	quotav1alpha1 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+var quotas = corev1.Resource("resourcequotas")
+
+func TestConvert(t *testing.T) {
+	conflict := k8serrors.NewApplyConflict([]metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldManagerConflict,
+		Message: `conflict with "kubectl"`,
+		Field:   ".spec.hard",
+	}}, "the fields are owned by another manager")
+	tests := []struct {
+		name      string
+		err       error
+		code      codes.Code
+		reason    types.ErrorReason
+		field     string
+		object    string
+		retryable bool
+	}{
+		{
+			name:   "not found",
+			err:    fmt.Errorf("failed to get the quota: %w", k8serrors.NewNotFound(quotas, "gpu")),
+			code:   codes.NotFound,
+			reason: types.ErrorReason_NOT_FOUND,
+			object: "resourcequotas gpu",
+		},
+		{
+			name:      "conflict",
+			err:       k8serrors.NewConflict(quotas, "gpu", errors.New("the object has been modified")),
+			code:      codes.Aborted,
+			reason:    types.ErrorReason_CONFLICT,
+			object:    "resourcequotas gpu",
+			retryable: true,
+		},
+		{
+			name:   "apply conflict",
+			err:    conflict,
+			code:   codes.Aborted,
+			reason: types.ErrorReason_CONFLICT,
+			field:  ".spec.hard",
+		},
+		{
+			name:   "forbidden",
+			err:    k8serrors.NewForbidden(quotas, "gpu", errors.New("user cannot get the quota")),
+			code:   codes.PermissionDenied,
+			reason: types.ErrorReason_FORBIDDEN,
+			object: "resourcequotas gpu",
+		},
+		{
+			name:   "quota exceeded",
+			err:    k8serrors.NewForbidden(corev1.Resource("pods"), "worker", errors.New("exceeded quota: gpu, requested: requests.nvidia.com/gpu=1")),
+			code:   codes.ResourceExhausted,
+			reason: types.ErrorReason_QUOTA_EXCEEDED,
+			object: "pods worker",
+		},
+		{
+			name: "invalid",
+			err: k8serrors.NewInvalid(schema.GroupKind{Kind: "ResourceQuota"}, "gpu", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "hard").Key("requests.nvidia.com/gpu"), "-1", "must be positive"),
+			}),
+			code:   codes.InvalidArgument,
+			reason: types.ErrorReason_INVALID,
+			field:  "spec.hard[requests.nvidia.com/gpu]",
+			object: "ResourceQuota gpu",
+		},
+		{
+			name:      "service unavailable",
+			err:       k8serrors.NewServiceUnavailable("the member cluster is down"),
+			code:      codes.Unavailable,
+			reason:    types.ErrorReason_UNAVAILABLE,
+			retryable: true,
+		},
+		{
+			name:      "deadline exceeded",
+			err:       fmt.Errorf("failed to query: %w", context.DeadlineExceeded),
+			code:      codes.DeadlineExceeded,
+			reason:    types.ErrorReason_TIMEOUT,
+			retryable: true,
+		},
+		{
+			name:   "status",
+			err:    status.Error(codes.FailedPrecondition, "the cluster is in maintenance"),
+			code:   codes.FailedPrecondition,
+			reason: types.ErrorReason_FAILED_PRECONDITION,
+		},
+		{
+			name:   "invalid field",
+			err:    Invalid("page_size", "page size %d is negative", -2),
+			code:   codes.InvalidArgument,
+			reason: types.ErrorReason_INVALID,
+			field:  "page_size",
+		},
+		{
+			name: "plain",
+			err:  errors.New("plain"),
+			code: codes.Unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := Convert(tt.err, "member")
+			detail := DetailOf(st)
+			if st.Code() != tt.code || detail == nil {
+				t.Fatalf("Convert() = %v with detail %v, want code %v", st.Code(), detail, tt.code)
+			}
+			if st.Message() != status.Convert(tt.err).Message() {
+				t.Errorf("message = %q, want %q", st.Message(), status.Convert(tt.err).Message())
+			}
+			if detail.GetReason() != tt.reason || detail.GetField() != tt.field || detail.GetRetryable() != tt.retryable || detail.GetCluster() != "member" {
+				t.Errorf("detail = %v, want reason %v field %q retryable %v", detail, tt.reason, tt.field, tt.retryable)
+			}
+			object := ""
+			if detail.GetObject() != nil {
+				object = detail.GetObject().GetKind() + " " + detail.GetObject().GetName()
+			}
+			if object != tt.object {
+				t.Errorf("object = %q, want %q", object, tt.object)
+			}
+		})
+	}
+
+	if Convert(nil, "member") != nil {
+		t.Error("Convert() of nil is not nil")
+	}
+}
+
+func TestConvertKeepsDetails(t *testing.T) {
+	st, err := status.New(codes.ResourceExhausted, "too many requests").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	converted := Convert(st.Err(), "")
+	if len(converted.Details()) != 2 {
+		t.Fatalf("details = %v, want the ErrorDetail and the RetryInfo", converted.Details())
+	}
+	if detail := DetailOf(converted); detail.GetReason() != types.ErrorReason_TOO_MANY_REQUESTS || !detail.GetRetryable() {
+		t.Errorf("detail = %v", detail)
+	}
+
+	// the detail built by the handler is kept, the cluster of the request is filled in.
+	converted = Convert(Convert(converted.Err(), "member").Err(), "other")
+	if len(converted.Details()) != 2 || DetailOf(converted).GetCluster() != "member" {
+		t.Errorf("details = %v, want the cluster member", converted.Details())
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kantaloupe.dynamia.ai.api.v1.Quota/GetQuota"}
+	_, err := interceptor(context.TODO(), &quotav1alpha1.GetQuotaRequest{Cluster: "member", Namespace: "team", Name: "gpu"}, info,
+		func(context.Context, interface{}) (interface{}, error) {
+			return nil, k8serrors.NewNotFound(quotas, "gpu")
+		})
+	st := status.Convert(err)
+	if st.Code() != codes.NotFound || DetailOf(st).GetCluster() != "member" {
+		t.Errorf("error = %v with detail %v, want NotFound in cluster member", err, DetailOf(st))
+	}
+
+	resp, err := interceptor(context.TODO(), &clustersv1alpha1.ListClustersRequest{}, info,
+		func(context.Context, interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+	if resp != "ok" || err != nil {
+		t.Errorf("interceptor() = %v, %v", resp, err)
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+		runtime.WithErrorHandler(HTTPErrorHandler),
+	)
+	for name, write := range map[string]func(w http.ResponseWriter, r *http.Request){
+		"gateway": func(w http.ResponseWriter, r *http.Request) {
+			runtime.HTTPError(r.Context(), mux, &runtime.JSONPb{}, w, r, k8serrors.NewAlreadyExists(quotas, "gpu"))
+		},
+		"middleware": func(w http.ResponseWriter, _ *http.Request) {
+			WriteHTTPError(w, k8serrors.NewAlreadyExists(quotas, "gpu"))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			write(w, httptest.NewRequest(http.MethodPost, "/apis/kantaloupe.dynamia.ai/v1/quotas", nil))
+			if w.Code != http.StatusConflict {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusConflict)
+			}
+			var body struct {
+				Code    int32
+				Message string
+				Details []map[string]interface{}
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %s is not JSON: %v", w.Body, err)
+			}
+			if body.Code != int32(codes.AlreadyExists) || len(body.Details) != 1 {
+				t.Fatalf("body = %s", w.Body)
+			}
+			detail := body.Details[0]
+			if detail["@type"] != "type.googleapis.com/kantaloupe.dynamia.ai.api.types.ErrorDetail" || detail["reason"] != "ALREADY_EXISTS" {
+				t.Errorf("detail = %v", detail)
+			}
+		})
+	}
+}
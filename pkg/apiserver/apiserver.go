@@ -2,26 +2,41 @@ package apiserver
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/soheilhy/cmux"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apiserver/pkg/server/healthz"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/bff"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/idempotency"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/ratelimit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/terminal"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/credential"
 	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/operation"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/pod"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/quota"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/middleware"
 )
 
@@ -32,89 +47,131 @@ type APIServer struct {
 	Server           *http.Server
 	GrpcServer       *grpc.Server
 	GatewayServerMux *runtime.ServeMux
-	CMux             cmux.CMux
+	// CMux and SecureCMux serve the insecure and secure ports, nil if disabled.
+	CMux       cmux.CMux
+	SecureCMux cmux.CMux
+	// InternalListener serves the gRPC requests forwarded by GatewayServerMux.
+	InternalListener *transport.InternalListener
 	router           *mux.Router
 	PrometheusAddr   string
+	// Authenticator authenticates the HTTP requests, the gRPC requests are authenticated
+	// by the interceptors of GrpcServer.
+	Authenticator *authentication.Authenticator
+	// Authorizer authorizes the HTTP APIs served by the router, the gRPC requests are
+	// authorized by the interceptors of GrpcServer.
+	Authorizer *authorization.Authorizer
+	// Auditor audits the HTTP APIs served by the router.
+	Auditor *audit.Auditor
+	// AuditStore keeps the latest audit events to serve ListAuditEvents.
+	AuditStore *audit.Store
+	// TerminalConfig configures the sessions of the web terminals.
+	TerminalConfig terminal.Config
+	// RateLimiter limits the RPCs of GrpcServer, it is stopped on shutdown.
+	RateLimiter *ratelimit.Limiter
+	// Idempotency deduplicates the RPCs of GrpcServer carrying idempotency keys, it is stopped
+	// on shutdown.
+	Idempotency *idempotency.Cache
+	// ShutdownDelay delays the drain after the readiness starts failing on shutdown, so the
+	// load balancers stop sending the requests first.
+	ShutdownDelay time.Duration
+	// ShutdownTimeout is how long the in-flight requests and streams are given to finish on
+	// shutdown, the remaining ones are closed after it.
+	ShutdownTimeout time.Duration
+
+	informerFactory informers.SharedInformerFactory
+	terminal        *terminal.Handler
+	// lifetime is canceled once the apiserver is shut down. The connections of the grpc-gateway
+	// are bound to it rather than to the signal, so the forwarded requests are drained as well.
+	lifetime     context.Context
+	stopLifetime context.CancelFunc
+	// shuttingDown is set once the apiserver starts shutting down.
+	shuttingDown atomic.Bool
 }
 
 func (s *APIServer) PrepareRun(ctx context.Context) error {
 	s.router = mux.NewRouter()
+	s.stopCh = make(chan struct{})
+	s.lifetime, s.stopLifetime = context.WithCancel(context.WithoutCancel(ctx))
 
 	clientManager := engine.NewClientManager()
-
-	if err := s.registerGrpcServices(ctx, clientManager); err != nil {
+	client, err := clientManager.GeteClient(engine.LocalCluster)
+	if err != nil {
 		return err
 	}
-
-	// mux middleware
-	s.router.Use(middleware.LogRequestAndResponse)
-
-	s.registerHTTPAPIs()
-
-	s.Server.Handler = s.router
-	s.stopCh = make(chan struct{})
-
-	client, err := clientManager.GeteClient(engine.LocalCluster)
+	monitoringEngine, err := engine.NewPrometheusClient(s.PrometheusAddr)
 	if err != nil {
 		return err
 	}
-	informerFactory := informers.NewSharedInformerFactoryWithOptions(client, time.Hour*1)
-	podManager := pod.NewService(informerFactory.Core().V1().Pods().Lister(), clientManager)
 
-	informer := informerFactory.Core().V1().Pods().Informer()
+	s.informerFactory = informers.NewSharedInformerFactoryWithOptions(client, time.Hour*1)
+	podManager := pod.NewService(s.informerFactory.Core().V1().Pods().Lister(), clientManager)
+
+	informer := s.informerFactory.Core().V1().Pods().Informer()
 	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    podManager.OnAddPod,
 		UpdateFunc: podManager.OnUpdatePod,
 		DeleteFunc: podManager.OnDelPod,
 	})
 
-	informerFactory.Start(s.stopCh)
-	informerFactory.WaitForCacheSync(s.stopCh)
+	// the HTTP APIs are registered first, so they are matched before grpc-gateway.
+	probePaths := s.installHealthChecks([]healthz.HealthChecker{healthz.PingHealthz}, s.readyzChecks(client, monitoringEngine))
+	s.registerHTTPAPIs(clientManager)
+	// the metrics are scraped without credentials like the probes.
+	metrics.Defaults.Install(s.router)
+
+	if err := s.registerGrpcServices(ctx, clientManager, monitoringEngine); err != nil {
+		return err
+	}
+
+	// mux middleware
+	s.router.Use(traceHTTP)
+	s.router.Use(middleware.LogRequestAndResponse)
+	s.router.Use(s.Authenticator.WithAuthentication(append(probePaths, metrics.Path)...))
+
+	// the HTTP/2 requests other than gRPC are served by the router as well.
+	s.Server.Handler = h2c.NewHandler(s.router, &http2.Server{})
+
+	// the informers are synced in the background, the readiness fails until they are.
+	s.informerFactory.Start(s.stopCh)
 
 	return s.printkRouters()
 }
 
-func (s *APIServer) registerGrpcServices(ctx context.Context, cm engine.ClientManagerInterface) error {
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	}
-
+func (s *APIServer) registerGrpcServices(ctx context.Context, cm engine.ClientManagerInterface, monitoringEngine engine.PrometheusInterface) error {
 	clientManager := engine.NewClientManager()
-	monitoringEngine, err := engine.NewPrometheusClient(s.PrometheusAddr)
-	if err != nil {
-		return err
-	}
+	// the async RPCs run in the operations, the running ones are canceled when the apiserver stops.
+	operations := operation.NewManager(ctx)
 	// register cluster service
-	kantaloupeapi.RegisterClusterServer(s.GrpcServer, bff.NewClusterHandler(cm, monitoringEngine))
-	err = kantaloupeapi.RegisterClusterHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	kantaloupeapi.RegisterClusterServer(s.GrpcServer, bff.NewClusterHandler(cm, monitoringEngine, operations))
+	err := s.registerGateway(kantaloupeapi.RegisterClusterHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
 	// register node service
 	// kantaloupeapi.RegisterNodeServer(s.GrpcServer, bff.NewNodeHandler(clientManager, monitoringEngine))
-	// err = kantaloupeapi.RegisterNodeHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	// err = s.registerGateway(kantaloupeapi.RegisterNodeHandlerFromEndpoint)
 	// if err != nil {
 	// 	return err
 	// }
 
 	// register core methods
 	kantaloupeapi.RegisterCoreServer(s.GrpcServer, bff.NewCoreHandler(clientManager, monitoringEngine))
-	err = kantaloupeapi.RegisterCoreHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterCoreHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
 	// register storage methods
 	kantaloupeapi.RegisterStorageServer(s.GrpcServer, bff.NewStorageHandler(clientManager))
-	err = kantaloupeapi.RegisterStorageHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterStorageHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
 	// register monitoring methods
 	kantaloupeapi.RegisterMonitoringServer(s.GrpcServer, bff.NewMonitoringHandler(clientManager, monitoringEngine))
-	err = kantaloupeapi.RegisterMonitoringHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterMonitoringHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
@@ -122,7 +179,7 @@ func (s *APIServer) registerGrpcServices(ctx context.Context, cm engine.ClientMa
 	// register credential service
 	credentialService := credential.NewService(clientManager)
 	kantaloupeapi.RegisterCredentialServer(s.GrpcServer, bff.NewCredentialHandler(credentialService))
-	err = kantaloupeapi.RegisterCredentialHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterCredentialHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
@@ -131,33 +188,79 @@ func (s *APIServer) registerGrpcServices(ctx context.Context, cm engine.ClientMa
 	quotaService := quota.NewService(clientManager, monitoringEngine)
 	workloadService := kfservice.NewService(clientManager)
 	kantaloupeapi.RegisterQuotaServer(s.GrpcServer, bff.NewQuotaHandler(quotaService, workloadService))
-	err = kantaloupeapi.RegisterQuotaHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterQuotaHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
-	kantaloupeapi.RegisterKantaloupeflowServer(s.GrpcServer, bff.NewKantaloupeflowHandler(clientManager, monitoringEngine))
-	err = kantaloupeapi.RegisterKantaloupeflowHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	kantaloupeapi.RegisterKantaloupeflowServer(s.GrpcServer, bff.NewKantaloupeflowHandler(clientManager, monitoringEngine, operations))
+	err = s.registerGateway(kantaloupeapi.RegisterKantaloupeflowHandlerFromEndpoint)
+	if err != nil {
+		return err
+	}
+
+	// register audit service
+	kantaloupeapi.RegisterAuditServer(s.GrpcServer, bff.NewAuditHandler(s.AuditStore))
+	err = s.registerGateway(kantaloupeapi.RegisterAuditHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
 	// register acceleratorcard service
 	kantaloupeapi.RegisterAcceleratorCardServer(s.GrpcServer, bff.NewAcceleratorCardHandler(clientManager, monitoringEngine))
-	err = kantaloupeapi.RegisterAcceleratorCardHandlerFromEndpoint(ctx, s.GatewayServerMux, s.Server.Addr, opts)
+	err = s.registerGateway(kantaloupeapi.RegisterAcceleratorCardHandlerFromEndpoint)
 	if err != nil {
 		return err
 	}
 
+	// register apply service
+	kantaloupeapi.RegisterApplyServer(s.GrpcServer, bff.NewApplyHandler(clientManager))
+	err = s.registerGateway(kantaloupeapi.RegisterApplyHandlerFromEndpoint)
+	if err != nil {
+		return err
+	}
+
+	// register operations service
+	kantaloupeapi.RegisterOperationsServer(s.GrpcServer, bff.NewOperationsHandler(operations))
+	err = s.registerGateway(kantaloupeapi.RegisterOperationsHandlerFromEndpoint)
+	if err != nil {
+		return err
+	}
+
+	endpoint, opts := s.gatewayEndpoint()
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	if err := s.registerDownloadAPIs(conn); err != nil {
+		return err
+	}
+
 	// TODO: registry handlers for other services
 	s.router.PathPrefix("/apis/kantaloupe.dynamia.ai/v1/").Handler(s.GatewayServerMux)
 	return err
 }
 
-func (s *APIServer) registerHTTPAPIs() {
-	healthRouter := s.router.PathPrefix("/").Subrouter()
-	healthRouter.HandleFunc("/healthz", livenessProbe)
-	healthRouter.HandleFunc("/readyz", readinessProbe)
+// gatewayEndpoint returns the endpoint and the dial options of the grpc-gateway. It dials the
+// gRPC server through the in-process listener, so the forwarded requests never leave the
+// process whether the ports are secure or not.
+func (s *APIServer) gatewayEndpoint() (string, []grpc.DialOption) {
+	opts := s.InternalListener.DialOptions()
+	// the trace context of the HTTP requests is propagated to the RPCs.
+	opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	return s.InternalListener.Endpoint(), opts
+}
+
+// registerGateway registers the handlers of a service to the grpc-gateway, its connection is
+// closed once the apiserver is shut down.
+func (s *APIServer) registerGateway(register func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error) error {
+	endpoint, opts := s.gatewayEndpoint()
+	return register(s.lifetime, s.GatewayServerMux, endpoint, opts)
+}
+
+func (s *APIServer) registerHTTPAPIs(cm engine.ClientManagerInterface) {
+	s.terminal = terminal.NewHandler(cm, s.Authorizer, s.Auditor, s.TerminalConfig)
+	s.router.Handle(terminal.Path, s.terminal).Methods(http.MethodGet)
 }
 
 func (s *APIServer) printkRouters() error {
@@ -187,47 +290,144 @@ func (s *APIServer) printkRouters() error {
 }
 
 func (s *APIServer) Run(ctx context.Context) error {
-	s.waitForResourceSync(ctx)
+	go s.waitForResourceSync(ctx)
 
-	// Match connections in order:
-	// First grpc, then HTTP, and otherwise Go RPC/TCP.
-	grpcListener := s.CMux.Match(cmux.HTTP2())
-	httpListener := s.CMux.Match(cmux.HTTP1Fast("PATCH"))
+	go s.serveGrpc(s.InternalListener)
 
-	// Use the muxed listeners for your servers.
+	errCh := make(chan error, 2)
+	for _, m := range []cmux.CMux{s.CMux, s.SecureCMux} {
+		if m == nil {
+			continue
+		}
+		// Match connections in order:
+		// First grpc, then HTTP/1 and HTTP/2.
+		grpcListener := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldPrefixSendSettings("content-type", "application/grpc"))
+		httpListener := m.Match(cmux.Any())
+
+		// Use the muxed listeners for your servers.
+		go s.serveGrpc(grpcListener)
+		go func() {
+			err := s.Server.Serve(httpListener)
+			if err != nil && !s.shuttingDown.Load() {
+				klog.ErrorS(err, "Failed to start http server")
+			}
+		}()
+		go func() {
+			errCh <- m.Serve()
+		}()
+	}
+
+	// Start serving!
+	klog.V(4).InfoS("Serving...")
+	var err error
+	select {
+	case <-ctx.Done():
+		klog.InfoS("Shutting down the apiserver", "delay", s.ShutdownDelay, "timeout", s.ShutdownTimeout)
+		// the readiness fails during the delay, so the load balancers stop sending the
+		// requests before they are drained.
+		s.shuttingDown.Store(true)
+		time.Sleep(s.ShutdownDelay)
+	case err = <-errCh:
+		klog.ErrorS(err, "Failed to serve, shutting down the apiserver")
+	}
+	s.shutdown()
+	return err
+}
+
+// shutdown stops accepting the connections, drains the in-flight requests and streams until
+// ShutdownTimeout, closes the remaining ones and then releases the resources.
+func (s *APIServer) shutdown() {
+	s.shuttingDown.Store(true)
+	ctx, cancel := context.WithTimeout(context.Background(), s.ShutdownTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
 	go func() {
-		err := s.GrpcServer.Serve(grpcListener)
-		if err != nil {
-			klog.ErrorS(err, "Failed to start grpc server")
+		defer wg.Done()
+		// the listeners of the server are closed first, they close the cmux listeners too.
+		if err := s.Server.Shutdown(ctx); err != nil {
+			klog.InfoS("Closing the HTTP requests not drained in time", "err", err)
+			_ = s.Server.Close()
 		}
 	}()
-
 	go func() {
-		err := s.Server.Serve(httpListener)
-		if err != nil {
-			klog.ErrorS(err, "Failed to start http server")
+		defer wg.Done()
+		done := make(chan struct{})
+		go func() {
+			s.GrpcServer.GracefulStop()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			klog.InfoS("Closing the RPCs not drained in time")
+			s.GrpcServer.Stop()
+			<-done
 		}
 	}()
+	// the WebSocket connections of the terminals are hijacked from the server.
+	if s.terminal != nil {
+		s.terminal.Shutdown(ctx)
+	}
+	wg.Wait()
+	// the connections of the grpc-gateway are closed after the forwarded requests are drained.
+	if s.stopLifetime != nil {
+		s.stopLifetime()
+	}
 
-	// Start serving!
-	klog.V(4).InfoS("Serving...")
-	return s.CMux.Serve()
+	for _, m := range []cmux.CMux{s.CMux, s.SecureCMux} {
+		if m != nil {
+			m.Close()
+		}
+	}
+	if s.stopCh != nil {
+		close(s.stopCh)
+	}
+	if s.RateLimiter != nil {
+		s.RateLimiter.Stop()
+	}
+	if s.Idempotency != nil {
+		s.Idempotency.Stop()
+	}
+	if s.Auditor != nil {
+		if err := s.Auditor.Close(); err != nil {
+			klog.ErrorS(err, "Failed to flush the audit events")
+		}
+	}
+	klog.InfoS("The apiserver is shut down")
+}
+
+func (s *APIServer) serveGrpc(l net.Listener) {
+	err := s.GrpcServer.Serve(l)
+	if err != nil && !s.shuttingDown.Load() {
+		klog.ErrorS(err, "Failed to start grpc server")
+	}
 }
 
-func (s *APIServer) waitForResourceSync(_ context.Context) {
+// waitForResourceSync waits for the informers to list the resources, the readiness reports
+// the informers not synced yet meanwhile.
+func (s *APIServer) waitForResourceSync(ctx context.Context) {
+	if s.informerFactory == nil {
+		return
+	}
 	klog.V(4).InfoS("Start cache objects")
 
-	// TODO:
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	for informerType, synced := range s.informerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			klog.InfoS("Stopped caching objects before synced", "type", informerType)
+			return
+		}
+	}
 
 	klog.V(4).InfoS("Finished caching objects")
 }
-
-func livenessProbe(w http.ResponseWriter, _ *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("ok"))
-}
-
-func readinessProbe(w http.ResponseWriter, _ *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("ok"))
-}
@@ -0,0 +1,246 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/klog/v2"
+
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/requestinfo"
+)
+
+const redacted = "******"
+
+// Event records a mutating API call.
+type Event struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Groups    []string  `json:"groups,omitempty"`
+	// Method is the full name of the called RPC, or the route of the called HTTP API.
+	Method string `json:"method"`
+	// Verb is the action of the call, one of create, update, delete, apply and exec.
+	Verb      string `json:"verb"`
+	Cluster   string `json:"cluster,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// Request is the summary of the request, the sensitive fields are redacted.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Code is the gRPC status code of the result.
+	Code                string `json:"code"`
+	Message             string `json:"message,omitempty"`
+	LatencyMilliseconds int64  `json:"latencyMilliseconds"`
+	// Replayed is set if the response is replayed from a previous call with the same
+	// idempotency key, the call is not performed again.
+	Replayed bool `json:"replayed,omitempty"`
+}
+
+// Sink records the audit events.
+type Sink interface {
+	// Write records the event, it must not block the call for long.
+	Write(event *Event)
+	// Close flushes the buffered events and releases the resources.
+	Close() error
+}
+
+// verbs maps the prefixes of the mutating RPCs to their verbs, the RPCs matching none
+// of them are not audited.
+var verbs = []struct {
+	prefix string
+	verb   string
+}{
+	{"Integrate", "create"},
+	{"Create", "create"},
+	{"Update", "update"},
+	{"Put", "update"},
+	{"UnSchedule", "update"},
+	{"Schedule", "update"},
+	{"Delete", "delete"},
+	{"Apply", "apply"},
+	{"Cancel", "cancel"},
+}
+
+// sensitiveFields are the string fields whose values are redacted in the request summary.
+var sensitiveFields = sets.New[protoreflect.Name]("kube_config", "kubeconfig", "password", "token")
+
+// sensitiveMaps are the map fields whose values are redacted in the request summary,
+// e.g. the data of the secrets and the credentials.
+var sensitiveMaps = sets.New[protoreflect.Name]("data", "string_data", "binary_data")
+
+// Auditor records the mutating RPCs to the sinks.
+type Auditor struct {
+	sinks []Sink
+}
+
+// New builds the auditor writing the events to all sinks.
+func New(sinks ...Sink) *Auditor {
+	return &Auditor{sinks: sinks}
+}
+
+// Close closes all sinks.
+func (a *Auditor) Close() error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Record writes the event of an API served outside of the gRPC server, e.g. the sessions of
+// the web terminals. The ID, the timestamp and the user are filled from ctx if absent.
+func (a *Auditor) Record(ctx context.Context, event *Event) {
+	if event.ID == "" {
+		event.ID = string(uuid.NewUUID())
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if u, ok := authentication.UserFrom(ctx); ok && event.User == "" {
+		event.User = u.GetName()
+		event.Groups = u.GetGroups()
+	}
+	for _, sink := range a.sinks {
+		sink.Write(event)
+	}
+}
+
+// UnaryServerInterceptor audits the mutating unary gRPC requests, it must be chained after
+// the authentication interceptor and before the authorization one, so the forbidden calls
+// are audited as well.
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		verb := verbOf(info.FullMethod)
+		if verb == "" || len(a.sinks) == 0 {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		replayed := &atomic.Bool{}
+		resp, err := handler(context.WithValue(ctx, replayedKey{}, replayed), req)
+
+		event := newEvent(ctx, info.FullMethod, verb, req)
+		event.Timestamp = start
+		event.LatencyMilliseconds = time.Since(start).Milliseconds()
+		event.Replayed = replayed.Load()
+		s := status.Convert(err)
+		event.Code = s.Code().String()
+		event.Message = s.Message()
+		for _, sink := range a.sinks {
+			sink.Write(event)
+		}
+		return resp, err
+	}
+}
+
+type replayedKey struct{}
+
+// MarkReplayed marks the call audited in the context as replayed from a previous call, the
+// interceptors serving the calls without their handlers must mark them.
+func MarkReplayed(ctx context.Context) {
+	if replayed, ok := ctx.Value(replayedKey{}).(*atomic.Bool); ok {
+		replayed.Store(true)
+	}
+}
+
+func verbOf(fullMethod string) string {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, v := range verbs {
+		if strings.HasPrefix(method, v.prefix) {
+			return v.verb
+		}
+	}
+	return ""
+}
+
+func newEvent(ctx context.Context, method, verb string, req interface{}) *Event {
+	event := &Event{
+		ID:     string(uuid.NewUUID()),
+		Method: method,
+		Verb:   verb,
+	}
+	if u, ok := authentication.UserFrom(ctx); ok {
+		event.User = u.GetName()
+		event.Groups = u.GetGroups()
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return event
+	}
+	m := msg.ProtoReflect()
+	event.Cluster = requestinfo.StringField(m, "cluster")
+	event.Namespace = requestinfo.Namespace(m)
+	event.Name = requestinfo.Name(m)
+	// the target of the RPCs of the Cluster service is the cluster itself.
+	if strings.HasPrefix(method, "/"+kantaloupeapi.Cluster_ServiceDesc.ServiceName+"/") {
+		event.Cluster = event.Name
+	}
+
+	summary := proto.Clone(msg)
+	redact(summary.ProtoReflect())
+	data, err := protojson.Marshal(summary)
+	if err != nil {
+		klog.V(4).ErrorS(err, "Failed to marshal the request summary", "method", method)
+		return event
+	}
+	event.Request = data
+	return event
+}
+
+// redact replaces the values of the sensitive fields of the message recursively.
+func redact(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+			if sensitiveMaps.Has(field.Name()) {
+				redactMap(msg.Mutable(field).Map(), field.MapValue())
+			} else if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redact(v.Message())
+					return true
+				})
+			}
+		case field.IsList():
+			if field.Kind() == protoreflect.MessageKind {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			redact(value.Message())
+		case field.Kind() == protoreflect.StringKind && sensitiveFields.Has(field.Name()):
+			msg.Set(field, protoreflect.ValueOfString(redacted))
+		}
+		return true
+	})
+}
+
+func redactMap(m protoreflect.Map, valueField protoreflect.FieldDescriptor) {
+	var value protoreflect.Value
+	switch valueField.Kind() {
+	case protoreflect.StringKind:
+		value = protoreflect.ValueOfString(redacted)
+	case protoreflect.BytesKind:
+		value = protoreflect.ValueOfBytes([]byte(redacted))
+	default:
+		return
+	}
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		m.Set(key, value)
+		return true
+	})
+}
@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	store := NewStore(10)
+	interceptor := New(store).UnaryServerInterceptor()
+	ctx := genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{Name: "alice", Groups: []string{"devs"}})
+
+	tests := []struct {
+		name          string
+		method        string
+		req           interface{}
+		err           error
+		expectAudited bool
+		expectVerb    string
+		expectCluster string
+		expectName    string
+		expectCode    string
+	}{
+		{
+			name:   "create secret",
+			method: kantaloupeapi.Core_CreateSecret_FullMethodName,
+			req: &corev1alpha1.CreateSecretRequest{Cluster: "member1", Namespace: "ml", Data: &corev1alpha1.Secret{
+				Data: map[string]string{"password": "secret-value"},
+			}},
+			expectAudited: true,
+			expectVerb:    "create",
+			expectCluster: "member1",
+			expectCode:    codes.OK.String(),
+		},
+		{
+			name:          "integrate cluster",
+			method:        kantaloupeapi.Cluster_IntegrateCluster_FullMethodName,
+			req:           &clustersv1alpha1.IntegrateClusterRequest{Name: "member2", KubeConfig: "secret-value"},
+			err:           status.Error(codes.PermissionDenied, "forbidden"),
+			expectAudited: true,
+			expectVerb:    "create",
+			expectCluster: "member2",
+			expectName:    "member2",
+			expectCode:    codes.PermissionDenied.String(),
+		},
+		{
+			name:          "taint node",
+			method:        kantaloupeapi.Core_PutNodeTaints_FullMethodName,
+			req:           &corev1alpha1.PutNodeTaintsRequest{Cluster: "member1", Node: "node1"},
+			expectAudited: true,
+			expectVerb:    "update",
+			expectCluster: "member1",
+			expectName:    "node1",
+			expectCode:    codes.OK.String(),
+		},
+		{
+			name:   "get secret is not audited",
+			method: kantaloupeapi.Core_GetSecret_FullMethodName,
+			req:    &corev1alpha1.GetSecretRequest{Cluster: "member1", Namespace: "ml", Name: "s"},
+		},
+		{
+			name:   "validate kubeconfig is not audited",
+			method: kantaloupeapi.Cluster_ValidateKubeconfig_FullMethodName,
+			req:    &clustersv1alpha1.ValidateKubeconfigRequest{Kubeconfig: "secret-value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(store.List(Filter{}))
+			_, _ = interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.err
+			})
+			events := store.List(Filter{})
+			if !tt.expectAudited {
+				if len(events) != before {
+					t.Fatalf("got %d events, want %d", len(events), before)
+				}
+				return
+			}
+			if len(events) != before+1 {
+				t.Fatalf("got %d events, want %d", len(events), before+1)
+			}
+			event := events[0]
+			if event.User != "alice" || event.Method != tt.method || event.Verb != tt.expectVerb ||
+				event.Cluster != tt.expectCluster || event.Name != tt.expectName || event.Code != tt.expectCode {
+				t.Errorf("unexpected event %+v", event)
+			}
+			if strings.Contains(string(event.Request), "secret-value") {
+				t.Errorf("request summary is not redacted: %s", event.Request)
+			}
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := NewFileSink(FileConfig{Path: path, MaxSize: 1})
+	for _, name := range []string{"a", "b", "c", "d"} {
+		sink.Write(&Event{ID: name, User: name, Method: kantaloupeapi.Core_CreateSecret_FullMethodName})
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStore(3)
+	if err := store.Load(path); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	events := store.List(Filter{})
+	var ids []string
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	if strings.Join(ids, ",") != "d,c,b" {
+		t.Errorf("List() = %v, want the latest 3 events", ids)
+	}
+	if events := store.List(Filter{User: "c"}); len(events) != 1 || events[0].ID != "c" {
+		t.Errorf("List() filtered by user = %v", events)
+	}
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"encoding/json"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+	"k8s.io/klog/v2"
+)
+
+// FileConfig is the configuration of the JSON file sink.
+type FileConfig struct {
+	// Path is the path of the audit log file.
+	Path string
+	// MaxSize is the maximum size in megabytes of the file before it is rotated.
+	MaxSize int
+	// MaxBackups is the maximum number of the rotated files to retain.
+	MaxBackups int
+	// MaxAge is the maximum number of days to retain the rotated files.
+	MaxAge int
+	// Compress compresses the rotated files with gzip.
+	Compress bool
+}
+
+// fileSink writes the events to the file as JSON lines, the file is rotated by size.
+type fileSink struct {
+	lock   sync.Mutex
+	writer *lumberjack.Logger
+}
+
+// NewFileSink builds the sink writing the events to the JSON file with rotation.
+func NewFileSink(config FileConfig) Sink {
+	return &fileSink{
+		writer: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    config.MaxSize,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAge,
+			Compress:   config.Compress,
+		},
+	}
+}
+
+func (s *fileSink) Write(event *Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal audit event", "id", event.ID)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.writer.Write(append(data, '\n')); err != nil {
+		klog.ErrorS(err, "Failed to write audit event", "id", event.ID)
+	}
+}
+
+func (s *fileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.writer.Close()
+}
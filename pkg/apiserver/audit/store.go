@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Filter selects the audit events, the zero values match all events.
+type Filter struct {
+	User      string
+	Cluster   string
+	Namespace string
+	// Method matches the events whose method contains it.
+	Method string
+	Start  time.Time
+	End    time.Time
+}
+
+func (f *Filter) match(event *Event) bool {
+	switch {
+	case f.User != "" && event.User != f.User:
+		return false
+	case f.Cluster != "" && event.Cluster != f.Cluster:
+		return false
+	case f.Namespace != "" && event.Namespace != f.Namespace:
+		return false
+	case f.Method != "" && !strings.Contains(event.Method, f.Method):
+		return false
+	case !f.Start.IsZero() && event.Timestamp.Before(f.Start):
+		return false
+	case !f.End.IsZero() && !event.Timestamp.Before(f.End):
+		return false
+	}
+	return true
+}
+
+// Store keeps the latest audit events in memory to serve the queries, it is a sink
+// as well.
+type Store struct {
+	lock   sync.RWMutex
+	events []*Event
+	// next is the index the next event is stored at once the store is full.
+	next int
+	size int
+}
+
+// NewStore builds the store keeping the latest size events.
+func NewStore(size int) *Store {
+	return &Store{
+		events: make([]*Event, 0, size),
+		size:   size,
+	}
+}
+
+// Load restores the events from the audit log file written by the file sink, so the
+// events survive the restarts. A missing file is not an error.
+func (s *Store) Load(path string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		event := &Event{}
+		// skip the lines partially written by a crash.
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			continue
+		}
+		s.Write(event)
+	}
+	return scanner.Err()
+}
+
+func (s *Store) Write(event *Event) {
+	if s.size <= 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.events) < s.size {
+		s.events = append(s.events, event)
+		return
+	}
+	s.events[s.next] = event
+	s.next = (s.next + 1) % s.size
+}
+
+func (s *Store) Close() error {
+	return nil
+}
+
+// List returns the events matching the filter, the latest first.
+func (s *Store) List(filter Filter) []*Event {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	res := []*Event{}
+	for i := len(s.events) - 1; i >= 0; i-- {
+		event := s.events[(s.next+i)%len(s.events)]
+		if filter.match(event) {
+			res = append(res, event)
+		}
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	webhookBufferSize    = 10000
+	webhookBatchSize     = 100
+	webhookBatchInterval = time.Second
+	webhookTimeout       = 10 * time.Second
+)
+
+// webhookSink posts the events to the webhook in batches of JSON arrays. The events are
+// buffered and sent asynchronously, they are dropped if the buffer is full.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	events chan *Event
+	wg     sync.WaitGroup
+}
+
+// NewWebhookSink builds the sink posting the events to the URL.
+func NewWebhookSink(url string) Sink {
+	s := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		events: make(chan *Event, webhookBufferSize),
+	}
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+func (s *webhookSink) Write(event *Event) {
+	select {
+	case s.events <- event:
+	default:
+		klog.ErrorS(nil, "Audit webhook buffer is full, dropping event", "id", event.ID)
+	}
+}
+
+func (s *webhookSink) Close() error {
+	close(s.events)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *webhookSink) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(webhookBatchInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, webhookBatchSize)
+	for {
+		select {
+		case event, ok := <-s.events:
+			if !ok {
+				s.send(batch)
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) < webhookBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		s.send(batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *webhookSink) send(batch []*Event) {
+	if len(batch) == 0 {
+		return
+	}
+	if err := s.post(batch); err != nil {
+		klog.ErrorS(err, "Failed to send audit events to webhook", "url", s.url, "count", len(batch))
+	}
+}
+
+func (s *webhookSink) post(batch []*Event) error {
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/token/cache"
+	"k8s.io/apiserver/pkg/authentication/token/tokenfile"
+	tokenunion "k8s.io/apiserver/pkg/authentication/token/union"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/plugin/pkg/authenticator/token/webhook"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+
+	// forwardedUserHeader and forwardedGroupHeader carry the user authenticated by the HTTP
+	// middleware to the gRPC server, they are only trusted on the internal connection of
+	// the grpc-gateway.
+	forwardedUserHeader  = "x-kantaloupe-forwarded-user"
+	forwardedGroupHeader = "x-kantaloupe-forwarded-group"
+
+	// WebSocketBearerProtocolPrefix prefixes the base64url encoded bearer token offered as a
+	// subprotocol of the WebSocket requests, the browsers can not set their authorization header.
+	WebSocketBearerProtocolPrefix = "base64url.bearer.authorization.kantaloupe.dynamia.ai."
+	webSocketProtocolHeader       = "Sec-WebSocket-Protocol"
+
+	tokenReviewTimeout = 10 * time.Second
+)
+
+// anonymousUser is the identity of the requests when no authenticator is configured.
+var anonymousUser = &user.DefaultInfo{Name: user.Anonymous, Groups: []string{user.AllUnauthenticated}}
+
+// ErrUnauthenticated is returned when the request carries no valid credential.
+var ErrUnauthenticated = errors.New("the request is not authenticated")
+
+// Config is the configuration of the authenticators of the apiserver, the authenticators
+// are tried in order of OIDC, static token file and TokenReview.
+type Config struct {
+	// OIDC configures the OIDC bearer token authenticator, nil disables it.
+	OIDC *OIDCConfig
+	// TokenFile is the path of the static token file in the csv format of
+	// "token,user,uid,\"group1,group2\"", empty disables it.
+	TokenFile string
+	// TokenReviewClient enables the Kubernetes TokenReview authenticator if it is not nil.
+	TokenReviewClient authenticationv1client.AuthenticationV1Interface
+	// CacheTTL is the duration the authentication results are cached, zero disables the cache.
+	CacheTTL time.Duration
+	// Anonymous allows the requests without bearer token as the anonymous user.
+	Anonymous bool
+}
+
+// Authenticator resolves the user of a request from its bearer token.
+type Authenticator struct {
+	token     authenticator.Token
+	anonymous bool
+}
+
+// New builds the authenticator of the apiserver from config. When no authenticator is
+// configured, all requests are served as the anonymous user.
+func New(ctx context.Context, config Config) (*Authenticator, error) {
+	var tokenAuthenticators []authenticator.Token
+
+	if config.OIDC != nil {
+		oidcAuthenticator, err := newOIDCAuthenticator(ctx, *config.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		tokenAuthenticators = append(tokenAuthenticators, oidcAuthenticator)
+	}
+
+	if config.TokenFile != "" {
+		tokenAuthenticator, err := tokenfile.NewCSV(config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		tokenAuthenticators = append(tokenAuthenticators, tokenAuthenticator)
+	}
+
+	if config.TokenReviewClient != nil {
+		tokenAuthenticator, err := webhook.NewFromInterface(config.TokenReviewClient, nil, wait.Backoff{
+			Duration: 500 * time.Millisecond,
+			Factor:   1.5,
+			Jitter:   0.2,
+			Steps:    5,
+		}, tokenReviewTimeout, webhook.AuthenticatorMetrics{
+			RecordRequestTotal:   func(context.Context, string) {},
+			RecordRequestLatency: func(context.Context, string, float64) {},
+		})
+		if err != nil {
+			return nil, err
+		}
+		tokenAuthenticators = append(tokenAuthenticators, tokenAuthenticator)
+	}
+
+	if len(tokenAuthenticators) == 0 {
+		klog.InfoS("No authenticator is configured, all requests are served as the anonymous user")
+		return &Authenticator{anonymous: true}, nil
+	}
+
+	token := tokenunion.New(tokenAuthenticators...)
+	if config.CacheTTL > 0 {
+		token = cache.New(token, false, config.CacheTTL, config.CacheTTL)
+	}
+	return &Authenticator{token: token, anonymous: config.Anonymous}, nil
+}
+
+// Authenticate resolves the user of the bearer token from the authorization header,
+// and returns the context carrying the user. The requests without bearer token are
+// authenticated by the verified client certificate of the connection if any.
+func (a *Authenticator) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	token, ok := bearerToken(authorization)
+	if !ok {
+		if u, ok := certificateUser(ctx); ok {
+			return genericapirequest.WithUser(ctx, u), nil
+		}
+	}
+	if !ok || a.token == nil {
+		if a.anonymous {
+			return genericapirequest.WithUser(ctx, anonymousUser), nil
+		}
+		return nil, ErrUnauthenticated
+	}
+
+	resp, ok, err := a.token.AuthenticateToken(ctx, token)
+	if err != nil {
+		klog.V(4).ErrorS(err, "Failed to authenticate the bearer token")
+		return nil, ErrUnauthenticated
+	}
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return genericapirequest.WithUser(ctx, resp.User), nil
+}
+
+// UserFrom returns the user resolved by the authenticator from the request context.
+func UserFrom(ctx context.Context) (user.Info, bool) {
+	return genericapirequest.UserFrom(ctx)
+}
+
+// certificateUser returns the user of the client certificate verified by the secure
+// listener, the common name is the user name and the organizations are the groups.
+func certificateUser(ctx context.Context) (user.Info, bool) {
+	state, ok := transport.TLSStateFrom(ctx)
+	if !ok || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	subject := state.VerifiedChains[0][0].Subject
+	if subject.CommonName == "" {
+		return nil, false
+	}
+	return &user.DefaultInfo{Name: subject.CommonName, Groups: subject.Organization}, true
+}
+
+func bearerToken(authorization string) (string, bool) {
+	if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(authorization[len(bearerPrefix):])
+	return token, token != ""
+}
+
+func (a *Authenticator) authenticateGRPC(ctx context.Context) (context.Context, error) {
+	var authorization string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(authorizationHeader); len(values) > 0 {
+		authorization = values[0]
+	}
+	// the requests of the grpc-gateway are authenticated by the HTTP middleware already.
+	if transport.IsInternal(ctx) {
+		if values := md.Get(forwardedUserHeader); len(values) > 0 {
+			return genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: values[0], Groups: md.Get(forwardedGroupHeader)}), nil
+		}
+	}
+	authenticated, err := a.Authenticate(ctx, authorization)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return authenticated, nil
+}
+
+// UnaryServerInterceptor authenticates the unary gRPC requests.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticateGRPC(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates the streaming gRPC requests.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticateGRPC(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// serverStream overrides the context of the stream with the authenticated one.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// WithAuthentication is the HTTP middleware authenticating the requests except the ones
+// to the unauthenticated paths, e.g. the health probes.
+func (a *Authenticator) WithAuthentication(unauthenticatedPaths ...string) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, path := range unauthenticatedPaths {
+				if r.URL.Path == path {
+					handler.ServeHTTP(w, r)
+					return
+				}
+			}
+			authorization := r.Header.Get(authorizationHeader)
+			if authorization == "" {
+				authorization = webSocketAuthorization(r)
+			}
+			ctx, err := a.Authenticate(r.Context(), authorization)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="kantaloupe"`)
+				apierrors.WriteHTTPError(w, status.Error(codes.Unauthenticated, err.Error()))
+				return
+			}
+			handler.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// webSocketAuthorization returns the authorization of the bearer token offered as a subprotocol
+// of the WebSocket request, it is empty if there is none.
+func webSocketAuthorization(r *http.Request) string {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return ""
+	}
+	for _, value := range r.Header.Values(webSocketProtocolHeader) {
+		for _, protocol := range strings.Split(value, ",") {
+			encoded, ok := strings.CutPrefix(strings.TrimSpace(protocol), WebSocketBearerProtocolPrefix)
+			if !ok {
+				continue
+			}
+			token, err := base64.RawURLEncoding.DecodeString(encoded)
+			if err != nil {
+				return ""
+			}
+			return "Bearer " + string(token)
+		}
+	}
+	return ""
+}
+
+// GatewayMetadata forwards the user authenticated by the HTTP middleware to the gRPC
+// server, it is the metadata annotator of the grpc-gateway.
+func GatewayMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	u, ok := UserFrom(ctx)
+	if !ok {
+		return nil
+	}
+	md := metadata.Pairs(forwardedUserHeader, u.GetName())
+	for _, group := range u.GetGroups() {
+		md.Append(forwardedGroupHeader, group)
+	}
+	return md
+}
+
+// IncomingHeaderMatcher is the header matcher of the grpc-gateway, it drops the forwarded
+// identity headers set by the clients so they can not impersonate other users.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok {
+		return "", false
+	}
+	switch strings.ToLower(name) {
+	case forwardedUserHeader, forwardedGroupHeader:
+		return "", false
+	}
+	return name, true
+}
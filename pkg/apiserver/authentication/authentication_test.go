@@ -0,0 +1,322 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testClientID = "kantaloupe"
+)
+
+func TestAuthenticate(t *testing.T) {
+	dir := t.TempDir()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, _ := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"}}})
+	jwksFile := filepath.Join(dir, "jwks.json")
+	tokenFile := filepath.Join(dir, "tokens.csv")
+	if err := os.WriteFile(jwksFile, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tokenFile, []byte(`static-token,alice,1001,"admins,devs"`+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	auth, err := New(context.TODO(), Config{
+		OIDC: &OIDCConfig{
+			IssuerURL:      testIssuer,
+			ClientID:       testClientID,
+			UsernameClaim:  "email",
+			UsernamePrefix: "oidc:",
+			GroupsClaim:    "groups",
+			GroupsPrefix:   "oidc:",
+			JWKSFile:       jwksFile,
+		},
+		TokenFile: tokenFile,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		expectUser    string
+		expectGroups  []string
+		expectErr     bool
+	}{
+		{
+			name:          "static token",
+			authorization: "Bearer static-token",
+			expectUser:    "alice",
+			expectGroups:  []string{"admins", "devs"},
+		},
+		{
+			name:          "oidc token",
+			authorization: "Bearer " + signToken(t, key, map[string]interface{}{"email": "bob@example.com", "groups": []string{"ml"}}),
+			expectUser:    "oidc:bob@example.com",
+			expectGroups:  []string{"oidc:ml"},
+		},
+		{
+			name:          "oidc token of another audience",
+			authorization: "Bearer " + signToken(t, key, map[string]interface{}{"email": "bob@example.com", "aud": "others"}),
+			expectErr:     true,
+		},
+		{
+			name:          "unknown token",
+			authorization: "Bearer unknown",
+			expectErr:     true,
+		},
+		{
+			name:      "no token",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(authorizationHeader, tt.authorization))
+			ctx, err := auth.authenticateGRPC(ctx)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("authenticateGRPC() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if err != nil {
+				return
+			}
+			u, ok := UserFrom(ctx)
+			if !ok {
+				t.Fatalf("no user in context")
+			}
+			if u.GetName() != tt.expectUser || !reflect.DeepEqual(u.GetGroups(), tt.expectGroups) {
+				t.Errorf("user = %s %v, want %s %v", u.GetName(), u.GetGroups(), tt.expectUser, tt.expectGroups)
+			}
+		})
+	}
+}
+
+func TestAuthenticateAnonymous(t *testing.T) {
+	auth, err := New(context.TODO(), Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx, err := auth.Authenticate(context.TODO(), "")
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if u, ok := UserFrom(ctx); !ok || u.GetName() != anonymousUser.Name {
+		t.Errorf("user = %v, want %s", u, anonymousUser.Name)
+	}
+}
+
+func TestAuthenticateTransport(t *testing.T) {
+	auth, err := New(context.TODO(), Config{TokenFile: writeTokenFile(t)})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	clientCert := &x509.Certificate{Subject: pkix.Name{CommonName: "controller", Organization: []string{"system:masters"}}}
+	forwarded := metadata.Pairs(forwardedUserHeader, "bob", forwardedGroupHeader, "devs")
+
+	tests := []struct {
+		name         string
+		authInfo     credentials.AuthInfo
+		md           metadata.MD
+		expectUser   string
+		expectGroups []string
+		expectErr    bool
+	}{
+		{
+			name:         "verified client certificate",
+			authInfo:     credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{clientCert}}}},
+			expectUser:   "controller",
+			expectGroups: []string{"system:masters"},
+		},
+		{
+			name:         "bearer token takes precedence over client certificate",
+			authInfo:     credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{clientCert}}}},
+			md:           metadata.Pairs(authorizationHeader, "Bearer static-token"),
+			expectUser:   "alice",
+			expectGroups: []string{"admins", "devs"},
+		},
+		{
+			name:      "unverified client certificate",
+			authInfo:  credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}},
+			expectErr: true,
+		},
+		{
+			name:         "forwarded user of grpc-gateway",
+			authInfo:     transport.InternalAuthInfo{},
+			md:           forwarded,
+			expectUser:   "bob",
+			expectGroups: []string{"devs"},
+		},
+		{
+			name:      "forwarded user of external connection",
+			md:        forwarded,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.TODO(), tt.md)
+			if tt.authInfo != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: tt.authInfo})
+			}
+			ctx, err := auth.authenticateGRPC(ctx)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("authenticateGRPC() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if err != nil {
+				return
+			}
+			u, _ := UserFrom(ctx)
+			if u.GetName() != tt.expectUser || !reflect.DeepEqual(u.GetGroups(), tt.expectGroups) {
+				t.Errorf("user = %s %v, want %s %v", u.GetName(), u.GetGroups(), tt.expectUser, tt.expectGroups)
+			}
+		})
+	}
+}
+
+func TestWithAuthentication(t *testing.T) {
+	auth, err := New(context.TODO(), Config{TokenFile: writeTokenFile(t)})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	handler := auth.WithAuthentication("/healthz")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, ok := UserFrom(r.Context()); ok {
+			_, _ = w.Write([]byte(u.GetName()))
+		}
+	}))
+
+	tests := []struct {
+		name       string
+		path       string
+		header     http.Header
+		expectCode int
+		expectUser string
+	}{
+		{
+			name:       "authorization header",
+			path:       "/apis",
+			header:     http.Header{"Authorization": {"Bearer static-token"}},
+			expectCode: http.StatusOK,
+			expectUser: "alice",
+		},
+		{
+			name: "websocket subprotocol",
+			path: "/apis",
+			header: http.Header{
+				"Upgrade":                {"websocket"},
+				"Sec-Websocket-Protocol": {"terminal, " + WebSocketBearerProtocolPrefix + base64.RawURLEncoding.EncodeToString([]byte("static-token"))},
+			},
+			expectCode: http.StatusOK,
+			expectUser: "alice",
+		},
+		{
+			name:       "subprotocol of plain request",
+			path:       "/apis",
+			header:     http.Header{"Sec-Websocket-Protocol": {WebSocketBearerProtocolPrefix + base64.RawURLEncoding.EncodeToString([]byte("static-token"))}},
+			expectCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "unauthenticated path",
+			path:       "/healthz",
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "no token",
+			path:       "/apis",
+			expectCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			for key, values := range tt.header {
+				r.Header[key] = values
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.expectCode {
+				t.Fatalf("code = %d, want %d", w.Code, tt.expectCode)
+			}
+			if tt.expectCode == http.StatusOK && w.Body.String() != tt.expectUser {
+				t.Errorf("user = %q, want %q", w.Body.String(), tt.expectUser)
+			}
+		})
+	}
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	for key, expect := range map[string]bool{
+		"Authorization":                             true,
+		"Grpc-Metadata-Trace":                       true,
+		"Grpc-Metadata-X-Kantaloupe-Forwarded-User": false,
+		"X-Kantaloupe-Forwarded-Group":              false,
+	} {
+		if _, ok := IncomingHeaderMatcher(key); ok != expect {
+			t.Errorf("IncomingHeaderMatcher(%s) = %v, want %v", key, ok, expect)
+		}
+	}
+}
+
+func writeTokenFile(t *testing.T) string {
+	t.Helper()
+	tokenFile := filepath.Join(t.TempDir(), "tokens.csv")
+	if err := os.WriteFile(tokenFile, []byte(`static-token,alice,1001,"admins,devs"`+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return tokenFile
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := map[string]interface{}{
+		"iss": testIssuer,
+		"aud": testClientID,
+		"sub": "subject",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	}
+	for k, v := range claims {
+		payload[k] = v
+	}
+	data, _ := json.Marshal(payload)
+	jws, err := signer.Sign(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jws.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
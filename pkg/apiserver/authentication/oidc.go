@@ -0,0 +1,152 @@
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v3"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+// OIDCConfig is the configuration of the OIDC bearer token authenticator.
+type OIDCConfig struct {
+	// IssuerURL is the URL of the OIDC issuer, the issuer of the tokens must match it.
+	IssuerURL string
+	// ClientID is the client ID the tokens must be issued for.
+	ClientID string
+	// UsernameClaim is the claim used as the user name, "sub" by default.
+	UsernameClaim string
+	// UsernamePrefix is prepended to the user name to avoid conflicts with other authenticators.
+	UsernamePrefix string
+	// GroupsClaim is the claim used as the groups of the user.
+	GroupsClaim string
+	// GroupsPrefix is prepended to the groups to avoid conflicts with other authenticators.
+	GroupsPrefix string
+	// JWKSFile is a local JWKS file used to verify the tokens instead of the keys discovered
+	// from the issuer, it is mainly used for testing.
+	JWKSFile string
+}
+
+type oidcAuthenticator struct {
+	config   OIDCConfig
+	verifier *oidc.IDTokenVerifier
+}
+
+func newOIDCAuthenticator(ctx context.Context, config OIDCConfig) (*oidcAuthenticator, error) {
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "sub"
+	}
+	verifierConfig := &oidc.Config{ClientID: config.ClientID}
+
+	if config.JWKSFile != "" {
+		keySet, err := loadJWKSFile(config.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		return &oidcAuthenticator{
+			config:   config,
+			verifier: oidc.NewVerifier(config.IssuerURL, keySet, verifierConfig),
+		}, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, config.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc issuer %s: %w", config.IssuerURL, err)
+	}
+	return &oidcAuthenticator{config: config, verifier: provider.Verifier(verifierConfig)}, nil
+}
+
+func loadJWKSFile(path string) (oidc.KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	jwks := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks file %s: %w", path, err)
+	}
+	keys := make([]crypto.PublicKey, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if !key.IsPublic() {
+			key = key.Public()
+		}
+		if key.Key != nil {
+			keys = append(keys, key.Key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing key found in jwks file %s", path)
+	}
+	return &oidc.StaticKeySet{PublicKeys: keys}, nil
+}
+
+// AuthenticateToken verifies the OIDC ID token and resolves the user from its claims.
+func (a *oidcAuthenticator) AuthenticateToken(ctx context.Context, token string) (*authenticator.Response, bool, error) {
+	// leave the tokens which are not JWTs to the other authenticators.
+	if strings.Count(token, ".") != 2 {
+		return nil, false, nil
+	}
+
+	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, false, err
+	}
+	claims := map[string]interface{}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, false, err
+	}
+
+	username, ok := claims[a.config.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, false, fmt.Errorf("oidc: claim %s is not a non-empty string", a.config.UsernameClaim)
+	}
+	if a.config.UsernameClaim == "email" {
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return nil, false, errors.New("oidc: email is not verified")
+		}
+	}
+
+	info := &user.DefaultInfo{Name: a.config.UsernamePrefix + username}
+	if a.config.GroupsClaim != "" {
+		groups, err := stringsClaim(claims[a.config.GroupsClaim])
+		if err != nil {
+			return nil, false, fmt.Errorf("oidc: claim %s is invalid: %w", a.config.GroupsClaim, err)
+		}
+		for _, group := range groups {
+			info.Groups = append(info.Groups, a.config.GroupsPrefix+group)
+		}
+	}
+	return &authenticator.Response{User: info}, true, nil
+}
+
+// stringsClaim accepts both a single string and a list of strings.
+func stringsClaim(claim interface{}) ([]string, error) {
+	switch value := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{value}, nil
+	case []interface{}:
+		res := make([]string, 0, len(value))
+		for _, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a string", v)
+			}
+			res = append(res, s)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("%v is neither a string nor a list of strings", claim)
+	}
+}
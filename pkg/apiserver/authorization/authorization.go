@@ -0,0 +1,383 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/requestinfo"
+)
+
+const (
+	// ModeAlwaysAllow allows all requests.
+	ModeAlwaysAllow = "AlwaysAllow"
+	// ModeRBAC authorizes the requests with the roles assigned by the RoleAssignments.
+	ModeRBAC = "RBAC"
+
+	defaultResyncPeriod = 30 * time.Second
+)
+
+// Permission is the permission required by an RPC.
+type Permission int
+
+const (
+	// PermissionAuthenticated is granted to all authenticated users.
+	PermissionAuthenticated Permission = iota
+	// PermissionManagePlatform is granted to the platform admins.
+	PermissionManagePlatform
+	// PermissionViewCluster is granted to the users having any role in the cluster.
+	PermissionViewCluster
+	// PermissionManageCluster is granted to the cluster admins.
+	PermissionManageCluster
+	// PermissionViewNamespace is granted to the members of the workspaces owning the namespace.
+	PermissionViewNamespace
+	// PermissionEditNamespace allows managing the kantaloupeflows, it is granted to the members
+	// of the workspaces owning the namespace.
+	PermissionEditNamespace
+	// PermissionManageNamespace is granted to the admins of the workspaces owning the namespace.
+	PermissionManageNamespace
+)
+
+// Config is the configuration of the authorizer of the apiserver.
+type Config struct {
+	// Mode is either ModeAlwaysAllow or ModeRBAC.
+	Mode string
+	// Client reads the Workspaces and RoleAssignments from the global cluster.
+	Client client.Reader
+	// PlatformAdminGroups are the groups whose users are platform admins without RoleAssignment.
+	PlatformAdminGroups []string
+	// ResyncPeriod is the period to reload the Workspaces and RoleAssignments, 30s by default.
+	ResyncPeriod time.Duration
+}
+
+// Authorizer authorizes the gRPC requests with the roles of the users.
+type Authorizer struct {
+	alwaysAllow         bool
+	client              client.Reader
+	platformAdminGroups sets.Set[string]
+
+	lock sync.RWMutex
+	// workspaces maps the name of the workspaces to their namespaces.
+	workspaces  map[string][]clustercrdv1alpha1.WorkspaceNamespace
+	assignments []clustercrdv1alpha1.RoleAssignmentSpec
+}
+
+// New builds the authorizer of the apiserver, the Workspaces and RoleAssignments are
+// reloaded periodically until ctx is done.
+func New(ctx context.Context, config Config) (*Authorizer, error) {
+	switch config.Mode {
+	case ModeAlwaysAllow, "":
+		return &Authorizer{alwaysAllow: true}, nil
+	case ModeRBAC:
+	default:
+		return nil, fmt.Errorf("unknown authorization mode %q", config.Mode)
+	}
+
+	a := &Authorizer{
+		client:              config.Client,
+		platformAdminGroups: sets.New(config.PlatformAdminGroups...),
+	}
+	resyncPeriod := config.ResyncPeriod
+	if resyncPeriod <= 0 {
+		resyncPeriod = defaultResyncPeriod
+	}
+	a.reload(ctx)
+	go wait.UntilWithContext(ctx, a.reload, resyncPeriod)
+	return a, nil
+}
+
+func (a *Authorizer) reload(ctx context.Context) {
+	workspaceList := &clustercrdv1alpha1.WorkspaceList{}
+	if err := a.client.List(ctx, workspaceList); err != nil {
+		klog.ErrorS(err, "Failed to list workspaces")
+		return
+	}
+	assignmentList := &clustercrdv1alpha1.RoleAssignmentList{}
+	if err := a.client.List(ctx, assignmentList); err != nil {
+		klog.ErrorS(err, "Failed to list role assignments")
+		return
+	}
+
+	workspaces := make(map[string][]clustercrdv1alpha1.WorkspaceNamespace, len(workspaceList.Items))
+	for _, workspace := range workspaceList.Items {
+		workspaces[workspace.Name] = workspace.Spec.Namespaces
+	}
+	assignments := make([]clustercrdv1alpha1.RoleAssignmentSpec, 0, len(assignmentList.Items))
+	for _, assignment := range assignmentList.Items {
+		assignments = append(assignments, assignment.Spec)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.workspaces = workspaces
+	a.assignments = assignments
+}
+
+// Scope is the set of the roles granted to a user.
+type Scope struct {
+	platformAdmin bool
+	clusterAdmin  sets.Set[string]
+	// namespaces maps the clusters to the roles in their namespaces.
+	namespaces map[string]map[string]clustercrdv1alpha1.Role
+}
+
+// scopeFor resolves the roles granted to the user.
+func (a *Authorizer) scopeFor(u user.Info) *Scope {
+	scope := &Scope{
+		platformAdmin: a.platformAdminGroups.HasAny(u.GetGroups()...),
+		clusterAdmin:  sets.New[string](),
+		namespaces:    map[string]map[string]clustercrdv1alpha1.Role{},
+	}
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	for _, assignment := range a.assignments {
+		if !matchSubjects(u, assignment.Subjects) {
+			continue
+		}
+		switch assignment.Role {
+		case clustercrdv1alpha1.RolePlatformAdmin:
+			scope.platformAdmin = true
+		case clustercrdv1alpha1.RoleClusterAdmin:
+			scope.clusterAdmin.Insert(assignment.Cluster)
+		case clustercrdv1alpha1.RoleWorkspaceAdmin, clustercrdv1alpha1.RoleMember:
+			for _, ns := range a.workspaces[assignment.Workspace] {
+				if scope.namespaces[ns.Cluster] == nil {
+					scope.namespaces[ns.Cluster] = map[string]clustercrdv1alpha1.Role{}
+				}
+				// workspace-admin takes precedence if the namespace is granted with both roles.
+				if scope.namespaces[ns.Cluster][ns.Namespace] != clustercrdv1alpha1.RoleWorkspaceAdmin {
+					scope.namespaces[ns.Cluster][ns.Namespace] = assignment.Role
+				}
+			}
+		}
+	}
+	return scope
+}
+
+func matchSubjects(u user.Info, subjects []clustercrdv1alpha1.Subject) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case clustercrdv1alpha1.SubjectKindUser:
+			if subject.Name == u.GetName() {
+				return true
+			}
+		case clustercrdv1alpha1.SubjectKindGroup:
+			for _, group := range u.GetGroups() {
+				if subject.Name == group {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Allows reports whether the permission is granted in the namespace of the cluster, an empty
+// namespace means the permission is required in the scope of the cluster.
+func (s *Scope) Allows(permission Permission, cluster, namespace string) bool {
+	return s.allows(permission, cluster, namespace, false)
+}
+
+// allows reports whether the permission is granted, if anyNamespace is set and namespace is
+// empty, the namespaced permission is granted if it is granted in any namespace of the cluster.
+func (s *Scope) allows(permission Permission, cluster, namespace string, anyNamespace bool) bool {
+	if s.platformAdmin || permission == PermissionAuthenticated {
+		return true
+	}
+	switch permission {
+	case PermissionManagePlatform:
+		return false
+	case PermissionManageCluster:
+		return s.clusterAdmin.Has(cluster)
+	case PermissionViewCluster:
+		return s.clusterAdmin.Has(cluster) || len(s.namespaces[cluster]) > 0
+	}
+
+	if s.clusterAdmin.Has(cluster) {
+		return true
+	}
+	if namespace != "" {
+		return roleAllows(s.namespaces[cluster][namespace], permission)
+	}
+	if !anyNamespace {
+		return false
+	}
+	for _, role := range s.namespaces[cluster] {
+		if roleAllows(role, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// roleAllows reports whether the workspace role grants the namespaced permission.
+func roleAllows(role clustercrdv1alpha1.Role, permission Permission) bool {
+	switch role {
+	case clustercrdv1alpha1.RoleWorkspaceAdmin:
+		return true
+	case clustercrdv1alpha1.RoleMember:
+		return permission == PermissionViewNamespace || permission == PermissionEditNamespace
+	default:
+		return false
+	}
+}
+
+type scopeKey struct{}
+
+// WithScope returns the context carrying the scope of the user.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFrom returns the scope of the user of the request, it is absent if the
+// authorization is disabled.
+func ScopeFrom(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(*Scope)
+	return scope, ok
+}
+
+// Allowed reports whether the user of the request is granted the permission, it is used by
+// the handlers to filter the list results. All permissions are granted if the authorization
+// is disabled.
+func Allowed(ctx context.Context, permission Permission, cluster, namespace string) bool {
+	scope, ok := ScopeFrom(ctx)
+	if !ok {
+		return true
+	}
+	return scope.Allows(permission, cluster, namespace)
+}
+
+// authorize resolves the scope of the user and returns the context carrying it.
+func (a *Authorizer) authorize(ctx context.Context) (context.Context, *Scope, user.Info, error) {
+	u, ok := authentication.UserFrom(ctx)
+	if !ok {
+		return nil, nil, nil, status.Error(codes.Unauthenticated, authentication.ErrUnauthenticated.Error())
+	}
+	scope := a.scopeFor(u)
+	return WithScope(ctx, scope), scope, u, nil
+}
+
+// check verifies the scope is granted the permission the RPC requires for the request.
+func check(scope *Scope, u user.Info, method string, req interface{}) error {
+	r, ok := rules[method]
+	if !ok {
+		r = rule{permission: PermissionManagePlatform}
+	}
+
+	cluster := r.cluster
+	var namespace string
+	if msg, ok := req.(proto.Message); ok {
+		clusterField := r.clusterField
+		if clusterField == "" {
+			clusterField = "cluster"
+		}
+		if cluster == "" {
+			cluster = requestinfo.StringField(msg.ProtoReflect(), clusterField)
+		}
+		namespace = requestinfo.Namespace(msg.ProtoReflect())
+	}
+	if cluster == "" {
+		cluster = r.defaultCluster
+	}
+
+	if scope.allows(r.permission, cluster, namespace, r.list) {
+		return nil
+	}
+	klog.V(4).InfoS("Request is forbidden", "user", u.GetName(), "method", method, "cluster", cluster, "namespace", namespace)
+	return status.Errorf(codes.PermissionDenied, "user %q is not allowed to call %s in cluster %q namespace %q",
+		u.GetName(), method, cluster, namespace)
+}
+
+// Authorize verifies the user of the request is granted the permission in the namespace of
+// the cluster, it authorizes the HTTP APIs served outside of the gRPC server. The action
+// describes the request in the error.
+func (a *Authorizer) Authorize(ctx context.Context, action string, permission Permission, cluster, namespace string) error {
+	if a.alwaysAllow {
+		return nil
+	}
+	_, scope, u, err := a.authorize(ctx)
+	if err != nil {
+		return err
+	}
+	if scope.Allows(permission, cluster, namespace) {
+		return nil
+	}
+	klog.V(4).InfoS("Request is forbidden", "user", u.GetName(), "action", action, "cluster", cluster, "namespace", namespace)
+	return status.Errorf(codes.PermissionDenied, "user %q is not allowed to %s in cluster %q namespace %q",
+		u.GetName(), action, cluster, namespace)
+}
+
+// UnaryServerInterceptor authorizes the unary gRPC requests, it must be chained after
+// the authentication interceptor.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.alwaysAllow {
+			return handler(ctx, req)
+		}
+		ctx, scope, u, err := a.authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := check(scope, u, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes the streaming gRPC requests with their first message,
+// it must be chained after the authentication interceptor.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.alwaysAllow {
+			return handler(srv, stream)
+		}
+		ctx, scope, u, err := a.authorize(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx, scope: scope, user: u, method: info.FullMethod})
+	}
+}
+
+// serverStream carries the scope of the user and authorizes the first received message.
+type serverStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	scope      *Scope
+	user       user.Info
+	method     string
+	authorized bool
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+	if err := check(s.scope, s.user, s.method, m); err != nil {
+		return err
+	}
+	s.authorized = true
+	return nil
+}
@@ -0,0 +1,204 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/gclient"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(
+		&clustercrdv1alpha1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ml"},
+			Spec: clustercrdv1alpha1.WorkspaceSpec{Namespaces: []clustercrdv1alpha1.WorkspaceNamespace{
+				{Cluster: "member1", Namespace: "ml"},
+			}},
+		},
+		&clustercrdv1alpha1.RoleAssignment{
+			ObjectMeta: metav1.ObjectMeta{Name: "ml-members"},
+			Spec: clustercrdv1alpha1.RoleAssignmentSpec{
+				Role:      clustercrdv1alpha1.RoleMember,
+				Workspace: "ml",
+				Subjects:  []clustercrdv1alpha1.Subject{{Kind: clustercrdv1alpha1.SubjectKindGroup, Name: "ml-team"}},
+			},
+		},
+		&clustercrdv1alpha1.RoleAssignment{
+			ObjectMeta: metav1.ObjectMeta{Name: "member1-admin"},
+			Spec: clustercrdv1alpha1.RoleAssignmentSpec{
+				Role:     clustercrdv1alpha1.RoleClusterAdmin,
+				Cluster:  "member1",
+				Subjects: []clustercrdv1alpha1.Subject{{Kind: clustercrdv1alpha1.SubjectKindUser, Name: "carol"}},
+			},
+		},
+	).Build()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	authorizer, err := New(ctx, Config{Mode: ModeRBAC, Client: client, PlatformAdminGroups: []string{user.SystemPrivilegedGroup}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	admin := &user.DefaultInfo{Name: "admin", Groups: []string{user.SystemPrivilegedGroup}}
+	member := &user.DefaultInfo{Name: "bob", Groups: []string{"ml-team"}}
+	clusterAdmin := &user.DefaultInfo{Name: "carol"}
+
+	tests := []struct {
+		name       string
+		user       user.Info
+		method     string
+		req        proto.Message
+		expectCode codes.Code
+	}{
+		{
+			name:   "platform admin deletes cluster",
+			user:   admin,
+			method: kantaloupeapi.Cluster_DeleteCluster_FullMethodName,
+			req:    &clustersv1alpha1.DeleteClusterRequest{Name: "member1"},
+		},
+		{
+			name:       "cluster admin can not delete cluster",
+			user:       clusterAdmin,
+			method:     kantaloupeapi.Cluster_DeleteCluster_FullMethodName,
+			req:        &clustersv1alpha1.DeleteClusterRequest{Name: "member1"},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name:   "cluster admin taints node",
+			user:   clusterAdmin,
+			method: kantaloupeapi.Core_PutNodeTaints_FullMethodName,
+			req:    &corev1alpha1.PutNodeTaintsRequest{Cluster: "member1", Node: "node1"},
+		},
+		{
+			name:       "member can not taint node",
+			user:       member,
+			method:     kantaloupeapi.Core_PutNodeTaints_FullMethodName,
+			req:        &corev1alpha1.PutNodeTaintsRequest{Cluster: "member1", Node: "node1"},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name:   "member gets cluster of workspace",
+			user:   member,
+			method: kantaloupeapi.Cluster_GetCluster_FullMethodName,
+			req:    &clustersv1alpha1.GetClusterRequest{Name: "member1"},
+		},
+		{
+			name:       "member can not get other cluster",
+			user:       member,
+			method:     kantaloupeapi.Cluster_GetCluster_FullMethodName,
+			req:        &clustersv1alpha1.GetClusterRequest{Name: "member2"},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name:   "member creates kantaloupeflow in workspace",
+			user:   member,
+			method: kantaloupeapi.Kantaloupeflow_CreateKantaloupeflow_FullMethodName,
+			req: &flowv1alpha1.CreateKantaloupeflowRequest{Cluster: "member1", Data: &flowv1alpha1.Kantaloupeflow{
+				Metadata: &types.ObjectMeta{Namespace: "ml"},
+			}},
+		},
+		{
+			name:   "member creates kantaloupeflow out of workspace",
+			user:   member,
+			method: kantaloupeapi.Kantaloupeflow_CreateKantaloupeflow_FullMethodName,
+			req: &flowv1alpha1.CreateKantaloupeflowRequest{Cluster: "member1", Data: &flowv1alpha1.Kantaloupeflow{
+				Metadata: &types.ObjectMeta{Namespace: "default"},
+			}},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name:   "member lists kantaloupeflows of all namespaces",
+			user:   member,
+			method: kantaloupeapi.Kantaloupeflow_ListKantaloupeflows_FullMethodName,
+			req:    &flowv1alpha1.ListKantaloupeflowsRequest{Cluster: "member1"},
+		},
+		{
+			name:       "member can not list secrets",
+			user:       member,
+			method:     kantaloupeapi.Core_ListSecrets_FullMethodName,
+			req:        &corev1alpha1.ListSecretsRequest{Cluster: "member1", Namespace: "ml"},
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name:       "unknown method is only allowed to platform admins",
+			user:       clusterAdmin,
+			method:     "/kantaloupev1.Unknown/Unknown",
+			req:        &clustersv1alpha1.GetClusterRequest{Name: "member1"},
+			expectCode: codes.PermissionDenied,
+		},
+	}
+
+	interceptor := authorizer.UnaryServerInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := genericapirequest.WithUser(context.TODO(), tt.user)
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.expectCode {
+				t.Errorf("interceptor() code = %v, want %v, error = %v", code, tt.expectCode, err)
+			}
+		})
+	}
+
+	// the HTTP APIs are authorized with the same scopes.
+	memberCtx := genericapirequest.WithUser(context.TODO(), member)
+	if err := authorizer.Authorize(memberCtx, "exec", PermissionEditNamespace, "member1", "ml"); err != nil {
+		t.Errorf("Authorize() in workspace error = %v", err)
+	}
+	if err := authorizer.Authorize(memberCtx, "exec", PermissionEditNamespace, "member1", "default"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Authorize() out of workspace error = %v, want PermissionDenied", err)
+	}
+	if err := authorizer.Authorize(context.TODO(), "exec", PermissionEditNamespace, "member1", "ml"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Authorize() without user error = %v, want Unauthenticated", err)
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	scope := &Scope{
+		namespaces: map[string]map[string]clustercrdv1alpha1.Role{
+			"member1": {"ml": clustercrdv1alpha1.RoleMember, "infra": clustercrdv1alpha1.RoleWorkspaceAdmin},
+		},
+	}
+	ctx := WithScope(context.TODO(), scope)
+
+	tests := []struct {
+		permission Permission
+		cluster    string
+		namespace  string
+		expect     bool
+	}{
+		{PermissionViewNamespace, "member1", "ml", true},
+		{PermissionManageNamespace, "member1", "ml", false},
+		{PermissionManageNamespace, "member1", "infra", true},
+		{PermissionViewNamespace, "member1", "default", false},
+		{PermissionViewNamespace, "member2", "ml", false},
+		{PermissionViewCluster, "member1", "", true},
+		{PermissionManageCluster, "member1", "", false},
+	}
+	for _, tt := range tests {
+		if got := Allowed(ctx, tt.permission, tt.cluster, tt.namespace); got != tt.expect {
+			t.Errorf("Allowed(%v, %s, %s) = %v, want %v", tt.permission, tt.cluster, tt.namespace, got, tt.expect)
+		}
+	}
+
+	if !Allowed(context.TODO(), PermissionManagePlatform, "", "") {
+		t.Errorf("Allowed() without scope = false, want true")
+	}
+}
@@ -0,0 +1,148 @@
+package authorization
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+// rule describes the permission required by an RPC and where the cluster and the
+// namespace of the request are.
+type rule struct {
+	permission Permission
+	// clusterField is the request field holding the member cluster, "cluster" by default.
+	clusterField protoreflect.Name
+	// defaultCluster is the cluster used if the request does not specify one.
+	defaultCluster string
+	// cluster is the cluster the RPC always operates on regardless of the request.
+	cluster string
+	// list means the namespace of the request may be omitted to list the resources
+	// of all namespaces, the results are filtered by the handler.
+	list bool
+}
+
+var (
+	authenticated   = rule{permission: PermissionAuthenticated}
+	managePlatform  = rule{permission: PermissionManagePlatform}
+	viewCluster     = rule{permission: PermissionViewCluster}
+	manageCluster   = rule{permission: PermissionManageCluster}
+	viewNamespace   = rule{permission: PermissionViewNamespace}
+	editNamespace   = rule{permission: PermissionEditNamespace}
+	manageNamespace = rule{permission: PermissionManageNamespace}
+
+	viewClusterByName   = rule{permission: PermissionViewCluster, clusterField: "name"}
+	manageClusterByName = rule{permission: PermissionManageCluster, clusterField: "name"}
+
+	listNamespaces  = rule{permission: PermissionViewNamespace, list: true}
+	listSecrets     = rule{permission: PermissionManageNamespace, list: true}
+	credential      = rule{permission: PermissionManageNamespace, defaultCluster: engine.LocalCluster}
+	localCredential = rule{permission: PermissionManageNamespace, cluster: engine.LocalCluster}
+	listCredentials = rule{permission: PermissionManageNamespace, cluster: engine.LocalCluster, list: true}
+)
+
+// rules maps the full method names of the RPCs to their rules, the RPCs missing in
+// the table are only allowed to the platform admins.
+var rules = map[string]rule{
+	// ListClusters, WatchClusters and GetKantaloupeTree are filtered by the handlers to the visible clusters.
+	kantaloupeapi.Cluster_ListClusters_FullMethodName:              authenticated,
+	kantaloupeapi.Cluster_WatchClusters_FullMethodName:             authenticated,
+	kantaloupeapi.Cluster_IntegrateCluster_FullMethodName:          managePlatform,
+	kantaloupeapi.Cluster_IntegrateClusterAsync_FullMethodName:     managePlatform,
+	kantaloupeapi.Cluster_GetCluster_FullMethodName:                viewClusterByName,
+	kantaloupeapi.Cluster_UpdateCluster_FullMethodName:             manageClusterByName,
+	kantaloupeapi.Cluster_DeleteCluster_FullMethodName:             managePlatform,
+	kantaloupeapi.Cluster_UpdateClusterMaintenance_FullMethodName:  manageClusterByName,
+	kantaloupeapi.Cluster_ValidateKubeconfig_FullMethodName:        managePlatform,
+	kantaloupeapi.Cluster_GetPlatformSummury_FullMethodName:        managePlatform,
+	kantaloupeapi.Cluster_ListClusterVersions_FullMethodName:       authenticated,
+	kantaloupeapi.Cluster_GetPlatformResourceTrend_FullMethodName:  managePlatform,
+	kantaloupeapi.Cluster_GetPlatformGPUTop_FullMethodName:         managePlatform,
+	kantaloupeapi.Cluster_GetClusterPlugins_FullMethodName:         viewClusterByName,
+	kantaloupeapi.Cluster_GetClusterCardRequestType_FullMethodName: viewClusterByName,
+	kantaloupeapi.Cluster_GetClusterCapabilities_FullMethodName:    viewClusterByName,
+
+	kantaloupeapi.Core_ListPersistentVolumes_FullMethodName:   viewCluster,
+	kantaloupeapi.Core_GetPersistentVolume_FullMethodName:     viewCluster,
+	kantaloupeapi.Core_GetPersistentVolumeJSON_FullMethodName: viewCluster,
+	kantaloupeapi.Core_CreatePersistentVolume_FullMethodName:  manageCluster,
+	kantaloupeapi.Core_UpdatePersistentVolume_FullMethodName:  manageCluster,
+	kantaloupeapi.Core_DeletePersistentVolume_FullMethodName:  manageCluster,
+	kantaloupeapi.Core_DeleteSecret_FullMethodName:            manageNamespace,
+	kantaloupeapi.Core_GetSecret_FullMethodName:               manageNamespace,
+	kantaloupeapi.Core_ListSecrets_FullMethodName:             listSecrets,
+	kantaloupeapi.Core_CreateSecret_FullMethodName:            manageNamespace,
+	kantaloupeapi.Core_ListClusterNamespaces_FullMethodName:   viewCluster,
+	kantaloupeapi.Core_ListClusterGPUSummary_FullMethodName:   viewCluster,
+	kantaloupeapi.Core_ListClusterEvents_FullMethodName:       manageCluster,
+	kantaloupeapi.Core_WatchEvents_FullMethodName:             manageCluster,
+	kantaloupeapi.Core_ListEvents_FullMethodName:              viewNamespace,
+	kantaloupeapi.Core_ListNodes_FullMethodName:               viewCluster,
+	kantaloupeapi.Core_WatchNodes_FullMethodName:              viewCluster,
+	kantaloupeapi.Core_GetNode_FullMethodName:                 viewCluster,
+	kantaloupeapi.Core_PutNodeLabels_FullMethodName:           manageCluster,
+	kantaloupeapi.Core_PutNodeTaints_FullMethodName:           manageCluster,
+	kantaloupeapi.Core_UpdateNodeAnnotations_FullMethodName:   manageCluster,
+	kantaloupeapi.Core_UnScheduleNode_FullMethodName:          manageCluster,
+	kantaloupeapi.Core_ScheduleNode_FullMethodName:            manageCluster,
+	kantaloupeapi.Core_GetConfigMap_FullMethodName:            viewNamespace,
+	kantaloupeapi.Core_GetConfigMapJSON_FullMethodName:        viewNamespace,
+	kantaloupeapi.Core_UpdateConfigMap_FullMethodName:         manageNamespace,
+
+	// the RPCs exposing the workloads of the whole cluster are only allowed to the cluster admins.
+	kantaloupeapi.Monitoring_ListAllPodsGPUUtilization_FullMethodName:           manageCluster,
+	kantaloupeapi.Monitoring_GetResourceTrend_FullMethodName:                    viewCluster,
+	kantaloupeapi.Monitoring_GetNodeResourceTrend_FullMethodName:                viewCluster,
+	kantaloupeapi.Monitoring_GetGpuResourceTrend_FullMethodName:                 viewCluster,
+	kantaloupeapi.Monitoring_GetKantaloupeflowResourceTrend_FullMethodName:      viewNamespace,
+	kantaloupeapi.Monitoring_GetNodeWorkloadDistribution_FullMethodName:         viewCluster,
+	kantaloupeapi.Monitoring_GetClusterWorkloadDistribution_FullMethodName:      viewCluster,
+	kantaloupeapi.Monitoring_GetTopNodes_FullMethodName:                         viewCluster,
+	kantaloupeapi.Monitoring_GetTopNodeWorkloads_FullMethodName:                 manageCluster,
+	kantaloupeapi.Monitoring_GetKantaloupeflowMemoryDistribution_FullMethodName: viewNamespace,
+	kantaloupeapi.Monitoring_GetCardTopWorkloads_FullMethodName:                 manageCluster,
+	kantaloupeapi.Monitoring_GetClusterWorkloadsTop_FullMethodName:              manageCluster,
+
+	kantaloupeapi.Kantaloupeflow_CreateKantaloupeflow_FullMethodName:          editNamespace,
+	kantaloupeapi.Kantaloupeflow_CreateKantaloupeflowAsync_FullMethodName:     editNamespace,
+	kantaloupeapi.Kantaloupeflow_GetKantaloupeflow_FullMethodName:             viewNamespace,
+	kantaloupeapi.Kantaloupeflow_DeleteKantaloupeflow_FullMethodName:          editNamespace,
+	kantaloupeapi.Kantaloupeflow_ListKantaloupeflows_FullMethodName:           listNamespaces,
+	kantaloupeapi.Kantaloupeflow_WatchKantaloupeflows_FullMethodName:          listNamespaces,
+	kantaloupeapi.Kantaloupeflow_GetKantaloupeTree_FullMethodName:             authenticated,
+	kantaloupeapi.Kantaloupeflow_UpdateKantaloupeflowGPUMemory_FullMethodName: editNamespace,
+	kantaloupeapi.Kantaloupeflow_GetKantaloupeflowConditions_FullMethodName:   viewNamespace,
+	kantaloupeapi.Kantaloupeflow_GetKantaloupeflowLogs_FullMethodName:         viewNamespace,
+	kantaloupeapi.Kantaloupeflow_DownloadKantaloupeflowLogs_FullMethodName:    viewNamespace,
+
+	// the credentials are listed and deleted in the global cluster whatever the request specifies.
+	kantaloupeapi.Credential_ListCredentials_FullMethodName:  listCredentials,
+	kantaloupeapi.Credential_DeleteCredential_FullMethodName: localCredential,
+	kantaloupeapi.Credential_CreateCredential_FullMethodName: credential,
+	kantaloupeapi.Credential_UpdateCredential_FullMethodName: credential,
+
+	// the quotas limit the workspaces, so they are managed by the cluster admins.
+	kantaloupeapi.Quota_ListQuotas_FullMethodName:  listNamespaces,
+	kantaloupeapi.Quota_DeleteQuota_FullMethodName: manageCluster,
+	kantaloupeapi.Quota_CreateQuota_FullMethodName: manageCluster,
+	kantaloupeapi.Quota_UpdateQuota_FullMethodName: manageCluster,
+	kantaloupeapi.Quota_GetQuota_FullMethodName:    viewNamespace,
+
+	kantaloupeapi.Storage_ListStorageClasses_FullMethodName: viewCluster,
+	kantaloupeapi.Storage_CreateStorage_FullMethodName:      manageNamespace,
+	kantaloupeapi.Storage_DeleteStorage_FullMethodName:      manageNamespace,
+	kantaloupeapi.Storage_ListStorages_FullMethodName:       listNamespaces,
+
+	kantaloupeapi.AcceleratorCard_ListAcceleratorCard_FullMethodName: viewCluster,
+	kantaloupeapi.AcceleratorCard_GetAcceleratorCard_FullMethodName:  viewCluster,
+	kantaloupeapi.AcceleratorCard_ListModelNames_FullMethodName:      viewCluster,
+
+	kantaloupeapi.Audit_ListAuditEvents_FullMethodName: managePlatform,
+
+	// the objects of the bundle are authorized by the handler.
+	kantaloupeapi.Apply_Apply_FullMethodName: authenticated,
+
+	// the operations of the other users are hidden by the handler.
+	kantaloupeapi.Operations_GetOperation_FullMethodName:    authenticated,
+	kantaloupeapi.Operations_CancelOperation_FullMethodName: authenticated,
+}
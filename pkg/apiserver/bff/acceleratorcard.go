@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/dynamia-ai/kantaloupe/pkg/service/monitoring"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/annotations"
+	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
 )
 
 var _ kantaloupeapi.AcceleratorCardServer = &AcceleratorCardHandler{}
@@ -63,7 +65,7 @@ func (h *AcceleratorCardHandler) ListAcceleratorCard(ctx context.Context, req *a
 	}
 
 	// list all nodes, as the number of nodes is small, we can tolerate the performance loss.
-	nodes, err := h.workloadService.ListNodes(ctx, req.GetCluster())
+	nodes, err := h.workloadService.ListNodes(ctx, req.GetCluster(), metav1.ListOptions{})
 	if err != nil {
 		klog.ErrorS(err, "failed to list nodes", "Error:", err)
 		return nil, err
@@ -110,18 +112,24 @@ func (h *AcceleratorCardHandler) GetAcceleratorCard(ctx context.Context, req *ac
 		return nil, err
 	}
 
-	// set cluster provider and type to card
-	if provider, ok := clustersv1alpha1.ClusterProvider_value[cluster.Spec.Provider]; ok {
-		res.Provider = clustersv1alpha1.ClusterProvider(provider)
-	}
-	if typ, ok := clustersv1alpha1.ClusterType_value[cluster.Spec.Type]; ok {
-		res.Type = clustersv1alpha1.ClusterType(typ)
-	}
 	node, err := h.workloadService.GetNode(ctx, req.GetCluster(), req.GetNode())
 	if err != nil {
 		return nil, err
 	}
-	limit, err := calculateWorkloadLimits(node, cluster.Spec.Type)
+
+	// set cluster provider and type to card, the type of the node takes precedence
+	// in a mixed-vendor cluster.
+	cardType := getClusterType(cluster)
+	if types := nodeutil.DetectAcceleratorTypes([]*corev1.Node{node}); len(types) > 0 {
+		cardType = types[0]
+	}
+	if provider, ok := clustersv1alpha1.ClusterProvider_value[getClusterProvider(cluster)]; ok {
+		res.Provider = clustersv1alpha1.ClusterProvider(provider)
+	}
+	if typ, ok := clustersv1alpha1.ClusterType_value[cardType]; ok {
+		res.Type = clustersv1alpha1.ClusterType(typ)
+	}
+	limit, err := calculateWorkloadLimits(node, cardType)
 	res.GpuMemoryTotal = res.GpuMemoryAllocatable / int64(annotations.GetFactorFromAnnotation(node.Annotations))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,169 @@
+package bff
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+
+	applyv1alpha1 "github.com/dynamia-ai/kantaloupe/api/apply/v1alpha1"
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	applyservice "github.com/dynamia-ai/kantaloupe/pkg/service/apply"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/cluster"
+	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
+)
+
+var _ kantaloupeapi.ApplyServer = &ApplyHandler{}
+
+// applyPermissions are the permissions required to apply and prune the objects of the kinds,
+// they are the same as the ones of the RPCs managing the objects one by one.
+var applyPermissions = map[string]authorization.Permission{
+	applyservice.KindCluster:             authorization.PermissionManageCluster,
+	applyservice.KindCredentialReference: authorization.PermissionManageNamespace,
+	applyservice.KindQuota:               authorization.PermissionManageCluster,
+	applyservice.KindStorage:             authorization.PermissionManageNamespace,
+	applyservice.KindKantaloupeflow:      authorization.PermissionEditNamespace,
+}
+
+// ApplyHandler handles the declarative apply of the kantaloupe objects.
+type ApplyHandler struct {
+	kantaloupeapi.UnimplementedApplyServer
+	service        applyservice.Service
+	clusterService cluster.Service
+	flowService    kfservice.Service
+	storage        *StorageHandler
+}
+
+// NewApplyHandler creates a new apply handler.
+func NewApplyHandler(clientManager engine.ClientManagerInterface) *ApplyHandler {
+	return &ApplyHandler{
+		service:        applyservice.NewService(clientManager),
+		clusterService: cluster.NewService(clientManager),
+		flowService:    kfservice.NewService(clientManager),
+		storage:        NewStorageHandler(clientManager),
+	}
+}
+
+// Apply applies the bundle of the kantaloupe objects. The whole bundle is validated and
+// authorized before any object is applied.
+func (h *ApplyHandler) Apply(ctx context.Context, req *applyv1alpha1.ApplyRequest) (*applyv1alpha1.ApplyResponse, error) {
+	fieldManager := cmp.Or(req.GetFieldManager(), applyservice.DefaultFieldManager)
+	// the field manager is the value of the label the prunable objects are selected by.
+	if errs := validation.IsValidLabelValue(fieldManager); len(errs) != 0 {
+		return nil, apierrors.Invalid("field_manager", "field manager %s is invalid, error: %s", fieldManager, errs)
+	}
+	documents, err := applyservice.ParseManifests(req.GetManifests())
+	if err != nil {
+		return nil, apierrors.Invalid("manifests", "%v", err)
+	}
+	if len(documents) == 0 {
+		return nil, apierrors.Invalid("manifests", "the manifests have no objects")
+	}
+
+	objects := make([]*applyservice.Object, 0, len(documents))
+	keys := map[string]*applyservice.Document{}
+	for _, document := range documents {
+		object, err := h.buildObject(ctx, document)
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := keys[object.Key()]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "%s and %s are the same object", previous, document)
+		}
+		keys[object.Key()] = document
+		if err := authorizeObject(ctx, object); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	slices.SortStableFunc(objects, func(a, b *applyservice.Object) int {
+		return slices.Index(applyservice.Kinds, a.Kind) - slices.Index(applyservice.Kinds, b.Kind)
+	})
+
+	var (
+		prunable []*applyservice.Object
+		skipped  []*applyv1alpha1.ApplyResult
+	)
+	if req.GetPrune() {
+		prunable, skipped, err = h.listPrunable(ctx, objects, fieldManager)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := h.service.Apply(ctx, objects, prunable, applyservice.Options{
+		FieldManager: fieldManager,
+		DryRun:       req.GetDryRun(),
+		Force:        req.GetForce(),
+	})
+	klog.V(4).InfoS("Applied manifests", "fieldManager", fieldManager, "dryRun", req.GetDryRun(), "objects", len(objects), "pruned", len(prunable))
+	return &applyv1alpha1.ApplyResponse{Results: append(convertApplyResults2Proto(results), skipped...)}, nil
+}
+
+// listPrunable lists the objects to prune in the running clusters visible to the user, the user
+// must be allowed to manage all of them. The clusters not running or failing to list their
+// objects are skipped and reported in the results, they do not block the apply.
+func (h *ApplyHandler) listPrunable(ctx context.Context, objects []*applyservice.Object, fieldManager string) ([]*applyservice.Object, []*applyv1alpha1.ApplyResult, error) {
+	clusters, err := h.clusterService.ListClusters(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		running []string
+		skipped []*applyv1alpha1.ApplyResult
+	)
+	for _, c := range clusters {
+		if !authorization.Allowed(ctx, authorization.PermissionViewCluster, c.Name, "") {
+			continue
+		}
+		if convertCondition2State(c.Status) != clustersv1alpha1.ClusterState_RUNNING {
+			skipped = append(skipped, pruneSkippedResult(c.Name, "the cluster is not running"))
+			continue
+		}
+		running = append(running, c.Name)
+	}
+	prunable, skippedClusters, err := h.service.ListPrunable(ctx, running, objects, fieldManager)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "failed to list the objects to prune: %v", err)
+	}
+	for _, cluster := range skippedClusters {
+		klog.V(4).InfoS("Skipped pruning the cluster", "cluster", cluster.Cluster, "err", cluster.Err)
+		skipped = append(skipped, pruneSkippedResult(cluster.Cluster, fmt.Sprintf("failed to list the objects to prune: %v", cluster.Err)))
+	}
+	for _, object := range prunable {
+		if err := authorizeObject(ctx, object); err != nil {
+			return nil, nil, err
+		}
+	}
+	return prunable, skipped, nil
+}
+
+func pruneSkippedResult(cluster, message string) *applyv1alpha1.ApplyResult {
+	return &applyv1alpha1.ApplyResult{
+		Cluster: cluster,
+		Action:  applyv1alpha1.ApplyAction_PRUNE_SKIPPED,
+		Message: message,
+	}
+}
+
+// authorizeObject verifies the user of the request is allowed to manage the object.
+func authorizeObject(ctx context.Context, object *applyservice.Object) error {
+	cluster, namespace := object.Cluster, object.Object.GetNamespace()
+	if object.Kind == applyservice.KindCluster {
+		cluster = object.Object.GetName()
+	}
+	if authorization.Allowed(ctx, applyPermissions[object.Kind], cluster, namespace) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "not allowed to apply %s %s in cluster %q namespace %q",
+		object.Kind, object.Object.GetName(), cluster, namespace)
+}
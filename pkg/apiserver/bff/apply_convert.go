@@ -0,0 +1,385 @@
+package bff
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	applyv1alpha1 "github.com/dynamia-ai/kantaloupe/api/apply/v1alpha1"
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	flowcrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	credentialv1alpha1 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	storagev1alpha1 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	applyservice "github.com/dynamia-ai/kantaloupe/pkg/service/apply"
+	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/namespace"
+)
+
+// buildObject validates the document and builds the object to apply, the objects are prepared
+// the same way as the RPCs creating them do.
+func (h *ApplyHandler) buildObject(ctx context.Context, document *applyservice.Document) (*applyservice.Object, error) {
+	metadata := document.Metadata
+	if !utils.IsValidLabelNames(metadata.Labels) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s label %s is invalid", document, metadata.Labels)
+	}
+	if !utils.IsValidAnnotationNames(metadata.Annotations) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s annotation %s is invalid", document, metadata.Annotations)
+	}
+	switch document.Kind {
+	case applyservice.KindCluster, applyservice.KindCredentialReference:
+		if metadata.Cluster != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is in the global cluster, the cluster can not be specified", document)
+		}
+	default:
+		if errs := validation.IsDNS1035Label(metadata.Cluster); len(errs) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s cluster name %s is invalid, error: %s", document, metadata.Cluster, errs)
+		}
+		if metadata.Namespace == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "%s namespace can not be empty", document)
+		}
+	}
+
+	switch document.Kind {
+	case applyservice.KindCluster:
+		return h.buildCluster(ctx, document)
+	case applyservice.KindCredentialReference:
+		return h.buildCredentialReference(ctx, document)
+	case applyservice.KindQuota:
+		return buildQuota(document)
+	case applyservice.KindStorage:
+		return h.buildStorage(document)
+	default:
+		return h.buildKantaloupeflow(ctx, document)
+	}
+}
+
+// unmarshalSpec decodes the spec of the document, the unknown fields are rejected.
+func unmarshalSpec(document *applyservice.Document, spec proto.Message) error {
+	if len(document.Spec) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(document.Spec, spec); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s spec is invalid: %v", document, err)
+	}
+	return nil
+}
+
+// buildCluster updates the integrated cluster, the kubeconfig is only set by integrating it.
+func (h *ApplyHandler) buildCluster(ctx context.Context, document *applyservice.Document) (*applyservice.Object, error) {
+	if document.Metadata.Namespace != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not namespaced", document)
+	}
+	spec := &applyv1alpha1.ClusterSpec{}
+	if err := unmarshalSpec(document, spec); err != nil {
+		return nil, err
+	}
+	if _, err := h.clusterService.GetCluster(ctx, document.Metadata.Name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is not integrated, integrate it with its kubeconfig first", document)
+		}
+		return nil, err
+	}
+
+	annotations := maps.Clone(document.Metadata.Annotations)
+	if spec.GetAliasName() != "" || spec.GetDescription() != "" {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if spec.GetAliasName() != "" {
+			annotations[constants.ClusterAliasAnnotationKey] = spec.GetAliasName()
+		}
+		if spec.GetDescription() != "" {
+			annotations[constants.ClusterDescriptionAnnotationKey] = spec.GetDescription()
+		}
+	}
+	// only the fields set by the document are owned by the field manager.
+	clusterSpec := map[string]interface{}{}
+	if spec.GetProvider() != clustersv1alpha1.ClusterProvider_CLUSTER_PROVIDER_UNSPECIFIED {
+		clusterSpec["provider"] = spec.GetProvider().String()
+	}
+	if spec.GetType() != clustersv1alpha1.ClusterType_CLUSTER_TYPE_UNSPECIFIED {
+		clusterSpec["type"] = spec.GetType().String()
+	}
+	if spec.GetPrometheusAddress() != "" {
+		clusterSpec["prometheusAddress"] = spec.GetPrometheusAddress()
+	}
+	if spec.GetGatewayAddress() != "" {
+		clusterSpec["gatewayAddress"] = spec.GetGatewayAddress()
+	}
+	if spec.GetDeletionPolicy() != clustersv1alpha1.DeletionPolicy_DELETION_POLICY_UNSPECIFIED {
+		clusterSpec["deletionPolicy"] = string(convertProto2DeletionPolicy(spec.GetDeletionPolicy()))
+	}
+
+	object := newApplyConfiguration(watchservice.ClusterGVR.GroupVersion().String(), clustercrdv1alpha1.ClusterResourceKind, document.Metadata, document.Metadata.Labels, annotations)
+	if len(clusterSpec) > 0 {
+		object.Object["spec"] = clusterSpec
+	}
+	return &applyservice.Object{Kind: document.Kind, Cluster: engine.LocalCluster, Resource: watchservice.ClusterGVR, Object: object}, nil
+}
+
+// buildCredentialReference makes the existing secret of the global cluster a credential.
+func (h *ApplyHandler) buildCredentialReference(ctx context.Context, document *applyservice.Document) (*applyservice.Object, error) {
+	spec := &applyv1alpha1.CredentialReferenceSpec{}
+	if err := unmarshalSpec(document, spec); err != nil {
+		return nil, err
+	}
+	metadata := document.Metadata
+	if metadata.Namespace == "" {
+		metadata.Namespace = namespace.GetCurrentNamespaceOrDefault()
+	}
+
+	secret, err := h.service.Get(ctx, engine.LocalCluster, applyservice.SecretGVR, metadata.Namespace, metadata.Name)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s refers to the missing secret %s/%s", document, metadata.Namespace, metadata.Name)
+	}
+	secretType, _, _ := unstructured.NestedString(secret.Object, "type")
+	data, _, _ := unstructured.NestedMap(secret.Object, "data")
+	labels := maps.Clone(metadata.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[constants.ManagedByLabelKey] = constants.ManagedByLabelValue
+	switch spec.GetType() {
+	case credentialv1alpha1.CredentialType_DOCKER_REGISTRY:
+		if corev1.SecretType(secretType) != corev1.SecretTypeDockerConfigJson {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s refers to the secret of type %s, it must be %s", document, secretType, corev1.SecretTypeDockerConfigJson)
+		}
+		labels[constants.CredentialTypeLabelKey] = constants.CredentialTypeDockerRegistry
+	case credentialv1alpha1.CredentialType_ACCESS_KEY:
+		if data["accessKey"] == nil || data["secretKey"] == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s refers to the secret without accessKey and secretKey", document)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "%s credential type cannot be unspecified", document)
+	}
+
+	object := newApplyConfiguration("v1", "Secret", metadata, labels, metadata.Annotations)
+	return &applyservice.Object{Kind: document.Kind, Cluster: engine.LocalCluster, Resource: applyservice.SecretGVR, Object: object}, nil
+}
+
+func buildQuota(document *applyservice.Document) (*applyservice.Object, error) {
+	spec := &applyv1alpha1.QuotaSpec{}
+	if err := unmarshalSpec(document, spec); err != nil {
+		return nil, err
+	}
+	if len(spec.GetHard()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s hard limits cannot be empty", document)
+	}
+	hard := map[string]interface{}{}
+	for name, value := range spec.GetHard() {
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s quantity %s of %s is invalid: %v", document, value, name, err)
+		}
+		hard[name] = value
+	}
+
+	labels := maps.Clone(document.Metadata.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[constants.ManagedByLabelKey] = constants.ManagedByLabelValue
+	object := newApplyConfiguration("v1", "ResourceQuota", document.Metadata, labels, document.Metadata.Annotations)
+	object.Object["spec"] = map[string]interface{}{"hard": hard}
+	return &applyservice.Object{Kind: document.Kind, Cluster: document.Metadata.Cluster, Resource: applyservice.ResourceQuotaGVR, Object: object}, nil
+}
+
+// buildStorage builds the persistent volume claim of the storage, the NFS and local storages
+// require their persistent volumes.
+func (h *ApplyHandler) buildStorage(document *applyservice.Document) (*applyservice.Object, error) {
+	spec := &applyv1alpha1.StorageSpec{}
+	if err := unmarshalSpec(document, spec); err != nil {
+		return nil, err
+	}
+	if spec.GetStorageSize() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s storage size can not be empty", document)
+	}
+	if spec.GetAccessMode() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s access mode can not be empty", document)
+	}
+	req := &storagev1alpha1.CreateStorageRequest{
+		Cluster:          document.Metadata.Cluster,
+		StorageType:      spec.GetStorageType(),
+		StorageName:      document.Metadata.Name,
+		Namespace:        document.Metadata.Namespace,
+		AccessMode:       spec.GetAccessMode(),
+		StorageSize:      spec.GetStorageSize(),
+		NfsServer:        spec.GetNfsServer(),
+		DataPath:         spec.GetDataPath(),
+		LocalPath:        spec.GetLocalPath(),
+		NodeName:         spec.GetNodeName(),
+		StorageClassName: spec.GetStorageClassName(),
+	}
+
+	var pv *corev1.PersistentVolume
+	var err error
+	switch spec.GetStorageType() {
+	case storagev1alpha1.StorageType_LocalPV:
+		pv, err = h.storage.convertRequestToLocalPV(req)
+	case storagev1alpha1.StorageType_NFS:
+		pv, err = h.storage.convertRequestToNFSPV(req)
+	case storagev1alpha1.StorageType_PVC:
+		if spec.GetStorageClassName() == "" {
+			err = errors.New("storageClass name is required for StorageType_PVC")
+		}
+	default:
+		err = errors.New("storageType is required and cannot be unspecified")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is invalid: %v", document, err)
+	}
+
+	storageClassName := ""
+	if pv != nil {
+		storageClassName = pv.Spec.StorageClassName
+	}
+	pvc, err := h.storage.convertRequestToPVC(req, storageClassName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is invalid: %v", document, err)
+	}
+	pvc.Labels[constants.ManagedByLabelKey] = constants.ManagedByLabelValue
+	maps.Copy(pvc.Labels, document.Metadata.Labels)
+	pvc.Annotations = maps.Clone(document.Metadata.Annotations)
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[constants.StorageTypeKey] = spec.GetStorageType().String()
+
+	object := &applyservice.Object{Kind: document.Kind, Cluster: document.Metadata.Cluster, Resource: applyservice.PersistentVolumeClaimGVR}
+	if pv != nil {
+		pvc.Spec.VolumeName = pv.Name
+		pvObject, err := applyservice.ToApplyConfiguration(pv, corev1.SchemeGroupVersion.WithKind("PersistentVolume"))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert the persistent volume of %s: %v", document, err)
+		}
+		object.Requires = []*applyservice.Object{{Kind: document.Kind, Cluster: document.Metadata.Cluster, Resource: applyservice.PersistentVolumeGVR, Object: pvObject}}
+	}
+	object.Object, err = applyservice.ToApplyConfiguration(pvc, corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert %s: %v", document, err)
+	}
+	return object, nil
+}
+
+// buildKantaloupeflow builds the kantaloupeflow, the secrets generated for the plugins are
+// kept from the live kantaloupeflow.
+func (h *ApplyHandler) buildKantaloupeflow(ctx context.Context, document *applyservice.Document) (*applyservice.Object, error) {
+	metadata := document.Metadata
+	if errs := validation.IsDNS1035Label(metadata.Name); len(errs) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "kantaloupeflow name %s is invalid, error: %s", metadata.Name, errs)
+	}
+	spec := &flowv1alpha1.KantaloupeflowSpec{}
+	if err := unmarshalSpec(document, spec); err != nil {
+		return nil, err
+	}
+	if len(spec.GetTemplate().GetSpec().GetContainers()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s podTemplate can not be emplty", document)
+	}
+	// TODO: Frontend dont have this paremeter yet. Default using deployment.
+	spec.Workload = flowv1alpha1.WorkloadType_Deployment
+
+	cluster, err := h.clusterService.GetCluster(ctx, metadata.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	live, err := h.flowService.GetKantaloupeflow(ctx, metadata.Cluster, metadata.Namespace, metadata.Name)
+	if k8serrors.IsNotFound(err) {
+		live, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if live == nil && cluster.Spec.Maintenance {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %s is in maintenance, no new kantaloupeflows are accepted", metadata.Cluster)
+	}
+
+	flow := ConvertProto2Kantaloupeflow(&flowv1alpha1.Kantaloupeflow{
+		Metadata: &types.ObjectMeta{
+			Name:        metadata.Name,
+			Namespace:   metadata.Namespace,
+			Labels:      metadata.Labels,
+			Annotations: metadata.Annotations,
+		},
+		Spec: spec,
+	})
+	if err := kfservice.ValidateCapabilities(cluster, flow); err != nil {
+		return nil, err
+	}
+	if err := patchByProvider(flow, cluster.Spec.Provider); err != nil {
+		return nil, err
+	}
+	kfservice.PreparePlugins(flow, live)
+
+	object, err := applyservice.ToApplyConfiguration(flow, watchservice.KantaloupeflowGVR.GroupVersion().WithKind(flowcrdv1alpha1.KantaloupeFlowResourceKind))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert %s: %v", document, err)
+	}
+	return &applyservice.Object{Kind: document.Kind, Cluster: metadata.Cluster, Resource: watchservice.KantaloupeflowGVR, Object: object}, nil
+}
+
+// newApplyConfiguration returns the apply configuration with the metadata of the document.
+func newApplyConfiguration(apiVersion, kind string, metadata applyservice.Metadata, labels, annotations map[string]string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetName(metadata.Name)
+	if metadata.Namespace != "" {
+		object.SetNamespace(metadata.Namespace)
+	}
+	if len(labels) > 0 {
+		object.SetLabels(labels)
+	}
+	if len(annotations) > 0 {
+		object.SetAnnotations(annotations)
+	}
+	return object
+}
+
+var applyActions = map[applyservice.Action]applyv1alpha1.ApplyAction{
+	applyservice.ActionCreated:    applyv1alpha1.ApplyAction_CREATED,
+	applyservice.ActionConfigured: applyv1alpha1.ApplyAction_CONFIGURED,
+	applyservice.ActionUnchanged:  applyv1alpha1.ApplyAction_UNCHANGED,
+	applyservice.ActionPruned:     applyv1alpha1.ApplyAction_PRUNED,
+}
+
+func convertApplyResults2Proto(results []*applyservice.Result) []*applyv1alpha1.ApplyResult {
+	items := make([]*applyv1alpha1.ApplyResult, 0, len(results))
+	for _, result := range results {
+		item := &applyv1alpha1.ApplyResult{
+			Kind:      result.Object.Kind,
+			Cluster:   result.Object.Cluster,
+			Namespace: result.Object.Object.GetNamespace(),
+			Name:      result.Object.Object.GetName(),
+			Diff:      result.Diff,
+		}
+		if result.Object.Kind == applyservice.KindCluster {
+			item.Cluster = ""
+		}
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+		} else {
+			item.Action = applyActions[result.Action]
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,50 @@
+package bff
+
+import (
+	"context"
+	"time"
+
+	auditv1alpha1 "github.com/dynamia-ai/kantaloupe/api/audit/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+)
+
+var _ kantaloupeapi.AuditServer = &AuditHandler{}
+
+// AuditHandler handles audit related API requests.
+type AuditHandler struct {
+	store *audit.Store
+	kantaloupeapi.UnimplementedAuditServer
+}
+
+// NewAuditHandler creates a new audit handler.
+func NewAuditHandler(store *audit.Store) *AuditHandler {
+	return &AuditHandler{
+		store: store,
+	}
+}
+
+// ListAuditEvents lists the audit events with optional filtering.
+func (h *AuditHandler) ListAuditEvents(_ context.Context, req *auditv1alpha1.ListAuditEventsRequest) (*auditv1alpha1.ListAuditEventsResponse, error) {
+	filter := audit.Filter{
+		User:      req.GetUser(),
+		Cluster:   req.GetCluster(),
+		Namespace: req.GetNamespace(),
+		Method:    req.GetMethod(),
+	}
+	if req.GetStartTime() > 0 {
+		filter.Start = time.UnixMilli(req.GetStartTime())
+	}
+	if req.GetEndTime() > 0 {
+		filter.End = time.UnixMilli(req.GetEndTime())
+	}
+
+	events := h.store.List(filter)
+	paged := utils.PagedItems(events, req.GetPage(), req.GetPageSize())
+
+	return &auditv1alpha1.ListAuditEventsResponse{
+		Items:      convertAuditEvents2Proto(paged),
+		Pagination: utils.NewPage(req.GetPage(), req.GetPageSize(), len(events)),
+	}, nil
+}
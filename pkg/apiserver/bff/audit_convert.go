@@ -0,0 +1,29 @@
+package bff
+
+import (
+	auditv1alpha1 "github.com/dynamia-ai/kantaloupe/api/audit/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+)
+
+func convertAuditEvents2Proto(events []*audit.Event) []*auditv1alpha1.AuditEvent {
+	items := make([]*auditv1alpha1.AuditEvent, 0, len(events))
+	for _, event := range events {
+		items = append(items, &auditv1alpha1.AuditEvent{
+			Id:                  event.ID,
+			Timestamp:           event.Timestamp.UnixMilli(),
+			User:                event.User,
+			Groups:              event.Groups,
+			Method:              event.Method,
+			Verb:                event.Verb,
+			Cluster:             event.Cluster,
+			Namespace:           event.Namespace,
+			Name:                event.Name,
+			Request:             string(event.Request),
+			Code:                event.Code,
+			Message:             event.Message,
+			LatencyMilliseconds: event.LatencyMilliseconds,
+			Replayed:            event.Replayed,
+		})
+	}
+	return items
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"net/url"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -12,8 +13,10 @@ import (
 	prometheusmodel "github.com/prometheus/common/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
@@ -21,12 +24,17 @@ import (
 	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
 	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
 	monitoringv1alpha1 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/cluster"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/core"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/fanout"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/monitoring"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/operation"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/helper"
 )
@@ -48,14 +56,19 @@ type ClusterHandler struct {
 	clusterService    cluster.Service
 	coreService       core.Service
 	monitoringService monitoring.Service
+	watchService      watchservice.Service
+	operations        *operation.Manager
 }
 
-// NewClusterHandler new a cluster handler by client manager.
-func NewClusterHandler(clientManager engine.ClientManagerInterface, prometheus engine.PrometheusInterface) *ClusterHandler {
+// NewClusterHandler new a cluster handler by client manager, the async RPCs run in the
+// operations of the manager.
+func NewClusterHandler(clientManager engine.ClientManagerInterface, prometheus engine.PrometheusInterface, operations *operation.Manager) *ClusterHandler {
 	return &ClusterHandler{
 		clusterService:    cluster.NewService(clientManager),
 		coreService:       core.NewService(clientManager),
 		monitoringService: monitoring.NewService(prometheus),
+		watchService:      watchservice.NewService(clientManager),
+		operations:        operations,
 	}
 }
 
@@ -75,6 +88,9 @@ func (h *ClusterHandler) ListClusters(
 	// 2. sort all clusters.
 	// 3. page all clusters.
 	filtered := filterClusters(clusters, req.GetName(), req.GetType().String(), req.GetState().String(), req.GetProvider().String())
+	filtered = slices.DeleteFunc(filtered, func(cluster *clustercrdv1alpha1.Cluster) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionViewCluster, cluster.Name, "")
+	})
 	// make local-cluster the first one
 	for i, cluster := range filtered {
 		if cluster.Name == "local-cluster" {
@@ -104,6 +120,37 @@ func (h *ClusterHandler) ListClusters(
 	}, nil
 }
 
+// WatchClusters streams the changes of the clusters, the clusters are not enriched with
+// the metrics.
+func (h *ClusterHandler) WatchClusters(req *clustersv1alpha1.WatchClustersRequest, stream kantaloupeapi.Cluster_WatchClustersServer) error {
+	ctx := stream.Context()
+	opts, err := newWatchOptions(ctx, "", req.GetLabelSelector(), req.GetResourceVersion())
+	if err != nil {
+		return err
+	}
+	opts.Filter = func(obj *unstructured.Unstructured) bool {
+		return utils.MatchByFuzzyName(obj, req.GetName()) &&
+			authorization.Allowed(ctx, authorization.PermissionViewCluster, obj.GetName(), "")
+	}
+
+	// the clusters are stored in the global cluster.
+	err = h.watchService.Watch(ctx, engine.LocalCluster, watchservice.ClusterGVR, opts, func(event *watchservice.Event) error {
+		resp := &clustersv1alpha1.WatchClustersResponse{
+			Type:            convertWatchEventType(event.Type),
+			ResourceVersion: event.ResourceVersion,
+		}
+		if event.Object != nil {
+			cluster, err := fromUnstructured[clustercrdv1alpha1.Cluster](event.Object)
+			if err != nil {
+				return err
+			}
+			resp.Object = ConvertCluster2Proto(cluster, nil)
+		}
+		return stream.Send(resp)
+	})
+	return watchError(err)
+}
+
 func filterClusters(clusters []clustercrdv1alpha1.Cluster, fuzzyName, clusterType, clusterState, provider string) []*clustercrdv1alpha1.Cluster {
 	res := []*clustercrdv1alpha1.Cluster{}
 
@@ -115,18 +162,18 @@ func filterClusters(clusters []clustercrdv1alpha1.Cluster, fuzzyName, clusterTyp
 		}
 
 		if clusterState != "" && clusterState != clustersv1alpha1.ClusterState_UNSPECIFED.String() {
-			state := convertCondition2State(cluster.Status)
+			state := convertCluster2State(&cluster)
 			if clusterState != "" && clusterState != state.String() {
 				continue
 			}
 		}
 		if clusterType != "" && clusterType != clustersv1alpha1.ClusterType_CLUSTER_TYPE_UNSPECIFIED.String() {
-			if clusterType != cluster.Spec.Type {
+			if !slices.Contains(getClusterAcceleratorTypes(&cluster), clusterType) {
 				continue
 			}
 		}
 		if provider != "" && provider != clustersv1alpha1.ClusterProvider_CLUSTER_PROVIDER_UNSPECIFIED.String() {
-			if provider != cluster.Spec.Provider {
+			if provider != getClusterProvider(&cluster) {
 				continue
 			}
 		}
@@ -135,59 +182,52 @@ func filterClusters(clusters []clustercrdv1alpha1.Cluster, fuzzyName, clusterTyp
 	return res
 }
 
+// enrichClustersWithMetrics converts the clusters with their metrics, the metrics of the ready
+// clusters are queried in parallel. The metrics degrade to empty ones on errors, so a cluster
+// is never left out of the list.
 func (h *ClusterHandler) enrichClustersWithMetrics(ctx context.Context, clusters []*clustercrdv1alpha1.Cluster) []*clustersv1alpha1.Cluster {
-	type clusterResult struct {
-		index   int
-		cluster *clustersv1alpha1.Cluster
-		err     error
+	names := make([]string, 0, len(clusters))
+	byName := make(map[string]*clustercrdv1alpha1.Cluster, len(clusters))
+	for _, c := range clusters {
+		names = append(names, c.Name)
+		byName[c.Name] = c
 	}
 
-	resultChan := make(chan clusterResult, len(clusters))
-	semaphore := make(chan struct{}, 10)
-
-	for i, cluster := range clusters {
-		go func(idx int, c *clustercrdv1alpha1.Cluster) {
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
-
-			result := clusterResult{index: idx}
-
-			if !helper.IsClusterReady(&c.Status) {
-				result.cluster = ConvertCluster2Proto(c, nil)
-				resultChan <- result
-				return
-			}
-
-			metric, gpuTotal := h.getClusterMetricsAndGPU(ctx, c)
+	results := fanout.Run(ctx, names, fanout.Options{}, func(ctx context.Context, name string) (*clustersv1alpha1.Cluster, error) {
+		c := byName[name]
+		if !helper.IsClusterReady(&c.Status) {
+			return ConvertCluster2Proto(c, nil), nil
+		}
 
-			protoCluster := ConvertCluster2Proto(c, &metric)
-			protoCluster.Status.GpuTotal = gpuTotal
+		metric := h.getClusterMetrics(ctx, c)
 
-			result.cluster = protoCluster
-			resultChan <- result
-		}(i, cluster)
-	}
+		protoCluster := ConvertCluster2Proto(c, &metric)
+		// clusters synced by an older controller have no gpu summary yet.
+		if c.Status.GPUSummary == nil {
+			protoCluster.Status.GpuTotal = h.queryClusterGPUTotal(ctx, c)
+		}
+		return protoCluster, nil
+	})
 
 	items := make([]*clustersv1alpha1.Cluster, len(clusters))
-	for range clusters {
-		result := <-resultChan
-		if result.err != nil {
-			klog.ErrorS(result.err, "failed to get cluster metrics", "cluster", clusters[result.index].Name)
+	for i, result := range results {
+		if result.Err != nil {
+			klog.ErrorS(result.Err, "failed to get cluster metrics", "cluster", result.Cluster)
+			result.Value = ConvertCluster2Proto(clusters[i], nil)
 		}
-		items[result.index] = result.cluster
+		items[i] = result.Value
 	}
 
 	return items
 }
 
-func (h *ClusterHandler) getClusterMetricsAndGPU(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) (clusterMetric, int32) {
+func (h *ClusterHandler) getClusterMetrics(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) clusterMetric {
 	type metricResult struct {
 		resourceType monitoringv1alpha1.ResourceType
 		usedVec      prometheusmodel.Vector
 		allocatedVec prometheusmodel.Vector
 	}
 
-	var gpuTotal int32
 	metric := clusterMetric{}
 	metricChan := make(chan metricResult, 4)
 
@@ -240,29 +280,25 @@ func (h *ClusterHandler) getClusterMetricsAndGPU(ctx context.Context, cluster *c
 		}(rt)
 	}
 
-	gpuChan := make(chan int32, 1)
-	go func() {
-		gpuNum, err := h.monitoringService.QueryGPUVector(ctx, cluster.Name, "", "", "", monitoring.GPUQueryTypeCount)
-		if err != nil {
-			klog.V(4).ErrorS(err, "failed to query GPU count", "cluster", cluster.GetName())
-			gpuChan <- 0
-			return
-		}
-
-		if len(gpuNum) > 0 {
-			gpuChan <- int32(gpuNum[0].Value)
-		} else {
-			gpuChan <- 0
-		}
-	}()
-
 	for range resourceTypes {
 		result := <-metricChan
 		metric.Save(result.resourceType, result.usedVec, result.allocatedVec)
 	}
 
-	gpuTotal = <-gpuChan
-	return metric, gpuTotal
+	return metric
+}
+
+// queryClusterGPUTotal queries the gpu count of the cluster from prometheus.
+func (h *ClusterHandler) queryClusterGPUTotal(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) int32 {
+	gpuNum, err := h.monitoringService.QueryGPUVector(ctx, cluster.Name, "", "", "", monitoring.GPUQueryTypeCount)
+	if err != nil {
+		klog.V(4).ErrorS(err, "failed to query GPU count", "cluster", cluster.GetName())
+		return 0
+	}
+	if len(gpuNum) > 0 {
+		return int32(gpuNum[0].Value)
+	}
+	return 0
 }
 
 // IntegrateCluster integrates a cluster.
@@ -270,34 +306,64 @@ func (h *ClusterHandler) IntegrateCluster(
 	ctx context.Context,
 	req *clustersv1alpha1.IntegrateClusterRequest,
 ) (*clustersv1alpha1.Cluster, error) {
+	if err := h.validateIntegrateCluster(ctx, req); err != nil {
+		return nil, err
+	}
+
+	created, err := h.clusterService.CreateCluster(ctx, buildClusterFromRequest(req), req.GetKubeConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertCluster2Proto(created, nil), nil
+}
+
+// IntegrateClusterAsync validates the request and integrates the cluster in an operation.
+func (h *ClusterHandler) IntegrateClusterAsync(
+	ctx context.Context,
+	req *clustersv1alpha1.IntegrateClusterRequest,
+) (*operationsv1alpha1.Operation, error) {
+	if err := h.validateIntegrateCluster(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return startOperation(ctx, h.operations, req.GetName(), func(ctx context.Context) (proto.Message, error) {
+		created, err := h.clusterService.CreateCluster(ctx, buildClusterFromRequest(req), req.GetKubeConfig())
+		if err != nil {
+			return nil, err
+		}
+		return ConvertCluster2Proto(created, nil), nil
+	})
+}
+
+// validateIntegrateCluster validates the request and whether the cluster and the Prometheus
+// are reachable.
+func (h *ClusterHandler) validateIntegrateCluster(ctx context.Context, req *clustersv1alpha1.IntegrateClusterRequest) error {
 	// validate the important params.
 	if req.GetKubeConfig() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "the kubeconfig can not be empty")
+		return status.Errorf(codes.InvalidArgument, "the kubeconfig can not be empty")
 	}
 	if errs := validation.IsDNS1035Label(req.GetName()); len(errs) != 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "cluster name %s is invalid, error: %s", req.GetName(), errs)
+		return status.Errorf(codes.InvalidArgument, "cluster name %s is invalid, error: %s", req.GetName(), errs)
 	}
 	if !utils.IsValidLabelNames(req.GetLabels()) {
-		return nil, status.Errorf(codes.InvalidArgument, "cluster label %s is invalid", req.GetLabels())
+		return status.Errorf(codes.InvalidArgument, "cluster label %s is invalid", req.GetLabels())
 	}
 	if !utils.IsValidAnnotationNames(req.GetAnnotations()) {
-		return nil, status.Errorf(codes.InvalidArgument, "cluster annotation %s is invalid", req.GetAnnotations())
-	}
-	if req.GetType() == clustersv1alpha1.ClusterType_CLUSTER_TYPE_UNSPECIFIED {
-		return nil, status.Errorf(codes.InvalidArgument, "cluster type must be specified")
+		return status.Errorf(codes.InvalidArgument, "cluster annotation %s is invalid", req.GetAnnotations())
 	}
 
 	// validate whether the kubeconfig is valid.
 	valid, err := h.clusterService.ValidateKubeconfig(ctx, req.GetKubeConfig())
 	if err != nil || !valid {
-		return nil, status.Errorf(codes.InvalidArgument, "the kubeconfig is invalid, err: %v", err)
+		return status.Errorf(codes.InvalidArgument, "the kubeconfig is invalid, err: %v", err)
 	}
 
 	// validate whether the prometheusAddress is valid if provided.
 	if req.GetPrometheusAddress() != "" {
 		valid, err := h.clusterService.ValidatePrometheusAddress(ctx, req.GetPrometheusAddress())
 		if err != nil || !valid {
-			return nil, status.Errorf(codes.InvalidArgument, "the prometheus address is invalid, err: %v", err)
+			return status.Errorf(codes.InvalidArgument, "the prometheus address is invalid, err: %v", err)
 		}
 	}
 
@@ -305,16 +371,11 @@ func (h *ClusterHandler) IntegrateCluster(
 	if req.GetGatewayAddress() != "" {
 		_, err := url.Parse(req.GetGatewayAddress())
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "the gateway address is invalid, err: %v", err)
+			return status.Errorf(codes.InvalidArgument, "the gateway address is invalid, err: %v", err)
 		}
 	}
 
-	created, err := h.clusterService.CreateCluster(ctx, buildClusterFromRequest(req), req.GetKubeConfig())
-	if err != nil {
-		return nil, err
-	}
-
-	return ConvertCluster2Proto(created, nil), nil
+	return nil
 }
 
 // GetCluster gets the details of the specified cluster.
@@ -331,12 +392,14 @@ func (h *ClusterHandler) GetCluster(
 		klog.ErrorS(err, "failed to get cluster")
 		return nil, err
 	}
-	var gpuNum prometheusmodel.Vector
-	var gpuMem prometheusmodel.Vector
-
-	gpuNum, _ = h.monitoringService.QueryGPUVector(context.TODO(), req.GetName(), "", "", "", monitoring.GPUQueryTypeCount)
-	gpuMem, _ = h.monitoringService.QueryCluster(context.TODO(), req.GetName(), monitoring.QueryTypeGPUMemoryTotal)
 	ret := ConvertCluster2Proto(cluster, nil)
+	if cluster.Status.GPUSummary != nil {
+		return ret, nil
+	}
+
+	// fall back to prometheus if the cluster has not been summarized by the controller.
+	gpuNum, _ := h.monitoringService.QueryGPUVector(ctx, req.GetName(), "", "", "", monitoring.GPUQueryTypeCount)
+	gpuMem, _ := h.monitoringService.QueryCluster(ctx, req.GetName(), monitoring.QueryTypeGPUMemoryTotal)
 	if gpuNum.Len() > 0 {
 		ret.Status.GpuTotal = int32(gpuNum[0].Value)
 	}
@@ -387,6 +450,9 @@ func (h *ClusterHandler) UpdateCluster(ctx context.Context, req *clustersv1alpha
 	if req.GetGatewayAddress() != "" {
 		cluster.Spec.GatewayAddress = req.GetGatewayAddress()
 	}
+	if req.GetDeletionPolicy() != clustersv1alpha1.DeletionPolicy_DELETION_POLICY_UNSPECIFIED {
+		cluster.Spec.DeletionPolicy = convertProto2DeletionPolicy(req.GetDeletionPolicy())
+	}
 	if _, err := h.clusterService.UpdateCluster(ctx, cluster, req.GetKubeConfig()); err != nil {
 		return nil, err
 	}
@@ -399,6 +465,21 @@ func (h *ClusterHandler) DeleteCluster(ctx context.Context, req *clustersv1alpha
 		return nil, status.Errorf(codes.InvalidArgument, "cluster name %s is invalid, error: %s", req.GetName(), errs)
 	}
 
+	// the deletion policy must be persisted before the deletion, the controller cleans up by it.
+	if req.GetDeletionPolicy() != clustersv1alpha1.DeletionPolicy_DELETION_POLICY_UNSPECIFIED {
+		cluster, err := h.clusterService.GetCluster(ctx, req.GetName())
+		if err != nil {
+			return &emptypb.Empty{}, err
+		}
+		if policy := convertProto2DeletionPolicy(req.GetDeletionPolicy()); cluster.Spec.DeletionPolicy != policy {
+			cluster.Spec.DeletionPolicy = policy
+			if _, err := h.clusterService.UpdateCluster(ctx, cluster, ""); err != nil {
+				klog.ErrorS(err, "failed to update deletion policy of cluster", "cluster", req.GetName())
+				return &emptypb.Empty{}, err
+			}
+		}
+	}
+
 	if err := h.clusterService.DeleteCluster(ctx, req.GetName()); err != nil {
 		klog.ErrorS(err, "failed to delete cluster")
 		return &emptypb.Empty{}, err
@@ -407,6 +488,46 @@ func (h *ClusterHandler) DeleteCluster(ctx context.Context, req *clustersv1alpha
 	return &emptypb.Empty{}, nil
 }
 
+// UpdateClusterMaintenance makes the specified cluster enter or leave maintenance mode,
+// the cluster controller cordons the nodes and drains the kantaloupeflows by the policy.
+func (h *ClusterHandler) UpdateClusterMaintenance(
+	ctx context.Context,
+	req *clustersv1alpha1.UpdateClusterMaintenanceRequest,
+) (*clustersv1alpha1.Cluster, error) {
+	if errs := validation.IsDNS1035Label(req.GetName()); len(errs) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster name %s is invalid, error: %s", req.GetName(), errs)
+	}
+
+	policy := convertProto2MaintenancePolicy(req.GetPolicy())
+	if req.GetMaintenance() && policy != nil && policy.DrainStrategy == clustercrdv1alpha1.DrainStrategyMigrate {
+		if policy.TargetCluster == "" || policy.TargetCluster == req.GetName() {
+			return nil, status.Errorf(codes.InvalidArgument, "a target cluster other than %s is required to migrate kantaloupeflows", req.GetName())
+		}
+		target, err := h.clusterService.GetCluster(ctx, policy.TargetCluster)
+		if err != nil {
+			return nil, err
+		}
+		if convertCluster2State(target) != clustersv1alpha1.ClusterState_RUNNING {
+			return nil, status.Errorf(codes.FailedPrecondition, "target cluster %s is not running", policy.TargetCluster)
+		}
+	}
+
+	cluster, err := h.clusterService.GetCluster(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cluster.Spec.Maintenance = req.GetMaintenance()
+	if req.GetMaintenance() {
+		cluster.Spec.MaintenancePolicy = policy
+	}
+	cluster, err = h.clusterService.UpdateCluster(ctx, cluster, "")
+	if err != nil {
+		klog.ErrorS(err, "failed to update cluster maintenance", "cluster", req.GetName())
+		return nil, err
+	}
+	return ConvertCluster2Proto(cluster, nil), nil
+}
+
 // ValidateKubeconfig validats whether the config is valid.
 func (h *ClusterHandler) ValidateKubeconfig(
 	ctx context.Context,
@@ -424,6 +545,9 @@ func (h *ClusterHandler) ValidateKubeconfig(
 	return &clustersv1alpha1.ValidateKubeconfigResponse{Validate: valid}, nil
 }
 
+// GetPlatformSummury summarizes the clusters and their accelerator cards. The cards of the
+// running clusters are queried in parallel, the clusters failing to answer are reported in
+// ClusterErrors and their cards are left out of the summary.
 func (h *ClusterHandler) GetPlatformSummury(
 	ctx context.Context,
 	req *clustersv1alpha1.GetPlatformSummuryRequest,
@@ -437,7 +561,13 @@ func (h *ClusterHandler) GetPlatformSummury(
 		AcceleratorCardSummury: []*clustersv1alpha1.AcceleratorCardSummury{},
 	}
 
-	temp := map[string]*clustersv1alpha1.AcceleratorCardSummury{}
+	// set threshold to default value.
+	threshold := req.GetThreshold()
+	if threshold <= 0 {
+		threshold = 5
+	}
+
+	running := []string{}
 	for _, cluster := range clusters {
 		resp.ClusterNum += 1
 		resp.NodeNum += cluster.Status.NodeSummary.TotalNum
@@ -445,61 +575,26 @@ func (h *ClusterHandler) GetPlatformSummury(
 		if convertCondition2State(cluster.Status) != clustersv1alpha1.ClusterState_RUNNING {
 			continue
 		}
+		running = append(running, cluster.Name)
+	}
 
-		var coreUsedVec prometheusmodel.Vector
-		// TODO: use goroutine
-		coreUsedVec, err = h.monitoringService.QueryGPUVector(ctx, cluster.Name, "", "", "", monitoring.GPUQueryTypeCoreUsed)
-		if err != nil {
-			return nil, err
-		}
-		resp.AcceleratorCardNum += int32(len(coreUsedVec))
-		memoryUsedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryUsed)
-		if err != nil {
-			return nil, err
-		}
-		memoryAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryAllocated)
-		if err != nil {
-			return nil, err
-		}
-		coreAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeCoreAllocated)
-		if err != nil {
-			return nil, err
-		}
-		memoryTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryTotal)
-		if err != nil {
-			return nil, err
-		}
-		// set threshold to default value.
-		if req.GetThreshold() <= 0 {
-			req.Threshold = 5
-		}
-		for _, vec := range coreUsedVec {
-			uuid := string(vec.Metric[prometheusmodel.LabelName("UUID")])
-			mode := string(vec.Metric["modelName"])
+	results := fanout.Run(ctx, running, fanout.Options{}, func(ctx context.Context, cluster string) (map[string]*clustersv1alpha1.AcceleratorCardSummury, error) {
+		return h.summarizeClusterCards(ctx, cluster, threshold)
+	})
+
+	temp := map[string]*clustersv1alpha1.AcceleratorCardSummury{}
+	for _, result := range results {
+		for mode, summury := range result.Value {
+			resp.AcceleratorCardNum += summury.TotalNum
 			if _, ok := temp[mode]; !ok {
 				temp[mode] = &clustersv1alpha1.AcceleratorCardSummury{Mode: mode}
 			}
-			var memoryUsage prometheusmodel.SampleValue
-			_, ok1 := memoryUsedMap[uuid]
-			_, ok2 := memoryTotalMap[uuid]
-			if ok1 && ok2 {
-				memoryUsage = memoryUsedMap[uuid].Value / memoryTotalMap[uuid].Value * 100
-			}
-
-			var idel, used int32
-			_, ok1 = memoryAllocatedMap[uuid]
-			_, ok2 = coreAllocatedMap[uuid]
-			if ok1 && ok2 && (memoryAllocatedMap[uuid].Value > 0 || coreAllocatedMap[uuid].Value > 0) {
-				used++
-				if vec.Value < prometheusmodel.SampleValue(req.GetThreshold()) && memoryUsage < prometheusmodel.SampleValue(req.GetThreshold()) {
-					idel++
-				}
-			}
-			temp[mode].IdelNum += idel
-			temp[mode].UseageNum += used
-			temp[mode].TotalNum++
+			temp[mode].IdelNum += summury.IdelNum
+			temp[mode].UseageNum += summury.UseageNum
+			temp[mode].TotalNum += summury.TotalNum
 		}
 	}
+	resp.ClusterErrors = convertClusterErrors2Proto(results)
 
 	for _, val := range temp {
 		resp.AcceleratorCardSummury = append(resp.AcceleratorCardSummury, val)
@@ -513,6 +608,62 @@ func (h *ClusterHandler) GetPlatformSummury(
 	return resp, nil
 }
 
+// summarizeClusterCards summarizes the accelerator cards of the cluster by their modes, a card
+// is idle if it is allocated but its core and memory usage are under the threshold.
+func (h *ClusterHandler) summarizeClusterCards(ctx context.Context, cluster string, threshold int32) (map[string]*clustersv1alpha1.AcceleratorCardSummury, error) {
+	coreUsedVec, err := h.monitoringService.QueryGPUVector(ctx, cluster, "", "", "", monitoring.GPUQueryTypeCoreUsed)
+	if err != nil {
+		return nil, err
+	}
+	memoryUsedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryUsed)
+	if err != nil {
+		return nil, err
+	}
+	memoryAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryAllocated)
+	if err != nil {
+		return nil, err
+	}
+	coreAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeCoreAllocated)
+	if err != nil {
+		return nil, err
+	}
+	memoryTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	temp := map[string]*clustersv1alpha1.AcceleratorCardSummury{}
+	for _, vec := range coreUsedVec {
+		uuid := string(vec.Metric[prometheusmodel.LabelName("UUID")])
+		mode := string(vec.Metric["modelName"])
+		if _, ok := temp[mode]; !ok {
+			temp[mode] = &clustersv1alpha1.AcceleratorCardSummury{Mode: mode}
+		}
+		var memoryUsage prometheusmodel.SampleValue
+		_, ok1 := memoryUsedMap[uuid]
+		_, ok2 := memoryTotalMap[uuid]
+		if ok1 && ok2 {
+			memoryUsage = memoryUsedMap[uuid].Value / memoryTotalMap[uuid].Value * 100
+		}
+
+		var idel, used int32
+		_, ok1 = memoryAllocatedMap[uuid]
+		_, ok2 = coreAllocatedMap[uuid]
+		if ok1 && ok2 && (memoryAllocatedMap[uuid].Value > 0 || coreAllocatedMap[uuid].Value > 0) {
+			used++
+			if vec.Value < prometheusmodel.SampleValue(threshold) && memoryUsage < prometheusmodel.SampleValue(threshold) {
+				idel++
+			}
+		}
+		temp[mode].IdelNum += idel
+		temp[mode].UseageNum += used
+		temp[mode].TotalNum++
+	}
+	return temp, nil
+}
+
+// GetPlatformGPUTop ranks the gpu models of the running clusters by their average usage. The
+// clusters are queried in parallel, the ones failing to answer are reported in ClusterErrors.
 func (h *ClusterHandler) GetPlatformGPUTop(
 	ctx context.Context,
 	req *clustersv1alpha1.GetPlatformGPUTopRequest,
@@ -522,67 +673,26 @@ func (h *ClusterHandler) GetPlatformGPUTop(
 		return nil, err
 	}
 
-	temp := map[string]*clustersv1alpha1.GPUSummary{}
+	running := []string{}
 	for _, cluster := range clusters {
 		if convertCondition2State(cluster.Status) != clustersv1alpha1.ClusterState_RUNNING {
 			continue
 		}
-		// Query all needed metrics and parse to map keyed by uuid.
-		memUsedVec, err := h.monitoringService.QueryGPUVector(ctx, cluster.Name, "", "", "", monitoring.GPUQueryTypeMemoryUsed)
-		if err != nil {
-			return nil, err
-		}
-		memAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryAllocated)
-		if err != nil {
-			return nil, err
-		}
-		coreUsedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeCoreUsed)
-		if err != nil {
-			return nil, err
-		}
-		coreAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeCoreAllocated)
-		if err != nil {
-			return nil, err
-		}
-		memTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryTotal)
-		if err != nil {
-			return nil, err
-		}
-		coreTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster.Name, "", "", "", "UUID", monitoring.GPUQueryTypeCoreTotal)
-		if err != nil {
-			return nil, err
-		}
-		// Summary of metrics by modelname.
-		for i := range memUsedVec {
-			mode := string(memUsedVec[i].Metric["modelName"])
-			uuid := string(memUsedVec[i].Metric["UUID"])
-			if _, ok := temp[mode]; !ok {
-				temp[mode] = &clustersv1alpha1.GPUSummary{
-					Model:         mode,
-					Total:         0,
-					MemAllocated:  0,
-					MemUsage:      0,
-					CoreAllocated: 0,
-					CoreUsage:     0,
-				}
-			}
+		running = append(running, cluster.Name)
+	}
+	results := fanout.Run(ctx, running, fanout.Options{}, h.sumClusterGPUs)
 
-			memTotal, ok1 := memTotalMap[uuid]
-			coreTotal, ok2 := coreTotalMap[uuid]
-			if !ok1 || !ok2 || memTotal.Value == 0 || coreTotal.Value == 0 {
-				continue
-			}
-			temp[mode].Total += 1
-			temp[mode].MemUsage += float64(memUsedVec[i].Value / memTotal.Value)
-			if sample, ok := memAllocatedMap[uuid]; ok && sample != nil {
-				temp[mode].MemAllocated += float64(sample.Value / memTotal.Value)
-			}
-			if sample, ok := coreUsedMap[uuid]; ok && sample != nil {
-				temp[mode].CoreUsage += float64(sample.Value) / float64(coreTotal.Value) * 100
-			}
-			if sample, ok := coreAllocatedMap[uuid]; ok && sample != nil {
-				temp[mode].CoreAllocated += float64(sample.Value) / float64(coreTotal.Value) * 100
+	temp := map[string]*clustersv1alpha1.GPUSummary{}
+	for _, result := range results {
+		for mode, sum := range result.Value {
+			if _, ok := temp[mode]; !ok {
+				temp[mode] = &clustersv1alpha1.GPUSummary{Model: mode}
 			}
+			temp[mode].Total += sum.Total
+			temp[mode].MemUsage += sum.MemUsage
+			temp[mode].MemAllocated += sum.MemAllocated
+			temp[mode].CoreUsage += sum.CoreUsage
+			temp[mode].CoreAllocated += sum.CoreAllocated
 		}
 	}
 	// Calculate the average of each mode.
@@ -614,7 +724,64 @@ func (h *ClusterHandler) GetPlatformGPUTop(
 	if req.GetTopn() > 0 && req.GetTopn() < int32(len(gpus)) {
 		gpus = gpus[:req.GetTopn()]
 	}
-	return &clustersv1alpha1.GetPlatformGPUTopResponse{Gpus: gpus}, nil
+	return &clustersv1alpha1.GetPlatformGPUTopResponse{Gpus: gpus, ClusterErrors: convertClusterErrors2Proto(results)}, nil
+}
+
+// sumClusterGPUs sums the usage of the gpus of the cluster by their models, the sums are
+// averaged across the clusters by GetPlatformGPUTop.
+func (h *ClusterHandler) sumClusterGPUs(ctx context.Context, cluster string) (map[string]*clustersv1alpha1.GPUSummary, error) {
+	// Query all needed metrics and parse to map keyed by uuid.
+	memUsedVec, err := h.monitoringService.QueryGPUVector(ctx, cluster, "", "", "", monitoring.GPUQueryTypeMemoryUsed)
+	if err != nil {
+		return nil, err
+	}
+	memAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryAllocated)
+	if err != nil {
+		return nil, err
+	}
+	coreUsedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeCoreUsed)
+	if err != nil {
+		return nil, err
+	}
+	coreAllocatedMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeCoreAllocated)
+	if err != nil {
+		return nil, err
+	}
+	memTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeMemoryTotal)
+	if err != nil {
+		return nil, err
+	}
+	coreTotalMap, err := h.monitoringService.QueryGPUMap(ctx, cluster, "", "", "", "UUID", monitoring.GPUQueryTypeCoreTotal)
+	if err != nil {
+		return nil, err
+	}
+	// Summary of metrics by modelname.
+	temp := map[string]*clustersv1alpha1.GPUSummary{}
+	for i := range memUsedVec {
+		mode := string(memUsedVec[i].Metric["modelName"])
+		uuid := string(memUsedVec[i].Metric["UUID"])
+		if _, ok := temp[mode]; !ok {
+			temp[mode] = &clustersv1alpha1.GPUSummary{Model: mode}
+		}
+
+		memTotal, ok1 := memTotalMap[uuid]
+		coreTotal, ok2 := coreTotalMap[uuid]
+		if !ok1 || !ok2 || memTotal.Value == 0 || coreTotal.Value == 0 {
+			continue
+		}
+		temp[mode].Total += 1
+		temp[mode].MemUsage += float64(memUsedVec[i].Value / memTotal.Value)
+		if sample, ok := memAllocatedMap[uuid]; ok && sample != nil {
+			temp[mode].MemAllocated += float64(sample.Value / memTotal.Value)
+		}
+		if sample, ok := coreUsedMap[uuid]; ok && sample != nil {
+			temp[mode].CoreUsage += float64(sample.Value) / float64(coreTotal.Value) * 100
+		}
+		if sample, ok := coreAllocatedMap[uuid]; ok && sample != nil {
+			temp[mode].CoreAllocated += float64(sample.Value) / float64(coreTotal.Value) * 100
+		}
+	}
+	return temp, nil
 }
 
 func buildClusterFromRequest(req *clustersv1alpha1.IntegrateClusterRequest) *clustercrdv1alpha1.Cluster {
@@ -637,6 +804,7 @@ func buildClusterFromRequest(req *clustersv1alpha1.IntegrateClusterRequest) *clu
 			SecretRef:         &clustercrdv1alpha1.LocalSecretReference{}, // for the secret of kubeconfig, to be filled.
 			PrometheusAddress: req.GetPrometheusAddress(),
 			GatewayAddress:    req.GetGatewayAddress(),
+			DeletionPolicy:    convertProto2DeletionPolicy(req.GetDeletionPolicy()),
 		},
 	}
 }
@@ -720,8 +888,11 @@ func (h *ClusterHandler) GetClusterCardRequestType(ctx context.Context, req *clu
 		RequestTypes: []*clustersv1alpha1.CardRequestType{},
 	}
 
+	// Request types of every vendor present in the cluster.
+	types := getClusterAcceleratorTypes(cluster)
+
 	// Nvidia cluster.
-	if cluster.Spec.Type == clustersv1alpha1.ClusterType_NVIDIA.String() {
+	if slices.Contains(types, clustersv1alpha1.ClusterType_NVIDIA.String()) {
 		res.RequestTypes = append(res.RequestTypes, &clustersv1alpha1.CardRequestType{
 			RequestType: "NVIDIA GPU",
 			ResourceNames: []*clustersv1alpha1.ResourceName{
@@ -743,7 +914,7 @@ func (h *ClusterHandler) GetClusterCardRequestType(ctx context.Context, req *clu
 	}
 
 	// MetaX cluster.
-	if cluster.Spec.Type == clustersv1alpha1.ClusterType_METAX.String() {
+	if slices.Contains(types, clustersv1alpha1.ClusterType_METAX.String()) {
 		res.RequestTypes = append(res.RequestTypes, &clustersv1alpha1.CardRequestType{
 			RequestType: "MetaX GPU",
 			ResourceNames: []*clustersv1alpha1.ResourceName{
@@ -765,7 +936,7 @@ func (h *ClusterHandler) GetClusterCardRequestType(ctx context.Context, req *clu
 	}
 
 	// Neuron cluster.
-	if cluster.Spec.Type == clustersv1alpha1.ClusterType_NEURON.String() {
+	if slices.Contains(types, clustersv1alpha1.ClusterType_NEURON.String()) {
 		res.RequestTypes = append(res.RequestTypes, &clustersv1alpha1.CardRequestType{
 			RequestType: "Neuron GPU",
 			ResourceNames: []*clustersv1alpha1.ResourceName{
@@ -787,7 +958,7 @@ func (h *ClusterHandler) GetClusterCardRequestType(ctx context.Context, req *clu
 	}
 
 	// Ascend cluster.
-	if cluster.Spec.Type == clustersv1alpha1.ClusterType_ASCEND.String() {
+	if slices.Contains(types, clustersv1alpha1.ClusterType_ASCEND.String()) {
 		models, err := h.ListAscendCardModel(ctx, req.GetName())
 		if err != nil {
 			return nil, err
@@ -818,8 +989,20 @@ func (h *ClusterHandler) GetClusterCardRequestType(ctx context.Context, req *clu
 	return res, nil
 }
 
+// GetClusterCapabilities gets the capabilities of the cluster discovered by the cluster controller.
+func (h *ClusterHandler) GetClusterCapabilities(ctx context.Context, req *clustersv1alpha1.GetClusterCapabilitiesRequest) (*clustersv1alpha1.ClusterCapabilities, error) {
+	cluster, err := h.clusterService.GetCluster(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status.Capabilities == nil {
+		return nil, status.Errorf(codes.Unavailable, "capabilities of cluster %s are not discovered yet", req.GetName())
+	}
+	return convertClusterCapabilities2Proto(cluster.GetName(), cluster.Status.Capabilities), nil
+}
+
 func (h *ClusterHandler) ListAscendCardModel(ctx context.Context, cluster string) ([]string, error) {
-	nodes, err := h.coreService.ListNodes(ctx, cluster)
+	nodes, err := h.coreService.ListNodes(ctx, cluster, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package bff
 
 import (
+	"slices"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -29,13 +32,16 @@ func ConvertClusters2Proto(clusters []*clustercrdv1alpha1.Cluster, metrics map[s
 // ConvertCluster2Proto converts cluster cr to protobuf cluster.
 func ConvertCluster2Proto(cluster *clustercrdv1alpha1.Cluster, metric *clusterMetric) *clustersv1alpha1.Cluster {
 	spec := &clustersv1alpha1.ClusterSpec{
-		Provider:          clustersv1alpha1.ClusterProvider(clustersv1alpha1.ClusterProvider_value[cluster.Spec.Provider]),
-		Type:              clustersv1alpha1.ClusterType(clustersv1alpha1.ClusterType_value[cluster.Spec.Type]),
+		Provider:          clustersv1alpha1.ClusterProvider(clustersv1alpha1.ClusterProvider_value[getClusterProvider(cluster)]),
+		Type:              clustersv1alpha1.ClusterType(clustersv1alpha1.ClusterType_value[getClusterType(cluster)]),
 		ApiEndpoint:       cluster.Spec.APIEndpoint,
 		AliasName:         getAliasNameFrom(cluster.Annotations),
 		Description:       cluster.Annotations[constants.ClusterDescriptionAnnotationKey],
 		PrometheusAddress: cluster.Spec.PrometheusAddress,
 		GatewayAddress:    cluster.Spec.GatewayAddress,
+		Maintenance:       cluster.Spec.Maintenance,
+		MaintenancePolicy: convertMaintenancePolicy2Proto(cluster.Spec.MaintenancePolicy),
+		DeletionPolicy:    convertDeletionPolicy2Proto(cluster.Spec.DeletionPolicy),
 	}
 
 	status := &clustersv1alpha1.ClusterStatus{
@@ -45,7 +51,10 @@ func ConvertCluster2Proto(cluster *clustercrdv1alpha1.Cluster, metric *clusterMe
 		PodSummary:            convertResourceSummary2Proto(cluster.Status.PodSetSummary),
 		KantaloupeflowSummary: convertResourceSummary2Proto(cluster.Status.KantaloupeflowSummary),
 		Conditions:            convertCondition2Proto(cluster.Status.Conditions),
-		State:                 convertCondition2State(cluster.Status),
+		State:                 convertCluster2State(cluster),
+		Maintenance:           convertMaintenanceStatus2Proto(cluster.Status.Maintenance),
+		Provider:              clustersv1alpha1.ClusterProvider(clustersv1alpha1.ClusterProvider_value[cluster.Status.Provider]),
+		AcceleratorTypes:      convertAcceleratorTypes2Proto(cluster.Status.AcceleratorTypes),
 	}
 
 	if metric != nil {
@@ -57,14 +66,16 @@ func ConvertCluster2Proto(cluster *clustercrdv1alpha1.Cluster, metric *clusterMe
 		status.GpuMemoryAllocated = metric.gpuMemoryAllocated
 	}
 
-	// cpu_total, mem_total, gpu_total, KantaloupeflowSummary,
-	if cluster.Status.ResourceSummary != nil {
-		if val, ok := cluster.Status.ResourceSummary.Allocatable["nvidia.com/gpu.count"]; ok {
-			status.GpuTotal = int32(val.Value())
-		}
-		if val, ok := cluster.Status.ResourceSummary.Allocatable["nvidia.com/gpu-memory.count"]; ok {
-			status.GpuMemoryTotal = val.Value()
+	// gpu_total and gpu_memory_total are summarized from HAMi node register annotations.
+	if cluster.Status.GPUSummary != nil {
+		status.GpuTotal = cluster.Status.GPUSummary.Total
+		for _, vendor := range cluster.Status.GPUSummary.Vendors {
+			status.GpuMemoryTotal += vendor.Memory
 		}
+	}
+
+	// cpu_total, mem_total.
+	if cluster.Status.ResourceSummary != nil {
 		if val, ok := cluster.Status.ResourceSummary.Allocatable[corev1.ResourceCPU]; ok {
 			status.CpuTotal = int32(val.Value())
 		}
@@ -117,3 +128,148 @@ func convertCondition2State(status clustercrdv1alpha1.ClusterStatus) clustersv1a
 	}
 	return clustersv1alpha1.ClusterState_UNHEALTH
 }
+
+// convertCluster2State returns the state of the cluster, a cluster in maintenance is
+// always in MAINTENANCE state regardless of its health.
+func convertCluster2State(cluster *clustercrdv1alpha1.Cluster) clustersv1alpha1.ClusterState {
+	if cluster.Spec.Maintenance {
+		return clustersv1alpha1.ClusterState_MAINTENANCE
+	}
+	return convertCondition2State(cluster.Status)
+}
+
+// getClusterProvider returns the provider of the cluster, the provider detected from the
+// nodes is used if it is not specified on integration.
+func getClusterProvider(cluster *clustercrdv1alpha1.Cluster) string {
+	if isSpecified(cluster.Spec.Provider, clustersv1alpha1.ClusterProvider_CLUSTER_PROVIDER_UNSPECIFIED.String()) {
+		return cluster.Spec.Provider
+	}
+	return cluster.Status.Provider
+}
+
+// getClusterType returns the type of the cluster, the first accelerator type detected from
+// the nodes is used if it is not specified on integration.
+func getClusterType(cluster *clustercrdv1alpha1.Cluster) string {
+	if types := getClusterAcceleratorTypes(cluster); len(types) > 0 {
+		return types[0]
+	}
+	return ""
+}
+
+// getClusterAcceleratorTypes returns the specified type of the cluster together with all the
+// accelerator types detected from the nodes, a mixed-vendor cluster has more than one type.
+func getClusterAcceleratorTypes(cluster *clustercrdv1alpha1.Cluster) []string {
+	types := []string{}
+	if isSpecified(cluster.Spec.Type, clustersv1alpha1.ClusterType_CLUSTER_TYPE_UNSPECIFIED.String()) {
+		types = append(types, cluster.Spec.Type)
+	}
+	for _, t := range cluster.Status.AcceleratorTypes {
+		if !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func isSpecified(value, unspecified string) bool {
+	return value != "" && value != unspecified
+}
+
+func convertAcceleratorTypes2Proto(types []string) []clustersv1alpha1.ClusterType {
+	res := make([]clustersv1alpha1.ClusterType, 0, len(types))
+	for _, t := range types {
+		if v, ok := clustersv1alpha1.ClusterType_value[t]; ok {
+			res = append(res, clustersv1alpha1.ClusterType(v))
+		}
+	}
+	return res
+}
+
+func convertMaintenancePolicy2Proto(policy *clustercrdv1alpha1.MaintenancePolicy) *clustersv1alpha1.MaintenancePolicy {
+	if policy == nil {
+		return nil
+	}
+	return &clustersv1alpha1.MaintenancePolicy{
+		CordonNodes:   policy.CordonNodes,
+		DrainStrategy: drainStrategies[policy.DrainStrategy],
+		TargetCluster: policy.TargetCluster,
+		Reason:        policy.Reason,
+	}
+}
+
+func convertProto2MaintenancePolicy(policy *clustersv1alpha1.MaintenancePolicy) *clustercrdv1alpha1.MaintenancePolicy {
+	if policy == nil {
+		return nil
+	}
+	res := &clustercrdv1alpha1.MaintenancePolicy{
+		CordonNodes:   policy.GetCordonNodes(),
+		DrainStrategy: clustercrdv1alpha1.DrainStrategyNone,
+		TargetCluster: policy.GetTargetCluster(),
+		Reason:        policy.GetReason(),
+	}
+	for strategy, val := range drainStrategies {
+		if val == policy.GetDrainStrategy() {
+			res.DrainStrategy = strategy
+		}
+	}
+	return res
+}
+
+var drainStrategies = map[clustercrdv1alpha1.DrainStrategy]clustersv1alpha1.DrainStrategy{
+	clustercrdv1alpha1.DrainStrategyNone:      clustersv1alpha1.DrainStrategy_DRAIN_STRATEGY_UNSPECIFIED,
+	clustercrdv1alpha1.DrainStrategyHibernate: clustersv1alpha1.DrainStrategy_HIBERNATE,
+	clustercrdv1alpha1.DrainStrategyMigrate:   clustersv1alpha1.DrainStrategy_MIGRATE,
+}
+
+func convertMaintenanceStatus2Proto(maintenance *clustercrdv1alpha1.MaintenanceStatus) *clustersv1alpha1.MaintenanceStatus {
+	if maintenance == nil {
+		return nil
+	}
+	res := &clustersv1alpha1.MaintenanceStatus{
+		Phase:         string(maintenance.Phase),
+		CordonedNodes: int32(len(maintenance.CordonedNodes)), // #nosec G115
+		TotalFlows:    maintenance.TotalFlows,
+		DrainedFlows:  maintenance.DrainedFlows,
+		FailedFlows:   maintenance.FailedFlows,
+		Message:       maintenance.Message,
+	}
+	if maintenance.StartTime != nil {
+		res.StartTime = maintenance.StartTime.Format("2006-01-02 15:04:05")
+	}
+	return res
+}
+
+func convertDeletionPolicy2Proto(policy clustercrdv1alpha1.DeletionPolicy) clustersv1alpha1.DeletionPolicy {
+	if policy == clustercrdv1alpha1.DeletionPolicyCleanup {
+		return clustersv1alpha1.DeletionPolicy_CLEANUP
+	}
+	return clustersv1alpha1.DeletionPolicy_ORPHAN
+}
+
+func convertProto2DeletionPolicy(policy clustersv1alpha1.DeletionPolicy) clustercrdv1alpha1.DeletionPolicy {
+	if policy == clustersv1alpha1.DeletionPolicy_CLEANUP {
+		return clustercrdv1alpha1.DeletionPolicyCleanup
+	}
+	return clustercrdv1alpha1.DeletionPolicyOrphan
+}
+
+func convertClusterCapabilities2Proto(name string, capabilities *clustercrdv1alpha1.ClusterCapabilities) *clustersv1alpha1.ClusterCapabilities {
+	res := &clustersv1alpha1.ClusterCapabilities{
+		Name:              name,
+		KubernetesVersion: capabilities.KubernetesVersion,
+		HamiVersion:       capabilities.HAMiVersion,
+		GatewayApiVersion: capabilities.GatewayAPIVersion,
+		PrometheusVersion: capabilities.PrometheusVersion,
+	}
+	for _, routeKind := range capabilities.RouteKinds {
+		res.RouteKinds = append(res.RouteKinds, &clustersv1alpha1.RouteKind{
+			Kind:    routeKind.Kind,
+			Version: routeKind.Version,
+			Alpha:   strings.Contains(routeKind.Version, "alpha"),
+		})
+	}
+	for _, feature := range capabilities.KantaloupeFlowFeatures {
+		res.KantaloupeflowFeatures = append(res.KantaloupeflowFeatures, string(feature))
+	}
+	return res
+}
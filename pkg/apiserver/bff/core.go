@@ -3,6 +3,7 @@ package bff
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"strings"
 	"sync"
 
@@ -12,17 +13,22 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/api/types"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/cluster"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/core"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/monitoring"
 	quotaservice "github.com/dynamia-ai/kantaloupe/pkg/service/quota"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/annotations"
 	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
@@ -37,6 +43,7 @@ type CoreHandler struct {
 	clusterService    cluster.Service
 	monitoringService monitoring.Service
 	quotaService      quotaservice.Service
+	watchService      watchservice.Service
 }
 
 func NewCoreHandler(clientManager engine.ClientManagerInterface, prometheus engine.PrometheusInterface) *CoreHandler {
@@ -46,6 +53,7 @@ func NewCoreHandler(clientManager engine.ClientManagerInterface, prometheus engi
 		service:           core.NewService(clientManager),
 		monitoringService: monitoringService,
 		quotaService:      quotaservice.NewService(clientManager, prometheus),
+		watchService:      watchservice.NewService(clientManager),
 	}
 }
 
@@ -165,22 +173,39 @@ func (h *CoreHandler) GetSecret(ctx context.Context, req *corev1alpha1.GetSecret
 }
 
 func (h *CoreHandler) ListSecrets(ctx context.Context, req *corev1alpha1.ListSecretsRequest) (*corev1alpha1.ListSecretsResponse, error) {
-	secrets, err := h.service.ListSecrets(ctx, req.GetCluster(), req.GetNamespace())
+	fieldSelector := req.GetFieldSelector()
+	if req.GetType() != "" {
+		fieldSelector = utils.JoinSelectors(fieldSelector, fields.OneTermEqualSelector("type", req.GetType()).String())
+	}
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(fieldSelector),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
 	if err != nil {
 		return nil, err
 	}
 
+	secrets, err := h.service.ListSecrets(ctx, req.GetCluster(), req.GetNamespace(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	secrets = slices.DeleteFunc(secrets, func(secret *corev1.Secret) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionManageNamespace, req.GetCluster(), secret.Namespace)
+	})
+
 	filtered := utils.FilterByFuzzyName(secrets, req.GetName())
-	// TODO: add map.
-	if err = utils.SortStructSlice(filtered, req.GetSortOption().GetField(), req.GetSortOption().GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+	paged, pagination, err := utils.PageList(filtered, opts)
+	if err != nil {
 		return nil, err
 	}
-	paged := utils.PagedItems(filtered, req.GetPage(), req.GetPageSize())
-	items := convertSecrets2Proto(paged)
 
 	return &corev1alpha1.ListSecretsResponse{
-		Items:      items,
-		Pagination: utils.NewPage(req.GetPage(), req.GetPageSize(), len(filtered)),
+		Items:      convertSecrets2Proto(paged),
+		Pagination: pagination,
 	}, nil
 }
 
@@ -193,7 +218,7 @@ func (h *CoreHandler) ListClusterNamespaces(ctx context.Context, req *corev1alph
 	// add resource quota message to namespace.
 	resourceQuotaMap := map[string][]string{}
 	if req.GetResourceQuota() {
-		resourceQuotas, err := h.quotaService.ListQuotas(ctx, metav1.NamespaceAll, req.GetCluster())
+		resourceQuotas, err := h.quotaService.ListQuotas(ctx, metav1.NamespaceAll, req.GetCluster(), metav1.ListOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -223,7 +248,7 @@ func (h *CoreHandler) ListClusterNamespaces(ctx context.Context, req *corev1alph
 }
 
 func (h *CoreHandler) ListClusterGPUSummary(ctx context.Context, req *corev1alpha1.ListClusterGPUSummaryRequest) (*corev1alpha1.ListClusterGPUSummaryResponse, error) {
-	nodes, err := h.service.ListNodes(ctx, req.GetCluster())
+	nodes, err := h.service.ListNodes(ctx, req.GetCluster(), metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -261,53 +286,94 @@ func listGpuSummaryFrom(nodes []*corev1.Node) *corev1alpha1.ListClusterGPUSummar
 }
 
 func (h *CoreHandler) ListClusterEvents(ctx context.Context, req *corev1alpha1.ListClusterEventsRequest) (*corev1alpha1.ListClusterEventsResponse, error) {
-	events, err := h.service.ListEvents(ctx, req.GetCluster(), req.GetNamespace())
+	involvedObject := fields.Set{}
+	if req.GetKind() != "" {
+		involvedObject["involvedObject.kind"] = req.GetKind()
+	}
+	if req.GetName() != "" {
+		involvedObject["involvedObject.name"] = req.GetName()
+	}
+	opts := utils.NewListOption(
+		utils.WithFieldSelector(utils.JoinSelectors(req.GetFieldSelector(), involvedObject.String(), eventTypeSelector(req.GetType()))),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: add map
-	if err = utils.SortStructSlice(events, req.GetSortOption().GetField(), req.GetSortOption().GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+	events, err := h.service.ListEvents(ctx, req.GetCluster(), req.GetNamespace(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	paged, pagination, err := utils.PageList(filterEventsByType(events, req.GetType()), opts)
+	if err != nil {
 		return nil, err
 	}
-
-	// TODO: why not page?
-
-	items := convertEvents(events)
 
 	return &corev1alpha1.ListClusterEventsResponse{
-		Items:      items,
-		Pagination: utils.NewPage(req.Page, req.PageSize, len(events)),
+		Items:      convertEvents(paged),
+		Pagination: pagination,
 	}, nil
 }
 
 func (h *CoreHandler) ListEvents(ctx context.Context, req *corev1alpha1.ListEventsRequest) (*corev1alpha1.ListEventsResponse, error) {
+	opts := utils.NewListOption(
+		utils.WithFieldSelector(utils.JoinSelectors(req.GetFieldSelector(), eventTypeSelector(req.GetType()))),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	var list []*corev1.Event
-	var err error
 	switch req.Kind.String() {
 	case constants.KindPod:
-		list, err = h.service.ListEventsByPod(ctx, req.GetCluster(), req.GetNamespace(), req.GetKindName())
+		list, err = h.service.ListEventsByPod(ctx, req.GetCluster(), req.GetNamespace(), req.GetKindName(), listOptions)
 	default:
-		list, err = h.service.ListEvents(ctx, req.GetCluster(), req.GetNamespace())
+		list, err = h.service.ListEvents(ctx, req.GetCluster(), req.GetNamespace(), listOptions)
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	// TODO: add map
-	if err = utils.SortStructSlice(list, req.GetSortOption().GetField(), req.GetSortOption().GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+	paged, pagination, err := utils.PageList(filterEventsByType(list, req.GetType()), opts)
+	if err != nil {
 		return nil, err
 	}
 
-	// TODO: why not page?
-
-	items := convertEvents(list)
 	return &corev1alpha1.ListEventsResponse{
-		Items:      items,
-		Pagination: utils.NewPage(req.Page, req.PageSize, len(list)),
+		Items:      convertEvents(paged),
+		Pagination: pagination,
 	}, nil
 }
 
+// eventTypeSelector selects the events of the type on the API server, the field selectors can
+// not select several types so they are filtered by filterEventsByType.
+func eventTypeSelector(eventTypes []corev1alpha1.EventType) string {
+	if len(eventTypes) != 1 || eventTypes[0] == corev1alpha1.EventType_EVENT_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return fields.OneTermEqualSelector("type", eventTypes[0].String()).String()
+}
+
+func filterEventsByType(events []*corev1.Event, eventTypes []corev1alpha1.EventType) []*corev1.Event {
+	if len(eventTypes) < 2 {
+		return events
+	}
+	wanted := sets.New[string]()
+	for _, t := range eventTypes {
+		wanted.Insert(t.String())
+	}
+	return slices.DeleteFunc(events, func(event *corev1.Event) bool {
+		return !wanted.Has(event.Type)
+	})
+}
+
 type nodeMetric struct {
 	cpuCapacity          int64
 	cpuAllocated         float64
@@ -331,7 +397,22 @@ func (h *CoreHandler) ListNodes(ctx context.Context, req *corev1alpha1.ListNodes
 		return &corev1alpha1.ListNodesResponse{Pagination: NewEmptyPage(req.Page, req.PageSize)}, nil
 	}
 
-	nodes, err := h.service.ListNodes(ctx, req.GetCluster())
+	if req.GetSortOption().GetField() == "" {
+		req.SortOption = &types.SortOption{Field: "metadata.name", Asc: true}
+	}
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(req.GetFieldSelector()),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := h.service.ListNodes(ctx, req.GetCluster(), listOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -357,18 +438,15 @@ func (h *CoreHandler) ListNodes(ctx context.Context, req *corev1alpha1.ListNodes
 		items = append(items, <-resultChan)
 	}
 
-	if req.GetSortOption().GetField() == "" {
-		req.SortOption = &types.SortOption{Field: "metadata.name", Asc: true}
-	}
-	if err = utils.SortStructSlice(items, req.GetSortOption().GetField(), req.GetSortOption().GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+	// the nodes are sorted after being enriched, so they are sortable by their metrics.
+	paged, pagination, err := utils.PageList(items, opts)
+	if err != nil {
 		return nil, err
 	}
 
-	paged := utils.PagedItems(items, req.GetPage(), req.GetPageSize())
-
 	return &corev1alpha1.ListNodesResponse{
 		Items:      paged,
-		Pagination: utils.NewPage(req.GetPage(), req.GetPageSize(), len(filtered)),
+		Pagination: pagination,
 	}, nil
 }
 
@@ -499,6 +577,71 @@ func (h *CoreHandler) GetNode(ctx context.Context, req *corev1alpha1.GetNodeRequ
 	return convertNode2Proto(node, metrics), nil
 }
 
+// WatchNodes streams the changes of the nodes, the nodes are not enriched with the metrics.
+func (h *CoreHandler) WatchNodes(req *corev1alpha1.WatchNodesRequest, stream kantaloupeapi.Core_WatchNodesServer) error {
+	ctx := stream.Context()
+	opts, err := newWatchOptions(ctx, "", req.GetLabelSelector(), req.GetResourceVersion())
+	if err != nil {
+		return err
+	}
+	opts.Filter = func(obj *unstructured.Unstructured) bool {
+		return utils.MatchByFuzzyName(obj, req.GetName())
+	}
+
+	err = h.watchService.Watch(ctx, req.GetCluster(), watchservice.NodeGVR, opts, func(event *watchservice.Event) error {
+		resp := &corev1alpha1.WatchNodesResponse{
+			Type:            convertWatchEventType(event.Type),
+			ResourceVersion: event.ResourceVersion,
+		}
+		if event.Object != nil {
+			node, err := fromUnstructured[corev1.Node](event.Object)
+			if err != nil {
+				return err
+			}
+			resp.Object = convertNode2Proto(node, nil)
+		}
+		return stream.Send(resp)
+	})
+	return watchError(err)
+}
+
+// WatchEvents streams the changes of the events.
+func (h *CoreHandler) WatchEvents(req *corev1alpha1.WatchEventsRequest, stream kantaloupeapi.Core_WatchEventsServer) error {
+	ctx := stream.Context()
+	opts, err := newWatchOptions(ctx, req.GetNamespace(), "", req.GetResourceVersion())
+	if err != nil {
+		return err
+	}
+	eventTypes := sets.New[string]()
+	for _, t := range req.GetType() {
+		eventTypes.Insert(t.String())
+	}
+	opts.Filter = func(obj *unstructured.Unstructured) bool {
+		eventType, _, _ := unstructured.NestedString(obj.Object, "type")
+		kind, _, _ := unstructured.NestedString(obj.Object, "involvedObject", "kind")
+		name, _, _ := unstructured.NestedString(obj.Object, "involvedObject", "name")
+		return (eventTypes.Len() == 0 || eventTypes.Has(eventType)) &&
+			(req.GetKind() == "" || req.GetKind() == kind) &&
+			(req.GetName() == "" || req.GetName() == name)
+	}
+
+	err = h.watchService.Watch(ctx, req.GetCluster(), watchservice.EventGVR, opts, func(event *watchservice.Event) error {
+		resp := &corev1alpha1.WatchEventsResponse{
+			Type:            convertWatchEventType(event.Type),
+			ResourceVersion: event.ResourceVersion,
+		}
+		if event.Object != nil {
+			e, err := fromUnstructured[corev1.Event](event.Object)
+			if err != nil {
+				return err
+			}
+			resp.Object = convertEvents([]*corev1.Event{e})[0]
+		}
+		return stream.Send(resp)
+	})
+	return watchError(err)
+}
+
 func filterNodes(nodes []*corev1.Node, keyword string, role corev1alpha1.Role, phase corev1alpha1.NodePhase) []*corev1.Node {
 	res := make([]*corev1.Node, 0, len(nodes))
 	for _, node := range nodes {
@@ -2,14 +2,18 @@ package bff
 
 import (
 	"context"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
 	credentialv1alpha1 "github.com/dynamia-ai/kantaloupe/api/credentials/v1alpha1"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	credentialservice "github.com/dynamia-ai/kantaloupe/pkg/service/credential"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 )
@@ -38,6 +42,11 @@ func (h *CredentialHandler) ListCredentials(ctx context.Context, req *credential
 		return nil, status.Errorf(codes.Internal, "failed to list credentials: %v", err)
 	}
 
+	// the credentials are stored in the global cluster.
+	credentials = slices.DeleteFunc(credentials, func(secret *corev1.Secret) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionManageNamespace, engine.LocalCluster, secret.Namespace)
+	})
+
 	paged := utils.PagedItems(credentials, req.GetPage(), req.GetPageSize())
 
 	// Convert to response objects
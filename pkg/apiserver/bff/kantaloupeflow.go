@@ -3,15 +3,20 @@ package bff
 import (
 	"context"
 	"fmt"
-	"sort"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -20,21 +25,36 @@ import (
 	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
 	flowcrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
 	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/cluster"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/core"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/fanout"
 	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/monitoring"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/operation"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/quota"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/annotations"
 )
 
 var _ kantaloupeapi.KantaloupeflowServer = &KantaloupeflowHandler{}
 
-const PodAllocationAnnotation = "kantaloupe.dynamia.io/pod-allocation-meet"
+const (
+	PodAllocationAnnotation = "kantaloupe.dynamia.io/pod-allocation-meet"
+
+	// kantaloupeflowPollInterval is the interval CreateKantaloupeflowAsync polls the state of
+	// the kantaloupeflow at.
+	kantaloupeflowPollInterval = 2 * time.Second
+	// kantaloupeflowRollbackTimeout is how long deleting the kantaloupeflow of a failed
+	// operation may take.
+	kantaloupeflowRollbackTimeout = 30 * time.Second
+)
 
 type KantaloupeflowHandler struct {
 	kantaloupeapi.UnimplementedKantaloupeflowServer
@@ -43,21 +63,107 @@ type KantaloupeflowHandler struct {
 	clusterService    cluster.Service
 	quotaService      quota.Service
 	monitoringService monitoring.Service
+	watchService      watchservice.Service
+	operations        *operation.Manager
 }
 
-// NewKantaloupeflowHandler new a cluster handler by client manager.
-func NewKantaloupeflowHandler(clientManager engine.ClientManagerInterface, prometheus engine.PrometheusInterface) *KantaloupeflowHandler {
+// NewKantaloupeflowHandler new a cluster handler by client manager, the async RPCs run in the
+// operations of the manager.
+func NewKantaloupeflowHandler(clientManager engine.ClientManagerInterface, prometheus engine.PrometheusInterface, operations *operation.Manager) *KantaloupeflowHandler {
 	return &KantaloupeflowHandler{
 		service:           kfservice.NewService(clientManager),
 		clusterService:    cluster.NewService(clientManager),
 		workloadService:   core.NewService(clientManager),
 		quotaService:      quota.NewService(clientManager, prometheus),
 		monitoringService: monitoring.NewService(prometheus),
+		watchService:      watchservice.NewService(clientManager),
+		operations:        operations,
 	}
 }
 
 // CreateKaantaloupeflow create a kantaloupeflow.
 func (h *KantaloupeflowHandler) CreateKantaloupeflow(ctx context.Context, req *flowv1alpha1.CreateKantaloupeflowRequest) (*flowv1alpha1.Kantaloupeflow, error) {
+	flow, err := h.buildKantaloupeflow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	flow, err = h.service.CreateKantaloupeflow(ctx, req.GetCluster(), flow)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertKantaloupeflow2Proto(flow), nil
+}
+
+// CreateKantaloupeflowAsync validates the request and creates the kantaloupeflow in an operation,
+// the operation is done once the kantaloupeflow is running. The kantaloupeflow is deleted if it
+// fails or the operation is canceled.
+func (h *KantaloupeflowHandler) CreateKantaloupeflowAsync(ctx context.Context, req *flowv1alpha1.CreateKantaloupeflowRequest) (*operationsv1alpha1.Operation, error) {
+	flow, err := h.buildKantaloupeflow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return startOperation(ctx, h.operations, req.GetCluster(), func(ctx context.Context) (proto.Message, error) {
+		operation.Report(ctx, 10, "creating the kantaloupeflow")
+		created, err := h.service.CreateKantaloupeflow(ctx, req.GetCluster(), flow)
+		if err != nil {
+			return nil, err
+		}
+		running, err := h.waitForKantaloupeflowRunning(ctx, req.GetCluster(), created)
+		if err != nil {
+			h.rollbackKantaloupeflow(ctx, req.GetCluster(), created)
+			return nil, err
+		}
+		return ConvertKantaloupeflow2Proto(running), nil
+	})
+}
+
+// waitForKantaloupeflowRunning polls the kantaloupeflow until it is running or failed.
+func (h *KantaloupeflowHandler) waitForKantaloupeflowRunning(
+	ctx context.Context,
+	cluster string,
+	flow *flowcrdv1alpha1.KantaloupeFlow,
+) (*flowcrdv1alpha1.KantaloupeFlow, error) {
+	operation.Report(ctx, 30, "waiting for the kantaloupeflow to be running")
+	ticker := time.NewTicker(kantaloupeflowPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		current, err := h.service.GetKantaloupeflow(ctx, cluster, flow.GetNamespace(), flow.GetName())
+		if err != nil {
+			return nil, err
+		}
+		switch calculateKantaloupeflowState(current.Status.Conditions) {
+		case flowv1alpha1.KantaloupeflowState_Running:
+			return current, nil
+		case flowv1alpha1.KantaloupeflowState_Falied:
+			return nil, status.Errorf(codes.Aborted, "kantaloupeflow %s/%s failed to run", flow.GetNamespace(), flow.GetName())
+		}
+		if current.Status.Replicas > 0 {
+			operation.Report(ctx, 30+60*current.Status.ReadyReplicas/current.Status.Replicas,
+				fmt.Sprintf("%d/%d replicas are ready", current.Status.ReadyReplicas, current.Status.Replicas))
+		}
+	}
+}
+
+// rollbackKantaloupeflow deletes the kantaloupeflow created by a failed operation, it goes on
+// when the operation is canceled.
+func (h *KantaloupeflowHandler) rollbackKantaloupeflow(ctx context.Context, cluster string, flow *flowcrdv1alpha1.KantaloupeFlow) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), kantaloupeflowRollbackTimeout)
+	defer cancel()
+	if err := h.service.DeleteKantaloupeflow(ctx, cluster, flow.GetNamespace(), flow.GetName()); err != nil && !k8serrors.IsNotFound(err) {
+		klog.ErrorS(err, "failed to delete the kantaloupeflow of the failed operation", "cluster", cluster, "kantaloupeflow", klog.KObj(flow))
+		return
+	}
+	klog.InfoS("Deleted the kantaloupeflow of the failed operation", "cluster", cluster, "kantaloupeflow", klog.KObj(flow))
+}
+
+// buildKantaloupeflow validates the request and builds the kantaloupeflow to create.
+func (h *KantaloupeflowHandler) buildKantaloupeflow(ctx context.Context, req *flowv1alpha1.CreateKantaloupeflowRequest) (*flowcrdv1alpha1.KantaloupeFlow, error) {
 	if errs := validation.IsDNS1035Label(req.GetData().GetMetadata().GetName()); len(errs) != 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "kantaloupeflow name %s is invalid, error: %s", req.GetData().GetMetadata().GetName(), errs)
 	}
@@ -79,16 +185,17 @@ func (h *KantaloupeflowHandler) CreateKantaloupeflow(ctx context.Context, req *f
 	if err != nil {
 		return nil, err
 	}
+	if cluster.Spec.Maintenance {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %s is in maintenance, no new kantaloupeflows are accepted", req.GetCluster())
+	}
 	flow := ConvertProto2Kantaloupeflow(req.Data)
-	err = patchByProvider(flow, cluster.Spec.Provider)
-	if err != nil {
+	if err := kfservice.ValidateCapabilities(cluster, flow); err != nil {
 		return nil, err
 	}
-	flow, err = h.service.CreateKantaloupeflow(ctx, req.GetCluster(), flow)
-	if err != nil {
+	if err := patchByProvider(flow, cluster.Spec.Provider); err != nil {
 		return nil, err
 	}
-	return ConvertKantaloupeflow2Proto(flow), nil
+	return flow, nil
 }
 
 // GetKantaloupeflow get a specified Kantaloupeflow.
@@ -138,15 +245,39 @@ func (h *KantaloupeflowHandler) ListKantaloupeflows(ctx context.Context, req *fl
 		}
 	}
 
-	flows, err := h.service.ListKantaloupeflows(ctx, req.Cluster, req.Namespace)
+	sortBy := req.GetSortBy().String()
+	if req.GetSortBy() == types.SortBy_SORT_BY_UNSPECIFIED {
+		sortBy = "created_at"
+	}
+	sortOption := utils.WithSort(sortBy, req.GetSortDir().String())
+	if req.GetSortOption().GetField() != "" {
+		sortOption = utils.WithSortOption(req.GetSortOption())
+	}
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(req.GetFieldSelector()),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		sortOption,
+	)
+	listOptions, err := opts.KubeListOptions()
 	if err != nil {
 		return nil, err
 	}
 
+	flows, err := h.service.ListKantaloupeflows(ctx, req.Cluster, req.Namespace, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	flows = slices.DeleteFunc(flows, func(flow *flowcrdv1alpha1.KantaloupeFlow) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionViewNamespace, req.GetCluster(), flow.Namespace)
+	})
+
 	filtered := filterKantaloupeflow(flows, req.GetName(), req.GetStatus())
-	// TODO: use utils.SortStructSlice
-	sortByMetaFields(filtered, req.GetSortBy().String(), req.GetSortDir().String())
-	paged := utils.PagedItems(filtered, req.GetPage(), req.GetPageSize())
+	paged, pagination, err := utils.PageList(filtered, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	items := ConvertKantaloupeflows2Proto(paged)
 	var (
@@ -176,55 +307,44 @@ func (h *KantaloupeflowHandler) ListKantaloupeflows(ctx context.Context, req *fl
 
 	return &flowv1alpha1.ListKantaloupeflowsResponse{
 		Items:      items,
-		Pagination: utils.NewPage(req.Page, req.PageSize, len(filtered)),
+		Pagination: pagination,
 	}, nil
 }
 
-// TODO: optimzie the function.
-func sortByMetaFields(list []*flowcrdv1alpha1.KantaloupeFlow, field, asc string) {
-	sort.Slice(list, func(i, j int) bool {
-		var less bool
-
-		switch field {
-		case "field_name":
-			less = list[i].GetName() < list[j].GetName()
-		case "created_at":
-			less = !list[i].GetCreationTimestamp().After(list[j].GetCreationTimestamp().Time)
-		default:
-			less = !list[i].GetCreationTimestamp().After(list[j].GetCreationTimestamp().Time)
-		}
-
-		if asc == constants.SortByDesc {
-			return !less
-		}
-		return less
-	})
-}
-
+// GetKantaloupeTree counts the kantaloupeflow pods of the running clusters the caller can view
+// by the clusters and their nodes. The clusters are queried in parallel, the ones failing to
+// answer are reported in ClusterErrors and left out of the tree.
 func (h *KantaloupeflowHandler) GetKantaloupeTree(ctx context.Context, _ *emptypb.Empty) (*flowv1alpha1.KantaloupeTree, error) {
 	clusters, err := h.clusterService.ListClusters(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	data := []*flowv1alpha1.KantaloupeTreeNode{}
+	labelKey, err := labels.NewRequirement(constants.KantaloupeFlowAppLabelKey, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	labelsSelector := labels.NewSelector().Add(*labelKey).String()
+
+	running := []string{}
 	for _, cluster := range clusters {
 		if convertCondition2State(cluster.Status) != clustersv1alpha1.ClusterState_RUNNING {
 			continue
 		}
-		labelKey, err := labels.NewRequirement(constants.KantaloupeFlowAppLabelKey, selection.Exists, nil)
-		if err != nil {
-			return nil, err
+		if !authorization.Allowed(ctx, authorization.PermissionViewCluster, cluster.GetName(), "") {
+			continue
 		}
-		labelsSelector := labels.NewSelector().Add(*labelKey)
+		running = append(running, cluster.GetName())
+	}
 
-		pods, err := h.workloadService.ListPods(ctx, cluster.GetName(), "", labelsSelector.String())
+	results := fanout.Run(ctx, running, fanout.Options{}, func(ctx context.Context, cluster string) (*flowv1alpha1.KantaloupeTreeNode, error) {
+		pods, err := h.workloadService.ListPods(ctx, cluster, "", labelsSelector)
 		if err != nil {
 			return nil, err
 		}
 
 		clusterTreeNode := &flowv1alpha1.KantaloupeTreeNode{
-			Name:     cluster.GetName(),
+			Name:     cluster,
 			Value:    int32(len(pods)),
 			Children: []*flowv1alpha1.KantaloupeTreeNode{},
 		}
@@ -241,10 +361,17 @@ func (h *KantaloupeflowHandler) GetKantaloupeTree(ctx context.Context, _ *emptyp
 				Value: num,
 			})
 		}
-		data = append(data, clusterTreeNode)
+		return clusterTreeNode, nil
+	})
+
+	data := []*flowv1alpha1.KantaloupeTreeNode{}
+	for _, result := range results {
+		if result.Err == nil {
+			data = append(data, result.Value)
+		}
 	}
 
-	return &flowv1alpha1.KantaloupeTree{Data: data}, nil
+	return &flowv1alpha1.KantaloupeTree{Data: data, ClusterErrors: convertClusterErrors2Proto(results)}, nil
 }
 
 func (h *KantaloupeflowHandler) UpdateKantaloupeflowGPUMemory(ctx context.Context, req *flowv1alpha1.UpdateKantaloupeflowGPUMemoryRequest) (*emptypb.Empty, error) {
@@ -273,7 +400,7 @@ func (h *KantaloupeflowHandler) UpdateKantaloupeflowGPUMemory(ctx context.Contex
 	}
 
 	// Get quota for the namespace.
-	quotas, err := h.quotaService.ListQuotas(ctx, req.Namespace, req.Cluster)
+	quotas, err := h.quotaService.ListQuotas(ctx, req.Namespace, req.Cluster, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -308,6 +435,36 @@ func (h *KantaloupeflowHandler) UpdateKantaloupeflowGPUMemory(ctx context.Contex
 	return &emptypb.Empty{}, nil
 }
 
+// WatchKantaloupeflows streams the changes of the kantaloupeflows, the kantaloupeflows are
+// not enriched with the GPUs of their pods.
+func (h *KantaloupeflowHandler) WatchKantaloupeflows(req *flowv1alpha1.WatchKantaloupeflowsRequest, stream kantaloupeapi.Kantaloupeflow_WatchKantaloupeflowsServer) error {
+	ctx := stream.Context()
+	opts, err := newWatchOptions(ctx, req.GetNamespace(), req.GetLabelSelector(), req.GetResourceVersion())
+	if err != nil {
+		return err
+	}
+	opts.Filter = func(obj *unstructured.Unstructured) bool {
+		return utils.MatchByFuzzyName(obj, req.GetName()) &&
+			authorization.Allowed(ctx, authorization.PermissionViewNamespace, req.GetCluster(), obj.GetNamespace())
+	}
+
+	err = h.watchService.Watch(ctx, req.GetCluster(), watchservice.KantaloupeflowGVR, opts, func(event *watchservice.Event) error {
+		resp := &flowv1alpha1.WatchKantaloupeflowsResponse{
+			Type:            convertWatchEventType(event.Type),
+			ResourceVersion: event.ResourceVersion,
+		}
+		if event.Object != nil {
+			flow, err := fromUnstructured[flowcrdv1alpha1.KantaloupeFlow](event.Object)
+			if err != nil {
+				return err
+			}
+			resp.Object = ConvertKantaloupeflow2Proto(flow)
+		}
+		return stream.Send(resp)
+	})
+	return watchError(err)
+}
+
 func filterKantaloupeflow(list []*flowcrdv1alpha1.KantaloupeFlow, keyword string, state flowv1alpha1.KantaloupeflowState) []*flowcrdv1alpha1.KantaloupeFlow {
 	res := []*flowcrdv1alpha1.KantaloupeFlow{}
 	for _, flow := range list {
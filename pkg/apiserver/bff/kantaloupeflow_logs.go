@@ -0,0 +1,316 @@
+package bff
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+)
+
+const (
+	// LogsArchiveContentType is the content type of the archives of DownloadKantaloupeflowLogs.
+	LogsArchiveContentType = "application/gzip"
+	// logsArchiveChunkSize is the size of the chunks of the streamed archives.
+	logsArchiveChunkSize = 32 * 1024
+)
+
+// GetKantaloupeflowLogs streams the logs of the replicas of a kantaloupeflow line by line. The
+// logs of the replicas are streamed one after another unless they are followed.
+func (h *KantaloupeflowHandler) GetKantaloupeflowLogs(req *flowv1alpha1.GetKantaloupeflowLogsRequest, stream kantaloupeapi.Kantaloupeflow_GetKantaloupeflowLogsServer) error {
+	ctx := stream.Context()
+	pods, err := h.listKantaloupeflowLogPods(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	containers := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		container, err := selectLogContainer(pod, req.GetContainer())
+		if err != nil {
+			return err
+		}
+		containers = append(containers, container)
+	}
+
+	// stream.Send must not be called concurrently.
+	var lock sync.Mutex
+	group, ctx := errgroup.WithContext(ctx)
+	if !req.GetFollow() {
+		group.SetLimit(1)
+	}
+	for i, pod := range pods {
+		group.Go(func() error {
+			logs, err := h.workloadService.GetPodLogs(ctx, req.GetCluster(), req.GetNamespace(), pod.Name, newPodLogOptions(req, containers[i], req.GetFollow()))
+			if err != nil {
+				return err
+			}
+			defer logs.Close()
+
+			reader := bufio.NewReader(logs)
+			for {
+				line, err := reader.ReadString('\n')
+				if line != "" {
+					lock.Lock()
+					err := stream.Send(&flowv1alpha1.GetKantaloupeflowLogsResponse{
+						Pod:       pod.Name,
+						Container: containers[i],
+						Content:   strings.TrimSuffix(line, "\n"),
+					})
+					lock.Unlock()
+					if err != nil {
+						return err
+					}
+				}
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return err
+				}
+			}
+		})
+	}
+	err = group.Wait()
+	if errors.Is(err, context.Canceled) && stream.Context().Err() != nil {
+		// the client stops following the logs.
+		return nil
+	}
+	return err
+}
+
+// DownloadKantaloupeflowLogs streams a tar.gz archive holding a <pod>/<container>.log file for
+// every container of the replicas of a kantaloupeflow, including the init containers.
+func (h *KantaloupeflowHandler) DownloadKantaloupeflowLogs(req *flowv1alpha1.GetKantaloupeflowLogsRequest, stream kantaloupeapi.Kantaloupeflow_DownloadKantaloupeflowLogsServer) error {
+	ctx := stream.Context()
+	pods, err := h.listKantaloupeflowLogPods(ctx, req)
+	if err != nil {
+		return err
+	}
+	if req.GetContainer() != "" {
+		for _, pod := range pods {
+			if _, err := selectLogContainer(pod, req.GetContainer()); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the size of a file must be known before it is written to the archive, so the logs
+	// are buffered in a temporary file rather than in memory.
+	buf, err := os.CreateTemp("", "kantaloupeflow-logs-")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to buffer the logs: %v", err)
+	}
+	defer func() {
+		buf.Close()
+		os.Remove(buf.Name())
+	}()
+
+	chunks := bufio.NewWriterSize(&httpBodyWriter{stream: stream}, logsArchiveChunkSize)
+	gz := gzip.NewWriter(chunks)
+	archive := tar.NewWriter(gz)
+	for _, pod := range pods {
+		for _, container := range podLogContainers(pod, req.GetContainer()) {
+			name := pod.Name + "/" + container + ".log"
+			if logErr := h.bufferPodLogs(ctx, req, pod.Name, container, buf); logErr != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// the containers having no logs, e.g. the waiting ones, do not fail the download.
+				klog.V(4).InfoS("Failed to get the logs of the container", "pod", klog.KObj(pod), "container", container, "err", logErr)
+				name = pod.Name + "/" + container + ".error"
+				if err := resetFile(buf); err != nil {
+					return status.Errorf(codes.Internal, "failed to buffer the logs: %v", err)
+				}
+				if _, err := buf.WriteString(logErr.Error() + "\n"); err != nil {
+					return status.Errorf(codes.Internal, "failed to buffer the logs: %v", err)
+				}
+			}
+			if err := writeArchiveFile(archive, name, buf); err != nil {
+				return err
+			}
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return chunks.Flush()
+}
+
+// listKantaloupeflowLogPods returns the replicas of the kantaloupeflow selected by the request.
+func (h *KantaloupeflowHandler) listKantaloupeflowLogPods(ctx context.Context, req *flowv1alpha1.GetKantaloupeflowLogsRequest) ([]*corev1.Pod, error) {
+	if errs := validation.IsDNS1035Label(req.GetName()); len(errs) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "kantaloupeflow name %s is invalid, error: %s", req.GetName(), errs)
+	}
+	if errs := validation.IsDNS1123Label(req.GetNamespace()); len(errs) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "kantaloupeflow namespace %s is invalid, error: %s", req.GetNamespace(), errs)
+	}
+	if req.GetTailLines() < 0 || req.GetSinceSeconds() < 0 || req.GetSinceTime() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "tailLines, sinceSeconds and sinceTime can not be negative")
+	}
+	if req.GetSinceSeconds() > 0 && req.GetSinceTime() > 0 {
+		return nil, status.Error(codes.InvalidArgument, "only one of sinceSeconds and sinceTime can be set")
+	}
+
+	if _, err := h.service.GetKantaloupeflow(ctx, req.GetCluster(), req.GetNamespace(), req.GetName()); err != nil {
+		return nil, err
+	}
+	pods, err := h.workloadService.ListPods(ctx, req.GetCluster(), req.GetNamespace(),
+		labels.Set{constants.KantaloupeFlowAppLabelKey: req.GetName()}.String())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPod() != "" {
+		pods = slices.DeleteFunc(pods, func(pod *corev1.Pod) bool {
+			return pod.Name != req.GetPod()
+		})
+		if len(pods) == 0 {
+			return nil, status.Errorf(codes.NotFound, "pod %s of kantaloupeflow %s not found", req.GetPod(), req.GetName())
+		}
+	}
+	if len(pods) == 0 {
+		return nil, status.Errorf(codes.NotFound, "kantaloupeflow %s has no replicas", req.GetName())
+	}
+	slices.SortFunc(pods, func(a, b *corev1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return pods, nil
+}
+
+// bufferPodLogs replaces the content of buf with the logs of the container.
+func (h *KantaloupeflowHandler) bufferPodLogs(ctx context.Context, req *flowv1alpha1.GetKantaloupeflowLogsRequest, pod, container string, buf *os.File) error {
+	if err := resetFile(buf); err != nil {
+		return err
+	}
+	logs, err := h.workloadService.GetPodLogs(ctx, req.GetCluster(), req.GetNamespace(), pod, newPodLogOptions(req, container, false))
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+	_, err = io.Copy(buf, logs)
+	return err
+}
+
+// selectLogContainer returns the requested container of the pod, or its default container
+// if none is requested.
+func selectLogContainer(pod *corev1.Pod, container string) (string, error) {
+	if container == "" {
+		if container = utils.DefaultContainerName(pod); container == "" {
+			return "", status.Errorf(codes.FailedPrecondition, "pod %s has no containers", pod.Name)
+		}
+		return container, nil
+	}
+	if !slices.Contains(podLogContainers(pod, ""), container) {
+		return "", status.Errorf(codes.InvalidArgument, "container %s is not valid for pod %s", container, pod.Name)
+	}
+	return container, nil
+}
+
+// podLogContainers returns the init containers and the containers of the pod, or only the
+// container if it is set.
+func podLogContainers(pod *corev1.Pod, container string) []string {
+	if container != "" {
+		return []string{container}
+	}
+	names := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, c := range pod.Spec.InitContainers {
+		names = append(names, c.Name)
+	}
+	for _, c := range pod.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func newPodLogOptions(req *flowv1alpha1.GetKantaloupeflowLogsRequest, container string, follow bool) *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Container:  container,
+		Follow:     follow,
+		Previous:   req.GetPrevious(),
+		Timestamps: req.GetTimestamps(),
+	}
+	if req.GetTailLines() > 0 {
+		tailLines := req.GetTailLines()
+		opts.TailLines = &tailLines
+	}
+	if req.GetSinceSeconds() > 0 {
+		sinceSeconds := req.GetSinceSeconds()
+		opts.SinceSeconds = &sinceSeconds
+	}
+	if req.GetSinceTime() > 0 {
+		sinceTime := metav1.Unix(req.GetSinceTime(), 0)
+		opts.SinceTime = &sinceTime
+	}
+	return opts
+}
+
+func resetFile(f *os.File) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	_, err := f.Seek(0, io.SeekStart)
+	return err
+}
+
+// writeArchiveFile writes the content of buf as the file of the archive.
+func writeArchiveFile(archive *tar.Writer, name string, buf *os.File) error {
+	size, err := buf.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to buffer the logs: %v", err)
+	}
+	if _, err := buf.Seek(0, io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "failed to buffer the logs: %v", err)
+	}
+	if err := archive.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(archive, buf, size); err != nil {
+		return fmt.Errorf("failed to archive %s: %w", name, err)
+	}
+	return nil
+}
+
+// httpBodyWriter sends the written bytes as the chunks of a stream.
+type httpBodyWriter struct {
+	stream kantaloupeapi.Kantaloupeflow_DownloadKantaloupeflowLogsServer
+}
+
+func (w *httpBodyWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&httpbody.HttpBody{
+		ContentType: LogsArchiveContentType,
+		Data:        slices.Clone(p),
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
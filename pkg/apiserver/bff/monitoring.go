@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
 	monitoringv1alpha1 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
@@ -331,7 +332,7 @@ func (m *MonitoringHandler) GetKantaloupeflowMemoryDistribution(ctx context.Cont
 
 func (m *MonitoringHandler) getWorkloads(ctx context.Context, cluster string) ([]string, error) {
 	workloadNames := []string{}
-	workloads, err := m.kfService.ListKantaloupeflows(ctx, cluster, corev1.NamespaceAll)
+	workloads, err := m.kfService.ListKantaloupeflows(ctx, cluster, corev1.NamespaceAll, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,118 @@
+package bff
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/operation"
+)
+
+var _ kantaloupeapi.OperationsServer = &OperationsHandler{}
+
+type OperationsHandler struct {
+	kantaloupeapi.UnimplementedOperationsServer
+	operations *operation.Manager
+}
+
+// NewOperationsHandler new an operations handler serving the operations of the manager.
+func NewOperationsHandler(operations *operation.Manager) *OperationsHandler {
+	return &OperationsHandler{operations: operations}
+}
+
+// GetOperation gets an operation started by the caller.
+func (h *OperationsHandler) GetOperation(ctx context.Context, req *operationsv1alpha1.GetOperationRequest) (*operationsv1alpha1.Operation, error) {
+	op, err := h.operations.Get(req.GetId())
+	if err := checkOperation(ctx, req.GetId(), op, err); err != nil {
+		return nil, err
+	}
+	return ConvertOperation2Proto(op), nil
+}
+
+// CancelOperation cancels an operation started by the caller.
+func (h *OperationsHandler) CancelOperation(ctx context.Context, req *operationsv1alpha1.CancelOperationRequest) (*operationsv1alpha1.Operation, error) {
+	op, err := h.operations.Get(req.GetId())
+	if err := checkOperation(ctx, req.GetId(), op, err); err != nil {
+		return nil, err
+	}
+	op, err = h.operations.Cancel(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return ConvertOperation2Proto(op), nil
+}
+
+// checkOperation hides the operations of the other users unless the caller is a platform admin,
+// they are not found like the expired ones.
+func checkOperation(ctx context.Context, id string, op operation.Operation, err error) error {
+	if err == nil && !authorization.Allowed(ctx, authorization.PermissionManagePlatform, "", "") {
+		if u, ok := authentication.UserFrom(ctx); !ok || u.GetName() != op.User {
+			err = operation.ErrNotFound
+		}
+	}
+	if errors.Is(err, operation.ErrNotFound) {
+		return apierrors.New(codes.NotFound, &types.ErrorDetail{
+			Reason: types.ErrorReason_NOT_FOUND,
+			Object: &types.ObjectReference{Kind: "operations", Name: id},
+		}, "operation %s is not found", id)
+	}
+	return err
+}
+
+// startOperation runs the function in an operation of the RPC of the request, the operation
+// belongs to the user of the request.
+func startOperation(ctx context.Context, operations *operation.Manager, cluster string, run operation.Func) (*operationsv1alpha1.Operation, error) {
+	meta := operation.Meta{Cluster: cluster}
+	meta.Method, _ = grpc.Method(ctx)
+	if u, ok := authentication.UserFrom(ctx); ok {
+		meta.User = u.GetName()
+	}
+	op, err := operations.Start(ctx, meta, run)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertOperation2Proto(op), nil
+}
+
+// ConvertOperation2Proto converts the operation to protobuf, the error is given its ErrorDetail
+// like the errors of the RPCs.
+func ConvertOperation2Proto(op operation.Operation) *operationsv1alpha1.Operation {
+	ret := &operationsv1alpha1.Operation{
+		Id:         op.ID,
+		Method:     op.Method,
+		User:       op.User,
+		Cluster:    op.Cluster,
+		State:      op.State,
+		Progress:   op.Progress,
+		Message:    op.Message,
+		CreateTime: op.CreateTime.UnixMilli(),
+		UpdateTime: op.UpdateTime.UnixMilli(),
+		Done:       op.Done(),
+	}
+	if op.Result != nil {
+		result, err := anypb.New(op.Result)
+		if err != nil {
+			klog.ErrorS(err, "failed to marshal the result of the operation", "id", op.ID)
+		}
+		ret.Result = result
+	}
+	if op.Err != nil {
+		st := apierrors.Convert(op.Err, op.Cluster)
+		ret.Error = &operationsv1alpha1.OperationError{
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+			Detail:  apierrors.DetailOf(st),
+		}
+	}
+	return ret
+}
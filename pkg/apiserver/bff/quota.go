@@ -2,15 +2,18 @@ package bff
 
 import (
 	"context"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
 	quotav1alpha1 "github.com/dynamia-ai/kantaloupe/api/quotas/v1alpha1"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
 	quotaservice "github.com/dynamia-ai/kantaloupe/pkg/service/quota"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
@@ -35,30 +38,38 @@ func NewQuotaHandler(quotaService quotaservice.Service, workloadService kfservic
 
 // ListQuotas lists resource quotas with optional filtering.
 func (h *QuotaHandler) ListQuotas(ctx context.Context, req *quotav1alpha1.ListQuotasRequest) (*quotav1alpha1.ListQuotasResponse, error) {
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(req.GetFieldSelector()),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get quotas from service
-	quotas, err := h.quotaService.ListQuotas(ctx, req.GetNamespace(), req.GetCluster())
+	quotas, err := h.quotaService.ListQuotas(ctx, req.GetNamespace(), req.GetCluster(), listOptions)
 	if err != nil {
 		klog.ErrorS(err, "failed to list resource quotas", "Error:", err)
 		return nil, status.Errorf(codes.Internal, "failed to list resource quotas: %v", err)
 	}
 
+	quotas = slices.DeleteFunc(quotas, func(quota *corev1.ResourceQuota) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionViewNamespace, req.GetCluster(), quota.Namespace)
+	})
 	filtered := filterQuotas(quotas, req.GetName())
-
-	// TODO: "createdTime" -> "metadata.creationTimestamp"
-	if req.SortOption.GetField() == "createdTime" {
-		req.SortOption.Field = "creationTimestamp"
-	}
-
-	if err := utils.SortStructSlice(filtered, req.SortOption.GetField(), req.SortOption.GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+	paged, pagination, err := utils.PageList(filtered, opts)
+	if err != nil {
 		return nil, err
 	}
 
-	paged := utils.PagedItems(filtered, req.GetPage(), req.GetPageSize())
-
 	// Convert to response objects
 	items := convertQuotasToResponses(paged)
 	for i := range items {
-		workloads, err := h.workloadService.ListKantaloupeflows(ctx, req.GetCluster(), items[i].Namespace)
+		workloads, err := h.workloadService.ListKantaloupeflows(ctx, req.GetCluster(), items[i].Namespace, metav1.ListOptions{})
 		if err != nil {
 			klog.ErrorS(err, "failed to list kantaloupeflows", "Error:", err)
 			return nil, status.Errorf(codes.Internal, "failed to list kantaloupeflows: %v", err)
@@ -70,7 +81,7 @@ func (h *QuotaHandler) ListQuotas(ctx context.Context, req *quotav1alpha1.ListQu
 
 	return &quotav1alpha1.ListQuotasResponse{
 		Items:      items,
-		Pagination: utils.NewPage(req.Page, req.PageSize, len(filtered)),
+		Pagination: pagination,
 	}, nil
 }
 
@@ -2,7 +2,7 @@ package bff
 
 import (
 	"context"
-	"sort"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +14,7 @@ import (
 	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
 	storagev1alpha1 "github.com/dynamia-ai/kantaloupe/api/storage/v1alpha1"
 	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/core"
@@ -32,19 +33,30 @@ func NewStorageHandler(clientManager engine.ClientManagerInterface) *StorageHand
 }
 
 func (h *StorageHandler) ListStorageClasses(ctx context.Context, req *storagev1alpha1.ListStorageClassesRequest) (*storagev1alpha1.ListStorageClassesResponse, error) {
-	storageClasses, err := h.workloadService.ListStorageClasses(ctx, req.GetCluster())
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(req.GetFieldSelector()),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		utils.WithSortOption(req.GetSortOption()),
+	)
+	listOptions, err := opts.KubeListOptions()
 	if err != nil {
 		return nil, err
 	}
-	items := convertStorageClasses(storageClasses)
-	if err = utils.SortStructSlice(items, req.GetSortOption().GetField(), req.GetSortOption().GetAsc(), utils.SnakeToCamelMapper()); err != nil {
+
+	storageClasses, err := h.workloadService.ListStorageClasses(ctx, req.GetCluster(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	paged, pagination, err := utils.PageList(convertStorageClasses(storageClasses), opts)
+	if err != nil {
 		return nil, err
 	}
 
-	// TODO: why not page?
 	return &storagev1alpha1.ListStorageClassesResponse{
-		Items:      items,
-		Pagination: utils.NewPage(req.Page, req.PageSize, len(storageClasses)),
+		Items:      paged,
+		Pagination: pagination,
 	}, nil
 }
 
@@ -151,49 +163,42 @@ func (h *StorageHandler) DeleteStorage(ctx context.Context, req *storagev1alpha1
 }
 
 func (h *StorageHandler) ListStorages(ctx context.Context, req *storagev1alpha1.ListStoragesRequest) (*storagev1alpha1.ListStoragesResponse, error) {
-	pvcs, err := h.workloadService.ListPersistentVolumeClaims(ctx, req.GetCluster(), req.GetNamespace(), req.GetIsManage())
+	sortOption := utils.WithSortOption(req.GetSortOption())
+	if req.GetSortOption().GetField() == "" {
+		sortOption = utils.WithSort("created_at", constants.SortByDesc)
+	}
+	opts := utils.NewListOption(
+		utils.WithLabelSelector(req.GetLabelSelector()),
+		utils.WithFieldSelector(req.GetFieldSelector()),
+		utils.WithPage(req.GetPage(), req.GetPageSize()),
+		utils.WithContinue(req.GetContinue()),
+		sortOption,
+	)
+	listOptions, err := opts.KubeListOptions()
 	if err != nil {
 		return nil, err
 	}
 
-	filtered := filterStorages(pvcs, req.GetName(), req.GetPhase(), req.GetStorageType())
-
-	// TODO: use utils.SortStructSlice
-	sortDir := constants.SortByDesc
-	if req.GetSortOption().GetAsc() {
-		sortDir = "asc"
+	pvcs, err := h.workloadService.ListPersistentVolumeClaims(ctx, req.GetCluster(), req.GetNamespace(), req.GetIsManage(), listOptions)
+	if err != nil {
+		return nil, err
 	}
 
-	sortPvcByMetaFields(filtered, req.GetSortOption().GetField(), sortDir)
-	paged := utils.PagedItems(filtered, req.GetPage(), req.GetPageSize())
-	items := convertPersistentVolumeClaims2Proto(paged)
+	pvcs = slices.DeleteFunc(pvcs, func(pvc *corev1.PersistentVolumeClaim) bool {
+		return !authorization.Allowed(ctx, authorization.PermissionViewNamespace, req.GetCluster(), pvc.Namespace)
+	})
+	filtered := filterStorages(pvcs, req.GetName(), req.GetPhase(), req.GetStorageType())
+	paged, pagination, err := utils.PageList(filtered, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	return &storagev1alpha1.ListStoragesResponse{
-		Items:      items,
-		Pagination: utils.NewPage(req.GetPage(), req.GetPageSize(), len(filtered)),
+		Items:      convertPersistentVolumeClaims2Proto(paged),
+		Pagination: pagination,
 	}, nil
 }
 
-func sortPvcByMetaFields(list []*corev1.PersistentVolumeClaim, field, asc string) {
-	sort.Slice(list, func(i, j int) bool {
-		var less bool
-
-		switch field {
-		case "field_name":
-			less = list[i].GetName() < list[j].GetName()
-		case "created_at":
-			less = !list[i].GetCreationTimestamp().After(list[j].GetCreationTimestamp().Time)
-		default:
-			less = !list[i].GetCreationTimestamp().After(list[j].GetCreationTimestamp().Time)
-		}
-
-		if asc == constants.SortByDesc {
-			return !less
-		}
-		return less
-	})
-}
-
 func filterStorages(pvcs []*corev1.PersistentVolumeClaim, keyword string, phase corev1alpha1.PVCPhase, storageType storagev1alpha1.StorageType) []*corev1.PersistentVolumeClaim {
 	res := []*corev1.PersistentVolumeClaim{}
 	for _, pvc := range pvcs {
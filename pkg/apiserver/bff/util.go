@@ -3,6 +3,8 @@ package bff
 import (
 	monitoringv1alpha1 "github.com/dynamia-ai/kantaloupe/api/monitoring/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/fanout"
 	"github.com/dynamia-ai/kantaloupe/pkg/service/monitoring"
 )
 
@@ -75,3 +77,22 @@ func ConvertResourceType2GPUQueryType(resourceType monitoringv1alpha1.ResourceTy
 	}
 	return map[string]monitoring.GPUQueryType{}
 }
+
+// convertClusterErrors2Proto converts the errors of the clusters failed in the fan-out to
+// protobuf, in the order of the clusters.
+func convertClusterErrors2Proto[T any](results []fanout.Result[T]) []*types.ClusterError {
+	errs := []*types.ClusterError{}
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+		st := apierrors.Convert(result.Err, result.Cluster)
+		errs = append(errs, &types.ClusterError{
+			Cluster: result.Cluster,
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+			Detail:  apierrors.DetailOf(st),
+		})
+	}
+	return errs
+}
@@ -0,0 +1,81 @@
+package bff
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
+)
+
+// lastEventIDHeader is sent by the reconnecting EventSource clients, it is the resource
+// version to resume from if the request does not specify one.
+const lastEventIDHeader = "last-event-id"
+
+// newWatchOptions builds the options of a watch request.
+func newWatchOptions(ctx context.Context, namespace, labelSelector, resourceVersion string) (watchservice.Options, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return watchservice.Options{}, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %v", labelSelector, err)
+	}
+	if namespace == constants.SelectAll {
+		namespace = ""
+	}
+	if resourceVersion == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(lastEventIDHeader); len(values) > 0 {
+				resourceVersion = values[0]
+			}
+		}
+	}
+	return watchservice.Options{Namespace: namespace, LabelSelector: selector, ResourceVersion: resourceVersion}, nil
+}
+
+// watchError converts the error of a watch to the status returned to the client.
+func watchError(err error) error {
+	switch {
+	case err == nil, errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return nil
+	case errors.Is(err, watchservice.ErrResourceVersionExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, watchservice.ErrWatcherTooSlow), errors.Is(err, watchservice.ErrSyncTimeout):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func convertWatchEventType(eventType watch.EventType) types.WatchEventType {
+	switch eventType {
+	case watch.Added:
+		return types.WatchEventType_ADDED
+	case watch.Modified:
+		return types.WatchEventType_MODIFIED
+	case watch.Deleted:
+		return types.WatchEventType_DELETED
+	case watch.Bookmark:
+		return types.WatchEventType_BOOKMARK
+	default:
+		return types.WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+// fromUnstructured converts the object of a watch event to its typed object.
+func fromUnstructured[T any](obj *unstructured.Unstructured) (*T, error) {
+	typed := new(T)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), typed); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert %s %s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+	return typed, nil
+}
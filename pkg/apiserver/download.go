@@ -0,0 +1,95 @@
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
+
+	flowv1alpha1 "github.com/dynamia-ai/kantaloupe/api/kantaloupeflow/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+)
+
+const kantaloupeflowLogsDownloadPattern = "/apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/namespaces/{namespace}/kantaloupeflows/{name}/logs/download"
+
+// registerDownloadAPIs serves the RPCs streaming files, grpc-gateway delimits the chunks of
+// the streams so the files are copied to the responses as they are here. The RPCs are called
+// through conn, so the requests are authorized by the interceptors like the others.
+func (s *APIServer) registerDownloadAPIs(conn grpc.ClientConnInterface) error {
+	client := kantaloupeapi.NewKantaloupeflowClient(conn)
+	return s.GatewayServerMux.HandlePath(http.MethodGet, kantaloupeflowLogsDownloadPattern,
+		func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, outbound := runtime.MarshalerForRequest(s.GatewayServerMux, r)
+			ctx, err := runtime.AnnotateContext(r.Context(), s.GatewayServerMux, r,
+				kantaloupeapi.Kantaloupeflow_DownloadKantaloupeflowLogs_FullMethodName,
+				runtime.WithHTTPPathPattern(kantaloupeflowLogsDownloadPattern))
+			if err != nil {
+				runtime.HTTPError(ctx, s.GatewayServerMux, outbound, w, r, err)
+				return
+			}
+
+			req := &flowv1alpha1.GetKantaloupeflowLogsRequest{
+				Cluster:   pathParams["cluster"],
+				Namespace: pathParams["namespace"],
+				Name:      pathParams["name"],
+			}
+			filter := utilities.NewDoubleArray([][]string{{"cluster"}, {"namespace"}, {"name"}})
+			if err := runtime.PopulateQueryParameters(req, r.URL.Query(), filter); err != nil {
+				runtime.HTTPError(ctx, s.GatewayServerMux, outbound, w, r, err)
+				return
+			}
+
+			stream, err := client.DownloadKantaloupeflowLogs(ctx, req)
+			if err != nil {
+				runtime.HTTPError(ctx, s.GatewayServerMux, outbound, w, r, err)
+				return
+			}
+			filename := fmt.Sprintf("%s-%s-logs.tar.gz", req.GetNamespace(), req.GetName())
+			copyHTTPBodyStream(w, r, s.GatewayServerMux, outbound, filename, stream.Recv)
+		})
+}
+
+// copyHTTPBodyStream writes the chunks of the stream as the attachment of the response. The
+// errors received before the first chunk are written as the errors of grpc-gateway, the
+// response is aborted on the later ones so the clients never take a truncated file.
+func copyHTTPBodyStream(w http.ResponseWriter, r *http.Request, mux *runtime.ServeMux, marshaler runtime.Marshaler,
+	filename string, recv func() (*httpbody.HttpBody, error)) {
+	rc := http.NewResponseController(w)
+	for wroteHeader := false; ; wroteHeader = true {
+		chunk, err := recv()
+		if errors.Is(err, io.EOF) {
+			if !wroteHeader {
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+		if err != nil {
+			if !wroteHeader {
+				runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+				return
+			}
+			klog.ErrorS(err, "Failed to stream the file", "file", filename)
+			panic(http.ErrAbortHandler)
+		}
+		if !wroteHeader {
+			w.Header().Set("Content-Type", chunk.GetContentType())
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+			w.WriteHeader(http.StatusOK)
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			klog.V(4).InfoS("Failed to write the file", "file", filename, "err", err)
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			klog.V(4).InfoS("Failed to flush the file", "file", filename, "err", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package apiserver
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCopyHTTPBodyStream(t *testing.T) {
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+
+	tests := []struct {
+		name        string
+		chunks      []*httpbody.HttpBody
+		err         error
+		code        int
+		contentType string
+		body        string
+	}{
+		{
+			name: "chunks are copied without delimiters",
+			chunks: []*httpbody.HttpBody{
+				{ContentType: "application/gzip", Data: []byte("abc")},
+				{ContentType: "application/gzip", Data: []byte("def")},
+			},
+			code:        http.StatusOK,
+			contentType: "application/gzip",
+			body:        "abcdef",
+		},
+		{
+			name: "error before the first chunk",
+			err:  status.Error(codes.PermissionDenied, "denied"),
+			code: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recv := func() (*httpbody.HttpBody, error) {
+				if len(tt.chunks) > 0 {
+					chunk := tt.chunks[0]
+					tt.chunks = tt.chunks[1:]
+					return chunk, nil
+				}
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return nil, io.EOF
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/download", nil)
+			copyHTTPBodyStream(w, r, mux, marshaler, "logs.tar.gz", recv)
+			if w.Code != tt.code {
+				t.Fatalf("code = %d, want %d", w.Code, tt.code)
+			}
+			if tt.code != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.contentType)
+			}
+			if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="logs.tar.gz"` {
+				t.Errorf("Content-Disposition = %q", got)
+			}
+			if got := w.Body.String(); got != tt.body {
+				t.Errorf("body = %q, want %q", got, tt.body)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package apiserver
+
+import (
+	"bytes"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/requestinfo"
+)
+
+const (
+	// EventStreamContentType is the content type of the Server-Sent Events.
+	EventStreamContentType = "text/event-stream"
+
+	lastEventIDHeader = "Last-Event-Id"
+)
+
+// EventStreamMarshaler marshals the messages of the streaming RPCs as Server-Sent Events
+// for the clients accepting text/event-stream, e.g. the EventSource of the browsers.
+type EventStreamMarshaler struct {
+	runtime.Marshaler
+}
+
+// ContentType implements runtime.Marshaler.
+func (m *EventStreamMarshaler) ContentType(interface{}) string {
+	return EventStreamContentType
+}
+
+// Marshal writes the message as the data of an event. The resource version of the watch
+// events is the id of the event, so the reconnecting EventSource resumes from it.
+func (m *EventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if id := eventID(v); id != "" {
+		buf.WriteString("id: " + id + "\n")
+	}
+	buf.WriteString("data: ")
+	buf.Write(data)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// Delimiter implements runtime.Delimited, the events are separated by blank lines.
+func (m *EventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// eventID returns the resource version of the message streamed by grpc-gateway.
+func eventID(v interface{}) string {
+	chunk, ok := v.(map[string]proto.Message)
+	if !ok || chunk["result"] == nil {
+		return ""
+	}
+	return requestinfo.StringField(chunk["result"].ProtoReflect(), "resource_version")
+}
+
+// EventStreamHeaderMatcher forwards the Last-Event-ID header of the reconnecting EventSource
+// to the watch RPCs besides the headers forwarded by matcher.
+func EventStreamHeaderMatcher(matcher runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if textproto.CanonicalMIMEHeaderKey(key) == lastEventIDHeader {
+			return key, true
+		}
+		return matcher(key)
+	}
+}
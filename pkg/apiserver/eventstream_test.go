@@ -0,0 +1,33 @@
+package apiserver
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	corev1alpha1 "github.com/dynamia-ai/kantaloupe/api/core/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+func TestEventStreamMarshaler(t *testing.T) {
+	marshaler := &EventStreamMarshaler{Marshaler: &runtime.JSONPb{}}
+	data, err := marshaler.Marshal(map[string]proto.Message{"result": &corev1alpha1.WatchNodesResponse{
+		Type:            types.WatchEventType_BOOKMARK,
+		ResourceVersion: "42",
+	}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	expect := "id: 42\ndata: {\"result\":{\"type\":\"BOOKMARK\",\"resourceVersion\":\"42\"}}\n"
+	if string(data)+string(marshaler.Delimiter()) != expect+"\n" {
+		t.Errorf("Marshal() = %q, want %q", data, expect)
+	}
+
+	matcher := EventStreamHeaderMatcher(runtime.DefaultHeaderMatcher)
+	for key, expect := range map[string]bool{"Last-Event-ID": true, "last-event-id": true, "X-Unknown": false} {
+		if _, ok := matcher(key); ok != expect {
+			t.Errorf("EventStreamHeaderMatcher()(%s) = %v, want %v", key, ok, expect)
+		}
+	}
+}
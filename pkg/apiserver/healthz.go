@@ -0,0 +1,77 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"k8s.io/apiserver/pkg/server/healthz"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+// healthCheckTimeout bounds the checks reaching the dependencies of the apiserver.
+const healthCheckTimeout = 5 * time.Second
+
+var errShuttingDown = errors.New("the apiserver is shutting down")
+
+// installHealthChecks serves the health probes, /livez and /healthz report whether the process
+// is alive, /readyz whether it is able to serve the requests. Every check is served at its own
+// path as well, e.g. /readyz/prometheus, ?verbose lists the results of the checks and
+// ?exclude=prometheus skips the check, e.g. for the probes of the deployments without Prometheus.
+// It returns the paths of the probes, they are not authenticated.
+func (s *APIServer) installHealthChecks(livez, readyz []healthz.HealthChecker) []string {
+	checks := http.NewServeMux()
+	healthz.InstallHandler(checks, livez...)
+	healthz.InstallLivezHandler(checks, livez...)
+	healthz.InstallReadyzHandler(checks, readyz...)
+
+	var paths []string
+	for path, pathChecks := range map[string][]healthz.HealthChecker{
+		"/healthz": livez,
+		"/livez":   livez,
+		"/readyz":  readyz,
+	} {
+		s.router.Handle(path, checks)
+		paths = append(paths, path)
+		for _, check := range pathChecks {
+			s.router.Handle(path+"/"+check.Name(), checks)
+			paths = append(paths, path+"/"+check.Name())
+		}
+	}
+	return paths
+}
+
+// readyzChecks returns the checks of the readiness, the apiserver is ready if the local cluster
+// and Prometheus are reachable, the informers are synced and it is not shutting down.
+func (s *APIServer) readyzChecks(localClient kubernetes.Interface, monitoring engine.PrometheusInterface) []healthz.HealthChecker {
+	return []healthz.HealthChecker{
+		healthz.PingHealthz,
+		healthz.NamedCheck("local-cluster", func(r *http.Request) error {
+			ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+			defer cancel()
+			_, err := localClient.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx)
+			return err
+		}),
+		healthz.NamedCheck("prometheus", func(r *http.Request) error {
+			ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+			defer cancel()
+			return monitoring.Ready(ctx)
+		}),
+		healthz.NewInformerSyncHealthz(s.informerFactory),
+		s.shutdownCheck(),
+	}
+}
+
+// shutdownCheck fails once the apiserver starts shutting down, so the load balancers stop
+// sending the requests to it while the in-flight ones are drained.
+func (s *APIServer) shutdownCheck() healthz.HealthChecker {
+	return healthz.NamedCheck("shutdown", func(*http.Request) error {
+		if s.shuttingDown.Load() {
+			return errShuttingDown
+		}
+		return nil
+	})
+}
@@ -0,0 +1,221 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc"
+	"k8s.io/apiserver/pkg/server/healthz"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+)
+
+func TestHealthChecks(t *testing.T) {
+	s := &APIServer{router: mux.NewRouter()}
+	dependencyErr := errors.New("unreachable")
+	paths := s.installHealthChecks(
+		[]healthz.HealthChecker{healthz.PingHealthz},
+		[]healthz.HealthChecker{
+			healthz.PingHealthz,
+			healthz.NamedCheck("dependency", func(*http.Request) error { return dependencyErr }),
+			s.shutdownCheck(),
+		},
+	)
+	for _, path := range []string{"/healthz", "/livez", "/livez/ping", "/readyz", "/readyz/dependency", "/readyz/shutdown"} {
+		if !slices.Contains(paths, path) {
+			t.Errorf("paths = %v, want %s", paths, path)
+		}
+	}
+
+	probe := func(target string) (int, string) {
+		w := httptest.NewRecorder()
+		s.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		return w.Code, w.Body.String()
+	}
+	for _, tt := range []struct {
+		target string
+		code   int
+		body   string
+	}{
+		{target: "/livez", code: http.StatusOK, body: "ok"},
+		{target: "/healthz", code: http.StatusOK, body: "ok"},
+		{target: "/readyz?verbose", code: http.StatusInternalServerError, body: "[-]dependency failed"},
+		{target: "/readyz/dependency", code: http.StatusInternalServerError, body: "unreachable"},
+		{target: "/readyz?exclude=dependency", code: http.StatusOK, body: "ok"},
+	} {
+		if code, body := probe(tt.target); code != tt.code || !strings.Contains(body, tt.body) {
+			t.Errorf("GET %s = %d %q, want %d %q", tt.target, code, body, tt.code, tt.body)
+		}
+	}
+
+	s.shuttingDown.Store(true)
+	if code, body := probe("/readyz?verbose&exclude=dependency"); code != http.StatusInternalServerError || !strings.Contains(body, "[-]shutdown failed") {
+		t.Errorf("GET /readyz = %d %q after shutdown, want the shutdown check failed", code, body)
+	}
+	if code, _ := probe("/livez"); code != http.StatusOK {
+		t.Errorf("GET /livez = %d after shutdown, want %d", code, http.StatusOK)
+	}
+}
+
+func TestRunShutdown(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	s := &APIServer{
+		Server: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			close(started)
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte("done"))
+		})},
+		GrpcServer:       grpc.NewServer(),
+		CMux:             cmux.New(l),
+		InternalListener: transport.NewInternalListener(),
+		ShutdownTimeout:  5 * time.Second,
+		stopCh:           make(chan struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- s.Run(ctx)
+	}()
+
+	type result struct {
+		body string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String())
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		results <- result{body: string(body), err: err}
+	}()
+
+	<-started
+	cancel()
+	// the in-flight request is drained.
+	if r := <-results; r.err != nil || r.body != "done" {
+		t.Errorf("GET = %q %v, want the in-flight request served", r.body, r.err)
+	}
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() does not return after the drain")
+	}
+	if !s.shuttingDown.Load() {
+		t.Error("the apiserver is not shutting down")
+	}
+	select {
+	case <-s.stopCh:
+	default:
+		t.Error("the informers are not stopped")
+	}
+	if conn, err := net.DialTimeout("tcp", l.Addr().String(), time.Second); err == nil {
+		conn.Close()
+		t.Error("the listener still accepts the connections after shutdown")
+	}
+}
+
+type slowOperationsServer struct {
+	kantaloupeapi.UnimplementedOperationsServer
+	started chan struct{}
+}
+
+func (s *slowOperationsServer) GetOperation(_ context.Context, req *operationsv1alpha1.GetOperationRequest) (*operationsv1alpha1.Operation, error) {
+	close(s.started)
+	time.Sleep(300 * time.Millisecond)
+	return &operationsv1alpha1.Operation{Id: req.GetId()}, nil
+}
+
+func TestRunShutdownDrainsGateway(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := &slowOperationsServer{started: make(chan struct{})}
+	s := &APIServer{
+		GrpcServer:       grpc.NewServer(),
+		GatewayServerMux: runtime.NewServeMux(),
+		CMux:             cmux.New(l),
+		InternalListener: transport.NewInternalListener(),
+		ShutdownDelay:    200 * time.Millisecond,
+		ShutdownTimeout:  5 * time.Second,
+		stopCh:           make(chan struct{}),
+	}
+	s.lifetime, s.stopLifetime = context.WithCancel(context.Background())
+	kantaloupeapi.RegisterOperationsServer(s.GrpcServer, operations)
+	if err := s.registerGateway(kantaloupeapi.RegisterOperationsHandlerFromEndpoint); err != nil {
+		t.Fatal(err)
+	}
+	s.Server = &http.Server{Handler: s.GatewayServerMux}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- s.Run(ctx)
+	}()
+
+	type result struct {
+		code int
+		body string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String() + "/apis/kantaloupe.dynamia.ai/v1/operations/op-1")
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		results <- result{code: resp.StatusCode, body: string(body), err: err}
+	}()
+
+	<-operations.started
+	cancel()
+	// the readiness fails during the shutdown delay rather than after it.
+	time.Sleep(50 * time.Millisecond)
+	if !s.shuttingDown.Load() {
+		t.Error("the apiserver is not shutting down during the shutdown delay")
+	}
+	// the request forwarded by the grpc-gateway is drained.
+	if r := <-results; r.err != nil || r.code != http.StatusOK || !strings.Contains(r.body, "op-1") {
+		t.Errorf("GET = %d %q %v, want the in-flight request served", r.code, r.body, r.err)
+	}
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() does not return after the drain")
+	}
+	if s.lifetime.Err() == nil {
+		t.Error("the connections of the grpc-gateway are not closed after shutdown")
+	}
+}
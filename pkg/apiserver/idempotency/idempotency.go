@@ -0,0 +1,201 @@
+// Package idempotency deduplicates the unary RPCs carrying an idempotency key, a retried call
+// with the same key gets the response of the first one instead of performing it again.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/textproto"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jellydator/ttlcache/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/apierrors"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+)
+
+const (
+	// Header is the metadata of the idempotency key, the grpc-gateway forwards the
+	// Idempotency-Key header as it.
+	Header = "idempotency-key"
+	// ReplayedHeader is set on the responses replayed from the first call.
+	ReplayedHeader = "idempotent-replayed"
+
+	// maxKeyLength is the longest idempotency key accepted.
+	maxKeyLength = 255
+	// DefaultTTL is how long the responses are remembered.
+	DefaultTTL = 24 * time.Hour
+	// DefaultCapacity is the number of the responses remembered, the least recently used ones
+	// are forgotten first.
+	DefaultCapacity = 10000
+)
+
+// errFailed marks the calls not done successfully.
+var errFailed = errors.New("the call failed")
+
+// Cache remembers the successful responses of the calls by the users, the methods and the
+// keys. The failed calls are not remembered, so they are performed again when retried.
+type Cache struct {
+	mu        sync.Mutex
+	responses *ttlcache.Cache[string, *call]
+}
+
+// call is a call with an idempotency key, it is pending until done is closed.
+type call struct {
+	fingerprint [sha256.Size]byte
+	done        chan struct{}
+	resp        interface{}
+	err         error
+}
+
+// New returns the cache remembering up to capacity responses for the ttl.
+func New(ttl time.Duration, capacity uint64) *Cache {
+	c := &Cache{
+		responses: ttlcache.New(
+			ttlcache.WithTTL[string, *call](ttl),
+			ttlcache.WithCapacity[string, *call](capacity),
+			ttlcache.WithDisableTouchOnHit[string, *call](),
+		),
+	}
+	go c.responses.Start()
+	return c
+}
+
+// Stop stops evicting the expired responses.
+func (c *Cache) Stop() {
+	c.responses.Stop()
+}
+
+// UnaryServerInterceptor deduplicates the unary RPCs carrying the idempotency key. The key is
+// scoped to the user and the method, reusing it with a different request is InvalidArgument.
+// A duplicate of a pending call waits for it. The replayed calls are marked in their audit
+// events, the interceptor must be chained after the audit one.
+func (c *Cache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := keyOf(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, apierrors.Invalid(Header, "the idempotency key is longer than %d characters", maxKeyLength)
+		}
+		fingerprint, err := fingerprintOf(req)
+		if err != nil {
+			return nil, err
+		}
+		scope := scopeOf(ctx, info.FullMethod, key)
+
+		for {
+			first, pending := c.begin(scope, fingerprint)
+			if pending == nil {
+				return c.perform(ctx, scope, first, req, handler)
+			}
+			if pending.fingerprint != fingerprint {
+				return nil, apierrors.Invalid(Header, "the idempotency key %q is already used by a different request", key)
+			}
+			select {
+			case <-pending.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// the failed calls are forgotten, the duplicate performs the call again.
+			if pending.err == nil {
+				klog.V(4).InfoS("Replayed the response of the idempotency key", "method", info.FullMethod, "key", key)
+				audit.MarkReplayed(ctx)
+				if err := grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true")); err != nil {
+					klog.V(4).InfoS("Failed to set the idempotent-replayed header", "err", err)
+				}
+				return clone(pending.resp), nil
+			}
+		}
+	}
+}
+
+// begin returns the call of the scope if there is one, or starts a new one.
+func (c *Cache) begin(scope string, fingerprint [sha256.Size]byte) (first, pending *call) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if item := c.responses.Get(scope); item != nil {
+		return nil, item.Value()
+	}
+	first = &call{fingerprint: fingerprint, done: make(chan struct{})}
+	c.responses.Set(scope, first, ttlcache.DefaultTTL)
+	return first, nil
+}
+
+func (c *Cache) perform(ctx context.Context, scope string, first *call, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	// the call is forgotten if the handler panics as well.
+	first.err = errFailed
+	defer func() {
+		if first.err != nil {
+			c.mu.Lock()
+			if item := c.responses.Get(scope); item != nil && item.Value() == first {
+				c.responses.Delete(scope)
+			}
+			c.mu.Unlock()
+		}
+		close(first.done)
+	}()
+	resp, err := handler(ctx, req)
+	first.resp, first.err = resp, err
+	if err != nil {
+		return nil, err
+	}
+	return clone(resp), nil
+}
+
+// fingerprintOf hashes the request, the same requests have the same fingerprints.
+func fingerprintOf(req interface{}) ([sha256.Size]byte, error) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return [sha256.Size]byte{}, nil
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+func scopeOf(ctx context.Context, method, key string) string {
+	name := ""
+	if u, ok := authentication.UserFrom(ctx); ok {
+		name = u.GetName()
+	}
+	return name + "\x00" + method + "\x00" + key
+}
+
+func keyOf(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(Header); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// clone copies the remembered responses, so they are not shared with the marshalers.
+func clone(resp interface{}) interface{} {
+	if m, ok := resp.(proto.Message); ok {
+		return proto.Clone(m)
+	}
+	return resp
+}
+
+// HeaderMatcher forwards the Idempotency-Key header to the RPCs besides the headers forwarded
+// by matcher.
+func HeaderMatcher(matcher runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(Header) {
+			return Header, true
+		}
+		return matcher(key)
+	}
+}
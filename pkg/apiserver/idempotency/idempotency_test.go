@@ -0,0 +1,183 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	clustersv1alpha1 "github.com/dynamia-ai/kantaloupe/api/clusters/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+)
+
+var integrate = &grpc.UnaryServerInfo{FullMethod: "/kantaloupev1.Cluster/IntegrateClusterAsync"}
+
+func keyContext(name, key string) context.Context {
+	ctx := genericapirequest.WithUser(context.Background(), &user.DefaultInfo{Name: name})
+	if key == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(Header, key))
+}
+
+// countingHandler returns the cluster of the request and counts the calls, the first failures
+// calls fail.
+func countingHandler(calls *atomic.Int32, failures int32) grpc.UnaryHandler {
+	return func(_ context.Context, req interface{}) (interface{}, error) {
+		if calls.Add(1) <= failures {
+			return nil, status.Error(codes.Unavailable, "the cluster is unreachable")
+		}
+		return &clustersv1alpha1.Cluster{Metadata: &types.ObjectMeta{Name: req.(*clustersv1alpha1.IntegrateClusterRequest).GetName()}}, nil
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	c := New(DefaultTTL, DefaultCapacity)
+	defer c.Stop()
+	interceptor := c.UnaryServerInterceptor()
+	req := &clustersv1alpha1.IntegrateClusterRequest{Name: "member"}
+
+	var calls atomic.Int32
+	handler := countingHandler(&calls, 0)
+	first, err := interceptor(keyContext("alice", "key-1"), req, integrate, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := interceptor(keyContext("alice", "key-1"), proto.Clone(req), integrate, handler)
+	if err != nil || !proto.Equal(first.(proto.Message), replayed.(proto.Message)) || calls.Load() != 1 {
+		t.Errorf("replayed = %v, %v after %d calls, want the first response", replayed, err, calls.Load())
+	}
+	if first == replayed {
+		t.Error("the replayed response is shared with the first one")
+	}
+
+	// the keys are scoped to the users and the methods.
+	if _, err := interceptor(keyContext("bob", "key-1"), req, integrate, handler); err != nil || calls.Load() != 2 {
+		t.Errorf("the call of another user is replayed: %v", err)
+	}
+	if _, err := interceptor(keyContext("alice", "key-1"), req, &grpc.UnaryServerInfo{FullMethod: "/kantaloupev1.Cluster/IntegrateCluster"}, handler); err != nil || calls.Load() != 3 {
+		t.Errorf("the call of another method is replayed: %v", err)
+	}
+	// the calls without key are not deduplicated.
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(keyContext("alice", ""), req, integrate, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls.Load() != 5 {
+		t.Errorf("calls = %d, want the calls without key performed", calls.Load())
+	}
+
+	// reusing the key with a different request is rejected.
+	_, err = interceptor(keyContext("alice", "key-1"), &clustersv1alpha1.IntegrateClusterRequest{Name: "other"}, integrate, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want InvalidArgument", err)
+	}
+
+	if _, err := interceptor(keyContext("alice", string(make([]byte, maxKeyLength+1))), req, integrate, handler); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want InvalidArgument for the long key", err)
+	}
+}
+
+func TestUnaryServerInterceptorFailed(t *testing.T) {
+	c := New(DefaultTTL, DefaultCapacity)
+	defer c.Stop()
+	interceptor := c.UnaryServerInterceptor()
+	req := &clustersv1alpha1.IntegrateClusterRequest{Name: "member"}
+
+	// the failed calls are performed again.
+	var calls atomic.Int32
+	handler := countingHandler(&calls, 1)
+	if _, err := interceptor(keyContext("alice", "key-1"), req, integrate, handler); status.Code(err) != codes.Unavailable {
+		t.Fatalf("error = %v, want Unavailable", err)
+	}
+	if _, err := interceptor(keyContext("alice", "key-1"), req, integrate, handler); err != nil || calls.Load() != 2 {
+		t.Errorf("retry = %v after %d calls, want performed again", err, calls.Load())
+	}
+}
+
+func TestUnaryServerInterceptorAudited(t *testing.T) {
+	c := New(DefaultTTL, DefaultCapacity)
+	defer c.Stop()
+	store := audit.NewStore(10)
+	auditor, idempotent := audit.New(store).UnaryServerInterceptor(), c.UnaryServerInterceptor()
+	req := &clustersv1alpha1.IntegrateClusterRequest{Name: "member"}
+
+	var calls atomic.Int32
+	handler := countingHandler(&calls, 0)
+	for i := 0; i < 2; i++ {
+		_, err := auditor(keyContext("alice", "key-1"), req, integrate, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return idempotent(ctx, req, integrate, handler)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the latest event is the replay.
+	events := store.List(audit.Filter{})
+	if len(events) != 2 || calls.Load() != 1 {
+		t.Fatalf("events = %d after %d calls, want both calls audited", len(events), calls.Load())
+	}
+	if !events[0].Replayed || events[1].Replayed {
+		t.Errorf("replayed = %v, %v, want only the second call marked replayed", events[1].Replayed, events[0].Replayed)
+	}
+}
+
+func TestUnaryServerInterceptorConcurrent(t *testing.T) {
+	c := New(time.Minute, 10)
+	defer c.Stop()
+	interceptor := c.UnaryServerInterceptor()
+	req := &clustersv1alpha1.IntegrateClusterRequest{Name: "member"}
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls.Add(1)
+		close(started)
+		<-proceed
+		return &clustersv1alpha1.Cluster{Metadata: &types.ObjectMeta{Name: "member"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, errs[0] = interceptor(keyContext("alice", "key-1"), req, integrate, handler)
+	}()
+	<-started
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, errs[1] = interceptor(keyContext("alice", "key-1"), req, integrate, handler)
+	}()
+	close(proceed)
+	wg.Wait()
+	if errors.Join(errs...) != nil || calls.Load() != 1 {
+		t.Errorf("errors = %v after %d calls, want the duplicate to wait for the first call", errs, calls.Load())
+	}
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	matcher := HeaderMatcher(authentication.IncomingHeaderMatcher)
+	if key, ok := matcher("Idempotency-Key"); !ok || key != Header {
+		t.Errorf("matcher() = %q, %v, want %q", key, ok, Header)
+	}
+	if _, ok := matcher("X-Unknown"); ok {
+		t.Error("the unknown header is forwarded")
+	}
+}
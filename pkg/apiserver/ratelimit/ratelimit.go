@@ -0,0 +1,281 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authentication"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+)
+
+const (
+	// RetryAfterHeader is the metadata of the rejected requests hinting the seconds to retry
+	// after, the grpc-gateway writes it as the Retry-After header.
+	RetryAfterHeader = "retry-after"
+
+	// forwardedForHeader is the client address forwarded by the grpc-gateway, the gateway
+	// appends the remote address of the HTTP request as its last hop.
+	forwardedForHeader = "x-forwarded-for"
+	// realIPHeader is taken from the headers of the clients by the logging middleware, it is
+	// dropped like forwardedForHeader so the clients can not choose the buckets they are
+	// limited by.
+	realIPHeader = "x-real-ip"
+
+	limitUser   = "user"
+	limitMethod = "method"
+
+	// userLimiterTTL is how long the limiters of the idle users are kept.
+	userLimiterTTL = 10 * time.Minute
+)
+
+// Limit is a token bucket, the requests are refilled at QPS up to Burst.
+type Limit struct {
+	QPS   float64
+	Burst int
+}
+
+// Enabled reports whether the limit rejects any request.
+func (l Limit) Enabled() bool {
+	return l.QPS > 0
+}
+
+// Config configures the rate limits of the RPCs.
+type Config struct {
+	// User limits the requests of each user, the unauthenticated ones are limited by
+	// their client addresses.
+	User Limit
+	// Methods limit the requests to the RPCs across the users, they are keyed by the full
+	// method names, e.g. /kantaloupe.dynamia.ai.api.v1.Cluster/ListClusters, or the method
+	// names, e.g. ListClusters.
+	Methods map[string]Limit
+}
+
+// Limiter rejects the RPCs exceeding the rate limits with ResourceExhausted.
+type Limiter struct {
+	limits atomic.Pointer[limits]
+}
+
+// limits are the buckets of a config, they are replaced as a whole when the config changes.
+type limits struct {
+	user    Limit
+	users   *ttlcache.Cache[string, *rate.Limiter]
+	methods map[string]*rate.Limiter
+}
+
+// New returns the limiter of the config.
+func New(config Config) *Limiter {
+	l := &Limiter{}
+	l.limits.Store(newLimits(config))
+	return l
+}
+
+func newLimits(config Config) *limits {
+	l := &limits{
+		user:    config.User,
+		methods: make(map[string]*rate.Limiter, len(config.Methods)),
+	}
+	for method, limit := range config.Methods {
+		if limit.Enabled() {
+			l.methods[method] = rate.NewLimiter(rate.Limit(limit.QPS), limit.Burst)
+		}
+	}
+	if l.user.Enabled() {
+		l.users = ttlcache.New(ttlcache.WithTTL[string, *rate.Limiter](userLimiterTTL))
+		// the limiters are keyed by users, evict the idle ones actively.
+		go l.users.Start()
+	}
+	return l
+}
+
+// Update replaces the limits with the ones of the config, e.g. when the configuration file
+// is reloaded. The buckets start full again.
+func (l *Limiter) Update(config Config) {
+	l.limits.Swap(newLimits(config)).stop()
+}
+
+// Enabled reports whether any limit is configured.
+func (l *Limiter) Enabled() bool {
+	current := l.limits.Load()
+	return current.users != nil || len(current.methods) > 0
+}
+
+// Stop stops evicting the limiters of the idle users.
+func (l *Limiter) Stop() {
+	l.limits.Load().stop()
+}
+
+func (l *limits) stop() {
+	if l.users != nil {
+		l.users.Stop()
+	}
+}
+
+// Allow takes a token of the method from the buckets of the user and the method, the
+// returned error is ResourceExhausted with the delay to retry after if there is none.
+func (l *Limiter) Allow(ctx context.Context, method string) error {
+	// the tokens are reserved and canceled at the same time, so they are restored.
+	now := time.Now()
+	current := l.limits.Load()
+	var reservations []*rate.Reservation
+	for _, limiter := range []struct {
+		name    string
+		limiter *rate.Limiter
+	}{
+		{limitUser, current.userLimiter(ctx)},
+		{limitMethod, current.methodLimiter(method)},
+	} {
+		if limiter.limiter == nil {
+			continue
+		}
+		r := limiter.limiter.ReserveN(now, 1)
+		delay := r.DelayFrom(now)
+		if r.OK() && delay == 0 {
+			reservations = append(reservations, r)
+			continue
+		}
+		// the rejected requests do not take the tokens.
+		r.CancelAt(now)
+		for _, reserved := range reservations {
+			reserved.CancelAt(now)
+		}
+		if !r.OK() {
+			// the burst is 0, the requests are never allowed.
+			delay = time.Second
+		}
+		metrics.RecordThrottledRequest(limiter.name, method)
+		klog.V(4).InfoS("Throttled the request", "method", method, "limit", limiter.name, "retryAfter", delay)
+		return exhausted(ctx, limiter.name, delay)
+	}
+	return nil
+}
+
+func (l *limits) userLimiter(ctx context.Context) *rate.Limiter {
+	if l.users == nil {
+		return nil
+	}
+	key := clientKey(ctx)
+	if item := l.users.Get(key); item != nil {
+		return item.Value()
+	}
+	item, _ := l.users.GetOrSet(key, rate.NewLimiter(rate.Limit(l.user.QPS), l.user.Burst))
+	return item.Value()
+}
+
+func (l *limits) methodLimiter(method string) *rate.Limiter {
+	if limiter, ok := l.methods[method]; ok {
+		return limiter
+	}
+	return l.methods[path.Base(method)]
+}
+
+// clientKey identifies the client of the request, it is the authenticated user or the
+// address of the anonymous ones.
+func clientKey(ctx context.Context) string {
+	if u, ok := authentication.UserFrom(ctx); ok && u.GetName() != "" && u.GetName() != user.Anonymous {
+		return "user:" + u.GetName()
+	}
+	// the address of the requests of the grpc-gateway is forwarded by it. The last hop of
+	// X-Forwarded-For is the remote address of the HTTP request, the previous ones are sent
+	// by the clients and are not trusted.
+	if transport.IsInternal(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(forwardedForHeader); len(values) > 0 {
+			hops := strings.Split(values[len(values)-1], ",")
+			if address := strings.TrimSpace(hops[len(hops)-1]); address != "" {
+				return "address:" + hostOf(address)
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return "address:" + hostOf(p.Addr.String())
+	}
+	return "address:"
+}
+
+func hostOf(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+// exhausted returns the ResourceExhausted error with the retry hints, the delay is sent as
+// the RetryInfo detail and the retry-after header in seconds.
+func exhausted(ctx context.Context, limit string, delay time.Duration) error {
+	seconds := int64(math.Ceil(delay.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10))); err != nil {
+		klog.V(4).InfoS("Failed to set the retry-after header", "err", err)
+	}
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("too many requests, the %s rate limit is exceeded, retry after %s", limit, delay.Round(time.Millisecond)))
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor rejects the unary RPCs exceeding the rate limits.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.Allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streaming RPCs exceeding the rate limits when they
+// are opened, the messages of the opened streams are not limited.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.Allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// IncomingHeaderMatcher drops the client addresses sent as the metadata by the clients besides
+// the headers dropped by matcher, the grpc-gateway forwards the remote addresses itself.
+func IncomingHeaderMatcher(matcher runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		name, ok := matcher(key)
+		if !ok {
+			return "", false
+		}
+		switch strings.ToLower(name) {
+		case forwardedForHeader, realIPHeader:
+			return "", false
+		}
+		return name, true
+	}
+}
+
+// OutgoingHeaderMatcher is the outgoing header matcher of the grpc-gateway, it writes the
+// retry-after metadata as the Retry-After header and the others as the default does.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == RetryAfterHeader {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
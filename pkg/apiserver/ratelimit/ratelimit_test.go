@@ -0,0 +1,209 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+	kantaloupeapi "github.com/dynamia-ai/kantaloupe/api/v1"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/transport"
+)
+
+const listClusters = "/kantaloupe.dynamia.ai.api.v1.Cluster/ListClusters"
+
+func userContext(name string) context.Context {
+	return genericapirequest.WithUser(context.Background(), &user.DefaultInfo{Name: name})
+}
+
+func addressContext(address string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 1234}})
+}
+
+type call struct {
+	ctx    context.Context
+	method string
+	code   codes.Code
+}
+
+func TestLimiterAllow(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		calls  []call
+	}{
+		{
+			name:   "the users are limited separately",
+			config: Config{User: Limit{QPS: 0.001, Burst: 1}},
+			calls: []call{
+				{userContext("alice"), listClusters, codes.OK},
+				{userContext("alice"), "/kantaloupe.dynamia.ai.api.v1.Node/ListNodes", codes.ResourceExhausted},
+				{userContext("bob"), listClusters, codes.OK},
+			},
+		},
+		{
+			name:   "the anonymous users are limited by their addresses",
+			config: Config{User: Limit{QPS: 0.001, Burst: 1}},
+			calls: []call{
+				{addressContext("10.0.0.1"), listClusters, codes.OK},
+				{addressContext("10.0.0.1"), listClusters, codes.ResourceExhausted},
+				{addressContext("10.0.0.2"), listClusters, codes.OK},
+			},
+		},
+		{
+			name:   "the methods are limited across the users",
+			config: Config{Methods: map[string]Limit{"ListClusters": {QPS: 0.001, Burst: 1}}},
+			calls: []call{
+				{userContext("alice"), listClusters, codes.OK},
+				{userContext("bob"), listClusters, codes.ResourceExhausted},
+				{userContext("bob"), "/kantaloupe.dynamia.ai.api.v1.Node/ListNodes", codes.OK},
+			},
+		},
+		{
+			name: "the requests rejected by the method limits do not take the tokens of the users",
+			config: Config{
+				User:    Limit{QPS: 0.001, Burst: 2},
+				Methods: map[string]Limit{listClusters: {QPS: 0.001, Burst: 1}},
+			},
+			calls: []call{
+				{userContext("alice"), listClusters, codes.OK},
+				{userContext("alice"), listClusters, codes.ResourceExhausted},
+				{userContext("alice"), "/kantaloupe.dynamia.ai.api.v1.Node/ListNodes", codes.OK},
+				{userContext("alice"), "/kantaloupe.dynamia.ai.api.v1.Node/ListNodes", codes.ResourceExhausted},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(tt.config)
+			defer l.Stop()
+			for i, call := range tt.calls {
+				err := l.Allow(call.ctx, call.method)
+				st := status.Convert(err)
+				if st.Code() != call.code {
+					t.Fatalf("call %d: Allow() = %v, want %v", i, err, call.code)
+				}
+				if st.Code() != codes.ResourceExhausted {
+					continue
+				}
+				var retryInfo *errdetails.RetryInfo
+				for _, detail := range st.Details() {
+					if info, ok := detail.(*errdetails.RetryInfo); ok {
+						retryInfo = info
+					}
+				}
+				if retryInfo == nil || retryInfo.GetRetryDelay().AsDuration() <= 0 {
+					t.Errorf("call %d: details = %v, want the retry delay", i, st.Details())
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterUpdate(t *testing.T) {
+	l := New(Config{User: Limit{QPS: 0.001, Burst: 1}})
+	defer l.Stop()
+	if err := l.Allow(userContext("alice"), listClusters); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Allow(userContext("alice"), listClusters); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Allow() = %v, want %v", err, codes.ResourceExhausted)
+	}
+
+	l.Update(Config{})
+	if l.Enabled() {
+		t.Error("Enabled() = true after the limits are removed")
+	}
+	if err := l.Allow(userContext("alice"), listClusters); err != nil {
+		t.Errorf("Allow() = %v after the limits are removed", err)
+	}
+}
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	for key, expect := range map[string]string{
+		RetryAfterHeader: "Retry-After",
+		"x-request-id":   "Grpc-Metadata-x-request-id",
+	} {
+		if got, ok := OutgoingHeaderMatcher(key); !ok || got != expect {
+			t.Errorf("OutgoingHeaderMatcher(%s) = %s %v, want %s", key, got, ok, expect)
+		}
+	}
+}
+
+type operationsServer struct {
+	kantaloupeapi.UnimplementedOperationsServer
+}
+
+func (operationsServer) GetOperation(_ context.Context, req *operationsv1alpha1.GetOperationRequest) (*operationsv1alpha1.Operation, error) {
+	return &operationsv1alpha1.Operation{Id: req.GetId()}, nil
+}
+
+func TestGatewayClientAddress(t *testing.T) {
+	l := New(Config{User: Limit{QPS: 0.001, Burst: 1}})
+	defer l.Stop()
+	listener := transport.NewInternalListener()
+	server := grpc.NewServer(grpc.Creds(transport.ServerCredentials()), grpc.UnaryInterceptor(l.UnaryServerInterceptor()))
+	kantaloupeapi.RegisterOperationsServer(server, operationsServer{})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gateway := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher(runtime.DefaultHeaderMatcher)),
+		runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
+	)
+	if err := kantaloupeapi.RegisterOperationsHandlerFromEndpoint(ctx, gateway, listener.Endpoint(), listener.DialOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tt := range []struct {
+		remoteAddr string
+		headers    map[string]string
+		code       int
+	}{
+		{remoteAddr: "10.0.0.1:1234", code: http.StatusOK},
+		// the addresses sent by the client do not move it to another bucket.
+		{
+			remoteAddr: "10.0.0.1:1234",
+			headers: map[string]string{
+				"X-Forwarded-For":               "10.0.0.9",
+				"Grpc-Metadata-X-Forwarded-For": "10.0.0.9",
+				"Grpc-Metadata-X-Real-Ip":       "10.0.0.9",
+			},
+			code: http.StatusTooManyRequests,
+		},
+		{remoteAddr: "10.0.0.2:1234", code: http.StatusOK},
+		// nor into the bucket of another client.
+		{
+			remoteAddr: "10.0.0.3:1234",
+			headers: map[string]string{
+				"Grpc-Metadata-X-Forwarded-For": "10.0.0.2",
+				"Grpc-Metadata-X-Real-Ip":       "10.0.0.2",
+			},
+			code: http.StatusOK,
+		},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/apis/kantaloupe.dynamia.ai/v1/operations/op-1", nil)
+		req.RemoteAddr = tt.remoteAddr
+		for key, value := range tt.headers {
+			req.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		gateway.ServeHTTP(w, req)
+		if w.Code != tt.code {
+			t.Errorf("request %d from %s = %d %s, want %d", i, tt.remoteAddr, w.Code, w.Body.String(), tt.code)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Package requestinfo resolves the target objects of the RPCs from their request messages.
+package requestinfo
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// StringField returns the value of the singular string field of the message, it is empty
+// if the message has no such field.
+func StringField(msg protoreflect.Message, name protoreflect.Name) string {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	return msg.Get(field).String()
+}
+
+// MessageField returns the value of the singular message field of the message, it is nil
+// if the message has no such field or the field is not set.
+func MessageField(msg protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || !msg.Has(field) {
+		return nil
+	}
+	return msg.Get(field).Message()
+}
+
+// Namespace returns the namespace of the request, the creating requests carry it in the
+// metadata of the object.
+func Namespace(msg protoreflect.Message) string {
+	if namespace := StringField(msg, "namespace"); namespace != "" {
+		return namespace
+	}
+	return metadataField(msg, "namespace")
+}
+
+// Name returns the name of the object the request targets.
+func Name(msg protoreflect.Message) string {
+	for _, field := range []protoreflect.Name{"name", "node", "storage_name"} {
+		if name := StringField(msg, field); name != "" {
+			return name
+		}
+	}
+	return metadataField(msg, "name")
+}
+
+func metadataField(msg protoreflect.Message, name protoreflect.Name) string {
+	if data := MessageField(msg, "data"); data != nil {
+		if metadata := MessageField(data, "metadata"); metadata != nil {
+			return StringField(metadata, name)
+		}
+	}
+	return ""
+}
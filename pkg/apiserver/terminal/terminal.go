@@ -0,0 +1,500 @@
+package terminal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/core"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+)
+
+const (
+	// Path is the route of the web terminals of the kantaloupeflows, the pod, the container and
+	// the command are selected by the query parameters of the same names.
+	Path = "/apis/kantaloupe.dynamia.ai/v1/clusters/{cluster}/namespaces/{namespace}/kantaloupeflows/{name}/exec"
+
+	// Protocol is the WebSocket subprotocol of the web terminals. The clients send the JSON text
+	// messages of the stdin and resize ops, the output of the terminal is sent back in binary
+	// messages as it is.
+	Protocol = "terminal.kantaloupe.dynamia.ai"
+
+	opStdin  = "stdin"
+	opResize = "resize"
+
+	// pingPeriod is the period of the pings detecting the broken connections.
+	pingPeriod = 30 * time.Second
+	// writeTimeout is the timeout of writing a message to the client.
+	writeTimeout = 10 * time.Second
+	// maxCloseReasonLength is the limit of the reason of the close messages.
+	maxCloseReasonLength = 123
+)
+
+// defaultCommand starts bash if the image has it, otherwise sh.
+var defaultCommand = []string{"/bin/sh", "-c", "TERM=xterm-256color; export TERM; [ -x /bin/bash ] && exec /bin/bash || exec /bin/sh"}
+
+var (
+	errIdleTimeout    = errors.New("the session is closed after being idle for too long")
+	errSessionExpired = errors.New("the session reaches the maximum duration")
+	errClientClosed   = errors.New("the session is closed by the client")
+	errServerShutdown = errors.New("the server is shutting down")
+)
+
+// Config configures the sessions of the web terminals.
+type Config struct {
+	// IdleTimeout closes the sessions receiving no input for the duration, zero disables it.
+	IdleTimeout time.Duration
+	// MaxSessionDuration closes the sessions lasting longer than it, zero disables it.
+	MaxSessionDuration time.Duration
+}
+
+// message is a message sent by the clients.
+type message struct {
+	// Op is either stdin or resize.
+	Op string `json:"op"`
+	// Data is the input of the stdin op.
+	Data string `json:"data,omitempty"`
+	// Cols and Rows are the terminal size of the resize op.
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
+
+// executorFunc builds the executor streaming the command in the container of the pod.
+type executorFunc func(client *engine.Client, namespace, pod, container string, command []string) (remotecommand.Executor, error)
+
+// Handler serves the web terminals, it opens a TTY in a container of the kantaloupeflow
+// through the exec API of the member cluster.
+type Handler struct {
+	clientManager   engine.ClientManagerInterface
+	workloadService core.Service
+	authorizer      *authorization.Authorizer
+	auditor         *audit.Auditor
+	config          Config
+	upgrader        websocket.Upgrader
+	newExecutor     executorFunc
+
+	// lock guards draining, the sessions are not opened once draining is set.
+	lock     sync.Mutex
+	draining bool
+	sessions sync.WaitGroup
+	// shutdown is closed to close the sessions still open after the drain.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+}
+
+// NewHandler builds the handler of the web terminals, the sessions are authorized by authorizer
+// and their start and end are recorded by auditor.
+func NewHandler(clientManager engine.ClientManagerInterface, authorizer *authorization.Authorizer, auditor *audit.Auditor, config Config) *Handler {
+	return &Handler{
+		clientManager:   clientManager,
+		workloadService: core.NewService(clientManager),
+		authorizer:      authorizer,
+		auditor:         auditor,
+		config:          config,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{Protocol},
+		},
+		newExecutor: newExecutor,
+		shutdown:    make(chan struct{}),
+	}
+}
+
+// Shutdown stops opening the sessions and waits for the open ones to end until ctx is
+// done, then closes the remaining ones telling the clients the server is shutting down.
+func (h *Handler) Shutdown(ctx context.Context) {
+	h.lock.Lock()
+	h.draining = true
+	h.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.sessions.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return
+	case <-ctx.Done():
+	}
+	h.shutdownOnce.Do(func() { close(h.shutdown) })
+	<-done
+}
+
+// track counts the session being opened, it is false if the handler is shutting down.
+func (h *Handler) track() bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.draining {
+		return false
+	}
+	h.sessions.Add(1)
+	return true
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.track() {
+		writeError(w, apierrors.NewServiceUnavailable(errServerShutdown.Error()))
+		return
+	}
+	defer h.sessions.Done()
+
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	cluster, namespace, name := vars["cluster"], vars["namespace"], vars["name"]
+	query := r.URL.Query()
+	command := query["command"]
+	if len(command) == 0 {
+		command = defaultCommand
+	}
+
+	if err := h.authorizer.Authorize(ctx, "exec into kantaloupeflow "+name, authorization.PermissionEditNamespace, cluster, namespace); err != nil {
+		writeError(w, err)
+		return
+	}
+	pod, container, err := h.selectContainer(ctx, cluster, namespace, name, query.Get("pod"), query.Get("container"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	client, err := h.clientManager.GeteClientForRequest(ctx, cluster)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	executor, err := h.newExecutor(client, namespace, pod, container, command)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// the upgrader replies the failed handshakes itself.
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		klog.V(4).InfoS("Failed to upgrade the terminal connection", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	request, _ := json.Marshal(map[string]interface{}{"pod": pod, "container": container, "command": command})
+	event := func(code codes.Code, message string) *audit.Event {
+		return &audit.Event{
+			Method:    Path,
+			Verb:      "exec",
+			Cluster:   cluster,
+			Namespace: namespace,
+			Name:      name,
+			Request:   request,
+			Code:      code.String(),
+			Message:   message,
+		}
+	}
+	start := time.Now()
+	h.auditor.Record(ctx, event(codes.OK, "session started"))
+	klog.V(4).InfoS("Terminal session started", "cluster", cluster, "pod", klog.KRef(namespace, pod), "container", container)
+
+	err = newSession(conn, h.config, h.shutdown).run(ctx, executor)
+
+	end := event(codes.OK, "session ended")
+	if err != nil {
+		end.Code = sessionCode(err).String()
+		end.Message = "session ended: " + err.Error()
+	}
+	end.LatencyMilliseconds = time.Since(start).Milliseconds()
+	h.auditor.Record(ctx, end)
+	klog.V(4).InfoS("Terminal session ended", "cluster", cluster, "pod", klog.KRef(namespace, pod), "container", container, "err", err)
+}
+
+// selectContainer returns the running replica of the kantaloupeflow and its container to exec
+// into, the first running replica and its default container are selected if not specified.
+func (h *Handler) selectContainer(ctx context.Context, cluster, namespace, name, podName, container string) (string, string, error) {
+	pods, err := h.workloadService.ListPods(ctx, cluster, namespace, labels.Set{constants.KantaloupeFlowAppLabelKey: name}.String())
+	if err != nil {
+		return "", "", err
+	}
+	slices.SortFunc(pods, func(a, b *corev1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var pod *corev1.Pod
+	for _, p := range pods {
+		if podName != "" && p.Name != podName {
+			continue
+		}
+		if p.Status.Phase == corev1.PodRunning {
+			pod = p
+			break
+		}
+		if podName != "" {
+			return "", "", status.Errorf(codes.FailedPrecondition, "pod %s is %s, not running", podName, p.Status.Phase)
+		}
+	}
+	if pod == nil {
+		if podName != "" {
+			return "", "", status.Errorf(codes.NotFound, "pod %s of kantaloupeflow %s not found", podName, name)
+		}
+		return "", "", status.Errorf(codes.FailedPrecondition, "kantaloupeflow %s has no running replicas", name)
+	}
+
+	if container == "" {
+		container = utils.DefaultContainerName(pod)
+	}
+	if !slices.ContainsFunc(pod.Spec.Containers, func(c corev1.Container) bool { return c.Name == container }) {
+		return "", "", status.Errorf(codes.InvalidArgument, "container %s is not valid for pod %s", container, pod.Name)
+	}
+	return pod.Name, container, nil
+}
+
+// newExecutor streams the command through the WebSocket exec of the cluster, and falls back
+// to SPDY for the clusters not supporting it.
+func newExecutor(client *engine.Client, namespace, pod, container string, command []string) (remotecommand.Executor, error) {
+	req := client.CoreV1().RESTClient().Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+
+	websocketExecutor, err := remotecommand.NewWebSocketExecutor(client.Config, http.MethodGet, req.URL().String())
+	if err != nil {
+		return nil, err
+	}
+	spdyExecutor, err := remotecommand.NewSPDYExecutor(client.Config, http.MethodPost, req.URL())
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(websocketExecutor, spdyExecutor, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
+
+// session bridges the WebSocket connection of the client and the exec stream of the container.
+type session struct {
+	conn   *websocket.Conn
+	config Config
+
+	writeLock sync.Mutex
+	stdin     *io.PipeReader
+	stdinPipe *io.PipeWriter
+	sizes     chan *remotecommand.TerminalSize
+	done      chan struct{}
+	// shutdown is closed when the server closes the sessions on shutdown.
+	shutdown <-chan struct{}
+}
+
+func newSession(conn *websocket.Conn, config Config, shutdown <-chan struct{}) *session {
+	stdin, stdinPipe := io.Pipe()
+	return &session{
+		conn:      conn,
+		config:    config,
+		stdin:     stdin,
+		stdinPipe: stdinPipe,
+		sizes:     make(chan *remotecommand.TerminalSize, 1),
+		done:      make(chan struct{}),
+		shutdown:  shutdown,
+	}
+}
+
+// run streams the session until the command exits, the client leaves or the session times
+// out, then closes the connection with the reason.
+func (s *session) run(ctx context.Context, executor remotecommand.Executor) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	if s.config.MaxSessionDuration > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, s.config.MaxSessionDuration, errSessionExpired)
+		defer cancelTimeout()
+	}
+	var idle *time.Timer
+	if s.config.IdleTimeout > 0 {
+		idle = time.AfterFunc(s.config.IdleTimeout, func() { cancel(errIdleTimeout) })
+		defer idle.Stop()
+	}
+
+	// unblock the executors copying the stdin once the session is over.
+	stop := context.AfterFunc(ctx, func() { s.stdin.Close() })
+	defer stop()
+
+	go s.readLoop(cancel, idle)
+	go s.pingLoop(ctx)
+	go func() {
+		select {
+		case <-s.shutdown:
+			cancel(errServerShutdown)
+		case <-ctx.Done():
+		}
+	}()
+
+	err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             s,
+		Stdout:            s,
+		Tty:               true,
+		TerminalSizeQueue: s,
+	})
+	if cause := context.Cause(ctx); cause != nil {
+		err = cause
+	}
+	close(s.done)
+	s.stdin.Close()
+
+	if errors.Is(err, errClientClosed) {
+		return nil
+	}
+	s.close(err)
+	return err
+}
+
+// readLoop forwards the messages of the client to the session until the connection is closed.
+func (s *session) readLoop(cancel context.CancelCauseFunc, idle *time.Timer) {
+	defer s.stdinPipe.Close()
+	_ = s.conn.SetReadDeadline(time.Now().Add(2 * pingPeriod))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(2 * pingPeriod))
+	})
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			cancel(errClientClosed)
+			return
+		}
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			klog.V(4).InfoS("Ignored the invalid terminal message", "err", err)
+			continue
+		}
+		switch msg.Op {
+		case opStdin:
+			if idle != nil {
+				idle.Reset(s.config.IdleTimeout)
+			}
+			if _, err := s.stdinPipe.Write([]byte(msg.Data)); err != nil {
+				return
+			}
+		case opResize:
+			if msg.Cols == 0 || msg.Rows == 0 {
+				continue
+			}
+			// only the latest size matters.
+			select {
+			case <-s.sizes:
+			default:
+			}
+			s.sizes <- &remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+		}
+	}
+}
+
+func (s *session) pingLoop(ctx context.Context) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Read implements io.Reader, it is the stdin of the container.
+func (s *session) Read(p []byte) (int, error) {
+	return s.stdin.Read(p)
+}
+
+// Write implements io.Writer, it sends the output of the container to the client.
+func (s *session) Write(p []byte) (int, error) {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := s.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Next implements remotecommand.TerminalSizeQueue.
+func (s *session) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-s.sizes:
+		return size
+	case <-s.done:
+		return nil
+	}
+}
+
+// close sends the close message telling the client why the session ends.
+func (s *session) close(err error) {
+	code, reason := websocket.CloseNormalClosure, "session ended"
+	if err != nil {
+		reason = err.Error()
+		switch sessionCode(err) {
+		case codes.Internal:
+			code = websocket.CloseInternalServerErr
+		case codes.Unavailable:
+			code = websocket.CloseGoingAway
+		}
+	}
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+	}
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeTimeout))
+}
+
+// sessionCode returns the code the end of the session is audited with.
+func sessionCode(err error) codes.Code {
+	var exitErr interface{ ExitStatus() int }
+	switch {
+	case errors.Is(err, errIdleTimeout), errors.Is(err, errSessionExpired):
+		return codes.DeadlineExceeded
+	case errors.Is(err, errServerShutdown):
+		return codes.Unavailable
+	case errors.As(err, &exitErr):
+		// the command exits with a non-zero code.
+		return codes.OK
+	}
+	return codes.Internal
+}
+
+// writeError replies the errors before the connection is upgraded.
+func writeError(w http.ResponseWriter, err error) {
+	code, message := http.StatusInternalServerError, err.Error()
+	var apiStatus apierrors.APIStatus
+	if s, ok := status.FromError(err); ok {
+		code, message = runtime.HTTPStatusFromCode(s.Code()), s.Message()
+	} else if errors.As(err, &apiStatus) && apiStatus.Status().Code != 0 {
+		code = int(apiStatus.Status().Code)
+	}
+	http.Error(w, fmt.Sprintf("failed to open the terminal: %s", message), code)
+}
@@ -0,0 +1,182 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/audit"
+	"github.com/dynamia-ai/kantaloupe/pkg/apiserver/authorization"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+type fakeClientManager struct {
+	client *engine.Client
+}
+
+func (m *fakeClientManager) GeteClient(string) (*engine.Client, error) {
+	return m.client, nil
+}
+
+func (m *fakeClientManager) GeteClientForRequest(context.Context, string) (*engine.Client, error) {
+	return m.client, nil
+}
+
+// echoExecutor echoes the stdin to the stdout until it reads exit.
+type echoExecutor struct {
+	sizes chan remotecommand.TerminalSize
+}
+
+func (e *echoExecutor) Stream(opts remotecommand.StreamOptions) error {
+	return e.StreamWithContext(context.Background(), opts)
+}
+
+func (e *echoExecutor) StreamWithContext(_ context.Context, opts remotecommand.StreamOptions) error {
+	go func() {
+		for size := opts.TerminalSizeQueue.Next(); size != nil; size = opts.TerminalSizeQueue.Next() {
+			e.sizes <- *size
+		}
+	}()
+	buf := make([]byte, 1024)
+	for {
+		n, err := opts.Stdin.Read(buf)
+		if err != nil || string(buf[:n]) == "exit\r" {
+			return nil
+		}
+		if _, err := opts.Stdout.Write(buf[:n]); err != nil {
+			return err
+		}
+	}
+}
+
+func newPod(name string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ml", Labels: map[string]string{constants.KantaloupeFlowAppLabelKey: "flow"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}, {Name: "sidecar"}}},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestHandler(t *testing.T) {
+	authorizer, err := authorization.New(context.TODO(), authorization.Config{Mode: authorization.ModeAlwaysAllow})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := audit.NewStore(10)
+	clientManager := &fakeClientManager{client: &engine.Client{
+		Interface: fake.NewSimpleClientset(newPod("flow-0", corev1.PodPending), newPod("flow-1", corev1.PodRunning)),
+	}}
+	executor := &echoExecutor{sizes: make(chan remotecommand.TerminalSize, 1)}
+	handler := NewHandler(clientManager, authorizer, audit.New(store), Config{IdleTimeout: time.Second})
+	var selected []string
+	handler.newExecutor = func(_ *engine.Client, namespace, pod, container string, _ []string) (remotecommand.Executor, error) {
+		selected = []string{namespace, pod, container}
+		return executor, nil
+	}
+	router := mux.NewRouter()
+	router.Handle(Path, handler)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/apis/kantaloupe.dynamia.ai/v1/clusters/member1/namespaces/ml/kantaloupeflows/flow/exec"
+	dialer := websocket.Dialer{Subprotocols: []string{Protocol}}
+
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	if got := strings.Join(selected, "/"); got != "ml/flow-1/main" {
+		t.Errorf("selected container = %s, want the default container of the running pod", got)
+	}
+	if err := conn.WriteJSON(message{Op: opResize, Cols: 80, Rows: 24}); err != nil {
+		t.Fatal(err)
+	}
+	if size := <-executor.sizes; size.Width != 80 || size.Height != 24 {
+		t.Errorf("terminal size = %v, want 80x24", size)
+	}
+	if err := conn.WriteJSON(message{Op: opStdin, Data: "ls\r"}); err != nil {
+		t.Fatal(err)
+	}
+	if typ, data, err := conn.ReadMessage(); err != nil || typ != websocket.BinaryMessage || string(data) != "ls\r" {
+		t.Errorf("ReadMessage() = %d %q %v, want the echoed input", typ, data, err)
+	}
+	if err := conn.WriteJSON(message{Op: opStdin, Data: "exit\r"}); err != nil {
+		t.Fatal(err)
+	}
+	expectClose(t, conn, websocket.CloseNormalClosure, "session ended")
+
+	// the idle sessions are closed.
+	conn, _, err = dialer.Dial(url+"?container=sidecar", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	expectClose(t, conn, websocket.CloseNormalClosure, errIdleTimeout.Error())
+
+	events := store.List(audit.Filter{Method: Path})
+	if len(events) != 4 {
+		t.Fatalf("got %d audit events, want 4", len(events))
+	}
+	// the latest events are listed first.
+	for i, expect := range []string{"DeadlineExceeded session ended", "OK session started", "OK session ended", "OK session started"} {
+		if got := events[i].Code + " " + events[i].Message; !strings.HasPrefix(got, expect) {
+			t.Errorf("audit event %d = %q, want %q", i, got, expect)
+		}
+	}
+
+	for query, expectCode := range map[string]int{
+		"?pod=flow-0":           http.StatusBadRequest,
+		"?pod=flow-2":           http.StatusNotFound,
+		"?container=unknown":    http.StatusBadRequest,
+		"?pod=flow-1&container": http.StatusSwitchingProtocols,
+	} {
+		conn, resp, err := dialer.Dial(url+query, nil)
+		if resp == nil || resp.StatusCode != expectCode {
+			t.Errorf("Dial(%s) = %v %v, want code %d", query, resp, err, expectCode)
+		}
+		if conn != nil {
+			conn.Close()
+		}
+	}
+
+	// the sessions still open after the drain are closed on shutdown.
+	conn, _, err = dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	go handler.Shutdown(ctx)
+	expectClose(t, conn, websocket.CloseGoingAway, errServerShutdown.Error())
+	if _, resp, _ := dialer.Dial(url, nil); resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Dial() = %v, want code %d after shutdown", resp, http.StatusServiceUnavailable)
+	}
+}
+
+func expectClose(t *testing.T, conn *websocket.Conn, code int, reason string) {
+	t.Helper()
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+		var closeErr *websocket.CloseError
+		if !errors.As(err, &closeErr) || closeErr.Code != code || closeErr.Text != reason {
+			t.Errorf("ReadMessage() error = %v, want close %d %q", err, code, reason)
+		}
+		return
+	}
+}
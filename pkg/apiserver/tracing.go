@@ -0,0 +1,54 @@
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+)
+
+// untracedPrefixes are the paths of the health probes and the metrics, their requests are
+// not traced.
+var untracedPrefixes = []string{"/healthz", "/livez", "/readyz", metrics.Path}
+
+// traceHTTP traces the HTTP requests served by the router in the spans named after their
+// routes, the trace context of the callers is continued.
+func traceHTTP(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, "kantaloupe-apiserver",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			for _, prefix := range untracedPrefixes {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					return false
+				}
+			}
+			return true
+		}),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			if route := mux.CurrentRoute(r); route != nil {
+				if template, err := route.GetPathTemplate(); err == nil {
+					return r.Method + " " + template
+				}
+			}
+			return r.Method
+		}),
+	)
+}
+
+// GatewayTracingMiddleware names the spans of the requests of the grpc-gateway after the
+// path patterns of the RPCs, it is a middleware of the grpc-gateway.
+func GatewayTracingMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern.String())
+			span.SetAttributes(semconv.HTTPRoute(pattern.String()))
+		}
+		next(w, r, pathParams)
+	}
+}
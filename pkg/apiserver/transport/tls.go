@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"sync/atomic"
+
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+	"k8s.io/klog/v2"
+)
+
+// TLSConfig is the configuration of the secure listener of the apiserver.
+type TLSConfig struct {
+	// CertFile and KeyFile are the paths of the serving certificate and its private key,
+	// they are reloaded when the files change on disk.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the path of the CA bundle verifying the client certificates, empty
+	// disables the client certificate authentication. The clients without certificate are
+	// still allowed, they have to authenticate with bearer tokens.
+	ClientCAFile string
+}
+
+// NewTLSConfig builds the tls config of the secure listener, the serving certificate and
+// the client CA bundle are reloaded from disk until ctx is done.
+func NewTLSConfig(ctx context.Context, config TLSConfig) (*tls.Config, error) {
+	servingContent, err := dynamiccertificates.NewDynamicServingContentFromFiles("serving-cert", config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	serving := &servingCert{content: servingContent}
+	if err := serving.load(); err != nil {
+		return nil, err
+	}
+	servingContent.AddListener(serving)
+	go servingContent.Run(ctx, 1)
+
+	var clientCA *dynamiccertificates.DynamicFileCAContent
+	if config.ClientCAFile != "" {
+		clientCA, err = dynamiccertificates.NewDynamicCAContentFromFile("client-ca", config.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		go clientCA.Run(ctx, 1)
+	}
+
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// h2 is required by the gRPC clients, the HTTP/2 requests other than gRPC are served
+		// by the HTTP server as well.
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	return &tls.Config{
+		MinVersion: base.MinVersion,
+		NextProtos: base.NextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := base.Clone()
+			c.Certificates = []tls.Certificate{*serving.cert.Load()}
+			if clientCA != nil {
+				if opts, ok := clientCA.VerifyOptions(); ok {
+					c.ClientAuth = tls.VerifyClientCertIfGiven
+					c.ClientCAs = opts.Roots
+				}
+			}
+			return c, nil
+		},
+	}, nil
+}
+
+// servingCert keeps the parsed serving certificate, it is notified by the dynamic content
+// when the files change.
+type servingCert struct {
+	content *dynamiccertificates.DynamicCertKeyPairContent
+	cert    atomic.Pointer[tls.Certificate]
+}
+
+func (s *servingCert) load() error {
+	cert, err := tls.X509KeyPair(s.content.CurrentCertKeyContent())
+	if err != nil {
+		return err
+	}
+	s.cert.Store(&cert)
+	return nil
+}
+
+// Enqueue implements dynamiccertificates.Listener.
+func (s *servingCert) Enqueue() {
+	if err := s.load(); err != nil {
+		klog.ErrorS(err, "Failed to reload the serving certificate", "name", s.content.Name())
+		return
+	}
+	klog.InfoS("Reloaded the serving certificate", "name", s.content.Name())
+}
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const internalBufferSize = 1 << 20
+
+// ErrClientHandshakeUnsupported is returned by the server credentials when used by clients.
+var ErrClientHandshakeUnsupported = errors.New("client handshake is not supported by the server credentials")
+
+// InternalListener is the in-process listener serving the gRPC requests forwarded by the
+// grpc-gateway, the forwarded requests never leave the process.
+type InternalListener struct {
+	*bufconn.Listener
+}
+
+// NewInternalListener builds the in-process listener of the grpc-gateway.
+func NewInternalListener() *InternalListener {
+	return &InternalListener{Listener: bufconn.Listen(internalBufferSize)}
+}
+
+// Accept marks the accepted connections as internal.
+func (l *InternalListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &internalConn{Conn: conn}, nil
+}
+
+// DialOptions returns the options of the grpc-gateway to dial the gRPC server through
+// the in-process listener.
+func (l *InternalListener) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+}
+
+// Endpoint is the endpoint of the in-process listener for the grpc-gateway.
+func (l *InternalListener) Endpoint() string {
+	return "passthrough:///internal"
+}
+
+type internalConn struct {
+	net.Conn
+}
+
+// InternalAuthInfo is the auth info of the connections of the in-process listener.
+type InternalAuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+// AuthType implements credentials.AuthInfo.
+func (InternalAuthInfo) AuthType() string {
+	return "internal"
+}
+
+// IsInternal reports whether the gRPC request is forwarded by the grpc-gateway of the process.
+func IsInternal(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = p.AuthInfo.(InternalAuthInfo)
+	return ok
+}
+
+// ServerCredentials returns the credentials of the gRPC server. The TLS connections are
+// terminated by the secure listener before cmux, the credentials only expose their state
+// to the handlers, so the gRPC server is able to serve the insecure, secure and internal
+// listeners together.
+func ServerCredentials() credentials.TransportCredentials {
+	return serverCredentials{}
+}
+
+type serverCredentials struct{}
+
+func (serverCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	switch c := unwrap(conn).(type) {
+	case *tls.Conn:
+		return conn, credentials.TLSInfo{
+			State:          c.ConnectionState(),
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}, nil
+	case *internalConn:
+		return conn, InternalAuthInfo{
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}, nil
+	default:
+		return conn, insecureAuthInfo{
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+		}, nil
+	}
+}
+
+func (serverCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, ErrClientHandshakeUnsupported
+}
+
+func (serverCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (c serverCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (serverCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+type insecureAuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+func (insecureAuthInfo) AuthType() string {
+	return "insecure"
+}
+
+type tlsStateKey struct{}
+
+// ConnContext is the ConnContext of the HTTP server, it keeps the TLS state of the
+// connections terminated by the secure listener in the request context.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	if c, ok := unwrap(conn).(*tls.Conn); ok {
+		state := c.ConnectionState()
+		return context.WithValue(ctx, tlsStateKey{}, &state)
+	}
+	return ctx
+}
+
+// TLSStateFrom returns the TLS state of the connection of the HTTP or gRPC request.
+func TLSStateFrom(ctx context.Context) (*tls.ConnectionState, bool) {
+	if state, ok := ctx.Value(tlsStateKey{}).(*tls.ConnectionState); ok {
+		return state, true
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			return &info.State, true
+		}
+	}
+	return nil, false
+}
+
+// unwrap returns the underlying connection of the connections matched by cmux.
+func unwrap(conn net.Conn) net.Conn {
+	if c, ok := conn.(*cmux.MuxConn); ok {
+		return c.Conn
+	}
+	return conn
+}
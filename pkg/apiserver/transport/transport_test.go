@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	ca, caKey := newCertificate(t, "ca", nil, nil)
+	writeCertificate(t, caFile, "", ca, nil)
+	serving, servingKey := newCertificate(t, "serving-1", ca, caKey)
+	writeCertificate(t, certFile, keyFile, serving, servingKey)
+	client, clientKey := newCertificate(t, "alice", ca, caKey)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	config, err := NewTLSConfig(ctx, TLSConfig{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile})
+	if err != nil {
+		t.Fatalf("NewTLSConfig() error = %v", err)
+	}
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	states := make(chan tls.ConnectionState, 10)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			c := conn.(*tls.Conn)
+			if c.Handshake() == nil {
+				states <- c.ConnectionState()
+			}
+			_ = c.Close()
+		}
+	}()
+
+	clientCert := tls.Certificate{Certificate: [][]byte{client.Raw}, PrivateKey: clientKey}
+	if name := handshake(t, l.Addr(), clientCert); name != "serving-1" {
+		t.Errorf("serving certificate = %s, want serving-1", name)
+	}
+	state := <-states
+	if len(state.VerifiedChains) == 0 || state.VerifiedChains[0][0].Subject.CommonName != "alice" {
+		t.Errorf("client certificate is not verified")
+	}
+
+	if handshake(t, l.Addr(), tls.Certificate{}); len((<-states).VerifiedChains) != 0 {
+		t.Errorf("verified chains without client certificate")
+	}
+
+	serving, servingKey = newCertificate(t, "serving-2", ca, caKey)
+	writeCertificate(t, certFile, keyFile, serving, servingKey)
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		name := handshake(t, l.Addr(), tls.Certificate{})
+		<-states
+		if name == "serving-2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("serving certificate is not reloaded")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func handshake(t *testing.T, addr net.Addr, cert tls.Certificate) string {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr.String(), &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return &cert, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+}
+
+func newCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+	}
+	if parent == nil {
+		template.IsCA, template.BasicConstraintsValid = true, true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func writeCertificate(t *testing.T, certFile, keyFile string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	t.Helper()
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if keyFile == "" {
+		return
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
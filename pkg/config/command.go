@@ -0,0 +1,42 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+	cliflag "k8s.io/component-base/cli/flag"
+)
+
+// NewCommand returns the config command of the component of the kind, config dump prints
+// the effective configuration of the flags, the configuration file at configFile and the
+// environment variables.
+func NewCommand(kind string, namedFlagSets cliflag.NamedFlagSets, configFile *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the configuration file",
+	}
+
+	dumpCmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Print the effective configuration as a configuration file",
+		Long: "Print the effective configuration as a configuration file, the flags are applied over the " +
+			"configuration file given by --config as the component does, e.g. to migrate the flags to a file.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := NewLoader(cmd.Flags(), *configFile, kind).Load(); err != nil {
+				return err
+			}
+			data, err := Dump(cmd.Flags(), kind)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+		SilenceUsage: true,
+	}
+	for _, f := range namedFlagSets.FlagSets {
+		dumpCmd.Flags().AddFlagSet(f)
+	}
+
+	cmd.AddCommand(dumpCmd)
+	return cmd
+}
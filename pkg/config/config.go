@@ -0,0 +1,211 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/env"
+)
+
+const (
+	// APIVersion is the version of the configuration files.
+	APIVersion = "config.kantaloupe.dynamia.ai/v1alpha1"
+	// KindAPIServer is the kind of the configuration file of the apiserver.
+	KindAPIServer = "APIServerConfiguration"
+	// KindControllerManager is the kind of the configuration file of the controller-manager.
+	KindControllerManager = "ControllerManagerConfiguration"
+
+	// ConfigFlag is the flag of the path of the configuration file.
+	ConfigFlag = "config"
+	// LogLevelFlag is the flag of the log level.
+	LogLevelFlag = "v"
+)
+
+// Configuration is the configuration file of a component, e.g.
+//
+//	apiVersion: config.kantaloupe.dynamia.ai/v1alpha1
+//	kind: APIServerConfiguration
+//	flags:
+//	  v: 2
+//	  rate-limit-user-qps: 10
+//	  rate-limit-methods: [ListClusters=10:20]
+//	env:
+//	  GATEWAY_PORT_START: 30000
+//
+// The flags set on the command line take precedence over the ones of the file, the
+// environment variables of the file take precedence over the environment.
+type Configuration struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Flags are the values of the flags of the component by their names, the lists are the
+	// values of the flags accepting multiple values.
+	Flags map[string]interface{} `json:"flags,omitempty"`
+	// Env are the settings otherwise read from the environment variables by their names,
+	// e.g. INIT_IMAGE and CLEANUP_INACTIVE_WORKLOAD_THRESHOLD.
+	Env map[string]interface{} `json:"env,omitempty"`
+}
+
+// values are the validated values of a configuration file.
+type values struct {
+	// flags are the values of the flags, a single one for the flags not accepting lists.
+	flags map[string][]string
+	env   map[string]string
+}
+
+// parse decodes and validates the configuration file of the kind for the flags, the values
+// of the flags are checked when they are set.
+func parse(data []byte, kind string, fs *pflag.FlagSet) (*values, error) {
+	c := &Configuration{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("failed to decode the configuration file: %w", err)
+	}
+	if c.APIVersion != APIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q of the configuration file, it must be %s", c.APIVersion, APIVersion)
+	}
+	if c.Kind != kind {
+		return nil, fmt.Errorf("unsupported kind %q of the configuration file, it must be %s", c.Kind, kind)
+	}
+
+	var errList []error
+	v := &values{flags: make(map[string][]string, len(c.Flags)), env: make(map[string]string, len(c.Env))}
+	for _, name := range sortedKeys(c.Flags) {
+		f := fs.Lookup(name)
+		if f == nil || name == ConfigFlag || name == "help" {
+			errList = append(errList, fmt.Errorf("unknown flag %q in the configuration file", name))
+			continue
+		}
+		_, isSlice := f.Value.(pflag.SliceValue)
+		switch value := c.Flags[name].(type) {
+		case []interface{}:
+			if !isSlice {
+				errList = append(errList, fmt.Errorf("flag %q does not accept a list", name))
+				continue
+			}
+			list := make([]string, 0, len(value))
+			for _, item := range value {
+				list = append(list, formatScalar(item))
+			}
+			v.flags[name] = list
+		case map[string]interface{}:
+			errList = append(errList, fmt.Errorf("flag %q does not accept an object", name))
+		default:
+			if isSlice {
+				v.flags[name] = splitList(formatScalar(value))
+			} else {
+				v.flags[name] = []string{formatScalar(value)}
+			}
+		}
+	}
+	for name, value := range c.Env {
+		v.env[name] = formatScalar(value)
+	}
+	errList = append(errList, env.Validate(v.env)...)
+	if len(errList) > 0 {
+		return nil, errors.Join(errList...)
+	}
+	return v, nil
+}
+
+// Dump returns the effective configuration of the flags and the environment variables as
+// the configuration file of the kind.
+func Dump(fs *pflag.FlagSet, kind string) ([]byte, error) {
+	c := &Configuration{
+		APIVersion: APIVersion,
+		Kind:       kind,
+		Flags:      make(map[string]interface{}),
+		Env:        make(map[string]interface{}),
+	}
+	fs.VisitAll(func(f *pflag.Flag) {
+		if f.Name == ConfigFlag || f.Name == "help" {
+			return
+		}
+		c.Flags[f.Name] = typedValue(f)
+	})
+	for name, value := range env.Values() {
+		c.Env[name] = value
+	}
+	return yaml.Marshal(c)
+}
+
+// typedValue returns the value of the flag as its type, so the numbers and the booleans
+// are not quoted in the dumped file.
+func typedValue(f *pflag.Flag) interface{} {
+	if slice, ok := f.Value.(pflag.SliceValue); ok {
+		return slice.GetSlice()
+	}
+	value := f.Value.String()
+	switch t := f.Value.Type(); {
+	case t == "bool":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case strings.HasPrefix(t, "int"), strings.HasPrefix(t, "uint"), strings.HasPrefix(t, "float"), t == "Level":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	}
+	return value
+}
+
+// currentValue returns the value of the flag in the form setFlag accepts.
+func currentValue(f *pflag.Flag) []string {
+	if slice, ok := f.Value.(pflag.SliceValue); ok {
+		return slice.GetSlice()
+	}
+	return []string{f.Value.String()}
+}
+
+// defaultValue returns the default value of the flag in the form setFlag accepts.
+func defaultValue(f *pflag.Flag) []string {
+	if _, ok := f.Value.(pflag.SliceValue); ok {
+		return splitList(strings.TrimSuffix(strings.TrimPrefix(f.DefValue, "["), "]"))
+	}
+	return []string{f.DefValue}
+}
+
+func setFlag(f *pflag.Flag, value []string) error {
+	if slice, ok := f.Value.(pflag.SliceValue); ok {
+		return slice.Replace(value)
+	}
+	return f.Value.Set(value[0])
+}
+
+func formatScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	list := strings.Split(value, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+	return list
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
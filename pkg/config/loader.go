@@ -0,0 +1,208 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/env"
+)
+
+// reloadPeriod is how often the configuration file is checked for changes, the mounted
+// ConfigMaps are updated by replacing the files so they are polled rather than watched.
+const reloadPeriod = 10 * time.Second
+
+// Loader sets the flags and the environment variables of a component from its configuration
+// file and reloads the ones safe to change at runtime when the file changes.
+type Loader struct {
+	path       string
+	kind       string
+	fs         *pflag.FlagSet
+	reloadable sets.Set[string]
+
+	lock sync.Mutex
+	// commandLine are the flags set on the command line, the file does not override them.
+	commandLine sets.Set[string]
+	// data and applied are the content of the file read last and the values in effect.
+	data    []byte
+	applied *values
+}
+
+// NewLoader returns the loader of the configuration file of the kind at the path for the
+// flags, reloadable are the names of the flags and the environment variables safe to
+// change at runtime. The loader does nothing if the path is empty.
+func NewLoader(fs *pflag.FlagSet, path, kind string, reloadable ...string) *Loader {
+	return &Loader{
+		path:       path,
+		kind:       kind,
+		fs:         fs,
+		reloadable: sets.New(reloadable...),
+		applied:    &values{},
+	}
+}
+
+// Load sets the flags not set on the command line and the environment variables from the
+// configuration file, it is called once the command line is parsed.
+func (l *Loader) Load() error {
+	if l.path == "" {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.commandLine = sets.New[string]()
+	l.fs.Visit(func(f *pflag.Flag) {
+		l.commandLine.Insert(f.Name)
+	})
+
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the configuration file: %w", err)
+	}
+	v, err := parse(data, l.kind, l.fs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", l.path, err)
+	}
+	for _, name := range sortedKeys(v.flags) {
+		if l.commandLine.Has(name) {
+			continue
+		}
+		if err := setFlag(l.fs.Lookup(name), v.flags[name]); err != nil {
+			return fmt.Errorf("%s: invalid value %v of flag %q: %w", l.path, v.flags[name], name, err)
+		}
+	}
+	env.Override(v.env)
+	l.data, l.applied = data, v
+	return nil
+}
+
+// Run reloads the configuration file when it changes until ctx is done, onReload is called
+// after the changed values are set, e.g. to validate them and apply them to the component.
+// The changes are reverted if onReload fails.
+func (l *Loader) Run(ctx context.Context, onReload func() error) {
+	if l.path == "" {
+		return
+	}
+	wait.UntilWithContext(ctx, func(context.Context) {
+		if err := l.reload(onReload); err != nil {
+			klog.ErrorS(err, "Failed to reload the configuration file, the previous configuration is kept", "path", l.path)
+		}
+	}, reloadPeriod)
+}
+
+func (l *Loader) reload(onReload func() error) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(data, l.data) {
+		return nil
+	}
+	// the invalid file is reported once rather than on every check.
+	l.data = data
+	v, err := parse(data, l.kind, l.fs)
+	if err != nil {
+		return err
+	}
+
+	next := &values{flags: make(map[string][]string), env: make(map[string]string)}
+	var reloaded, pending []string
+	for _, name := range sets.KeySet(l.applied.flags).Union(sets.KeySet(v.flags)).UnsortedList() {
+		previous, hadPrevious := l.applied.flags[name]
+		value, hasValue := v.flags[name]
+		switch {
+		case l.commandLine.Has(name) || hadPrevious == hasValue && slices.Equal(previous, value):
+			if hasValue {
+				next.flags[name] = value
+			}
+		case !l.reloadable.Has(name):
+			pending = append(pending, name)
+			if hadPrevious {
+				next.flags[name] = previous
+			}
+		default:
+			reloaded = append(reloaded, name)
+			if hasValue {
+				next.flags[name] = value
+			}
+		}
+	}
+	for _, name := range sets.KeySet(l.applied.env).Union(sets.KeySet(v.env)).UnsortedList() {
+		previous, hadPrevious := l.applied.env[name]
+		value, hasValue := v.env[name]
+		switch {
+		case hadPrevious == hasValue && previous == value:
+			if hasValue {
+				next.env[name] = value
+			}
+		case !l.reloadable.Has(name):
+			pending = append(pending, name)
+			if hadPrevious {
+				next.env[name] = previous
+			}
+		default:
+			reloaded = append(reloaded, name)
+			if hasValue {
+				next.env[name] = value
+			}
+		}
+	}
+	slices.Sort(pending)
+	slices.Sort(reloaded)
+	if len(pending) > 0 {
+		klog.InfoS("The changes of the configuration file take effect after restart", "path", l.path, "fields", pending)
+	}
+	if len(reloaded) == 0 {
+		l.applied = next
+		return nil
+	}
+
+	// the flags removed from the file are reset to their defaults.
+	revert := make(map[string][]string)
+	for _, name := range reloaded {
+		f := l.fs.Lookup(name)
+		if f == nil {
+			continue
+		}
+		revert[name] = currentValue(f)
+		value, ok := next.flags[name]
+		if !ok {
+			value = defaultValue(f)
+		}
+		if err := setFlag(f, value); err != nil {
+			l.revert(revert)
+			return fmt.Errorf("invalid value %v of flag %q: %w", value, name, err)
+		}
+	}
+	env.Override(next.env)
+	if onReload != nil {
+		if err := onReload(); err != nil {
+			l.revert(revert)
+			env.Override(l.applied.env)
+			return err
+		}
+	}
+	l.applied = next
+	klog.InfoS("Reloaded the configuration file", "path", l.path, "fields", reloaded)
+	return nil
+}
+
+func (l *Loader) revert(values map[string][]string) {
+	for name, value := range values {
+		if err := setFlag(l.fs.Lookup(name), value); err != nil {
+			klog.ErrorS(err, "Failed to revert the flag", "flag", name)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/env"
+)
+
+var testEnv = env.RegisterIntVar("KANTALOUPE_TEST_THRESHOLD", 10, "The threshold of the tests.")
+
+type testOptions struct {
+	port    int
+	qps     float64
+	methods []string
+}
+
+func newTestFlags(o *testOptions) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.StringVar(new(string), ConfigFlag, "", "")
+	fs.IntVar(&o.port, "port", 8000, "")
+	fs.Float64Var(&o.qps, "qps", 0, "")
+	fs.StringSliceVar(&o.methods, "methods", []string{"ListClusters=1"}, "")
+	return fs
+}
+
+func writeConfig(t *testing.T, path, body string) {
+	t.Helper()
+	content := "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\n" + body
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoaderLoad(t *testing.T) {
+	defer env.Override(nil)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, "flags:\n  port: 9000\n  qps: 2.5\n  methods: [ListNodes=5, ListClusters=10:20]\nenv:\n  KANTALOUPE_TEST_THRESHOLD: 30\n")
+
+	o := &testOptions{}
+	fs := newTestFlags(o)
+	if err := fs.Parse([]string{"--port=8080"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewLoader(fs, path, KindAPIServer).Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if o.port != 8080 {
+		t.Errorf("port = %d, want the one of the command line", o.port)
+	}
+	if o.qps != 2.5 || !slices.Equal(o.methods, []string{"ListNodes=5", "ListClusters=10:20"}) {
+		t.Errorf("qps, methods = %v, %v, want the ones of the file", o.qps, o.methods)
+	}
+	if got := testEnv.Get(); got != 30 {
+		t.Errorf("env = %d, want 30", got)
+	}
+
+	data, err := Dump(fs, KindAPIServer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dumped := &Configuration{}
+	if err := yaml.Unmarshal(data, dumped); err != nil {
+		t.Fatal(err)
+	}
+	if dumped.Flags["port"] != float64(8080) || dumped.Flags["qps"] != 2.5 || dumped.Env["KANTALOUPE_TEST_THRESHOLD"] != "30" {
+		t.Errorf("Dump() = %s", data)
+	}
+	if _, ok := dumped.Flags[ConfigFlag]; ok {
+		t.Errorf("Dump() includes the flag of the configuration file: %s", data)
+	}
+}
+
+func TestLoaderLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "kind", content: "apiVersion: " + APIVersion + "\nkind: ControllerManagerConfiguration\n", wantErr: "unsupported kind"},
+		{name: "unknown field", content: "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\nflag: {}\n", wantErr: "unknown field"},
+		{name: "unknown flag", content: "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\nflags:\n  nope: 1\n", wantErr: `unknown flag "nope"`},
+		{name: "list of a scalar flag", content: "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\nflags:\n  port: [1]\n", wantErr: "does not accept a list"},
+		{name: "invalid flag", content: "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\nflags:\n  port: abc\n", wantErr: `flag "port"`},
+		{name: "invalid env", content: "apiVersion: " + APIVersion + "\nkind: " + KindAPIServer + "\nenv:\n  KANTALOUPE_TEST_THRESHOLD: abc\n", wantErr: "KANTALOUPE_TEST_THRESHOLD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			err := NewLoader(newTestFlags(&testOptions{}), path, KindAPIServer).Load()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoaderReload(t *testing.T) {
+	defer env.Override(nil)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, "flags:\n  port: 9000\n  qps: 1\n")
+
+	o := &testOptions{}
+	fs := newTestFlags(o)
+	l := NewLoader(fs, path, KindAPIServer, "qps", "methods", "KANTALOUPE_TEST_THRESHOLD")
+	if err := l.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the port is not reloadable, it is kept until restart.
+	writeConfig(t, path, "flags:\n  port: 9001\n  methods: [ListNodes=5]\nenv:\n  KANTALOUPE_TEST_THRESHOLD: 20\n")
+	reloads := 0
+	if err := l.reload(func() error { reloads++; return nil }); err != nil {
+		t.Fatalf("reload() error = %v", err)
+	}
+	if reloads != 1 || o.port != 9000 || o.qps != 0 || !slices.Equal(o.methods, []string{"ListNodes=5"}) || testEnv.Get() != 20 {
+		t.Errorf("reloads, port, qps, methods, env = %d, %d, %v, %v, %d", reloads, o.port, o.qps, o.methods, testEnv.Get())
+	}
+
+	// the changes are reverted if they are rejected.
+	writeConfig(t, path, "flags:\n  port: 9001\n  qps: 5\nenv:\n  KANTALOUPE_TEST_THRESHOLD: 40\n")
+	rejected := errors.New("rejected")
+	if err := l.reload(func() error { return rejected }); !errors.Is(err, rejected) {
+		t.Fatalf("reload() error = %v, want %v", err, rejected)
+	}
+	if o.qps != 0 || !slices.Equal(o.methods, []string{"ListNodes=5"}) || testEnv.Get() != 20 {
+		t.Errorf("qps, methods, env = %v, %v, %d, want the previous ones", o.qps, o.methods, testEnv.Get())
+	}
+
+	// the unchanged file is not reloaded again.
+	if err := l.reload(func() error { t.Error("reloaded the unchanged file"); return nil }); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -24,6 +24,10 @@ const (
 	// ManagedByLabelValue defines the label value for resources managed by kantaloupe.
 	ManagedByLabelValue = "kantaloupe"
 
+	// AppliedByLabelKey defines the label key of the field manager the object is applied by,
+	// the objects missing in the bundles applied later by the manager are pruned by it.
+	AppliedByLabelKey = "kantaloupe.dynamia.ai/applied-by"
+
 	// CredentialTypeLabelKey defines the label key for credential type.
 	CredentialTypeLabelKey = "kantaloupe.io/credential-type" // #nosec G101 - not a credential
 	// CredentialTypeDockerRegistry defines the label value for Docker registry credentials.
@@ -37,6 +41,11 @@ const (
 
 	// TODO: fix misspelling.
 	KantaloupeFlowAppLabelKey = "katanloupeflow-name"
+	// KantaloupeFlowHibernatedReplicasAnnotationKey records the replicas of a kantaloupeflow
+	// hibernated by the cluster maintenance, they are restored once the maintenance is over.
+	KantaloupeFlowHibernatedReplicasAnnotationKey = "kantaloupe.dynamia.ai/hibernated-replicas"
+	// KantaloupeFlowMigratedFromAnnotationKey records the cluster a kantaloupeflow is migrated from.
+	KantaloupeFlowMigratedFromAnnotationKey = "kantaloupe.dynamia.ai/migrated-from"
 
 	GlobalCluster          = "local-cluster"
 	DefaultProvider        = "GENERIC"
@@ -46,6 +55,7 @@ const (
 	MasterLabelKey     = "node-role.kubernetes.io/master"
 	WorkerLabelKey     = "node-role.kubernetes.io/worker"
 
+	SortByAsc  = "asc"
 	SortByDesc = "desc"
 )
 
@@ -88,6 +98,13 @@ const (
 	AWSNeuronCore        = "aws.amazon.com/neuroncore"
 )
 
+// Accelerator node register annotations.
+const (
+	NvidiaNodeRegisterAnnotationKey    = "hami.io/node-nvidia-register"
+	MetaxNodeRegisterAnnotationKey     = "metax-tech.com/node-gpu-devices"
+	AscendNodeRegisterAnnotationPrefix = "hami.io/node-register-Ascend"
+)
+
 // Provider constants.
 const (
 	GCPLabelKey = "cloud.google.com/gke-nodepool"
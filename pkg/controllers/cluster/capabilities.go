@@ -0,0 +1,239 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	prometheusapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/klog/v2"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+)
+
+const (
+	hamiComponentLabelKey   = "app.kubernetes.io/component"
+	hamiSchedulerComponent  = "hami-scheduler"
+	appVersionLabelKey      = "app.kubernetes.io/version"
+	gatewayBundleVersionKey = "gateway.networking.k8s.io/bundle-version"
+	gatewayCRDPath          = "/apis/apiextensions.k8s.io/v1/customresourcedefinitions/gateways." + gatewayv1.GroupName
+
+	prometheusBuildinfoTimeout = 5 * time.Second
+	// prometheusVersionResyncPeriod is the period the version of the Prometheus of a member
+	// cluster is probed again with, unless the address of the Prometheus is changed.
+	prometheusVersionResyncPeriod = 10 * time.Minute
+)
+
+// getClusterCapabilities discovers the versions of the components installed in the member
+// cluster. A component which is not found is regarded as not installed, while a component
+// which fails to be discovered keeps the version previously recorded in the cluster status,
+// so that a transient failure does not withdraw the KantaloupeFlow features of the cluster.
+func (c *Controller) getClusterCapabilities(ctx context.Context, discoveryClient discovery.DiscoveryInterface,
+	cluster *clustercrdv1alpha1.Cluster, kubernetesVersion string, pods []*corev1.Pod, listPodsErr error,
+) *clustercrdv1alpha1.ClusterCapabilities {
+	previous := cluster.Status.Capabilities
+	if previous == nil {
+		previous = &clustercrdv1alpha1.ClusterCapabilities{}
+	}
+	capabilities := &clustercrdv1alpha1.ClusterCapabilities{
+		KubernetesVersion: kubernetesVersion,
+		HAMiVersion:       previous.HAMiVersion,
+	}
+	if listPodsErr == nil {
+		capabilities.HAMiVersion = getHAMiVersion(pods)
+	}
+
+	routeKinds, err := getGatewayRouteKinds(discoveryClient)
+	if err != nil {
+		klog.V(4).ErrorS(err, "failed to discover gateway-api routes", "cluster", klog.KObj(cluster))
+		capabilities.RouteKinds = previous.RouteKinds
+		capabilities.GatewayAPIVersion = previous.GatewayAPIVersion
+	} else {
+		capabilities.RouteKinds = routeKinds
+		if len(routeKinds) > 0 {
+			capabilities.GatewayAPIVersion, err = getGatewayAPIVersion(ctx, discoveryClient)
+			if err != nil {
+				klog.V(4).ErrorS(err, "failed to get gateway-api version", "cluster", klog.KObj(cluster))
+				capabilities.GatewayAPIVersion = previous.GatewayAPIVersion
+			}
+		}
+	}
+
+	capabilities.PrometheusVersion = c.prometheusVersionCache.version(ctx, cluster, previous.PrometheusVersion, getPrometheusVersion)
+
+	capabilities.KantaloupeFlowFeatures = getKantaloupeFlowFeatures(capabilities)
+	return capabilities
+}
+
+// getHAMiVersion returns the version of the HAMi scheduler, the app version label is
+// preferred and the image tag is used otherwise.
+func getHAMiVersion(pods []*corev1.Pod) string {
+	for _, pod := range pods {
+		if pod.Labels[hamiComponentLabelKey] != hamiSchedulerComponent {
+			continue
+		}
+		if version := pod.Labels[appVersionLabelKey]; version != "" {
+			return version
+		}
+		for _, container := range pod.Spec.Containers {
+			if tag := imageTag(container.Image); tag != "" {
+				return tag
+			}
+		}
+	}
+	return ""
+}
+
+// imageTag returns the tag of the image, it is empty if the image is referenced by digest only.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	idx := strings.LastIndex(image, ":")
+	if idx < 0 || strings.Contains(image[idx+1:], "/") {
+		return ""
+	}
+	return image[idx+1:]
+}
+
+// getGatewayRouteKinds returns the route kinds served by the member cluster, a kind served
+// with several versions is reported with the most stable one. The route kinds are empty if
+// gateway-api is not installed.
+func getGatewayRouteKinds(discoveryClient discovery.DiscoveryInterface) ([]clustercrdv1alpha1.RouteKind, error) {
+	routeKinds := []clustercrdv1alpha1.RouteKind{}
+	for _, gv := range []string{gatewayv1.GroupVersion.String(), gatewayv1alpha2.GroupVersion.String()} {
+		resources, err := discoveryClient.ServerResourcesForGroupVersion(gv)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources.APIResources {
+			if !strings.HasSuffix(resource.Kind, "Route") || strings.Contains(resource.Name, "/") {
+				continue
+			}
+			if slices.ContainsFunc(routeKinds, func(r clustercrdv1alpha1.RouteKind) bool { return r.Kind == resource.Kind }) {
+				continue
+			}
+			routeKinds = append(routeKinds, clustercrdv1alpha1.RouteKind{
+				Kind:    resource.Kind,
+				Version: strings.TrimPrefix(gv, gatewayv1.GroupName+"/"),
+			})
+		}
+	}
+	slices.SortFunc(routeKinds, func(a, b clustercrdv1alpha1.RouteKind) int { return strings.Compare(a.Kind, b.Kind) })
+	return routeKinds, nil
+}
+
+// getGatewayAPIVersion returns the bundle version recorded in the gateway-api CRDs.
+func getGatewayAPIVersion(ctx context.Context, discoveryClient discovery.DiscoveryInterface) (string, error) {
+	data, err := discoveryClient.RESTClient().Get().AbsPath(gatewayCRDPath).DoRaw(ctx)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	crd := &metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(data, crd); err != nil {
+		return "", err
+	}
+	return crd.Annotations[gatewayBundleVersionKey], nil
+}
+
+func getPrometheusVersion(ctx context.Context, address string) (string, error) {
+	client, err := prometheusapi.NewClient(prometheusapi.Config{Address: address})
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, prometheusBuildinfoTimeout)
+	defer cancel()
+	buildinfo, err := promv1.NewAPI(client).Buildinfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return buildinfo.Version, nil
+}
+
+// getKantaloupeFlowFeatures returns the KantaloupeFlow features enabled by the installed components.
+func getKantaloupeFlowFeatures(capabilities *clustercrdv1alpha1.ClusterCapabilities) []clustercrdv1alpha1.KantaloupeFlowFeature {
+	features := []clustercrdv1alpha1.KantaloupeFlowFeature{}
+	for _, routeKind := range capabilities.RouteKinds {
+		switch routeKind.Kind {
+		case "HTTPRoute":
+			features = append(features, clustercrdv1alpha1.KantaloupeFlowFeatureHTTPRoute)
+		case "TCPRoute":
+			features = append(features, clustercrdv1alpha1.KantaloupeFlowFeatureTCPRoute)
+		}
+	}
+	if capabilities.HAMiVersion != "" {
+		features = append(features, clustercrdv1alpha1.KantaloupeFlowFeatureGPUSharing)
+	}
+	return features
+}
+
+type prometheusVersion struct {
+	// address is the address of the Prometheus the version is probed from.
+	address string
+	// version is the last probed version, it is kept if the probe fails.
+	version string
+	// probeTime is the time of the last probe.
+	probeTime time.Time
+}
+
+// prometheusVersionStore caches the version of the Prometheus of each cluster, so that the
+// buildinfo API is not called on every status sync.
+type prometheusVersionStore struct {
+	versionMap sync.Map
+}
+
+// version returns the version of the Prometheus of the cluster, the Prometheus is probed only
+// if its address is changed or the cached version is older than prometheusVersionResyncPeriod.
+// The previous version is returned if the probe fails.
+func (s *prometheusVersionStore) version(ctx context.Context, cluster *clustercrdv1alpha1.Cluster, previous string,
+	probe func(context.Context, string) (string, error),
+) string {
+	address := cluster.Spec.PrometheusAddress
+	if address == "" {
+		s.delete(cluster.Name)
+		return ""
+	}
+	if saved := s.get(cluster.Name); saved != nil {
+		if saved.address == address && time.Since(saved.probeTime) < prometheusVersionResyncPeriod {
+			return saved.version
+		}
+		if saved.address != address {
+			// the previous version belongs to another Prometheus.
+			previous = ""
+		}
+	}
+
+	version, err := probe(ctx, address)
+	if err != nil {
+		klog.V(4).ErrorS(err, "failed to get prometheus version", "cluster", klog.KObj(cluster))
+		version = previous
+	}
+	s.versionMap.Store(cluster.Name, &prometheusVersion{address: address, version: version, probeTime: time.Now()})
+	return version
+}
+
+func (s *prometheusVersionStore) get(cluster string) *prometheusVersion {
+	version, ok := s.versionMap.Load(cluster)
+	if !ok {
+		return nil
+	}
+	return version.(*prometheusVersion)
+}
+
+func (s *prometheusVersionStore) delete(cluster string) {
+	s.versionMap.Delete(cluster)
+}
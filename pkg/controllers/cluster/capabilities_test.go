@@ -0,0 +1,210 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+)
+
+func TestGetHAMiVersion(t *testing.T) {
+	schedulerPod := func(labels map[string]string, image string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "hami-scheduler", Labels: labels},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "scheduler", Image: image}}},
+		}
+	}
+	tests := []struct {
+		name     string
+		pods     []*corev1.Pod
+		expected string
+	}{
+		{
+			name:     "hami is not installed",
+			pods:     []*corev1.Pod{schedulerPod(nil, "nginx:1.25")},
+			expected: "",
+		},
+		{
+			name: "version label",
+			pods: []*corev1.Pod{schedulerPod(map[string]string{
+				hamiComponentLabelKey: hamiSchedulerComponent,
+				appVersionLabelKey:    "2.5.0",
+			}, "projecthami/hami:v2.5.0")},
+			expected: "2.5.0",
+		},
+		{
+			name:     "image tag with registry port",
+			pods:     []*corev1.Pod{schedulerPod(map[string]string{hamiComponentLabelKey: hamiSchedulerComponent}, "registry:5000/projecthami/hami:v2.4.1")},
+			expected: "v2.4.1",
+		},
+		{
+			name:     "image digest",
+			pods:     []*corev1.Pod{schedulerPod(map[string]string{hamiComponentLabelKey: hamiSchedulerComponent}, "registry:5000/projecthami/hami@sha256:abcd")},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getHAMiVersion(tt.pods); got != tt.expected {
+				t.Errorf("getHAMiVersion() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetGatewayRouteKinds(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "gateway.networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "gateways", Kind: "Gateway"},
+				{Name: "httproutes", Kind: "HTTPRoute"},
+				{Name: "httproutes/status", Kind: "HTTPRoute"},
+			},
+		},
+		{
+			GroupVersion: "gateway.networking.k8s.io/v1alpha2",
+			APIResources: []metav1.APIResource{
+				{Name: "httproutes", Kind: "HTTPRoute"},
+				{Name: "tcproutes", Kind: "TCPRoute"},
+			},
+		},
+	}
+
+	routeKinds, err := getGatewayRouteKinds(client.Discovery())
+	if err != nil {
+		t.Fatalf("getGatewayRouteKinds() error = %v", err)
+	}
+	expected := []clustercrdv1alpha1.RouteKind{
+		{Kind: "HTTPRoute", Version: "v1"},
+		{Kind: "TCPRoute", Version: "v1alpha2"},
+	}
+	if !reflect.DeepEqual(routeKinds, expected) {
+		t.Errorf("getGatewayRouteKinds() = %+v, want %+v", routeKinds, expected)
+	}
+
+	features := getKantaloupeFlowFeatures(&clustercrdv1alpha1.ClusterCapabilities{RouteKinds: routeKinds, HAMiVersion: "v2.4.1"})
+	expectedFeatures := []clustercrdv1alpha1.KantaloupeFlowFeature{
+		clustercrdv1alpha1.KantaloupeFlowFeatureHTTPRoute,
+		clustercrdv1alpha1.KantaloupeFlowFeatureTCPRoute,
+		clustercrdv1alpha1.KantaloupeFlowFeatureGPUSharing,
+	}
+	if !reflect.DeepEqual(features, expectedFeatures) {
+		t.Errorf("getKantaloupeFlowFeatures() = %v, want %v", features, expectedFeatures)
+	}
+
+	routeKinds, err = getGatewayRouteKinds(fake.NewSimpleClientset().Discovery())
+	if err != nil || len(routeKinds) != 0 {
+		t.Errorf("getGatewayRouteKinds() = %+v, %v, want no route kinds if gateway-api is not installed", routeKinds, err)
+	}
+
+	client.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	if _, err := getGatewayRouteKinds(client.Discovery()); err == nil {
+		t.Errorf("getGatewayRouteKinds() expects an error if the discovery fails")
+	}
+}
+
+func TestGetClusterCapabilitiesKeepsPrevious(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	cluster := &clustercrdv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member"},
+		Status: clustercrdv1alpha1.ClusterStatus{
+			Capabilities: &clustercrdv1alpha1.ClusterCapabilities{
+				KubernetesVersion: "v1.30.0",
+				HAMiVersion:       "v2.4.1",
+				GatewayAPIVersion: "v1.2.0",
+				RouteKinds:        []clustercrdv1alpha1.RouteKind{{Kind: "HTTPRoute", Version: "v1"}},
+			},
+		},
+	}
+
+	c := &Controller{}
+	capabilities := c.getClusterCapabilities(context.Background(), client.Discovery(), cluster, "v1.31.0", nil, errors.New("informer not synced"))
+	expected := &clustercrdv1alpha1.ClusterCapabilities{
+		KubernetesVersion: "v1.31.0",
+		HAMiVersion:       "v2.4.1",
+		GatewayAPIVersion: "v1.2.0",
+		RouteKinds:        []clustercrdv1alpha1.RouteKind{{Kind: "HTTPRoute", Version: "v1"}},
+		KantaloupeFlowFeatures: []clustercrdv1alpha1.KantaloupeFlowFeature{
+			clustercrdv1alpha1.KantaloupeFlowFeatureHTTPRoute,
+			clustercrdv1alpha1.KantaloupeFlowFeatureGPUSharing,
+		},
+	}
+	if !reflect.DeepEqual(capabilities, expected) {
+		t.Errorf("getClusterCapabilities() = %+v, want %+v", capabilities, expected)
+	}
+
+	capabilities = c.getClusterCapabilities(context.Background(), fake.NewSimpleClientset().Discovery(), cluster, "v1.31.0", nil, nil)
+	if capabilities.HAMiVersion != "" || len(capabilities.RouteKinds) != 0 || capabilities.GatewayAPIVersion != "" ||
+		len(capabilities.KantaloupeFlowFeatures) != 0 {
+		t.Errorf("getClusterCapabilities() = %+v, want no components once they are uninstalled", capabilities)
+	}
+}
+
+func TestPrometheusVersionStore(t *testing.T) {
+	probes := 0
+	var probeErr error
+	probe := func(context.Context, string) (string, error) {
+		probes++
+		if probeErr != nil {
+			return "", probeErr
+		}
+		return fmt.Sprintf("2.%d.0", probes), nil
+	}
+	cluster := &clustercrdv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member"},
+		Spec:       clustercrdv1alpha1.ClusterSpec{PrometheusAddress: "http://prometheus:9090"},
+	}
+	ctx := context.Background()
+	s := &prometheusVersionStore{}
+
+	if got := s.version(ctx, cluster, "", probe); got != "2.1.0" || probes != 1 {
+		t.Fatalf("version() = %s after %d probes, want 2.1.0 after 1 probe", got, probes)
+	}
+	if got := s.version(ctx, cluster, "2.1.0", probe); got != "2.1.0" || probes != 1 {
+		t.Errorf("version() = %s after %d probes, want the cached version", got, probes)
+	}
+
+	// the version expires after the resync period and the previous one is kept if the probe fails.
+	s.get(cluster.Name).probeTime = time.Now().Add(-prometheusVersionResyncPeriod)
+	probeErr = errors.New("timeout")
+	if got := s.version(ctx, cluster, "2.1.0", probe); got != "2.1.0" || probes != 2 {
+		t.Errorf("version() = %s after %d probes, want the previous version after 2 probes", got, probes)
+	}
+	if got := s.version(ctx, cluster, "2.1.0", probe); got != "2.1.0" || probes != 2 {
+		t.Errorf("version() = %s after %d probes, want a failed probe not to be retried on every sync", got, probes)
+	}
+
+	// the previous version belongs to another Prometheus once the address is changed.
+	cluster.Spec.PrometheusAddress = "http://prometheus.monitoring:9090"
+	if got := s.version(ctx, cluster, "2.1.0", probe); got != "" || probes != 3 {
+		t.Errorf("version() = %s after %d probes, want no version after 3 probes", got, probes)
+	}
+	probeErr = nil
+	s.get(cluster.Name).probeTime = time.Time{}
+	if got := s.version(ctx, cluster, "", probe); got != "2.4.0" || probes != 4 {
+		t.Errorf("version() = %s after %d probes, want 2.4.0 after 4 probes", got, probes)
+	}
+
+	cluster.Spec.PrometheusAddress = ""
+	if got := s.version(ctx, cluster, "2.4.0", probe); got != "" || s.get(cluster.Name) != nil {
+		t.Errorf("version() = %s, want no version without the address of the Prometheus", got)
+	}
+}
@@ -9,20 +9,17 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -54,6 +51,8 @@ import (
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/helper"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/informermanager"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -87,10 +86,10 @@ const (
 	clusterNotReachableMsg    = "cluster is not reachable"
 	statusCollectionFailed    = "StatusCollectionFailed"
 
-	metaxAnnotationKey        = "metax-tech.com/node-gpu-devices"
-	nvidiaAnnotationKey       = "hami.io/node-nvidia-register"
-	neuronDeviceKey           = "aws.amazon.com/neuron"
-	ascendAnnotationPrefixKey = "hami.io/node-register-Ascend"
+	metaxAnnotationKey        = constants.MetaxNodeRegisterAnnotationKey
+	nvidiaAnnotationKey       = constants.NvidiaNodeRegisterAnnotationKey
+	neuronDeviceKey           = constants.AWSNeuron
+	ascendAnnotationPrefixKey = constants.AscendNodeRegisterAnnotationPrefix
 )
 
 var kubeConfigCache sync.Map
@@ -104,12 +103,20 @@ type Controller struct {
 	ClusterClientSetFunc func(string, client.Client, *utils.ClientOption) (*utils.ClusterClient, error)
 	// ClusterClientOption holds the attributes that should be injected to a Kubernetes client.
 	ClusterClientOption *utils.ClientOption
+	// MemberClientFunc returns the client the resources of a member cluster are cleaned up with,
+	// the client is built from the kubeconfig of the cluster if it is nil.
+	MemberClientFunc func(*clustercrdv1alpha1.Cluster) (client.Client, error)
 	// clusterConditionCache stores the condition status of each cluster.
 	clusterConditionCache clusterConditionStore
+	// prometheusVersionCache stores the version of the Prometheus of each cluster.
+	prometheusVersionCache prometheusVersionStore
 	// ClusterSuccessThreshold is the duration of successes for the cluster to be considered healthy after recovery.
 	ClusterSuccessThreshold metav1.Duration
 	// ClusterFailureThreshold is the duration of failure for the cluster to be considered unhealthy.
 	ClusterFailureThreshold metav1.Duration
+	// ClusterCleanupTimeout is the duration to wait for the cleanup of a cluster being deleted,
+	// the cluster is removed forcibly after the timeout.
+	ClusterCleanupTimeout metav1.Duration
 	// ConcurrentClusterStatusSyncs is the number of cluster status that are allowed to sync concurrently.
 	ConcurrentWorkSyncs int
 	// ClusterStatusUpdateFrequency is the frequency that controller computes and report cluster status.
@@ -147,21 +154,23 @@ func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Reques
 		if apierrors.IsNotFound(err) {
 			c.InformerManager.Stop(req.Name)
 			c.clusterConditionCache.delete(req.Name)
+			c.prometheusVersionCache.delete(req.Name)
 			return controllerruntime.Result{}, nil
 		}
 		return controllerruntime.Result{}, err
 	}
 
-	// remove the finalizer if the cluster is be deleted.
+	// clean up the cluster and remove the finalizer if the cluster is be deleted.
 	if !cluster.DeletionTimestamp.IsZero() {
-		// add loginc for deleting cluster
 		c.InformerManager.Stop(req.Name)
 		c.clusterConditionCache.delete(req.Name)
-		if err := c.removeFinalizer(ctx, cluster); err != nil {
-			klog.ErrorS(err, "failed to delete finalizer for cluster", "cluster", klog.KObj(cluster))
+		c.prometheusVersionCache.delete(req.Name)
+		result, err := c.deregisterCluster(ctx, cluster)
+		if err != nil {
+			klog.ErrorS(err, "failed to deregister cluster", "cluster", klog.KObj(cluster))
 			return controllerruntime.Result{}, err
 		}
-		return controllerruntime.Result{}, nil
+		return result, nil
 	}
 	if err := c.ensureFinalizer(ctx, cluster); err != nil {
 		klog.ErrorS(err, "faild to ensure finalizer for cluster", "cluster", klog.KObj(cluster))
@@ -212,6 +221,9 @@ func (c *Controller) syncCluster(ctx context.Context, cluster *clustercrdv1alpha
 			return err
 		}
 		conditions = append(conditions, *readyCondition)
+		if maintenanceCondition := c.syncMaintenance(ctx, clusterClient, cluster, &currentClusterStatus); maintenanceCondition != nil {
+			conditions = append(conditions, *maintenanceCondition)
+		}
 		return c.updateStatusIfNeeded(ctx, cluster, currentClusterStatus, conditions...)
 	}
 
@@ -249,9 +261,9 @@ func (c *Controller) setCurrentClusterStatus(
 		klog.ErrorS(err, "Failed to list nodes for Cluster", "cluster", klog.KObj(cluster))
 	}
 
-	pods, err := listPods(clusterInformerManager)
-	if err != nil {
-		klog.ErrorS(err, "Failed to list pods for Cluster", "cluster", klog.KObj(cluster))
+	pods, listPodsErr := listPods(clusterInformerManager)
+	if listPodsErr != nil {
+		klog.ErrorS(listPodsErr, "Failed to list pods for Cluster", "cluster", klog.KObj(cluster))
 	}
 
 	kts, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(corev1.NamespaceAll).List(ctx, metav1.ListOptions{})
@@ -263,6 +275,10 @@ func (c *Controller) setCurrentClusterStatus(
 	currentClusterStatus.KubeSystemID = kubeSystemID
 	currentClusterStatus.NodeSummary = getNodeSummary(nodes)
 	currentClusterStatus.ResourceSummary = getResourceSummary(nodes, pods)
+	currentClusterStatus.GPUSummary = getGPUSummary(nodes)
+	currentClusterStatus.Provider = nodeutil.DetectProvider(nodes)
+	currentClusterStatus.AcceleratorTypes = nodeutil.DetectAcceleratorTypes(nodes)
+	currentClusterStatus.Capabilities = c.getClusterCapabilities(ctx, clusterClient.KubeClient.Discovery(), cluster, clusterVersion, pods, listPodsErr)
 	currentClusterStatus.KantaloupeflowSummary = &clustercrdv1alpha1.ResourceSummary{
 		TotalNum: int32(len(kts.Items)),
 		ReadyNum: helper.GetReadyKantaloupeflowNum(kts.Items),
@@ -282,47 +298,6 @@ func (c *Controller) ensureFinalizer(ctx context.Context, cluster *clustercrdv1a
 	return nil
 }
 
-func (c *Controller) removeFinalizer(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) error {
-	if err := c.DeleteScrapeConfig(ctx, cluster); err != nil {
-		return err
-	}
-	// build member cluster client
-	config, err := utils.ClusterKubeconfig(cluster.Name, c.Client, &utils.ClientOption{})
-	if err != nil {
-		return err
-	}
-	k8sClient, err := client.New(config, client.Options{})
-	if err != nil {
-		return err
-	}
-	monitoringv1.AddToScheme(k8sClient.Scheme())
-	serviceMonitors := []string{
-		constants.HamiDevicePluginSMName,
-		constants.HamiSchedulerSMName,
-		constants.MetaxSMTemplateName,
-		constants.NvidiaSMTemplateName,
-		constants.AscendSMTemplateName,
-	}
-	for _, name := range serviceMonitors {
-		sm := &monitoringv1.ServiceMonitor{}
-		err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "monitoring", Name: name}, sm)
-		if err != nil {
-			if apierrors.IsNotFound(err) {
-				continue
-			}
-			return err
-		}
-		err = k8sClient.Delete(ctx, sm)
-		if err != nil && !apierrors.IsNotFound(err) {
-			return err
-		}
-	}
-	if ctrlutil.RemoveFinalizer(cluster, ClusterControllerFinalizer) {
-		return c.Client.Update(ctx, cluster)
-	}
-	return nil
-}
-
 // updateStatusIfNeeded calls updateStatus only if the status of the member cluster is
 // not the same as the old status.
 func (c *Controller) updateStatusIfNeeded(ctx context.Context, cluster *clustercrdv1alpha1.Cluster,
@@ -343,6 +318,11 @@ func (c *Controller) updateStatusIfNeeded(ctx context.Context, cluster *clusterc
 					cluster.Status.PodSetSummary = currentClusterStatus.PodSetSummary
 					cluster.Status.KantaloupeflowSummary = currentClusterStatus.KantaloupeflowSummary
 					cluster.Status.ResourceSummary = currentClusterStatus.ResourceSummary
+					cluster.Status.GPUSummary = currentClusterStatus.GPUSummary
+					cluster.Status.Provider = currentClusterStatus.Provider
+					cluster.Status.AcceleratorTypes = currentClusterStatus.AcceleratorTypes
+					cluster.Status.Capabilities = currentClusterStatus.Capabilities
+					cluster.Status.Maintenance = currentClusterStatus.Maintenance
 
 					// add others...
 
@@ -549,52 +529,24 @@ func (c *Controller) EnsureGlobalClusterExist(ctx context.Context, kubeConfig st
 	return c.applyGlobalCluster(ctx, globalCluster)
 }
 
-// getClusterProviderAndType return cluster Provider and Type.
+// getClusterProviderAndType return cluster Provider and Type, the Type is the first
+// accelerator type detected if the cluster has mixed vendors.
 func (c *Controller) getClusterProviderAndType(ctx context.Context) (string, string, error) {
-	// Get cluster type from node Annotations.
-	clusterType := ""
-	provider := constants.DefaultProvider
-
 	var nodes corev1.NodeList
 	if err := c.Client.List(ctx, &nodes); err != nil {
 		return "", "", err
 	}
 
-	if len(nodes.Items) > 0 {
-		providers := map[string]string{
-			constants.GCPLabelKey: "GCP_GKE",
-			constants.AWSLabelKey: "AWS_EKS",
-		}
-		for labelKey, prov := range providers {
-			if _, ok := nodes.Items[0].Labels[labelKey]; ok {
-				provider = prov
-				break
-			}
-		}
+	nodePtrs := make([]*corev1.Node, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		nodePtrs = append(nodePtrs, &nodes.Items[i])
 	}
 
-	for _, node := range nodes.Items {
-		if _, exists := node.Annotations[metaxAnnotationKey]; exists {
-			clusterType = "METAX"
-		}
-		if _, exists := node.Annotations[nvidiaAnnotationKey]; exists {
-			clusterType = "NVIDIA"
-		}
-		if _, exists := node.Status.Allocatable[neuronDeviceKey]; exists {
-			clusterType = "NEURON"
-		}
-		for key := range node.Annotations {
-			if strings.HasPrefix(key, ascendAnnotationPrefixKey) {
-				clusterType = "ASCEND"
-				break
-			}
-		}
-		if clusterType != "" {
-			break
-		}
+	clusterType := ""
+	if types := nodeutil.DetectAcceleratorTypes(nodePtrs); len(types) > 0 {
+		clusterType = types[0]
 	}
-
-	return provider, clusterType, nil
+	return nodeutil.DetectProvider(nodePtrs), clusterType, nil
 }
 
 // applyGlobalCluster make sure the global cluster is created (need to update if it exists).
@@ -836,7 +788,7 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 					}
 					return logger
 				},
-			}).Complete(c),
+			}).Complete(tracing.Reconciler(ClusterControllerName, c)),
 		mgr.Add(c),
 	})
 }
@@ -1000,8 +952,6 @@ func getResourceSummary(nodes []*corev1.Node, pods []*corev1.Pod) *clustercrdv1a
 
 func getClusterAllocatable(nodeList []*corev1.Node) corev1.ResourceList {
 	allocatable := make(corev1.ResourceList)
-	var gpuTotal int // the number of gpu
-	var gpuMemoryTotal int
 	for _, node := range nodeList {
 		for key, val := range node.Status.Allocatable {
 			tmpCap, ok := allocatable[key]
@@ -1012,29 +962,8 @@ func getClusterAllocatable(nodeList []*corev1.Node) corev1.ResourceList {
 			}
 			allocatable[key] = tmpCap
 		}
-
-		if val, ok := node.Annotations[constants.HamiRegisterAnonationKey]; ok {
-			gpuInfos := strings.Split(val, ":")
-
-			for _, info := range gpuInfos {
-				res := strings.Split(info, ",")
-				if len(res) == 7 {
-					gpuMemoryStr := res[2]
-					gpuMeory, err := strconv.Atoi(gpuMemoryStr)
-					if err != nil {
-						klog.ErrorS(err, "failed parse gpu memory if node", "node", node.GetName())
-					}
-					gpuMemoryTotal += gpuMeory
-					gpuTotal++
-				}
-			}
-		}
 	}
 
-	// TODO: the number of gpu
-	allocatable["nvidia.com/gpu.count"] = resource.MustParse(strconv.Itoa(gpuTotal))
-	allocatable["nvidia.com/gpu-memory.count"] = resource.MustParse(strconv.Itoa(gpuMemoryTotal))
-
 	return allocatable
 }
 
@@ -0,0 +1,339 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/helper"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/portallocate"
+)
+
+const (
+	cleanupCompleted = "CleanupCompleted"
+	cleanupForced    = "CleanupForced"
+
+	// cleanupRequeueAfter is the time after which an unfinished cleanup is retried.
+	cleanupRequeueAfter = 10 * time.Second
+
+	kindServiceMonitor  = "ServiceMonitor"
+	kindScrapeConfig    = "ScrapeConfig"
+	kindCRD             = "CustomResourceDefinition"
+	kindGatewayListener = "GatewayListener"
+
+	// kantaloupeGateway is the gateway the kantaloupeflows expose their networking through.
+	kantaloupeGateway = "kantaloupe"
+
+	orphanedMsg = "kept by the deletion policy"
+)
+
+// cleanupScheme holds the types of the resources kantaloupe installs in the member cluster.
+var cleanupScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(apiextensionsv1.AddToScheme(cleanupScheme))
+	utilruntime.Must(kfv1alpha1.Install(cleanupScheme))
+	utilruntime.Must(gatewayv1.Install(cleanupScheme))
+	utilruntime.Must(monitoringv1.AddToScheme(cleanupScheme))
+}
+
+// serviceMonitors are the ServiceMonitors installed in the member cluster when it is integrated.
+var serviceMonitors = []string{
+	constants.HamiDevicePluginSMName,
+	constants.HamiSchedulerSMName,
+	constants.MetaxSMTemplateName,
+	constants.NvidiaSMTemplateName,
+	constants.AscendSMTemplateName,
+}
+
+// kantaloupeflowCRDName is the name of the CRD installed by the multi-cluster controller.
+var kantaloupeflowCRDName = fmt.Sprintf("%s.%s", "kantaloupeflows", kfv1alpha1.GroupName)
+
+// deregisterCluster cleans up the resources of the cluster by its deletion policy, records the
+// cleanup report and removes the finalizer. The cleanup is retried until it finishes, the cluster
+// is removed forcibly if it can not finish within the cleanup timeout, e.g. the member is unreachable.
+func (c *Controller) deregisterCluster(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) (controllerruntime.Result, error) {
+	if !ctrlutil.ContainsFinalizer(cluster, ClusterControllerFinalizer) {
+		return controllerruntime.Result{}, nil
+	}
+
+	report := &clustercrdv1alpha1.CleanupReport{
+		Policy:    deletionPolicy(cluster),
+		StartTime: cluster.DeletionTimestamp,
+	}
+
+	// the federation scrape config lives in the global cluster, it is always deleted.
+	scrapeConfig := clustercrdv1alpha1.CleanupResource{
+		Kind:      kindScrapeConfig,
+		Namespace: "monitoring",
+		Name:      fmt.Sprintf("kantaloupe-federate-%s", cluster.Name),
+		Result:    clustercrdv1alpha1.CleanupResultDeleted,
+	}
+	if err := c.DeleteScrapeConfig(ctx, cluster); err != nil {
+		scrapeConfig.Result, scrapeConfig.Message = clustercrdv1alpha1.CleanupResultFailed, err.Error()
+	}
+	report.Resources = append(report.Resources, scrapeConfig)
+
+	// the ServiceMonitors are removed by both policies like before the deletion policies were
+	// introduced, the Orphan policy keeps the kantaloupeflows and what they depend on.
+	switch {
+	case report.Policy != clustercrdv1alpha1.DeletionPolicyCleanup && !helper.IsClusterReady(&cluster.Status):
+		report.Resources = append(report.Resources, workloadResources(clustercrdv1alpha1.CleanupResultOrphaned, orphanedMsg)...)
+		report.Resources = append(report.Resources, monitorResources(clustercrdv1alpha1.CleanupResultSkipped, clusterNotReachableMsg)...)
+	case report.Policy != clustercrdv1alpha1.DeletionPolicyCleanup:
+		report.Resources = append(report.Resources, workloadResources(clustercrdv1alpha1.CleanupResultOrphaned, orphanedMsg)...)
+		report.Resources = append(report.Resources, c.cleanupServiceMonitors(ctx, cluster)...)
+	case !helper.IsClusterReady(&cluster.Status):
+		report.Resources = append(report.Resources, memberResources(clustercrdv1alpha1.CleanupResultSkipped, clusterNotReachableMsg)...)
+	default:
+		report.Resources = append(report.Resources, c.cleanupMemberCluster(ctx, cluster)...)
+	}
+
+	if !isCleanupFinished(report) {
+		if time.Since(cluster.DeletionTimestamp.Time) < c.ClusterCleanupTimeout.Duration {
+			klog.V(2).InfoS("Cleanup of cluster is not finished, retry again", "cluster", klog.KObj(cluster))
+			if err := c.updateCleanupReport(ctx, cluster, report); err != nil {
+				return controllerruntime.Result{}, err
+			}
+			return controllerruntime.Result{RequeueAfter: cleanupRequeueAfter}, nil
+		}
+		klog.InfoS("Cleanup of cluster timed out, remove it forcibly", "cluster", klog.KObj(cluster), "timeout", c.ClusterCleanupTimeout.Duration)
+		report.Forced = true
+	}
+
+	if report.Forced {
+		c.EventRecorder.Event(cluster, corev1.EventTypeWarning, cleanupForced, cleanupReportMessage(report))
+	} else {
+		c.EventRecorder.Event(cluster, corev1.EventTypeNormal, cleanupCompleted, cleanupReportMessage(report))
+	}
+
+	if ctrlutil.RemoveFinalizer(cluster, ClusterControllerFinalizer) {
+		return controllerruntime.Result{}, c.Client.Update(ctx, cluster)
+	}
+	return controllerruntime.Result{}, nil
+}
+
+func deletionPolicy(cluster *clustercrdv1alpha1.Cluster) clustercrdv1alpha1.DeletionPolicy {
+	if cluster.Spec.DeletionPolicy == "" {
+		return clustercrdv1alpha1.DeletionPolicyOrphan
+	}
+	return cluster.Spec.DeletionPolicy
+}
+
+// memberResources returns the resources kantaloupe installs in the member cluster with the same result.
+func memberResources(result clustercrdv1alpha1.CleanupResult, message string) []clustercrdv1alpha1.CleanupResource {
+	return append(workloadResources(result, message), monitorResources(result, message)...)
+}
+
+// workloadResources returns the resources the kantaloupeflows depend on with the same result.
+func workloadResources(result clustercrdv1alpha1.CleanupResult, message string) []clustercrdv1alpha1.CleanupResource {
+	return []clustercrdv1alpha1.CleanupResource{
+		{Kind: kindCRD, Name: kantaloupeflowCRDName, Result: result, Message: message},
+		{Kind: kindGatewayListener, Namespace: helper.GetCurrentNSOrDefault(), Name: kantaloupeGateway, Result: result, Message: message},
+	}
+}
+
+// monitorResources returns the ServiceMonitors with the same result.
+func monitorResources(result clustercrdv1alpha1.CleanupResult, message string) []clustercrdv1alpha1.CleanupResource {
+	resources := make([]clustercrdv1alpha1.CleanupResource, 0, len(serviceMonitors))
+	for _, name := range serviceMonitors {
+		resources = append(resources, clustercrdv1alpha1.CleanupResource{
+			Kind: kindServiceMonitor, Namespace: "monitoring", Name: name, Result: result, Message: message,
+		})
+	}
+	return resources
+}
+
+// memberClient returns the client of the member cluster for the cleanup.
+func (c *Controller) memberClient(cluster *clustercrdv1alpha1.Cluster) (client.Client, error) {
+	if c.MemberClientFunc != nil {
+		return c.MemberClientFunc(cluster)
+	}
+	config, err := utils.ClusterKubeconfig(cluster.Name, c.Client, &utils.ClientOption{})
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: cleanupScheme})
+}
+
+// cleanupServiceMonitors removes the ServiceMonitors from the member cluster.
+func (c *Controller) cleanupServiceMonitors(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) []clustercrdv1alpha1.CleanupResource {
+	memberClient, err := c.memberClient(cluster)
+	if err != nil {
+		return monitorResources(clustercrdv1alpha1.CleanupResultFailed, err.Error())
+	}
+	return deleteServiceMonitors(ctx, memberClient)
+}
+
+func deleteServiceMonitors(ctx context.Context, memberClient client.Client) []clustercrdv1alpha1.CleanupResource {
+	resources := make([]clustercrdv1alpha1.CleanupResource, 0, len(serviceMonitors))
+	for _, name := range serviceMonitors {
+		resources = append(resources, deleteMemberResource(ctx, memberClient, kindServiceMonitor, &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: name},
+		}))
+	}
+	return resources
+}
+
+// cleanupMemberCluster removes the kantaloupeflows, the gateway listeners, the kantaloupe CRDs and
+// the ServiceMonitors from the member cluster. The kantaloupeflows are deleted first, so that the
+// member controllers can release their resources before the CRDs are removed.
+func (c *Controller) cleanupMemberCluster(ctx context.Context, cluster *clustercrdv1alpha1.Cluster) []clustercrdv1alpha1.CleanupResource {
+	memberClient, err := c.memberClient(cluster)
+	if err != nil {
+		return memberResources(clustercrdv1alpha1.CleanupResultFailed, err.Error())
+	}
+
+	resources, pending := cleanupKantaloupeflows(ctx, memberClient)
+	if pending {
+		// wait for the kantaloupeflows to be deleted before the others.
+		return append(resources, memberResources(clustercrdv1alpha1.CleanupResultPending, "waiting for the kantaloupeflows to be deleted")...)
+	}
+
+	resources = append(resources, removeGatewayListeners(ctx, memberClient)...)
+	resources = append(resources, deleteMemberResource(ctx, memberClient, kindCRD, &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: kantaloupeflowCRDName},
+	}))
+	return append(resources, deleteServiceMonitors(ctx, memberClient)...)
+}
+
+// cleanupKantaloupeflows deletes all kantaloupeflows of the member cluster,
+// it returns true if any kantaloupeflow is still being deleted.
+func cleanupKantaloupeflows(ctx context.Context, memberClient client.Client) ([]clustercrdv1alpha1.CleanupResource, bool) {
+	flows := &kfv1alpha1.KantaloupeFlowList{}
+	if err := memberClient.List(ctx, flows); err != nil {
+		// the CRD has been removed by the previous attempt.
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, false
+		}
+		return []clustercrdv1alpha1.CleanupResource{{
+			Kind: kfv1alpha1.KantaloupeFlowResourceKind, Result: clustercrdv1alpha1.CleanupResultFailed, Message: err.Error(),
+		}}, true
+	}
+
+	var (
+		resources []clustercrdv1alpha1.CleanupResource
+		pending   bool
+	)
+	for i := range flows.Items {
+		flow := &flows.Items[i]
+		resource := clustercrdv1alpha1.CleanupResource{
+			Kind:      kfv1alpha1.KantaloupeFlowResourceKind,
+			Namespace: flow.Namespace,
+			Name:      flow.Name,
+			Result:    clustercrdv1alpha1.CleanupResultPending,
+		}
+		if flow.DeletionTimestamp.IsZero() {
+			if err := memberClient.Delete(ctx, flow); err != nil && !apierrors.IsNotFound(err) {
+				resource.Result, resource.Message = clustercrdv1alpha1.CleanupResultFailed, err.Error()
+			}
+		}
+		resources = append(resources, resource)
+		pending = true
+	}
+	return resources, pending
+}
+
+// removeGatewayListeners removes the TCP listeners kantaloupe added to the gateway for the kantaloupeflows.
+func removeGatewayListeners(ctx context.Context, memberClient client.Client) []clustercrdv1alpha1.CleanupResource {
+	resource := clustercrdv1alpha1.CleanupResource{
+		Kind:      kindGatewayListener,
+		Namespace: helper.GetCurrentNSOrDefault(),
+		Name:      kantaloupeGateway,
+		Result:    clustercrdv1alpha1.CleanupResultDeleted,
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gateway := &gatewayv1.Gateway{}
+		if err := memberClient.Get(ctx, client.ObjectKey{Namespace: resource.Namespace, Name: resource.Name}, gateway); err != nil {
+			return err
+		}
+
+		listeners := make([]gatewayv1.Listener, 0, len(gateway.Spec.Listeners))
+		for _, listener := range gateway.Spec.Listeners {
+			if _, _, err := portallocate.SplitGatewaySectionName(string(listener.Name)); err == nil && listener.Protocol == gatewayv1.TCPProtocolType {
+				continue
+			}
+			listeners = append(listeners, listener)
+		}
+		if len(listeners) == len(gateway.Spec.Listeners) {
+			return nil
+		}
+		resource.Message = fmt.Sprintf("%d listeners removed", len(gateway.Spec.Listeners)-len(listeners))
+		gateway.Spec.Listeners = listeners
+		return memberClient.Update(ctx, gateway)
+	})
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		resource.Result, resource.Message = clustercrdv1alpha1.CleanupResultFailed, err.Error()
+	}
+	return []clustercrdv1alpha1.CleanupResource{resource}
+}
+
+// deleteMemberResource deletes a resource of the member cluster and returns the cleanup result.
+func deleteMemberResource(ctx context.Context, memberClient client.Client, kind string, obj client.Object) clustercrdv1alpha1.CleanupResource {
+	resource := clustercrdv1alpha1.CleanupResource{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Result:    clustercrdv1alpha1.CleanupResultDeleted,
+	}
+	if err := memberClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		resource.Result, resource.Message = clustercrdv1alpha1.CleanupResultFailed, err.Error()
+	}
+	return resource
+}
+
+func isCleanupFinished(report *clustercrdv1alpha1.CleanupReport) bool {
+	for _, resource := range report.Resources {
+		if resource.Result != clustercrdv1alpha1.CleanupResultDeleted && resource.Result != clustercrdv1alpha1.CleanupResultOrphaned {
+			return false
+		}
+	}
+	return true
+}
+
+// cleanupReportMessage summarizes the cleanup report for the event of the cluster.
+func cleanupReportMessage(report *clustercrdv1alpha1.CleanupReport) string {
+	counts := map[clustercrdv1alpha1.CleanupResult]int{}
+	for _, resource := range report.Resources {
+		counts[resource.Result]++
+	}
+	message := fmt.Sprintf("cluster deregistered with %s policy: %d deleted, %d orphaned, %d skipped, %d failed, %d pending",
+		report.Policy, counts[clustercrdv1alpha1.CleanupResultDeleted], counts[clustercrdv1alpha1.CleanupResultOrphaned],
+		counts[clustercrdv1alpha1.CleanupResultSkipped], counts[clustercrdv1alpha1.CleanupResultFailed],
+		counts[clustercrdv1alpha1.CleanupResultPending])
+	if report.Forced {
+		message += ", removed forcibly after the cleanup timeout"
+	}
+	return message
+}
+
+// updateCleanupReport records the progress of the cleanup in the status of the cluster.
+func (c *Controller) updateCleanupReport(ctx context.Context, cluster *clustercrdv1alpha1.Cluster, report *clustercrdv1alpha1.CleanupReport) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := utils.UpdateStatus(ctx, c.Client, cluster, func() error {
+			cluster.Status.CleanupReport = report
+			return nil
+		})
+		return err
+	})
+}
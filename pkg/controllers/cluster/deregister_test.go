@@ -0,0 +1,133 @@
+package cluster
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
+
+func TestDeregisterCluster(t *testing.T) {
+	tests := []struct {
+		name                 string
+		policy               clustercrdv1alpha1.DeletionPolicy
+		ready                bool
+		deletedBefore        time.Duration
+		expectRemoved        bool
+		expectRequeue        bool
+		expectEventHas       string
+		expectMonitorDeleted bool
+	}{
+		{
+			name:                 "orphan policy keeps the kantaloupeflows and removes the ServiceMonitors",
+			policy:               clustercrdv1alpha1.DeletionPolicyOrphan,
+			ready:                true,
+			expectRemoved:        true,
+			expectEventHas:       "6 deleted, 2 orphaned",
+			expectMonitorDeleted: true,
+		},
+		{
+			name:                 "unset policy removes the ServiceMonitors like before the deletion policies",
+			ready:                true,
+			expectRemoved:        true,
+			expectEventHas:       "with Orphan policy: 6 deleted, 2 orphaned",
+			expectMonitorDeleted: true,
+		},
+		{
+			name:          "orphan policy waits for the unreachable cluster to remove the ServiceMonitors",
+			policy:        clustercrdv1alpha1.DeletionPolicyOrphan,
+			deletedBefore: time.Minute,
+			expectRequeue: true,
+		},
+		{
+			name:          "unreachable cluster waits for the cleanup timeout",
+			policy:        clustercrdv1alpha1.DeletionPolicyCleanup,
+			deletedBefore: time.Minute,
+			expectRequeue: true,
+		},
+		{
+			name:           "unreachable cluster is removed forcibly after the cleanup timeout",
+			policy:         clustercrdv1alpha1.DeletionPolicyCleanup,
+			deletedBefore:  time.Hour,
+			expectRemoved:  true,
+			expectEventHas: "removed forcibly",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = clustercrdv1alpha1.Install(scheme)
+			_ = monitoringv1alpha1.AddToScheme(scheme)
+
+			cluster := &clustercrdv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "member",
+					Finalizers:        []string{ClusterControllerFinalizer},
+					DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-tt.deletedBefore)},
+				},
+				Spec: clustercrdv1alpha1.ClusterSpec{DeletionPolicy: tt.policy},
+			}
+			if tt.ready {
+				cluster.Status.Conditions = []metav1.Condition{{Type: clustercrdv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue}}
+			}
+			monitor := &monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: constants.NvidiaSMTemplateName}}
+			flow := &kfv1alpha1.KantaloupeFlow{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "flow"}}
+			memberClient := fake.NewClientBuilder().WithScheme(cleanupScheme).WithObjects(monitor, flow).Build()
+			recorder := record.NewFakeRecorder(10)
+			c := &Controller{
+				Client:                fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).WithStatusSubresource(cluster).Build(),
+				EventRecorder:         recorder,
+				ClusterCleanupTimeout: metav1.Duration{Duration: 10 * time.Minute},
+				MemberClientFunc: func(*clustercrdv1alpha1.Cluster) (client.Client, error) {
+					return memberClient, nil
+				},
+			}
+
+			result, err := c.deregisterCluster(context.TODO(), cluster)
+			if err != nil {
+				t.Fatalf("deregisterCluster() error = %v", err)
+			}
+			if requeue := result.RequeueAfter > 0; requeue != tt.expectRequeue {
+				t.Errorf("deregisterCluster() requeue = %v, want %v", requeue, tt.expectRequeue)
+			}
+
+			err = c.Client.Get(context.TODO(), client.ObjectKeyFromObject(cluster), &clustercrdv1alpha1.Cluster{})
+			if removed := apierrors.IsNotFound(err); removed != tt.expectRemoved {
+				t.Errorf("cluster removed = %v, want %v", removed, tt.expectRemoved)
+			}
+			err = memberClient.Get(context.TODO(), client.ObjectKeyFromObject(monitor), &monitoringv1.ServiceMonitor{})
+			if deleted := apierrors.IsNotFound(err); deleted != tt.expectMonitorDeleted {
+				t.Errorf("ServiceMonitor deleted = %v, want %v", deleted, tt.expectMonitorDeleted)
+			}
+			if err := memberClient.Get(context.TODO(), client.ObjectKeyFromObject(flow), &kfv1alpha1.KantaloupeFlow{}); err != nil && tt.policy != clustercrdv1alpha1.DeletionPolicyCleanup {
+				t.Errorf("kantaloupeflow is not kept by the %s policy: %v", tt.policy, err)
+			}
+
+			if tt.expectEventHas == "" {
+				return
+			}
+			select {
+			case event := <-recorder.Events:
+				if !strings.Contains(event, tt.expectEventHas) {
+					t.Errorf("event = %s, want it contains %s", event, tt.expectEventHas)
+				}
+			default:
+				t.Errorf("no cleanup event is recorded")
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/annotations"
+	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
+)
+
+const metaxGPUResourceName = "metax-tech.com/gpu"
+
+// getGPUSummary computes the accelerator totals of every vendor from the HAMi node register
+// annotations, so that the cluster summary does not depend on the monitoring stack.
+func getGPUSummary(nodes []*corev1.Node) *clustercrdv1alpha1.GPUSummary {
+	vendors := map[string]*clustercrdv1alpha1.VendorGPUSummary{}
+	vendorSummary := func(vendor string) *clustercrdv1alpha1.VendorGPUSummary {
+		if _, ok := vendors[vendor]; !ok {
+			vendors[vendor] = &clustercrdv1alpha1.VendorGPUSummary{Vendor: vendor}
+		}
+		return vendors[vendor]
+	}
+
+	for _, node := range nodes {
+		if val, ok := node.Annotations[nvidiaAnnotationKey]; ok {
+			addNodeDevices(vendorSummary(nodeutil.AcceleratorTypeNVIDIA), node, val)
+		}
+
+		if val, ok := node.Annotations[metaxAnnotationKey]; ok {
+			summary := vendorSummary(nodeutil.AcceleratorTypeMetax)
+			if !addNodeDevices(summary, node, val) {
+				// The metax device plugin may not register devices in HAMi format,
+				// fall back to the allocatable cards of the node.
+				if quantity, ok := node.Status.Allocatable[metaxGPUResourceName]; ok {
+					summary.Cards += int32(quantity.Value()) // #nosec G115
+				}
+			}
+		}
+
+		for key, val := range node.Annotations {
+			if strings.HasPrefix(key, ascendAnnotationPrefixKey) {
+				addNodeDevices(vendorSummary(nodeutil.AcceleratorTypeAscend), node, val)
+			}
+		}
+
+		if quantity, ok := node.Status.Allocatable[neuronDeviceKey]; ok && !quantity.IsZero() {
+			summary := vendorSummary(nodeutil.AcceleratorTypeNeuron)
+			summary.Cards += int32(quantity.Value()) // #nosec G115
+			if cores, ok := node.Status.Allocatable[constants.AWSNeuronCore]; ok {
+				summary.Cores += int32(cores.Value())     // #nosec G115
+				summary.VGPUSlots += int32(cores.Value()) // #nosec G115
+			}
+		}
+	}
+
+	gpuSummary := &clustercrdv1alpha1.GPUSummary{}
+	for _, vendor := range []string{nodeutil.AcceleratorTypeNVIDIA, nodeutil.AcceleratorTypeMetax, nodeutil.AcceleratorTypeAscend, nodeutil.AcceleratorTypeNeuron} {
+		summary, ok := vendors[vendor]
+		if !ok {
+			continue
+		}
+		slices.Sort(summary.Models)
+		gpuSummary.Total += summary.Cards
+		gpuSummary.Vendors = append(gpuSummary.Vendors, *summary)
+	}
+	return gpuSummary
+}
+
+// addNodeDevices adds the devices of the register annotation to the vendor summary,
+// and returns false if the annotation can not be parsed.
+func addNodeDevices(summary *clustercrdv1alpha1.VendorGPUSummary, node *corev1.Node, annotation string) bool {
+	devices, err := annotations.ParseNodeDevicesAnnotation(annotation)
+	if err != nil {
+		klog.V(4).ErrorS(err, "failed to parse node devices annotation", "node", node.GetName(), "vendor", summary.Vendor)
+		return false
+	}
+	for _, device := range devices {
+		summary.Cards++
+		summary.VGPUSlots += device.Count
+		summary.Memory += device.Memory
+		summary.Cores += device.Core
+		if device.Type != "" && !slices.Contains(summary.Models, device.Type) {
+			summary.Models = append(summary.Models, device.Type)
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
+)
+
+func TestGetGPUSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodes    []*corev1.Node
+		expected *clustercrdv1alpha1.GPUSummary
+	}{
+		{
+			name:     "no accelerator nodes",
+			nodes:    []*corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "cpu-node"}}},
+			expected: &clustercrdv1alpha1.GPUSummary{},
+		},
+		{
+			name: "mixed vendors",
+			nodes: []*corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "nvidia-node",
+						Annotations: map[string]string{
+							nvidiaAnnotationKey: "GPU-0,10,15360,100,NVIDIA-Tesla T4,0,true:GPU-1,10,15360,100,NVIDIA-Tesla T4,0,true:",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "ascend-node",
+						Annotations: map[string]string{
+							ascendAnnotationPrefixKey + "910B": `[{"id":"npu-0","count":4,"devmem":65536,"devcore":100,"type":"Ascend910B","health":true}]`,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "neuron-node"},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{
+							neuronDeviceKey:             resource.MustParse("2"),
+							"aws.amazon.com/neuroncore": resource.MustParse("4"),
+						},
+					},
+				},
+			},
+			expected: &clustercrdv1alpha1.GPUSummary{
+				Total: 5,
+				Vendors: []clustercrdv1alpha1.VendorGPUSummary{
+					{Vendor: nodeutil.AcceleratorTypeNVIDIA, Cards: 2, VGPUSlots: 20, Memory: 30720, Cores: 200, Models: []string{"NVIDIA-Tesla T4"}},
+					{Vendor: nodeutil.AcceleratorTypeAscend, Cards: 1, VGPUSlots: 4, Memory: 65536, Cores: 100, Models: []string{"Ascend910B"}},
+					{Vendor: nodeutil.AcceleratorTypeNeuron, Cards: 2, VGPUSlots: 4, Cores: 4},
+				},
+			},
+		},
+		{
+			name: "metax falls back to allocatable",
+			nodes: []*corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "metax-node",
+						Annotations: map[string]string{metaxAnnotationKey: "unknown"},
+					},
+					Status: corev1.NodeStatus{
+						Allocatable: corev1.ResourceList{metaxGPUResourceName: resource.MustParse("8")},
+					},
+				},
+			},
+			expected: &clustercrdv1alpha1.GPUSummary{
+				Total:   8,
+				Vendors: []clustercrdv1alpha1.VendorGPUSummary{{Vendor: nodeutil.AcceleratorTypeMetax, Cards: 8}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getGPUSummary(tt.nodes)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("getGPUSummary() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	kfservice "github.com/dynamia-ai/kantaloupe/pkg/service/kantaloupeflow"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+)
+
+const (
+	maintenanceStarted  = "MaintenanceStarted"
+	maintenanceDraining = "MaintenanceDraining"
+	maintenanceDrained  = "MaintenanceDrained"
+	maintenanceFailed   = "MaintenanceFailed"
+	maintenanceFinished = "MaintenanceFinished"
+
+	flowHibernated = "Hibernated"
+	flowResumed    = "Resumed"
+	flowMigrated   = "Migrated"
+)
+
+// syncMaintenance orchestrates the maintenance of the member cluster. It cordons the nodes and
+// drains the kantaloupeflows once the cluster enters maintenance mode, and reverts them after
+// the maintenance is over. The returned condition is nil if nothing changed.
+func (c *Controller) syncMaintenance(
+	ctx context.Context,
+	clusterClient *utils.ClusterClient, cluster *clustercrdv1alpha1.Cluster,
+	currentClusterStatus *clustercrdv1alpha1.ClusterStatus,
+) *metav1.Condition {
+	if !cluster.Spec.Maintenance {
+		if currentClusterStatus.Maintenance == nil {
+			return nil
+		}
+		if err := c.exitMaintenance(ctx, clusterClient, currentClusterStatus.Maintenance); err != nil {
+			klog.ErrorS(err, "failed to exit maintenance for cluster", "cluster", klog.KObj(cluster))
+			currentClusterStatus.Maintenance.Message = err.Error()
+			return &metav1.Condition{
+				Type:    clustercrdv1alpha1.ClusterConditionMaintenance,
+				Status:  metav1.ConditionTrue,
+				Reason:  maintenanceFailed,
+				Message: err.Error(),
+			}
+		}
+		currentClusterStatus.Maintenance = nil
+		c.EventRecorder.Event(cluster, corev1.EventTypeNormal, maintenanceFinished, "cluster left maintenance mode")
+		return &metav1.Condition{
+			Type:    clustercrdv1alpha1.ClusterConditionMaintenance,
+			Status:  metav1.ConditionFalse,
+			Reason:  maintenanceFinished,
+			Message: "cluster left maintenance mode",
+		}
+	}
+
+	policy := cluster.Spec.MaintenancePolicy
+	if policy == nil {
+		policy = &clustercrdv1alpha1.MaintenancePolicy{}
+	}
+
+	flows, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(corev1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		klog.ErrorS(err, "failed to list kantaloupeflows for maintenance", "cluster", klog.KObj(cluster))
+		return maintenanceCondition(currentClusterStatus.Maintenance, err)
+	}
+
+	maintenance := currentClusterStatus.Maintenance
+	if maintenance == nil {
+		maintenance = &clustercrdv1alpha1.MaintenanceStatus{
+			StartTime:  ptr.To(metav1.Now()),
+			TotalFlows: int32(len(flows.Items)), // #nosec G115
+		}
+		currentClusterStatus.Maintenance = maintenance
+
+		c.EventRecorder.Eventf(cluster, corev1.EventTypeNormal, maintenanceStarted,
+			"cluster entered maintenance mode, drain strategy: %s, reason: %s", drainStrategy(policy), policy.Reason)
+		for i := range flows.Items {
+			recordFlowEvent(ctx, clusterClient, &flows.Items[i], corev1.EventTypeWarning, maintenanceStarted,
+				fmt.Sprintf("cluster %s entered maintenance mode, drain strategy: %s, reason: %s",
+					cluster.Name, drainStrategy(policy), policy.Reason))
+		}
+	}
+
+	if policy.CordonNodes {
+		maintenance.Phase = clustercrdv1alpha1.MaintenancePhaseCordoning
+		if err := cordonNodes(ctx, clusterClient, maintenance); err != nil {
+			klog.ErrorS(err, "failed to cordon nodes for maintenance", "cluster", klog.KObj(cluster))
+			return maintenanceCondition(maintenance, err)
+		}
+	}
+
+	maintenance.Phase = clustercrdv1alpha1.MaintenancePhaseDraining
+	pending, errs := c.drainKantaloupeflows(ctx, clusterClient, cluster, policy, flows.Items)
+	failed := int32(len(errs)) // #nosec G115
+	maintenance.FailedFlows = failed
+	maintenance.DrainedFlows = max(maintenance.TotalFlows-pending-failed, 0)
+	if len(errs) != 0 {
+		err := utilerrors.NewAggregate(errs)
+		klog.ErrorS(err, "failed to drain kantaloupeflows for maintenance", "cluster", klog.KObj(cluster))
+		return maintenanceCondition(maintenance, err)
+	}
+	if pending == 0 {
+		maintenance.Phase = clustercrdv1alpha1.MaintenancePhaseCompleted
+	}
+	return maintenanceCondition(maintenance, nil)
+}
+
+// maintenanceCondition generates the maintenance condition by the progress of the maintenance.
+func maintenanceCondition(maintenance *clustercrdv1alpha1.MaintenanceStatus, err error) *metav1.Condition {
+	condition := &metav1.Condition{
+		Type:   clustercrdv1alpha1.ClusterConditionMaintenance,
+		Status: metav1.ConditionTrue,
+	}
+	switch {
+	case err != nil:
+		condition.Reason = maintenanceFailed
+		condition.Message = err.Error()
+	case maintenance.Phase == clustercrdv1alpha1.MaintenancePhaseCompleted:
+		condition.Reason = maintenanceDrained
+		condition.Message = "cluster is drained and ready for maintenance"
+	default:
+		condition.Reason = maintenanceDraining
+		condition.Message = fmt.Sprintf("%d/%d kantaloupeflows drained", maintenance.DrainedFlows, maintenance.TotalFlows)
+	}
+	if maintenance != nil {
+		maintenance.Message = condition.Message
+	}
+	return condition
+}
+
+func drainStrategy(policy *clustercrdv1alpha1.MaintenancePolicy) clustercrdv1alpha1.DrainStrategy {
+	if policy.DrainStrategy == "" {
+		return clustercrdv1alpha1.DrainStrategyNone
+	}
+	return policy.DrainStrategy
+}
+
+// cordonNodes marks all schedulable nodes unschedulable and records them,
+// so that only these nodes are uncordoned after the maintenance.
+func cordonNodes(ctx context.Context, clusterClient *utils.ClusterClient, maintenance *clustercrdv1alpha1.MaintenanceStatus) error {
+	nodes, err := clusterClient.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	loadBytes, _ := utils.NodeScheduleConvert(true)
+	var errs []error
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if _, err := clusterClient.KubeClient.CoreV1().Nodes().Patch(ctx, node.Name, types.JSONPatchType, loadBytes, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to cordon node %s: %w", node.Name, err))
+			continue
+		}
+		maintenance.CordonedNodes = append(maintenance.CordonedNodes, node.Name)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// drainKantaloupeflows drains the kantaloupeflows by the drain strategy, it returns the number
+// of kantaloupeflows that are still being drained and the errors of the failed ones.
+func (c *Controller) drainKantaloupeflows(
+	ctx context.Context,
+	clusterClient *utils.ClusterClient, cluster *clustercrdv1alpha1.Cluster,
+	policy *clustercrdv1alpha1.MaintenancePolicy, flows []kfv1alpha1.KantaloupeFlow,
+) (int32, []error) {
+	var (
+		pending int32
+		errs    []error
+	)
+
+	switch drainStrategy(policy) {
+	case clustercrdv1alpha1.DrainStrategyHibernate:
+		for i := range flows {
+			flow := &flows[i]
+			if _, ok := flow.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey]; ok {
+				if flow.Status.Replicas > 0 {
+					pending++
+				}
+				continue
+			}
+			if err := hibernateKantaloupeflow(ctx, clusterClient, flow); err != nil {
+				errs = append(errs, fmt.Errorf("failed to hibernate kantaloupeflow %s/%s: %w", flow.Namespace, flow.Name, err))
+				continue
+			}
+			pending++
+		}
+	case clustercrdv1alpha1.DrainStrategyMigrate:
+		target := &clustercrdv1alpha1.Cluster{}
+		if err := c.Client.Get(ctx, types.NamespacedName{Name: policy.TargetCluster}, target); err != nil {
+			return int32(len(flows)), []error{fmt.Errorf("failed to get target cluster %s: %w", policy.TargetCluster, err)} // #nosec G115
+		}
+		targetClient, err := c.ClusterClientSetFunc(policy.TargetCluster, c.Client, c.ClusterClientOption)
+		if err != nil {
+			return int32(len(flows)), []error{fmt.Errorf("failed to create client for target cluster %s: %w", policy.TargetCluster, err)} // #nosec G115
+		}
+		for i := range flows {
+			flow := &flows[i]
+			migrated, err := migrateKantaloupeflow(ctx, clusterClient, targetClient, cluster, target, flow)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to migrate kantaloupeflow %s/%s: %w", flow.Namespace, flow.Name, err))
+				continue
+			}
+			if !migrated {
+				pending++
+			}
+		}
+	}
+
+	return pending, errs
+}
+
+// hibernateKantaloupeflow scales the kantaloupeflow to zero replicas and records the original replicas.
+func hibernateKantaloupeflow(ctx context.Context, clusterClient *utils.ClusterClient, flow *kfv1alpha1.KantaloupeFlow) error {
+	flowClient := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(flow.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := flowClient.Get(ctx, flow.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if latest.Annotations == nil {
+			latest.Annotations = make(map[string]string)
+		}
+		latest.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey] = strconv.Itoa(int(ptr.Deref(latest.Spec.Replicas, 1)))
+		latest.Spec.Replicas = ptr.To[int32](0)
+		_, err = flowClient.Update(ctx, latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	recordFlowEvent(ctx, clusterClient, flow, corev1.EventTypeNormal, flowHibernated,
+		"kantaloupeflow is hibernated for the cluster maintenance")
+	return nil
+}
+
+// migrateKantaloupeflow recreates the kantaloupeflow in the target cluster, the original one is
+// deleted only after the migrated one is ready, so the flow is never lost on the way. It
+// reports whether the migration is done.
+func migrateKantaloupeflow(
+	ctx context.Context,
+	clusterClient, targetClient *utils.ClusterClient,
+	cluster, target *clustercrdv1alpha1.Cluster, flow *kfv1alpha1.KantaloupeFlow,
+) (bool, error) {
+	targetFlowClient := targetClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(flow.Namespace)
+	migrated, err := targetFlowClient.Get(ctx, flow.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		if migrated, err = createMigratedKantaloupeflow(ctx, targetClient, cluster, target, flow); err != nil {
+			return false, err
+		}
+	case err != nil:
+		return false, err
+	case migrated.Annotations[constants.KantaloupeFlowMigratedFromAnnotationKey] != cluster.Name:
+		// the flow is not created by a previous attempt, the unrelated flow of the same name
+		// must not be taken for the migrated one, otherwise the original one is lost.
+		return false, fmt.Errorf("kantaloupeflow %s/%s already exists in cluster %s and is not migrated from cluster %s",
+			flow.Namespace, flow.Name, targetClient.ClusterName, cluster.Name)
+	}
+
+	if migrated.Status.ReadyReplicas < ptr.Deref(migrated.Spec.Replicas, 1) {
+		klog.V(4).InfoS("waiting for the migrated kantaloupeflow to be ready", "cluster", targetClient.ClusterName,
+			"kantaloupeflow", klog.KObj(migrated), "readyReplicas", migrated.Status.ReadyReplicas)
+		return false, nil
+	}
+
+	recordFlowEvent(ctx, clusterClient, flow, corev1.EventTypeNormal, flowMigrated,
+		fmt.Sprintf("kantaloupeflow is migrated to cluster %s for the cluster maintenance", targetClient.ClusterName))
+	err = clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(flow.Namespace).Delete(ctx, flow.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// createMigratedKantaloupeflow creates the copy of the kantaloupeflow in the target cluster after
+// checking the target cluster can serve it.
+func createMigratedKantaloupeflow(
+	ctx context.Context,
+	targetClient *utils.ClusterClient,
+	cluster, target *clustercrdv1alpha1.Cluster, flow *kfv1alpha1.KantaloupeFlow,
+) (*kfv1alpha1.KantaloupeFlow, error) {
+	if err := kfservice.ValidateCapabilities(target, flow); err != nil {
+		return nil, err
+	}
+	if err := checkKantaloupeflowDependencies(ctx, targetClient, flow); err != nil {
+		return nil, err
+	}
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: flow.Namespace}}
+	if _, err := targetClient.KubeClient.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	// the maps are cloned, the flow may be shared with the informer cache.
+	migrated := &kfv1alpha1.KantaloupeFlow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        flow.Name,
+			Namespace:   flow.Namespace,
+			Labels:      maps.Clone(flow.Labels),
+			Annotations: maps.Clone(flow.Annotations),
+		},
+		Spec: *flow.Spec.DeepCopy(),
+	}
+	if migrated.Annotations == nil {
+		migrated.Annotations = make(map[string]string)
+	}
+	// a flow hibernated before is migrated with its original replicas.
+	if hibernated, ok := migrated.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey]; ok {
+		if replicas, err := strconv.ParseInt(hibernated, 10, 32); err == nil {
+			migrated.Spec.Replicas = ptr.To(int32(replicas))
+		}
+		delete(migrated.Annotations, constants.KantaloupeFlowHibernatedReplicasAnnotationKey)
+	}
+	migrated.Annotations[constants.KantaloupeFlowMigratedFromAnnotationKey] = cluster.Name
+	return targetClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(flow.Namespace).Create(ctx, migrated, metav1.CreateOptions{})
+}
+
+// checkKantaloupeflowDependencies checks the secrets and configmaps the kantaloupeflow depends on
+// and the persistent volume claims of its volumes exist in the target cluster.
+func checkKantaloupeflowDependencies(ctx context.Context, targetClient *utils.ClusterClient, flow *kfv1alpha1.KantaloupeFlow) error {
+	var errs []error
+	check := func(kind, namespace, name string, err error) {
+		switch {
+		case apierrors.IsNotFound(err):
+			errs = append(errs, fmt.Errorf("%s %s/%s is not found in cluster %s", kind, namespace, name, targetClient.ClusterName))
+		case err != nil:
+			errs = append(errs, err)
+		}
+	}
+
+	core := targetClient.KubeClient.CoreV1()
+	for _, dependOn := range flow.Spec.DependOn {
+		namespace, name := dependOn.ResourceRef.Namespace, dependOn.ResourceRef.Name
+		if namespace == "" {
+			namespace = flow.Namespace
+		}
+		switch dependOn.Kind {
+		case kfv1alpha1.DependOnKindSecret:
+			_, err := core.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+			check("secret", namespace, name, err)
+		case kfv1alpha1.DependOnKindConfigMap:
+			_, err := core.ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+			check("configmap", namespace, name, err)
+		}
+	}
+	for _, volume := range flow.Spec.Template.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		_, err := core.PersistentVolumeClaims(flow.Namespace).Get(ctx, volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+		check("persistentvolumeclaim", flow.Namespace, volume.PersistentVolumeClaim.ClaimName, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// exitMaintenance uncordons the nodes cordoned by the maintenance and resumes the hibernated kantaloupeflows.
+func (c *Controller) exitMaintenance(ctx context.Context, clusterClient *utils.ClusterClient, maintenance *clustercrdv1alpha1.MaintenanceStatus) error {
+	var errs []error
+
+	loadBytes, _ := utils.NodeScheduleConvert(false)
+	uncordoned := 0
+	for _, node := range maintenance.CordonedNodes {
+		_, err := clusterClient.KubeClient.CoreV1().Nodes().Patch(ctx, node, types.JSONPatchType, loadBytes, metav1.PatchOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to uncordon node %s: %w", node, err))
+			maintenance.CordonedNodes[uncordoned] = node
+			uncordoned++
+		}
+	}
+	// keep the nodes failed to uncordon for the next attempt.
+	maintenance.CordonedNodes = maintenance.CordonedNodes[:uncordoned]
+
+	flows, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(corev1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return utilerrors.NewAggregate(append(errs, err))
+	}
+	for i := range flows.Items {
+		flow := &flows.Items[i]
+		if _, ok := flow.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey]; !ok {
+			continue
+		}
+		if err := resumeKantaloupeflow(ctx, clusterClient, flow); err != nil {
+			errs = append(errs, fmt.Errorf("failed to resume kantaloupeflow %s/%s: %w", flow.Namespace, flow.Name, err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// resumeKantaloupeflow restores the replicas of a hibernated kantaloupeflow.
+func resumeKantaloupeflow(ctx context.Context, clusterClient *utils.ClusterClient, flow *kfv1alpha1.KantaloupeFlow) error {
+	flowClient := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows(flow.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := flowClient.Get(ctx, flow.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		replicas, err := strconv.ParseInt(latest.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey], 10, 32)
+		if err != nil {
+			replicas = 1
+		}
+		delete(latest.Annotations, constants.KantaloupeFlowHibernatedReplicasAnnotationKey)
+		latest.Spec.Replicas = ptr.To(int32(replicas))
+		_, err = flowClient.Update(ctx, latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	recordFlowEvent(ctx, clusterClient, flow, corev1.EventTypeNormal, flowResumed,
+		"kantaloupeflow is resumed after the cluster maintenance")
+	return nil
+}
+
+// recordFlowEvent creates an event for the kantaloupeflow in the member cluster, so that
+// the owners of the kantaloupeflow are notified.
+func recordFlowEvent(ctx context.Context, clusterClient *utils.ClusterClient, flow *kfv1alpha1.KantaloupeFlow, eventType, reason, message string) {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: flow.Name + ".",
+			Namespace:    flow.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      kfv1alpha1.SchemeGroupVersion.String(),
+			Kind:            kfv1alpha1.KantaloupeFlowResourceKind,
+			Namespace:       flow.Namespace,
+			Name:            flow.Name,
+			UID:             flow.UID,
+			ResourceVersion: flow.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: ClusterControllerName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := clusterClient.KubeClient.CoreV1().Events(flow.Namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		klog.ErrorS(err, "failed to record event for kantaloupeflow", "cluster", clusterClient.ClusterName, "kantaloupeflow", klog.KObj(flow))
+	}
+}
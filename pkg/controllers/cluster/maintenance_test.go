@@ -0,0 +1,365 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	generatedfake "github.com/dynamia-ai/kantaloupe/api/crd/generated/clientset/versioned/fake"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+)
+
+func TestMaintenanceCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		maintenance *clustercrdv1alpha1.MaintenanceStatus
+		err         error
+		reason      string
+		message     string
+	}{
+		{
+			name:    "failed to list kantaloupeflows",
+			err:     errors.New("connection refused"),
+			reason:  maintenanceFailed,
+			message: "connection refused",
+		},
+		{
+			name: "draining",
+			maintenance: &clustercrdv1alpha1.MaintenanceStatus{
+				Phase:        clustercrdv1alpha1.MaintenancePhaseDraining,
+				TotalFlows:   3,
+				DrainedFlows: 1,
+			},
+			reason:  maintenanceDraining,
+			message: "1/3 kantaloupeflows drained",
+		},
+		{
+			name: "completed",
+			maintenance: &clustercrdv1alpha1.MaintenanceStatus{
+				Phase:        clustercrdv1alpha1.MaintenancePhaseCompleted,
+				TotalFlows:   3,
+				DrainedFlows: 3,
+			},
+			reason:  maintenanceDrained,
+			message: "cluster is drained and ready for maintenance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maintenanceCondition(tt.maintenance, tt.err)
+			if got.Status != metav1.ConditionTrue || got.Reason != tt.reason || got.Message != tt.message {
+				t.Errorf("maintenanceCondition() = %+v, want reason %s and message %s", got, tt.reason, tt.message)
+			}
+			if tt.maintenance != nil && tt.maintenance.Message != tt.message {
+				t.Errorf("maintenance message = %s, want %s", tt.maintenance.Message, tt.message)
+			}
+		})
+	}
+}
+
+func newMaintenanceClusterClient(name string, kubeObjects []runtime.Object, flows ...runtime.Object) *utils.ClusterClient {
+	return &utils.ClusterClient{
+		ClusterName:     name,
+		KubeClient:      kubefake.NewSimpleClientset(kubeObjects...),
+		GeneratedClient: generatedfake.NewSimpleClientset(flows...),
+	}
+}
+
+func newMaintenanceFlow(name string, replicas int32, annotations map[string]string) *kfv1alpha1.KantaloupeFlow {
+	return &kfv1alpha1.KantaloupeFlow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Labels:      map[string]string{"app": name},
+			Annotations: annotations,
+		},
+		Spec:   kfv1alpha1.KantaloupeFlowSpec{Replicas: ptr.To(replicas)},
+		Status: kfv1alpha1.KantaloupeFlowStatus{Replicas: replicas},
+	}
+}
+
+func TestSyncMaintenanceCordonAndHibernate(t *testing.T) {
+	ctx := context.TODO()
+	schedulable := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "schedulable"}}
+	cordoned := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cordoned"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	clusterClient := newMaintenanceClusterClient("member", []runtime.Object{schedulable, cordoned},
+		newMaintenanceFlow("flow", 3, nil))
+	c := &Controller{EventRecorder: record.NewFakeRecorder(10)}
+	cluster := &clustercrdv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member"},
+		Spec: clustercrdv1alpha1.ClusterSpec{
+			Maintenance: true,
+			MaintenancePolicy: &clustercrdv1alpha1.MaintenancePolicy{
+				CordonNodes:   true,
+				DrainStrategy: clustercrdv1alpha1.DrainStrategyHibernate,
+			},
+		},
+	}
+	status := &clustercrdv1alpha1.ClusterStatus{}
+
+	condition := c.syncMaintenance(ctx, clusterClient, cluster, status)
+	if condition == nil || condition.Reason != maintenanceDraining {
+		t.Fatalf("syncMaintenance() = %+v, want reason %s", condition, maintenanceDraining)
+	}
+	if got := status.Maintenance.CordonedNodes; len(got) != 1 || got[0] != "schedulable" {
+		t.Errorf("cordoned nodes = %v, want only the schedulable node", got)
+	}
+	node, err := clusterClient.KubeClient.CoreV1().Nodes().Get(ctx, "schedulable", metav1.GetOptions{})
+	if err != nil || !node.Spec.Unschedulable {
+		t.Errorf("node schedulable is not cordoned, err: %v", err)
+	}
+	flow, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Get(ctx, "flow", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get kantaloupeflow: %v", err)
+	}
+	if ptr.Deref(flow.Spec.Replicas, -1) != 0 || flow.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey] != "3" {
+		t.Errorf("kantaloupeflow is not hibernated, replicas: %v, annotations: %v", ptr.Deref(flow.Spec.Replicas, -1), flow.Annotations)
+	}
+
+	// the flow is drained once its pods are gone.
+	flow.Status.Replicas = 0
+	if _, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Update(ctx, flow, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to update kantaloupeflow: %v", err)
+	}
+	condition = c.syncMaintenance(ctx, clusterClient, cluster, status)
+	if condition.Reason != maintenanceDrained || status.Maintenance.Phase != clustercrdv1alpha1.MaintenancePhaseCompleted {
+		t.Errorf("syncMaintenance() = %+v, phase %s, want the maintenance completed", condition, status.Maintenance.Phase)
+	}
+	if got := status.Maintenance.CordonedNodes; len(got) != 1 {
+		t.Errorf("cordoned nodes = %v, want the node recorded once", got)
+	}
+
+	cluster.Spec.Maintenance = false
+	condition = c.syncMaintenance(ctx, clusterClient, cluster, status)
+	if condition.Reason != maintenanceFinished || status.Maintenance != nil {
+		t.Fatalf("syncMaintenance() = %+v, maintenance %+v, want the maintenance finished", condition, status.Maintenance)
+	}
+	for name, unschedulable := range map[string]bool{"schedulable": false, "cordoned": true} {
+		node, err := clusterClient.KubeClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil || node.Spec.Unschedulable != unschedulable {
+			t.Errorf("node %s unschedulable = %v, want %v, err: %v", name, node.Spec.Unschedulable, unschedulable, err)
+		}
+	}
+	flow, err = clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Get(ctx, "flow", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get kantaloupeflow: %v", err)
+	}
+	if _, ok := flow.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey]; ok || ptr.Deref(flow.Spec.Replicas, -1) != 3 {
+		t.Errorf("kantaloupeflow is not resumed, replicas: %v, annotations: %v", ptr.Deref(flow.Spec.Replicas, -1), flow.Annotations)
+	}
+}
+
+func TestDrainKantaloupeflowsMigrate(t *testing.T) {
+	migratedFrom := func(cluster string) map[string]string {
+		return map[string]string{constants.KantaloupeFlowMigratedFromAnnotationKey: cluster}
+	}
+	ready := func(flow *kfv1alpha1.KantaloupeFlow) *kfv1alpha1.KantaloupeFlow {
+		flow.Status.ReadyReplicas = ptr.Deref(flow.Spec.Replicas, 1)
+		return flow
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ssh-key"}}
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"}}
+
+	tests := []struct {
+		name          string
+		mutateFlow    func(flow *kfv1alpha1.KantaloupeFlow)
+		capabilities  *clustercrdv1alpha1.ClusterCapabilities
+		targetObjects []runtime.Object
+		targetFlow    *kfv1alpha1.KantaloupeFlow
+		expectPending int32
+		expectErr     bool
+		expectDeleted bool
+		// expectReplicas is the replicas of the kantaloupeflow created in the target cluster, nil
+		// if none is created.
+		expectReplicas *int32
+	}{
+		{
+			name:           "migrated kantaloupeflow is not ready yet",
+			expectPending:  1,
+			expectReplicas: ptr.To[int32](2),
+		},
+		{
+			name:          "migrated by a previous attempt and ready",
+			targetFlow:    ready(newMaintenanceFlow("flow", 1, migratedFrom("member"))),
+			expectDeleted: true,
+		},
+		{
+			name:          "migrated by a previous attempt but not ready",
+			targetFlow:    newMaintenanceFlow("flow", 1, migratedFrom("member")),
+			expectPending: 1,
+		},
+		{
+			name:       "unrelated kantaloupeflow of the same name in the target cluster",
+			targetFlow: ready(newMaintenanceFlow("flow", 1, map[string]string{"owner": "someone-else"})),
+			expectErr:  true,
+		},
+		{
+			name:       "kantaloupeflow migrated from another cluster",
+			targetFlow: ready(newMaintenanceFlow("flow", 1, migratedFrom("other"))),
+			expectErr:  true,
+		},
+		{
+			name: "networking not supported by the target cluster",
+			mutateFlow: func(flow *kfv1alpha1.KantaloupeFlow) {
+				flow.Spec.Networking = []kfv1alpha1.Networking{{Name: "web", Type: "httproute", Port: 80}}
+			},
+			capabilities: &clustercrdv1alpha1.ClusterCapabilities{
+				KantaloupeFlowFeatures: []clustercrdv1alpha1.KantaloupeFlowFeature{clustercrdv1alpha1.KantaloupeFlowFeatureTCPRoute},
+			},
+			expectErr: true,
+		},
+		{
+			name: "dependencies missing in the target cluster",
+			mutateFlow: func(flow *kfv1alpha1.KantaloupeFlow) {
+				flow.Spec.DependOn = []kfv1alpha1.DependOn{{Kind: kfv1alpha1.DependOnKindSecret, ResourceRef: kfv1alpha1.ResourceReference{Name: "ssh-key"}}}
+				flow.Spec.Template.Spec.Volumes = []corev1.Volume{{Name: "data", VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+				}}}
+			},
+			targetObjects: []runtime.Object{secret},
+			expectErr:     true,
+		},
+		{
+			name: "dependencies exist in the target cluster",
+			mutateFlow: func(flow *kfv1alpha1.KantaloupeFlow) {
+				flow.Spec.DependOn = []kfv1alpha1.DependOn{{Kind: kfv1alpha1.DependOnKindSecret, ResourceRef: kfv1alpha1.ResourceReference{Name: "ssh-key"}}}
+				flow.Spec.Template.Spec.Volumes = []corev1.Volume{{Name: "data", VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+				}}}
+			},
+			targetObjects:  []runtime.Object{secret, pvc},
+			expectPending:  1,
+			expectReplicas: ptr.To[int32](2),
+		},
+		{
+			name: "hibernated kantaloupeflow is migrated with its original replicas",
+			mutateFlow: func(flow *kfv1alpha1.KantaloupeFlow) {
+				flow.Spec.Replicas = ptr.To[int32](0)
+				flow.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey] = "3"
+			},
+			expectPending:  1,
+			expectReplicas: ptr.To[int32](3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			flow := newMaintenanceFlow("flow", 2, map[string]string{"note": "keep"})
+			if tt.mutateFlow != nil {
+				tt.mutateFlow(flow)
+			}
+			clusterClient := newMaintenanceClusterClient("member", nil, flow)
+			var targetFlows []runtime.Object
+			if tt.targetFlow != nil {
+				targetFlows = append(targetFlows, tt.targetFlow)
+			}
+			targetClient := newMaintenanceClusterClient("target", tt.targetObjects, targetFlows...)
+
+			scheme := runtime.NewScheme()
+			_ = clustercrdv1alpha1.Install(scheme)
+			target := &clustercrdv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "target"},
+				Status:     clustercrdv1alpha1.ClusterStatus{Capabilities: tt.capabilities},
+			}
+			c := &Controller{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(target).Build(),
+				ClusterClientSetFunc: func(name string, _ client.Client, _ *utils.ClientOption) (*utils.ClusterClient, error) {
+					if name != "target" {
+						t.Errorf("client of cluster %s is created, want the target cluster", name)
+					}
+					return targetClient, nil
+				},
+			}
+			cluster := &clustercrdv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member"}}
+			policy := &clustercrdv1alpha1.MaintenancePolicy{DrainStrategy: clustercrdv1alpha1.DrainStrategyMigrate, TargetCluster: "target"}
+
+			pending, errs := c.drainKantaloupeflows(ctx, clusterClient, cluster, policy, []kfv1alpha1.KantaloupeFlow{*flow})
+			if pending != tt.expectPending || (len(errs) != 0) != tt.expectErr {
+				t.Errorf("drainKantaloupeflows() = %d, %v, want %d pending and error %v", pending, errs, tt.expectPending, tt.expectErr)
+			}
+			if _, ok := flow.Annotations[constants.KantaloupeFlowMigratedFromAnnotationKey]; ok {
+				t.Errorf("the annotations of the source kantaloupeflow are modified: %v", flow.Annotations)
+			}
+
+			_, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Get(ctx, "flow", metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tt.expectDeleted {
+				t.Errorf("source kantaloupeflow deleted = %v, want %v", deleted, tt.expectDeleted)
+			}
+			migrated, err := targetClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Get(ctx, "flow", metav1.GetOptions{})
+			switch {
+			case tt.targetFlow != nil:
+				if err != nil || !reflect.DeepEqual(migrated.Annotations, tt.targetFlow.Annotations) {
+					t.Errorf("the kantaloupeflow of the target cluster is modified: %v, err: %v", migrated, err)
+				}
+			case tt.expectReplicas == nil:
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("kantaloupeflow is created in the target cluster, err: %v", err)
+				}
+			case err != nil:
+				t.Fatalf("failed to get the kantaloupeflow of the target cluster: %v", err)
+			default:
+				if migrated.Annotations[constants.KantaloupeFlowMigratedFromAnnotationKey] != "member" || migrated.Annotations["note"] != "keep" ||
+					ptr.Deref(migrated.Spec.Replicas, 0) != *tt.expectReplicas {
+					t.Errorf("migrated kantaloupeflow = %+v, want a copy of the source with %d replicas", migrated, *tt.expectReplicas)
+				}
+				if _, ok := migrated.Annotations[constants.KantaloupeFlowHibernatedReplicasAnnotationKey]; ok {
+					t.Errorf("the hibernated replicas of the source are migrated: %v", migrated.Annotations)
+				}
+			}
+		})
+	}
+}
+
+func TestDrainKantaloupeflowsMigrateOnceReady(t *testing.T) {
+	ctx := context.TODO()
+	flow := newMaintenanceFlow("flow", 2, nil)
+	clusterClient := newMaintenanceClusterClient("member", nil, flow)
+	targetClient := newMaintenanceClusterClient("target", nil)
+	scheme := runtime.NewScheme()
+	_ = clustercrdv1alpha1.Install(scheme)
+	c := &Controller{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&clustercrdv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "target"}}).Build(),
+		ClusterClientSetFunc: func(string, client.Client, *utils.ClientOption) (*utils.ClusterClient, error) {
+			return targetClient, nil
+		},
+	}
+	cluster := &clustercrdv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member"}}
+	policy := &clustercrdv1alpha1.MaintenancePolicy{DrainStrategy: clustercrdv1alpha1.DrainStrategyMigrate, TargetCluster: "target"}
+
+	if pending, errs := c.drainKantaloupeflows(ctx, clusterClient, cluster, policy, []kfv1alpha1.KantaloupeFlow{*flow}); pending != 1 || len(errs) != 0 {
+		t.Fatalf("drainKantaloupeflows() = %d, %v, want the migration pending", pending, errs)
+	}
+
+	// the source is deleted once the migrated kantaloupeflow is ready.
+	targetFlows := targetClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default")
+	migrated, err := targetFlows.Get(ctx, "flow", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the migrated kantaloupeflow: %v", err)
+	}
+	migrated.Status.ReadyReplicas = 2
+	if _, err := targetFlows.Update(ctx, migrated, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to update the migrated kantaloupeflow: %v", err)
+	}
+	if pending, errs := c.drainKantaloupeflows(ctx, clusterClient, cluster, policy, []kfv1alpha1.KantaloupeFlow{*flow}); pending != 0 || len(errs) != 0 {
+		t.Fatalf("drainKantaloupeflows() = %d, %v, want the migration done", pending, errs)
+	}
+	if _, err := clusterClient.GeneratedClient.KantaloupeflowV1alpha1().KantaloupeFlows("default").Get(ctx, "flow", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("source kantaloupeflow is not deleted, err: %v", err)
+	}
+}
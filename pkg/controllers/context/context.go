@@ -25,6 +25,8 @@ type Options struct {
 	ClusterSuccessThreshold metav1.Duration
 	// ClusterFailureThreshold is the duration of failure for the cluster to be considered unhealthy.
 	ClusterFailureThreshold metav1.Duration
+	// ClusterCleanupTimeout is the duration to wait for the cleanup of a cluster being deleted.
+	ClusterCleanupTimeout metav1.Duration
 	// ClusterAPIQPS is the QPS to use while talking with cluster kube-apiserver.
 	ClusterAPIQPS float32
 	// ClusterAPIBurst is the burst to allow while talking with cluster kube-apiserver.
@@ -23,6 +23,7 @@ import (
 
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/portallocate"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -221,7 +222,7 @@ func (c *SectionController) SetupWithManager(mgr controllerruntime.Manager) erro
 		For(&gatewayv1alpha2.TCPRoute{}, builder.WithPredicates(LabelSelectorPredicate())).
 		Named(ControllerName).
 		Named(fmt.Sprintf(ControllerName, c.Cluster)).
-		Complete(c)
+		Complete(tracing.Reconciler("gatewaysection-controller", c, tracing.ClusterKey.String(c.Cluster)))
 }
 
 func LabelSelectorPredicate() predicate.Predicate {
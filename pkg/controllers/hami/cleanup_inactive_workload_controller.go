@@ -25,8 +25,10 @@ const (
 type CleanupInactiveWorkloadController struct {
 	Cluster string
 	client.Client
-	MonitoringService                monitoring.Service
-	CleanupInactiveWorkloadThreshold time.Duration
+	MonitoringService monitoring.Service
+	// CleanupInactiveWorkloadThreshold returns how long a workload is inactive before it is
+	// cleaned up, it is called on every sync.
+	CleanupInactiveWorkloadThreshold func() time.Duration
 	EventRecorder                    record.EventRecorder
 }
 
@@ -49,9 +51,9 @@ func (c *CleanupInactiveWorkloadController) Start(ctx context.Context) error {
 			return
 		}
 
+		threshold := c.CleanupInactiveWorkloadThreshold().Seconds()
 		for _, vec := range vecs {
 			val := vec.Value
-			threshold := c.CleanupInactiveWorkloadThreshold.Seconds()
 			if float64(val) > threshold {
 				podName := vec.Metric["podname"]
 				namespace := vec.Metric["podnamespace"]
@@ -19,6 +19,7 @@ import (
 	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/annotations"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -139,5 +140,5 @@ func (c *PodGPUMemScaleController) SetupWithManager(mgr controllerruntime.Manage
 	return controllerruntime.NewControllerManagedBy(mgr).
 		For(&corev1.Pod{}, builder.WithPredicates(configMapPredicateFunc)).
 		Named(fmt.Sprintf(PodGPUMemScaleControllerName, c.Cluster)).
-		Complete(c)
+		Complete(tracing.Reconciler("pod-gpumem-scale-controller", c, tracing.ClusterKey.String(c.Cluster)))
 }
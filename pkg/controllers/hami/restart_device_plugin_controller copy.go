@@ -16,6 +16,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -114,5 +116,5 @@ func (c *RestartDevicePluginController) SetupWithManager(mgr controllerruntime.M
 	return controllerruntime.NewControllerManagedBy(mgr).
 		For(&corev1.ConfigMap{}, builder.WithPredicates(configMapPredicateFunc)).
 		Named(fmt.Sprintf(RestartDevicePluginControllerName, c.Cluster)).
-		Complete(c)
+		Complete(tracing.Reconciler("restart-device-plugin-controller", c, tracing.ClusterKey.String(c.Cluster)))
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/helper"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/namespace"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/portallocate"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -904,7 +905,7 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 		For(&kfv1alpha1.KantaloupeFlow{}).
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(deploymentPredicateFunc)).
 		Named(fmt.Sprintf(ControllerName, c.Cluster)).
-		Complete(c)
+		Complete(tracing.Reconciler("kantaloupeflow-controller", c, tracing.ClusterKey.String(c.Cluster)))
 }
 
 func isKantaloupeflowEabledPlugin(flow *kfv1alpha1.KantaloupeFlow) bool {
@@ -19,6 +19,7 @@ import (
 
 	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -115,7 +116,7 @@ func (c *DeplymentController) SetupWithManager(mgr controllerruntime.Manager) er
 	return controllerruntime.NewControllerManagedBy(mgr).
 		For(&appsv1.Deployment{}, builder.WithPredicates(clusterPredicateFunc)).
 		Named(fmt.Sprintf(DeploymentControllerName, c.Cluster)).
-		Complete(c)
+		Complete(tracing.Reconciler("kantaloupeflow-deployment-controller", c, tracing.ClusterKey.String(c.Cluster)))
 }
 
 func hasOwnerRef(deploy *appsv1.Deployment) bool {
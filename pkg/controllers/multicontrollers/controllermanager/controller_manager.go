@@ -32,6 +32,7 @@ import (
 
 	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
 	kfv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	clustercontroller "github.com/dynamia-ai/kantaloupe/pkg/controllers/cluster"
 	"github.com/dynamia-ai/kantaloupe/pkg/controllers/gateway"
 	"github.com/dynamia-ai/kantaloupe/pkg/controllers/hami"
 	"github.com/dynamia-ai/kantaloupe/pkg/controllers/kantaloupeflow"
@@ -42,6 +43,7 @@ import (
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/gclient"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/helper"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/portallocate"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 const (
@@ -124,6 +126,12 @@ func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Reques
 	}
 
 	if !cluster.DeletionTimestamp.IsZero() {
+		// keep the member controllers running until the cluster controller cleans up the
+		// kantaloupeflows, they release the networking resources of the kantaloupeflows.
+		if controllerutil.ContainsFinalizer(cluster, clustercontroller.ClusterControllerFinalizer) {
+			klog.V(4).InfoS("Waiting for the cleanup of cluster", "cluster", cluster.Name)
+			return controllerruntime.Result{RequeueAfter: 5 * time.Second}, nil
+		}
 		if err := c.CleanupBeforeStop(cluster.Name); err != nil {
 			return controllerruntime.Result{}, err
 		}
@@ -204,7 +212,7 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 			Named(ControllerName).
 			WithOptions(controller.Options{
 				MaxConcurrentReconciles: c.ConcurrentWorkSyncs,
-			}).Complete(c),
+			}).Complete(tracing.Reconciler(ControllerName, c)),
 		mgr.Add(c),
 	})
 }
@@ -485,6 +493,13 @@ func startPodGPUMemScaleController(_ context.Context, _ *Controller, mgr control
 	return podGPUMemScaleController, nil
 }
 
+// cleanupInactiveWorkloadThreshold returns the threshold of the inactive workloads, it is
+// read on every sync so the reloaded configuration takes effect.
+func cleanupInactiveWorkloadThreshold() time.Duration {
+	threshold, _ := strconv.Atoi(env.CleanupInactiveWorkloadThreshold.Get())
+	return time.Second * time.Duration(threshold)
+}
+
 func startCleanupInactiveWorkloadController(_ context.Context, _ *Controller, mgr controllerruntime.Manager, cluster *clustercrdv1alpha1.Cluster, _ portallocate.Allocate) (interface{}, error) {
 	monitoringEngine, err := engine.NewPrometheusClient(cluster.Spec.PrometheusAddress)
 	if err != nil {
@@ -492,14 +507,11 @@ func startCleanupInactiveWorkloadController(_ context.Context, _ *Controller, mg
 	}
 	service := monitoring.NewService(monitoringEngine)
 
-	thresholdStr := env.CleanupInactiveWorkloadThreshold.Get()
-	threshold, _ := strconv.Atoi(thresholdStr)
-
 	cleanupInactiveWorloadController := &hami.CleanupInactiveWorkloadController{
 		Cluster:                          cluster.Name,
 		Client:                           mgr.GetClient(),
 		MonitoringService:                service,
-		CleanupInactiveWorkloadThreshold: time.Second * time.Duration(threshold),
+		CleanupInactiveWorkloadThreshold: cleanupInactiveWorkloadThreshold,
 		EventRecorder:                    mgr.GetEventRecorderFor(fmt.Sprintf(hami.CleanupInactiveWorkloadControllerName, cluster.Name)),
 	}
 
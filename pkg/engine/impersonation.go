@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/rest"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
+)
+
+// GeteClientForRequest implements ClientManagerInterface.
+func (c *ClientManager) GeteClientForRequest(ctx context.Context, clusterName string) (_ *Client, err error) {
+	_, span := tracing.Start(ctx, "ClientManager.GeteClientForRequest", tracing.ClusterKey.String(clusterName))
+	defer func() { tracing.End(span, err) }()
+
+	u, ok := genericapirequest.UserFrom(ctx)
+	if !c.ops.Impersonate || !ok {
+		return c.GeteClient(clusterName)
+	}
+
+	key := impersonationKey(clusterName, u)
+	v := c.impersonatedClients.Get(key)
+	metrics.RecordClusterClientCache(clusterName, metrics.ClientImpersonated, v != nil)
+	if v != nil {
+		span.SetAttributes(attribute.Bool("kantaloupe.client.cached", true))
+		return v.Value(), nil
+	}
+	span.SetAttributes(attribute.Bool("kantaloupe.client.cached", false), attribute.String("kantaloupe.impersonated_user", u.GetName()))
+
+	start := time.Now()
+	defer func() { metrics.RecordClusterClientBuild(clusterName, metrics.ClientImpersonated, start, err) }()
+
+	config, err := c.clusterConfig(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	config = c.clientConfig(clusterName, config)
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: u.GetName(),
+		UID:      u.GetUID(),
+		Groups:   u.GetGroups(),
+		Extra:    u.GetExtra(),
+	}
+	cli, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	c.impersonatedClients.Set(key, cli, ttlcache.DefaultTTL)
+	return cli, nil
+}
+
+// clusterConfig returns the config of the cluster with the stored credential.
+func (c *ClientManager) clusterConfig(clusterName string) (*rest.Config, error) {
+	if v := c.configs.Get(clusterName); v != nil {
+		return v.Value(), nil
+	}
+
+	c.locker.Lock()
+	defer c.locker.Unlock()
+	config, err := c.buildClusterConfig(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, fmt.Errorf("no config found for cluster %s", clusterName)
+	}
+	c.configs.Set(clusterName, config, ttlcache.DefaultTTL)
+	return config, nil
+}
+
+// impersonationKey identifies the impersonated client of the user in the cluster, all
+// the attributes of the user are included since they are all sent to the cluster.
+func impersonationKey(clusterName string, u user.Info) string {
+	groups := slices.Clone(u.GetGroups())
+	slices.Sort(groups)
+
+	extra := make([]string, 0, len(u.GetExtra()))
+	for k, v := range u.GetExtra() {
+		extra = append(extra, k+"="+strings.Join(v, ","))
+	}
+	slices.Sort(extra)
+
+	return strings.Join([]string{clusterName, u.GetName(), u.GetUID(), strings.Join(groups, ","), strings.Join(extra, ";")}, "\x00")
+}
@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jellydator/ttlcache/v3"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/rest"
+)
+
+const memberCluster = "member"
+
+func newTestClientManager(opts ...func(*Options)) *ClientManager {
+	c := newClientManager(opts...)
+	if c.configs != nil {
+		c.impersonatedClients.Stop()
+		// the config of the cluster is normally read from the secret in the local cluster.
+		c.configs.Set(memberCluster, &rest.Config{Host: "https://member.example.com"}, ttlcache.DefaultTTL)
+	}
+	return c
+}
+
+func requestContext(u user.Info) context.Context {
+	return genericapirequest.WithUser(context.Background(), u)
+}
+
+func TestGeteClientForRequestImpersonatesUser(t *testing.T) {
+	c := newTestClientManager(WithImpersonation(true))
+	u := &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"dev", "ops"}}
+
+	cli, err := c.GeteClientForRequest(requestContext(u), memberCluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	impersonate := cli.Config.Impersonate
+	if impersonate.UserName != "alice" || impersonate.UID != "1" {
+		t.Errorf("expected the client to impersonate alice, got %+v", impersonate)
+	}
+	if !slices.Equal(impersonate.Groups, []string{"dev", "ops"}) {
+		t.Errorf("expected the client to impersonate the groups of alice, got %v", impersonate.Groups)
+	}
+	if c.ops.QPS > 0 && cli.Config.QPS != c.ops.QPS {
+		t.Errorf("expected the QPS of the manager to be applied, got %v", cli.Config.QPS)
+	}
+	if cached := c.configs.Get(memberCluster).Value(); cached.Impersonate.UserName != "" {
+		t.Errorf("expected the cached config of the cluster not to be modified, got %+v", cached.Impersonate)
+	}
+}
+
+func TestGeteClientForRequestCachesPerUser(t *testing.T) {
+	c := newTestClientManager(WithImpersonation(true))
+	alice := &user.DefaultInfo{Name: "alice", Groups: []string{"dev"}}
+
+	first, err := c.GeteClientForRequest(requestContext(alice), memberCluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := c.GeteClientForRequest(requestContext(&user.DefaultInfo{Name: "alice", Groups: []string{"dev"}}), memberCluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second {
+		t.Errorf("expected the client of the same user to be reused")
+	}
+
+	for _, other := range []*user.DefaultInfo{
+		{Name: "bob", Groups: []string{"dev"}},
+		{Name: "alice", Groups: []string{"dev", "admin"}},
+		{Name: "alice", Groups: []string{"dev"}, Extra: map[string][]string{"scope": {"all"}}},
+	} {
+		cli, err := c.GeteClientForRequest(requestContext(other), memberCluster)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cli == first {
+			t.Errorf("expected a different client for %+v", other)
+		}
+		if cli.Config.Impersonate.UserName != other.Name || !slices.Equal(cli.Config.Impersonate.Groups, other.Groups) {
+			t.Errorf("expected the client to impersonate %+v, got %+v", other, cli.Config.Impersonate)
+		}
+	}
+	if got := c.impersonatedClients.Len(); got != 4 {
+		t.Errorf("expected 4 impersonated clients, got %d", got)
+	}
+}
+
+func TestGeteClientForRequestImpersonationDisabledByDefault(t *testing.T) {
+	c := newTestClientManager()
+	if c.ops.Impersonate {
+		t.Fatalf("expected impersonation to be disabled by default")
+	}
+	shared := &Client{Config: &rest.Config{Host: "https://member.example.com"}}
+	c.clusters.Set(memberCluster, shared, ttlcache.DefaultTTL)
+
+	cli, err := c.GeteClientForRequest(requestContext(&user.DefaultInfo{Name: "alice"}), memberCluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cli != shared {
+		t.Errorf("expected the shared client of the cluster, got %+v", cli)
+	}
+}
+
+func TestGeteClientForRequestWithoutUser(t *testing.T) {
+	c := newTestClientManager(WithImpersonation(true))
+	shared := &Client{Config: &rest.Config{Host: "https://member.example.com"}}
+	c.clusters.Set(memberCluster, shared, ttlcache.DefaultTTL)
+
+	cli, err := c.GeteClientForRequest(context.Background(), memberCluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cli != shared {
+		t.Errorf("expected the shared client of the cluster, got %+v", cli)
+	}
+}
+
+func TestNewClientManagerRejectsLateOptions(t *testing.T) {
+	once, clientManager = sync.Once{}, nil
+	t.Cleanup(func() { once, clientManager = sync.Once{}, nil })
+
+	first := NewClientManager(WithImpersonation(true))
+	t.Cleanup(clientManager.impersonatedClients.Stop)
+	if NewClientManager() != first {
+		t.Errorf("expected the client manager to be shared")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected the options passed after the initialization to panic")
+		}
+	}()
+	NewClientManager(WithImpersonation(false))
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+)
+
+// WithMaxInflightRequests limits the concurrent requests to each cluster, 0 is unlimited.
+func WithMaxInflightRequests(maxInflight int) func(*Options) {
+	return func(o *Options) {
+		o.MaxInflightRequests = maxInflight
+	}
+}
+
+// inflightLimiter limits the concurrent requests to a cluster. The slots are shared by the
+// clients of the cluster, including the impersonated ones, so the users together can not
+// saturate the API server of the cluster.
+type inflightLimiter struct {
+	cluster string
+	slots   chan struct{}
+}
+
+// inflightLimiter returns the limiter of the cluster, it is nil if the requests are unlimited.
+func (c *ClientManager) inflightLimiter(clusterName string) *inflightLimiter {
+	if c.ops.MaxInflightRequests <= 0 {
+		return nil
+	}
+	c.inflightLocker.Lock()
+	defer c.inflightLocker.Unlock()
+	if c.inflightLimiters == nil {
+		c.inflightLimiters = make(map[string]*inflightLimiter)
+	}
+	l, ok := c.inflightLimiters[clusterName]
+	if !ok {
+		l = &inflightLimiter{cluster: clusterName, slots: make(chan struct{}, c.ops.MaxInflightRequests)}
+		c.inflightLimiters[clusterName] = l
+	}
+	return l
+}
+
+func (l *inflightLimiter) wrap(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		// the long running requests would hold the slots as long as they last.
+		if isLongRunning(req) {
+			return rt.RoundTrip(req)
+		}
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			metrics.RecordClusterThrottledRequest(l.cluster)
+			select {
+			case l.slots <- struct{}{}:
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+		}
+		metrics.RecordClusterInflightRequests(l.cluster, 1)
+
+		var once sync.Once
+		release := func() {
+			once.Do(func() {
+				<-l.slots
+				metrics.RecordClusterInflightRequests(l.cluster, -1)
+			})
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil || resp.Body == nil {
+			release()
+			return resp, err
+		}
+		// the slot is held until the body is read, e.g. the large lists.
+		resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+		return resp, nil
+	})
+}
+
+// isLongRunning reports whether the request is a watch, a log follow or a stream upgraded
+// to another protocol, e.g. exec, attach and port forward.
+func isLongRunning(req *http.Request) bool {
+	if req.Header.Get("Upgrade") != "" {
+		return true
+	}
+	query := req.URL.Query()
+	if query.Get("watch") == "true" || query.Get("watch") == "1" || query.Get("follow") == "true" {
+		return true
+	}
+	for _, suffix := range []string{"/exec", "/attach", "/portforward"} {
+		if strings.HasSuffix(req.URL.Path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// releaseOnClose releases the slot of the response when its body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
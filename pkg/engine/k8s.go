@@ -3,10 +3,13 @@ package engine
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -21,6 +24,8 @@ import (
 	kantaloupetypedclientset "github.com/dynamia-ai/kantaloupe/api/crd/generated/clientset/versioned/typed/cluster/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/gclient"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
 var (
@@ -33,6 +38,10 @@ type Options struct {
 	Burst      int
 	Kubeconfig string
 	TTL        time.Duration
+	// Impersonate makes the clients of the requests impersonate the users of the requests.
+	Impersonate bool
+	// MaxInflightRequests limits the concurrent requests to each cluster, 0 is unlimited.
+	MaxInflightRequests int
 }
 
 const LocalCluster = "local-cluster"
@@ -61,7 +70,17 @@ func WithTTL(ttl time.Duration) func(*Options) {
 	}
 }
 
+// WithImpersonation makes the clients returned by GeteClientForRequest impersonate the
+// users of the requests, so the RBAC of the member clusters is enforced on them.
+func WithImpersonation(impersonate bool) func(*Options) {
+	return func(o *Options) {
+		o.Impersonate = impersonate
+	}
+}
+
 type Client struct {
+	// Config is the config the clients are built with, e.g. for the exec streams of the pods.
+	Config  *rest.Config
 	Dynamic dynamic.Interface
 	clientset.Interface
 	client.Client
@@ -70,16 +89,27 @@ type Client struct {
 
 type ClientManagerInterface interface {
 	GeteClient(clusterName string) (*Client, error)
+	// GeteClientForRequest returns the client impersonating the user of the request if
+	// impersonation is enabled, otherwise it is the same as GeteClient.
+	GeteClientForRequest(ctx context.Context, clusterName string) (*Client, error)
 }
 
 func (cli *Client) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
 	return cli.Dynamic.Resource(resource)
 }
 
+// NewClientManager returns the client manager shared by the process, the options are only
+// applied by the first call. Passing options to a later call panics since they would be
+// ignored, e.g. impersonation would silently be disabled.
 func NewClientManager(opts ...func(*Options)) ClientManagerInterface {
+	initialized := true
 	once.Do(func() {
+		initialized = false
 		clientManager = newClientManager(opts...)
 	})
+	if initialized && len(opts) != 0 {
+		panic("engine: the client manager is already initialized, the options must be passed to the first NewClientManager call")
+	}
 	return clientManager
 }
 
@@ -92,10 +122,17 @@ func newClientManager(opts ...func(*Options)) *ClientManager {
 	for _, opt := range opts {
 		opt(&options)
 	}
-	return &ClientManager{
+	manager := &ClientManager{
 		clusters: ttlcache.New(ttlcache.WithTTL[string, *Client](options.TTL)),
 		ops:      options,
 	}
+	if options.Impersonate {
+		manager.configs = ttlcache.New(ttlcache.WithTTL[string, *rest.Config](options.TTL))
+		manager.impersonatedClients = ttlcache.New(ttlcache.WithTTL[string, *Client](options.TTL))
+		// the impersonated clients are keyed by users, evict the expired ones actively.
+		go manager.impersonatedClients.Start()
+	}
+	return manager
 }
 
 type ClientManager struct {
@@ -103,22 +140,34 @@ type ClientManager struct {
 	clusters    *ttlcache.Cache[string, *Client]
 	ops         Options
 	localClient *Client
+
+	// configs caches the configs of the clusters the impersonated clients are built from.
+	configs *ttlcache.Cache[string, *rest.Config]
+	// impersonatedClients caches the impersonated clients by cluster and user.
+	impersonatedClients *ttlcache.Cache[string, *Client]
+
+	inflightLocker sync.Mutex
+	// inflightLimiters limits the concurrent requests to the clusters.
+	inflightLimiters map[string]*inflightLimiter
 }
 
 // GeteClient implements ClientManagerInterface.
-func (c *ClientManager) GeteClient(clusterName string) (*Client, error) {
+func (c *ClientManager) GeteClient(clusterName string) (_ *Client, err error) {
 	if clusterName == LocalCluster {
 		c.locker.RLock()
 		localCluster := c.localClient
 		c.locker.RUnlock()
 
+		metrics.RecordClusterClientCache(clusterName, metrics.ClientShared, localCluster != nil)
 		if localCluster != nil {
 			return localCluster, nil
 		}
 
 		c.locker.Lock()
 		defer c.locker.Unlock()
+		start := time.Now()
 		_, err := c.buildClusterConfig(clusterName)
+		metrics.RecordClusterClientBuild(clusterName, metrics.ClientShared, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -127,12 +176,15 @@ func (c *ClientManager) GeteClient(clusterName string) (*Client, error) {
 	}
 
 	v := c.clusters.Get(clusterName)
+	metrics.RecordClusterClientCache(clusterName, metrics.ClientShared, v != nil)
 	if v != nil {
 		return v.Value(), nil
 	}
 	c.locker.Lock()
 	defer c.locker.Unlock()
 
+	start := time.Now()
+	defer func() { metrics.RecordClusterClientBuild(clusterName, metrics.ClientShared, start, err) }()
 	config, err := c.buildClusterConfig(clusterName)
 	if err != nil {
 		return nil, err
@@ -141,6 +193,40 @@ func (c *ClientManager) GeteClient(clusterName string) (*Client, error) {
 		return nil, err
 	}
 
+	cli, err := newClient(c.clientConfig(clusterName, config))
+	if err != nil {
+		return nil, err
+	}
+	c.clusters.Set(clusterName, cli, ttlcache.DefaultTTL)
+	return cli, nil
+}
+
+// clientConfig returns a copy of the config of the cluster with the QPS, burst and concurrency
+// limits of the manager applied, the requests to the cluster are traced in the spans.
+func (c *ClientManager) clientConfig(clusterName string, config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	if c.ops.QPS > 0 {
+		config.QPS = c.ops.QPS
+	}
+	if c.ops.Burst > 0 {
+		config.Burst = c.ops.Burst
+	}
+	if l := c.inflightLimiter(clusterName); l != nil {
+		config.Wrap(l.wrap)
+	}
+	// the spans include the time waiting for the concurrency limits.
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "k8s " + r.Method
+			}),
+			otelhttp.WithSpanOptions(trace.WithAttributes(tracing.ClusterKey.String(clusterName))),
+		)
+	})
+	return config
+}
+
+func newClient(config *rest.Config) (*Client, error) {
 	cs, err := clientset.NewForConfig(config)
 	if err != nil {
 		klog.ErrorS(err, "Failed to init clientset")
@@ -160,20 +246,22 @@ func (c *ClientManager) GeteClient(clusterName string) (*Client, error) {
 		klog.ErrorS(err, "Failed to init clientset")
 		return nil, err
 	}
-	cli := &Client{
+	return &Client{
+		Config:    config,
 		Interface: cs,
 		Dynamic:   dc,
 		Client:    k8sCli,
-	}
-	c.clusters.Set(clusterName, cli, ttlcache.DefaultTTL)
-	return cli, nil
+	}, nil
 }
 
+// buildClusterConfig builds the local client and returns the config of the cluster, the
+// limits of the manager are applied to the clients built from the config, not the config.
 func (c *ClientManager) buildClusterConfig(clusterName string) (*rest.Config, error) {
-	localConfig, err := utils.BuildLocalClusterConfig(c.ops.Kubeconfig)
+	clusterConfig, err := utils.BuildLocalClusterConfig(c.ops.Kubeconfig)
 	if err != nil {
 		return nil, err
 	}
+	localConfig := c.clientConfig(LocalCluster, clusterConfig)
 	localClusterClient, err := kantaloupev1alpha1.NewForConfig(localConfig)
 	if err != nil {
 		return nil, err
@@ -194,13 +282,14 @@ func (c *ClientManager) buildClusterConfig(clusterName string) (*rest.Config, er
 		return nil, err
 	}
 	c.localClient = &Client{
+		Config:                   localConfig,
 		Interface:                localClient,
 		Client:                   k8sCli,
 		Dynamic:                  dc,
 		ClusterV1alpha1Interface: localClusterClient.ClusterV1alpha1(),
 	}
 	if clusterName == LocalCluster {
-		return localConfig, nil
+		return clusterConfig, nil
 	}
 
 	cluster, err := localClusterClient.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
@@ -2,19 +2,31 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	prometheusapi "github.com/prometheus/client_golang/api"
 	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	prometheusmodel "github.com/prometheus/common/model"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/klog/v2"
 
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/errs"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/metrics"
+	"github.com/dynamia-ai/kantaloupe/pkg/utils/tracing"
 )
 
+// promQLKey is the attribute of the PromQL of the spans of the queries.
+var promQLKey = attribute.Key("prometheus.query")
+
 type PrometheusInterface interface {
 	Query(ctx context.Context, query string, ts time.Time) (prometheusmodel.Value, error)
 	QueryRange(ctx context.Context, query string, r prometheusv1.Range) (prometheusmodel.Value, error)
+	// Ready checks whether Prometheus is reachable and ready to serve the queries.
+	Ready(ctx context.Context) error
 }
 
 func newPrometheus(addr string) (prometheusapi.Client, error) {
@@ -61,7 +73,13 @@ func NewPrometheusClient(addr string, opts ...func(*PrometheusOptions)) (Prometh
 	return &Prometheus{client: client, ops: options}, err
 }
 
-func (p *Prometheus) Query(ctx context.Context, query string, ts time.Time) (prometheusmodel.Value, error) {
+func (p *Prometheus) Query(ctx context.Context, query string, ts time.Time) (_ prometheusmodel.Value, err error) {
+	ctx, span := tracing.Start(ctx, "Prometheus.Query", promQLKey.String(query), attribute.String("prometheus.time", ts.Format(time.RFC3339)))
+	start := time.Now()
+	defer func() {
+		tracing.End(span, err)
+		metrics.RecordPrometheusQuery("query", start, queryFailureReason(err), err)
+	}()
 	if p.client == nil {
 		return nil, errs.ErrPrometheusClientUninitialized
 	}
@@ -76,7 +94,17 @@ func (p *Prometheus) Query(ctx context.Context, query string, ts time.Time) (pro
 	return val, nil
 }
 
-func (p *Prometheus) QueryRange(ctx context.Context, query string, r prometheusv1.Range) (prometheusmodel.Value, error) {
+func (p *Prometheus) QueryRange(ctx context.Context, query string, r prometheusv1.Range) (_ prometheusmodel.Value, err error) {
+	ctx, span := tracing.Start(ctx, "Prometheus.QueryRange", promQLKey.String(query),
+		attribute.String("prometheus.start", r.Start.Format(time.RFC3339)),
+		attribute.String("prometheus.end", r.End.Format(time.RFC3339)),
+		attribute.String("prometheus.step", r.Step.String()),
+	)
+	start := time.Now()
+	defer func() {
+		tracing.End(span, err)
+		metrics.RecordPrometheusQuery("query_range", start, queryFailureReason(err), err)
+	}()
 	if p.client == nil {
 		return nil, errs.ErrPrometheusClientUninitialized
 	}
@@ -90,3 +118,44 @@ func (p *Prometheus) QueryRange(ctx context.Context, query string, r prometheusv
 	}
 	return val, nil
 }
+
+// queryFailureReason returns the reason of the failed query for the metrics, it is the type
+// of the error returned by Prometheus, e.g. bad_data and execution, or the cause of the
+// failure to reach Prometheus.
+func queryFailureReason(err error) string {
+	var apiErr *prometheusv1.Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &apiErr):
+		return string(apiErr.Type)
+	case errors.Is(err, context.DeadlineExceeded):
+		return string(prometheusv1.ErrTimeout)
+	case errors.Is(err, context.Canceled):
+		return string(prometheusv1.ErrCanceled)
+	case errors.Is(err, errs.ErrPrometheusClientUninitialized):
+		return "uninitialized"
+	default:
+		return "unavailable"
+	}
+}
+
+func (p *Prometheus) Ready(ctx context.Context) error {
+	if p.client == nil {
+		return errs.ErrPrometheusClientUninitialized
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.ops.TimeOut)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.client.URL("/-/ready", nil).String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, body, err := p.client.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("prometheus is not ready: %s %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
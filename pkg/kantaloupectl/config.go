@@ -0,0 +1,226 @@
+package kantaloupectl
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/dynamia-ai/kantaloupe/api/sdk"
+)
+
+const (
+	// ConfigAPIVersion and ConfigKind are the version and the kind of the context files.
+	ConfigAPIVersion = "kantaloupectl.kantaloupe.dynamia.ai/v1alpha1"
+	ConfigKind       = "Config"
+
+	// ConfigEnv is the environment variable of the path of the context file.
+	ConfigEnv = "KANTALOUPECONFIG"
+)
+
+// ErrContextNotFound is returned when the context is not in the context file.
+var ErrContextNotFound = errors.New("context not found")
+
+// Config is the context file of kantaloupectl, like the kubeconfig it holds the contexts of
+// the kantaloupe endpoints and the one in use, e.g.
+//
+//	apiVersion: kantaloupectl.kantaloupe.dynamia.ai/v1alpha1
+//	kind: Config
+//	current-context: prod
+//	contexts:
+//	- name: prod
+//	  context:
+//	    server: kantaloupe.example.com:443
+//	    token-file: ~/.kantaloupe/prod.token
+//	    cluster: gpu-cluster-1
+type Config struct {
+	APIVersion     string         `json:"apiVersion"`
+	Kind           string         `json:"kind"`
+	CurrentContext string         `json:"current-context,omitempty"`
+	Contexts       []NamedContext `json:"contexts,omitempty"`
+}
+
+// NamedContext is a context of the context file by its name.
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context is the endpoint of the kantaloupe apiserver and the credential to call it.
+type Context struct {
+	// Server is the address of the apiserver, e.g. kantaloupe.example.com:443.
+	Server string `json:"server,omitempty"`
+	// Token is the bearer token of the user, TokenFile is the file it is read from on every
+	// call otherwise.
+	Token     string `json:"token,omitempty"`
+	TokenFile string `json:"token-file,omitempty"`
+	// CertificateAuthority is the file of the CA bundle of the apiserver, the system roots
+	// are used if it is empty.
+	CertificateAuthority  string `json:"certificate-authority,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecure-skip-tls-verify,omitempty"`
+	// Plaintext connects to the insecure port of the apiserver without TLS.
+	Plaintext bool `json:"plaintext,omitempty"`
+	// Cluster and Namespace are the member cluster and the namespace used by default.
+	Cluster   string `json:"cluster,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the context file, it is $KANTALOUPECONFIG if it is
+// set, otherwise ~/.kantaloupe/config.
+func DefaultConfigPath() string {
+	if path := os.Getenv(ConfigEnv); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".kantaloupe", "config")
+	}
+	return filepath.Join(home, ".kantaloupe", "config")
+}
+
+// LoadConfig reads the context file at path, the missing file is an empty one.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{APIVersion: ConfigAPIVersion, Kind: ConfigKind}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the context file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to decode the context file %s: %w", path, err)
+	}
+	if config.APIVersion != ConfigAPIVersion || config.Kind != ConfigKind {
+		return nil, fmt.Errorf("unsupported context file %s of %s %s, it must be %s %s", path, config.APIVersion, config.Kind, ConfigAPIVersion, ConfigKind)
+	}
+	return config, nil
+}
+
+// Save writes the context file to path, it is only readable by the user as it holds the
+// tokens.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create the directory of the context file: %w", err)
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Context returns the context of the name, or the current context if name is empty.
+func (c *Config) Context(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%w: no context is in use, set one with kantaloupectl config use-context", ErrContextNotFound)
+	}
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i].Context, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrContextNotFound, name)
+}
+
+// SetContext adds the context of the name or replaces the one of the same name.
+func (c *Config) SetContext(name string, context Context) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			c.Contexts[i].Context = context
+			return
+		}
+	}
+	c.Contexts = append(c.Contexts, NamedContext{Name: name, Context: context})
+}
+
+// DeleteContext removes the context of the name, the current context is unset if it is the
+// one removed.
+func (c *Config) DeleteContext(name string) error {
+	i := slices.IndexFunc(c.Contexts, func(named NamedContext) bool { return named.Name == name })
+	if i < 0 {
+		return fmt.Errorf("%w: %q", ErrContextNotFound, name)
+	}
+	c.Contexts = slices.Delete(c.Contexts, i, i+1)
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return nil
+}
+
+// BearerToken returns the token of the context, it is read from TokenFile if Token is empty.
+func (c *Context) BearerToken() (string, error) {
+	if c.Token != "" || c.TokenFile == "" {
+		return c.Token, nil
+	}
+	data, err := os.ReadFile(expandHome(c.TokenFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the token file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// TLSConfig returns the TLS config of the connections to the apiserver, it is nil if the
+// context is plaintext.
+func (c *Context) TLSConfig() (*tls.Config, error) {
+	if c.Plaintext {
+		return nil, nil
+	}
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipTLSVerify, //nolint:gosec
+	}
+	if c.CertificateAuthority != "" {
+		data, err := os.ReadFile(expandHome(c.CertificateAuthority))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the certificate authority: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate is found in %s", c.CertificateAuthority)
+		}
+	}
+	return config, nil
+}
+
+// NewClient returns the client of the apiserver of the context.
+func (c *Context) NewClient() (*sdk.Client, error) {
+	if c.Server == "" {
+		return nil, errors.New("the server of the context is empty, set it with --server or kantaloupectl config set-context")
+	}
+	opts := []sdk.Option{}
+	tlsConfig, err := c.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		opts = append(opts, sdk.WithInsecure())
+	} else {
+		opts = append(opts, sdk.WithTLSConfig(tlsConfig))
+	}
+	if c.Token != "" || c.TokenFile != "" {
+		opts = append(opts, sdk.WithTokenSource(func(context.Context) (string, error) {
+			return c.BearerToken()
+		}))
+	}
+	return sdk.New(c.Server, opts...)
+}
+
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
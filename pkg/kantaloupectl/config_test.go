@@ -0,0 +1,80 @@
+package kantaloupectl
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kantaloupe", "config")
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() of the missing file error = %v", err)
+	}
+	if _, err := config.Context(""); !errors.Is(err, ErrContextNotFound) {
+		t.Errorf("Context() error = %v, want %v", err, ErrContextNotFound)
+	}
+
+	config.SetContext("dev", Context{Server: "localhost:8000", Plaintext: true})
+	config.SetContext("prod", Context{Server: "kantaloupe.example.com:443", Token: "token", Cluster: "gpu-1"})
+	config.SetContext("dev", Context{Server: "localhost:8001", Plaintext: true})
+	config.CurrentContext = "prod"
+	if err := config.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("the mode of the context file = %v, %v, want 0600", info.Mode().Perm(), err)
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := loaded.Context("")
+	if err != nil || current.Cluster != "gpu-1" {
+		t.Errorf("Context() = %+v, %v, want the prod context", current, err)
+	}
+	if dev, err := loaded.Context("dev"); err != nil || dev.Server != "localhost:8001" || len(loaded.Contexts) != 2 {
+		t.Errorf("Context(dev) = %+v, %v, want the replaced context", dev, err)
+	}
+
+	if err := loaded.DeleteContext("prod"); err != nil || loaded.CurrentContext != "" {
+		t.Errorf("DeleteContext() error = %v, current context = %q", err, loaded.CurrentContext)
+	}
+	if err := loaded.DeleteContext("prod"); !errors.Is(err, ErrContextNotFound) {
+		t.Errorf("DeleteContext() error = %v, want %v", err, ErrContextNotFound)
+	}
+
+	if err := os.WriteFile(path, []byte("apiVersion: v1\nkind: Config\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(path); err == nil {
+		t.Errorf("LoadConfig() of another apiVersion succeeded")
+	}
+}
+
+func TestContextBearerToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		context Context
+		want    string
+	}{
+		{name: "token", context: Context{Token: "token", TokenFile: tokenFile}, want: "token"},
+		{name: "token file", context: Context{TokenFile: tokenFile}, want: "from-file"},
+		{name: "none", context: Context{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.context.BearerToken()
+			if err != nil || got != tt.want {
+				t.Errorf("BearerToken() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package kantaloupectl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// The output formats of the commands printing the resources.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// OutputFormats are the supported output formats.
+var OutputFormats = []string{OutputTable, OutputJSON, OutputYAML}
+
+// Table is the table output of the resources, a row per resource.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// AddRow appends the row of the cells.
+func (t *Table) AddRow(cells ...string) {
+	t.Rows = append(t.Rows, cells)
+}
+
+// Print writes the table aligned by its columns.
+func (t *Table) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	if _, err := fmt.Fprintln(tw, strings.Join(t.Headers, "\t")); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// Printer prints the resources in an output format.
+type Printer struct {
+	Format string
+	Out    io.Writer
+}
+
+// ValidateOutput returns an error if the output format is not supported.
+func ValidateOutput(format string) error {
+	switch format {
+	case OutputTable, OutputJSON, OutputYAML:
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q, it must be one of %s", format, strings.Join(OutputFormats, ", "))
+}
+
+// PrintObject prints the resource, table builds its table output.
+func (p *Printer) PrintObject(object proto.Message, table func() *Table) error {
+	if p.Format == OutputTable || p.Format == "" {
+		return table().Print(p.Out)
+	}
+	data, err := protojson.Marshal(object)
+	if err != nil {
+		return err
+	}
+	return p.write(data)
+}
+
+// PrintList prints the resources, they are printed as the items of a list in the JSON and
+// YAML outputs.
+func PrintList[T proto.Message](p *Printer, objects []T, table func() *Table) error {
+	if p.Format == OutputTable || p.Format == "" {
+		return table().Print(p.Out)
+	}
+	items := make([]json.RawMessage, 0, len(objects))
+	for _, object := range objects {
+		data, err := protojson.Marshal(object)
+		if err != nil {
+			return err
+		}
+		items = append(items, data)
+	}
+	data, err := json.Marshal(map[string]interface{}{"items": items})
+	if err != nil {
+		return err
+	}
+	return p.write(data)
+}
+
+func (p *Printer) write(data []byte) error {
+	switch p.Format {
+	case OutputYAML:
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return err
+		}
+		_, err = p.Out.Write(out)
+		return err
+	default:
+		// protojson does not produce a stable format, it is indented again.
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, data, "", "  "); err != nil {
+			return err
+		}
+		indented.WriteByte('\n')
+		_, err := indented.WriteTo(p.Out)
+		return err
+	}
+}
+
+// Age returns the age of the unix timestamp in seconds as kubectl prints it, e.g. 3d or 5h.
+func Age(timestamp int64) string {
+	if timestamp <= 0 {
+		return "<unknown>"
+	}
+	d := time.Since(time.Unix(timestamp, 0))
+	switch {
+	case d < 0:
+		return "0s"
+	case d < 2*time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < 2*time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
+// ValueOrNone returns the value, or <none> if it is empty.
+func ValueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
@@ -0,0 +1,47 @@
+package kantaloupectl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+)
+
+func TestPrinter(t *testing.T) {
+	objects := []*types.ObjectMeta{{Name: "a", Namespace: "default"}, {Name: "bb", Namespace: "kube-system"}}
+	table := func() *Table {
+		table := &Table{Headers: []string{"NAME", "NAMESPACE"}}
+		for _, object := range objects {
+			table.AddRow(object.GetName(), object.GetNamespace())
+		}
+		return table
+	}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: OutputTable, want: "NAME   NAMESPACE\na      default\nbb     kube-system\n"},
+		{format: OutputJSON, want: "{\n  \"items\": [\n    {\n      \"name\": \"a\",\n      \"namespace\": \"default\"\n    },\n    {\n      \"name\": \"bb\",\n      \"namespace\": \"kube-system\"\n    }\n  ]\n}\n"},
+		{format: OutputYAML, want: "items:\n- name: a\n  namespace: default\n- name: bb\n  namespace: kube-system\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := PrintList(&Printer{Format: tt.format, Out: &out}, objects, table); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("PrintList() = %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+
+	var out bytes.Buffer
+	if err := (&Printer{Format: OutputYAML, Out: &out}).PrintObject(objects[0], nil); err != nil || out.String() != "name: a\nnamespace: default\n" {
+		t.Errorf("PrintObject() = %q, %v", out.String(), err)
+	}
+	if err := ValidateOutput("wide"); err == nil || !strings.Contains(err.Error(), "table, json, yaml") {
+		t.Errorf("ValidateOutput() error = %v", err)
+	}
+}
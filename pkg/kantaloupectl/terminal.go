@@ -0,0 +1,214 @@
+package kantaloupectl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/term"
+)
+
+const (
+	// terminalPath and terminalProtocol are the route and the WebSocket subprotocol of the
+	// web terminals of the apiserver, see pkg/apiserver/terminal.
+	terminalPath     = "/apis/kantaloupe.dynamia.ai/v1/clusters/%s/namespaces/%s/kantaloupeflows/%s/exec"
+	terminalProtocol = "terminal.kantaloupe.dynamia.ai"
+	// sessionEnded is the reason of the close message of the sessions ended normally.
+	sessionEnded = "session ended"
+
+	handshakeTimeout = 30 * time.Second
+	writeTimeout     = 10 * time.Second
+	// resizePeriod is how often the size of the local terminal is checked, it is polled as
+	// not all the platforms signal the resizes.
+	resizePeriod = 250 * time.Millisecond
+)
+
+// TerminalSize is the size of the local terminal.
+type TerminalSize struct {
+	Cols uint16
+	Rows uint16
+}
+
+// ExecOptions are the options of a terminal session in a kantaloupeflow.
+type ExecOptions struct {
+	Cluster   string
+	Namespace string
+	Name      string
+	// Pod and Container select the replica and its container, the apiserver selects the
+	// running one if they are empty.
+	Pod       string
+	Container string
+	// Command is the command to run, the shell of the container is run if it is empty.
+	Command []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	// Sizes are the sizes of the local terminal, the first one is the initial size. It is nil
+	// if the input is not a terminal.
+	Sizes <-chan TerminalSize
+}
+
+// terminalMessage is the message sent to the web terminals, the op is either stdin or resize.
+type terminalMessage struct {
+	Op   string `json:"op"`
+	Data string `json:"data,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
+
+// Exec opens a terminal session in the kantaloupeflow through the apiserver of the context,
+// the member cluster is not accessed directly. It returns when the command exits, the error
+// carries the reason if the session does not end normally, e.g. the exit code of the command.
+func (c *Context) Exec(ctx context.Context, opts ExecOptions) error {
+	tlsConfig, err := c.TLSConfig()
+	if err != nil {
+		return err
+	}
+	scheme := "wss"
+	if tlsConfig == nil {
+		scheme = "ws"
+	}
+	query := url.Values{}
+	if opts.Pod != "" {
+		query.Set("pod", opts.Pod)
+	}
+	if opts.Container != "" {
+		query.Set("container", opts.Container)
+	}
+	for _, arg := range opts.Command {
+		query.Add("command", arg)
+	}
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     c.Server,
+		Path:     fmt.Sprintf(terminalPath, url.PathEscape(opts.Cluster), url.PathEscape(opts.Namespace), url.PathEscape(opts.Name)),
+		RawQuery: query.Encode(),
+	}
+
+	header := http.Header{}
+	token, err := c.BearerToken()
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: handshakeTimeout,
+		Subprotocols:     []string{terminalProtocol},
+	}
+	conn, resp, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+			if body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096)); len(body) > 0 {
+				return errors.New(strings.TrimSpace(string(body)))
+			}
+		}
+		return fmt.Errorf("failed to open the terminal: %w", err)
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	var writeLock sync.Mutex
+	send := func(msg terminalMessage) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		return conn.WriteMessage(websocket.TextMessage, data)
+	}
+
+	if opts.Sizes != nil {
+		go func() {
+			for size := range opts.Sizes {
+				if err := send(terminalMessage{Op: "resize", Cols: size.Cols, Rows: size.Rows}); err != nil {
+					return
+				}
+			}
+		}()
+	}
+	if opts.Stdin != nil {
+		go func() {
+			buf := make([]byte, 32*1024)
+			for {
+				n, err := opts.Stdin.Read(buf)
+				if n > 0 {
+					if sendErr := send(terminalMessage{Op: "stdin", Data: string(buf[:n])}); sendErr != nil {
+						return
+					}
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			var closeErr *websocket.CloseError
+			switch {
+			case ctx.Err() != nil:
+				return ctx.Err()
+			case errors.As(err, &closeErr) && closeErr.Code == websocket.CloseNormalClosure && closeErr.Text == sessionEnded:
+				return nil
+			case errors.As(err, &closeErr):
+				return errors.New(closeErr.Text)
+			}
+			return err
+		}
+		if messageType != websocket.BinaryMessage {
+			continue
+		}
+		if _, err := opts.Stdout.Write(data); err != nil {
+			return err
+		}
+	}
+}
+
+// WatchTerminalSize sends the size of the terminal of fd and then its new sizes when it is
+// resized until ctx is done.
+func WatchTerminalSize(ctx context.Context, fd int) <-chan TerminalSize {
+	sizes := make(chan TerminalSize, 1)
+	go func() {
+		defer close(sizes)
+		ticker := time.NewTicker(resizePeriod)
+		defer ticker.Stop()
+		var last TerminalSize
+		for {
+			if cols, rows, err := term.GetSize(fd); err == nil {
+				size := TerminalSize{Cols: uint16(cols), Rows: uint16(rows)} //nolint:gosec
+				if size != last {
+					last = size
+					select {
+					case sizes <- size:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return sizes
+}
@@ -0,0 +1,97 @@
+package kantaloupectl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestContextExec(t *testing.T) {
+	tests := []struct {
+		name    string
+		reason  string
+		wantErr string
+	}{
+		{name: "ended", reason: sessionEnded},
+		{name: "exit code", reason: "command terminated with exit code 2", wantErr: "exit code 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrader := websocket.Upgrader{Subprotocols: []string{terminalProtocol}}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/apis/kantaloupe.dynamia.ai/v1/clusters/c1/namespaces/default/kantaloupeflows/f1/exec" ||
+					r.URL.Query()["command"][1] != "-l" || r.Header.Get("Authorization") != "Bearer token" {
+					http.Error(w, "unexpected request "+r.URL.String(), http.StatusBadRequest)
+					return
+				}
+				conn, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				// the initial size comes first, then the input is echoed.
+				for _, want := range []string{"resize", "stdin"} {
+					_, data, err := conn.ReadMessage()
+					msg := terminalMessage{}
+					if err != nil || json.Unmarshal(data, &msg) != nil || msg.Op != want {
+						t.Errorf("message = %s, %v, want %s", data, err, want)
+						return
+					}
+					if msg.Op == "stdin" {
+						_ = conn.WriteMessage(websocket.BinaryMessage, []byte(strings.ToUpper(msg.Data)))
+					}
+				}
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, tt.reason), time.Now().Add(time.Second))
+			}))
+			defer server.Close()
+
+			sizes := make(chan TerminalSize, 1)
+			sizes <- TerminalSize{Cols: 80, Rows: 24}
+			// the input is sent after the size.
+			stdin := &delayedReader{Reader: strings.NewReader("ls\n"), delay: 50 * time.Millisecond}
+			var stdout bytes.Buffer
+			c := &Context{Server: strings.TrimPrefix(server.URL, "http://"), Plaintext: true, Token: "token"}
+			err := c.Exec(context.Background(), ExecOptions{
+				Cluster: "c1", Namespace: "default", Name: "f1",
+				Command: []string{"ls", "-l"},
+				Stdin:   stdin, Stdout: &stdout, Sizes: sizes,
+			})
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Exec() error = %v, want %q", err, tt.wantErr)
+			}
+			if stdout.String() != "LS\n" {
+				t.Errorf("stdout = %q, want the echoed input", stdout.String())
+			}
+		})
+	}
+}
+
+func TestContextExecRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "failed to open the terminal: permission denied", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	c := &Context{Server: strings.TrimPrefix(server.URL, "http://"), Plaintext: true}
+	err := c.Exec(context.Background(), ExecOptions{Cluster: "c1", Namespace: "default", Name: "f1", Stdout: &bytes.Buffer{}})
+	if err == nil || err.Error() != "failed to open the terminal: permission denied" {
+		t.Errorf("Exec() error = %v, want the reason of the apiserver", err)
+	}
+}
+
+type delayedReader struct {
+	*strings.Reader
+	delay time.Duration
+}
+
+func (r *delayedReader) Read(p []byte) (int, error) {
+	time.Sleep(r.delay)
+	return r.Reader.Read(p)
+}
@@ -0,0 +1,362 @@
+// Package apply applies the bundles of the kantaloupe objects with the server-side apply of
+// Kubernetes, the fields set by a bundle are owned by its field manager.
+package apply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/fanout"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
+)
+
+// DefaultFieldManager is the field manager of the bundles not specifying one.
+const DefaultFieldManager = "kantaloupe-apply"
+
+var (
+	SecretGVR                = corev1.SchemeGroupVersion.WithResource("secrets")
+	ResourceQuotaGVR         = corev1.SchemeGroupVersion.WithResource("resourcequotas")
+	PersistentVolumeGVR      = corev1.SchemeGroupVersion.WithResource("persistentvolumes")
+	PersistentVolumeClaimGVR = corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims")
+)
+
+// Action is the change made to an object.
+type Action string
+
+const (
+	ActionCreated    Action = "created"
+	ActionConfigured Action = "configured"
+	ActionUnchanged  Action = "unchanged"
+	ActionPruned     Action = "pruned"
+)
+
+// prunableKinds are the kinds whose objects are pruned in the reverse order they are applied,
+// the clusters are never pruned. The credential references are released instead of deleted,
+// the secrets are not created by the bundles.
+var prunableKinds = []prunableKind{
+	{kind: KindKantaloupeflow, resource: watchservice.KantaloupeflowGVR},
+	{kind: KindStorage, resource: PersistentVolumeClaimGVR},
+	{kind: KindQuota, resource: ResourceQuotaGVR},
+	{kind: KindCredentialReference, resource: SecretGVR, global: true, release: true},
+}
+
+type prunableKind struct {
+	kind     string
+	resource schema.GroupVersionResource
+	global   bool
+	release  bool
+}
+
+// Object is an object to apply, it is built from a document of a bundle.
+type Object struct {
+	// Kind is the kind of the document.
+	Kind string
+	// Cluster is the cluster the Kubernetes object is in.
+	Cluster  string
+	Resource schema.GroupVersionResource
+	// Object is the apply configuration of the Kubernetes object, the fields it sets are owned
+	// by the field manager.
+	Object *unstructured.Unstructured
+	// Requires are applied before the object, e.g. the persistent volume of a storage, their
+	// diffs are part of the result of the object.
+	Requires []*Object
+	// release means the fields owned by the field manager are removed instead of deleting the
+	// object when it is pruned.
+	release bool
+}
+
+// Key identifies the Kubernetes object.
+func (o *Object) Key() string {
+	return fmt.Sprintf("%s/%s/%s/%s", o.Cluster, o.Resource.String(), o.Object.GetNamespace(), o.Object.GetName())
+}
+
+// Options are the options of applying a bundle.
+type Options struct {
+	FieldManager string
+	DryRun       bool
+	// Force takes the ownership of the fields owned by the other managers on conflicts.
+	Force bool
+}
+
+// Result is the result of applying or pruning an object.
+type Result struct {
+	Object *Object
+	Action Action
+	// Diff is the unified diff from the live object to the applied one.
+	Diff string
+	Err  error
+}
+
+// SkippedCluster is a cluster whose objects are not pruned since they failed to be listed.
+type SkippedCluster struct {
+	Cluster string
+	Err     error
+}
+
+// Service applies the bundles of the kantaloupe objects.
+type Service interface {
+	// Get returns the live object, it is nil if the object does not exist.
+	Get(ctx context.Context, cluster string, resource schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error)
+	// ListPrunable lists the objects of the clusters applied by the field manager but missing
+	// in the objects, the credential references are listed in the global cluster. The clusters
+	// are listed in parallel, the ones failing are skipped and returned instead of failing the
+	// call.
+	ListPrunable(ctx context.Context, clusters []string, objects []*Object, fieldManager string) ([]*Object, []SkippedCluster, error)
+	// Apply applies the objects in order and prunes the prunable objects if all of them are
+	// applied. The objects failing to be applied are reported in their results.
+	Apply(ctx context.Context, objects, prunable []*Object, opts Options) []*Result
+}
+
+type service struct {
+	clientManager engine.ClientManagerInterface
+}
+
+// NewService creates a new apply service.
+func NewService(clientManager engine.ClientManagerInterface) Service {
+	return &service{
+		clientManager: clientManager,
+	}
+}
+
+func (s *service) resource(ctx context.Context, cluster string, resource schema.GroupVersionResource, namespace string) (dynamic.ResourceInterface, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		return client.Resource(resource), nil
+	}
+	return client.Resource(resource).Namespace(namespace), nil
+}
+
+func (s *service) Get(ctx context.Context, cluster string, resource schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	ri, err := s.resource(ctx, cluster, resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+	live, err := ri.Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return live, err
+}
+
+func (s *service) ListPrunable(ctx context.Context, clusters []string, objects []*Object, fieldManager string) ([]*Object, []SkippedCluster, error) {
+	applied := map[string]bool{}
+	for _, object := range objects {
+		applied[object.Key()] = true
+	}
+
+	selector := constants.AppliedByLabelKey + "=" + fieldManager
+	list := func(ctx context.Context, cluster string, global bool) ([]*Object, error) {
+		var prunable []*Object
+		for _, kind := range prunableKinds {
+			if kind.global != global {
+				continue
+			}
+			ri, err := s.resource(ctx, cluster, kind.resource, metav1.NamespaceAll)
+			if err != nil {
+				return nil, err
+			}
+			list, err := ri.List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the %s to prune in cluster %s: %w", kind.resource.Resource, cluster, err)
+			}
+			for i := range list.Items {
+				object := &Object{Kind: kind.kind, Cluster: cluster, Resource: kind.resource, Object: &list.Items[i], release: kind.release}
+				if !applied[object.Key()] {
+					prunable = append(prunable, object)
+				}
+			}
+		}
+		return prunable, nil
+	}
+
+	results := fanout.Run(ctx, clusters, fanout.Options{}, func(ctx context.Context, cluster string) ([]*Object, error) {
+		return list(ctx, cluster, false)
+	})
+	prunable, err := list(ctx, engine.LocalCluster, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	var skipped []SkippedCluster
+	for _, result := range results {
+		if result.Err != nil {
+			skipped = append(skipped, SkippedCluster{Cluster: result.Cluster, Err: result.Err})
+			continue
+		}
+		prunable = append(prunable, result.Value...)
+	}
+	// the objects are pruned in the order of their kinds whatever their clusters are.
+	slices.SortStableFunc(prunable, func(a, b *Object) int {
+		return prunableKindIndex(a.Kind) - prunableKindIndex(b.Kind)
+	})
+	return prunable, skipped, nil
+}
+
+func prunableKindIndex(kind string) int {
+	return slices.IndexFunc(prunableKinds, func(k prunableKind) bool { return k.kind == kind })
+}
+
+func (s *service) Apply(ctx context.Context, objects, prunable []*Object, opts Options) []*Result {
+	results := make([]*Result, 0, len(objects)+len(prunable))
+	failed := false
+	for _, object := range objects {
+		result := s.apply(ctx, object, opts)
+		failed = failed || result.Err != nil
+		results = append(results, result)
+	}
+	if failed && len(prunable) > 0 {
+		klog.V(4).InfoS("Skipped pruning for the objects failing to be applied", "fieldManager", opts.FieldManager, "prunable", len(prunable))
+		return results
+	}
+	for _, object := range prunable {
+		results = append(results, s.prune(ctx, object, opts))
+	}
+	return results
+}
+
+// apply applies the object and the ones it requires.
+func (s *service) apply(ctx context.Context, object *Object, opts Options) *Result {
+	result := &Result{Object: object}
+	var diffs []string
+	for _, required := range object.Requires {
+		requiredResult := s.apply(ctx, required, opts)
+		if requiredResult.Err != nil {
+			result.Err = requiredResult.Err
+			return result
+		}
+		diffs = append(diffs, requiredResult.Diff)
+	}
+
+	live, err := s.Get(ctx, object.Cluster, object.Resource, object.Object.GetNamespace(), object.Object.GetName())
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	labels := object.Object.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[constants.AppliedByLabelKey] = opts.FieldManager
+	object.Object.SetLabels(labels)
+
+	applied, err := s.patch(ctx, object.Cluster, object.Resource, object.Object, opts)
+	if err != nil {
+		if k8serrors.IsConflict(err) && !opts.Force {
+			err = fmt.Errorf("%w, force the apply to take the ownership of the fields", err)
+		}
+		klog.V(4).ErrorS(err, "Failed to apply object", "cluster", object.Cluster, "resource", object.Resource, "object", klog.KObj(object.Object))
+		result.Err = err
+		return result
+	}
+
+	diff, changed, err := Diff(live, applied)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	switch {
+	case live == nil:
+		result.Action = ActionCreated
+	case changed:
+		result.Action = ActionConfigured
+	default:
+		result.Action = ActionUnchanged
+	}
+	result.Diff = joinDiffs(append(diffs, diff))
+	return result
+}
+
+// prune deletes the object or releases the fields owned by the field manager.
+func (s *service) prune(ctx context.Context, object *Object, opts Options) *Result {
+	result := &Result{Object: object, Action: ActionPruned}
+	live := object.Object
+	if object.release {
+		released := &unstructured.Unstructured{}
+		released.SetGroupVersionKind(live.GroupVersionKind())
+		released.SetNamespace(live.GetNamespace())
+		released.SetName(live.GetName())
+		applied, err := s.patch(ctx, object.Cluster, object.Resource, released, opts)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.Diff, _, result.Err = Diff(live, applied)
+		return result
+	}
+
+	ri, err := s.resource(ctx, object.Cluster, object.Resource, live.GetNamespace())
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	propagation := metav1.DeletePropagationBackground
+	deleteOptions := metav1.DeleteOptions{PropagationPolicy: &propagation}
+	if opts.DryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	if err := ri.Delete(ctx, live.GetName(), deleteOptions); err != nil && !k8serrors.IsNotFound(err) {
+		result.Err = err
+		return result
+	}
+	result.Diff, _, result.Err = Diff(live, nil)
+	return result
+}
+
+// patch applies the apply configuration with the field manager.
+func (s *service) patch(ctx context.Context, cluster string, resource schema.GroupVersionResource, object *unstructured.Unstructured, opts Options) (*unstructured.Unstructured, error) {
+	ri, err := s.resource(ctx, cluster, resource, object.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	applyOptions := metav1.ApplyOptions{FieldManager: opts.FieldManager, Force: opts.Force}
+	if opts.DryRun {
+		applyOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	return ri.Apply(ctx, object.GetName(), object, applyOptions)
+}
+
+// ToApplyConfiguration converts the typed object to the apply configuration of the kind, the
+// status and the null fields, e.g. the empty creation timestamp, are dropped so they are not
+// owned by the field manager.
+func ToApplyConfiguration(object runtime.Object, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	removeNulls(content)
+	configuration := &unstructured.Unstructured{Object: content}
+	configuration.SetGroupVersionKind(gvk)
+	return configuration, nil
+}
+
+func removeNulls(content map[string]interface{}) {
+	for key, value := range content {
+		switch value := value.(type) {
+		case nil:
+			delete(content, key)
+		case map[string]interface{}:
+			removeNulls(value)
+		case []interface{}:
+			for _, item := range value {
+				if item, ok := item.(map[string]interface{}); ok {
+					removeNulls(item)
+				}
+			}
+		}
+	}
+}
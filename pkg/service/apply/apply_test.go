@@ -0,0 +1,436 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	watchservice "github.com/dynamia-ai/kantaloupe/pkg/service/watch"
+)
+
+type fakeClientManager struct {
+	client *engine.Client
+	// unreachable are the clusters failing to be connected.
+	unreachable []string
+}
+
+func (m *fakeClientManager) GeteClient(string) (*engine.Client, error) {
+	return m.client, nil
+}
+
+func (m *fakeClientManager) GeteClientForRequest(_ context.Context, cluster string) (*engine.Client, error) {
+	if slices.Contains(m.unreachable, cluster) {
+		return nil, fmt.Errorf("cluster %s is unreachable", cluster)
+	}
+	return m.client, nil
+}
+
+// fakeDynamicClient honors the dry run and the force of the applies and the dry run of the
+// deletes, the fake dynamic client drops their options.
+type fakeDynamicClient struct {
+	*dynamicfake.FakeDynamicClient
+}
+
+func (c *fakeDynamicClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &fakeNamespaceableResource{NamespaceableResourceInterface: c.FakeDynamicClient.Resource(resource), resource: resource}
+}
+
+type fakeNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	resource schema.GroupVersionResource
+}
+
+func (r *fakeNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &fakeResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), resource: r.resource}
+}
+
+type fakeResource struct {
+	dynamic.ResourceInterface
+	resource schema.GroupVersionResource
+}
+
+// Apply merges the apply configuration into the live object, the objects named conflicting
+// conflict unless the apply is forced.
+func (r *fakeResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if name == "conflicting" && !options.Force {
+		return nil, k8serrors.NewConflict(r.resource.GroupResource(), name, errors.New("the fields are owned by another manager"))
+	}
+	if len(options.DryRun) == 0 {
+		return r.ResourceInterface.Apply(ctx, name, obj, options, subresources...)
+	}
+	live, err := r.Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return obj.DeepCopy(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	merge(live.Object, obj.DeepCopy().Object)
+	return live, nil
+}
+
+func (r *fakeResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	if len(options.DryRun) == 0 {
+		return r.ResourceInterface.Delete(ctx, name, options, subresources...)
+	}
+	_, err := r.Get(ctx, name, metav1.GetOptions{})
+	return err
+}
+
+func newFakeClient(objects ...runtime.Object) dynamic.Interface {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		watchservice.KantaloupeflowGVR: "KantaloupeFlowList",
+		PersistentVolumeClaimGVR:       "PersistentVolumeClaimList",
+		PersistentVolumeGVR:            "PersistentVolumeList",
+		ResourceQuotaGVR:               "ResourceQuotaList",
+		SecretGVR:                      "SecretList",
+	}, objects...)
+	client.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchActionImpl)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		configuration := map[string]interface{}{}
+		if err := json.Unmarshal(patch.GetPatch(), &configuration); err != nil {
+			return true, nil, err
+		}
+		tracker := client.Tracker()
+		live, err := tracker.Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if k8serrors.IsNotFound(err) {
+			applied := &unstructured.Unstructured{Object: configuration}
+			return true, applied, tracker.Create(patch.GetResource(), applied, patch.GetNamespace())
+		}
+		if err != nil {
+			return true, nil, err
+		}
+		applied := live.(*unstructured.Unstructured).DeepCopy()
+		merge(applied.Object, configuration)
+		return true, applied, tracker.Update(patch.GetResource(), applied, patch.GetNamespace())
+	})
+	return &fakeDynamicClient{FakeDynamicClient: client}
+}
+
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if value, ok := value.(map[string]interface{}); ok {
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				merge(existing, value)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+func newQuota(namespace, name, hard string, labels map[string]string) *unstructured.Unstructured {
+	quota := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"hard": map[string]interface{}{"requests.nvidia.com/gpu": hard}},
+	}}
+	quota.SetAPIVersion("v1")
+	quota.SetKind("ResourceQuota")
+	quota.SetNamespace(namespace)
+	quota.SetName(name)
+	quota.SetLabels(labels)
+	return quota
+}
+
+func newQuotaObject(namespace, name, hard string) *Object {
+	return &Object{Kind: KindQuota, Cluster: "member", Resource: ResourceQuotaGVR, Object: newQuota(namespace, name, hard, nil)}
+}
+
+func actions(results []*Result) []string {
+	var res []string
+	for _, result := range results {
+		action := string(result.Action)
+		if result.Err != nil {
+			action = "error"
+		}
+		res = append(res, result.Object.Object.GetName()+" "+action)
+	}
+	return res
+}
+
+func TestParseManifests(t *testing.T) {
+	documents, err := ParseManifests(`
+# the quotas of the team
+---
+apiVersion: kantaloupe.dynamia.ai/v1
+kind: Quota
+metadata:
+  name: gpu
+  namespace: team
+  cluster: member
+spec:
+  hard:
+    requests.nvidia.com/gpu: "4"
+---
+---
+apiVersion: kantaloupe.dynamia.ai/v1
+kind: Cluster
+metadata:
+  name: member
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(documents) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(documents))
+	}
+	if got := documents[0].String(); got != "Quota member/team/gpu" {
+		t.Errorf("unexpected document %s", got)
+	}
+	if got := string(documents[0].Spec); got != `{"hard":{"requests.nvidia.com/gpu":"4"}}` {
+		t.Errorf("unexpected spec %s", got)
+	}
+	if documents[1].Spec != nil {
+		t.Errorf("expected no spec, got %s", documents[1].Spec)
+	}
+
+	for manifests, expected := range map[string]string{
+		"apiVersion: v1\nkind: Quota\nmetadata:\n  name: gpu":                                        `apiVersion "v1" is not supported`,
+		"apiVersion: kantaloupe.dynamia.ai/v1\nkind: Pod\nmetadata:\n  name: gpu":                    `kind "Pod" is not supported`,
+		"apiVersion: kantaloupe.dynamia.ai/v1\nkind: Quota\nmetadata: {}":                            "the name is required",
+		"apiVersion: kantaloupe.dynamia.ai/v1\nkind: Quota\nmetadata:\n  name: gpu\n  owner: team-a": `unknown field "owner"`,
+	} {
+		if _, err := ParseManifests(manifests); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error %q parsing %q, got %v", expected, manifests, err)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	live := newQuota("team", "gpu", "4", nil)
+	live.SetResourceVersion("1")
+	live.Object["status"] = map[string]interface{}{"used": map[string]interface{}{"requests.nvidia.com/gpu": "2"}}
+	applied := newQuota("team", "gpu", "4", nil)
+	applied.SetResourceVersion("2")
+
+	if diff, changed, err := Diff(live, applied); err != nil || changed || diff != "" {
+		t.Errorf("expected no diff, got %q %v %v", diff, changed, err)
+	}
+
+	applied = newQuota("team", "gpu", "8", nil)
+	diff, changed, err := Diff(live, applied)
+	if err != nil || !changed {
+		t.Fatalf("expected diff, got %v %v", changed, err)
+	}
+	for _, expected := range []string{"--- live/resourcequota/gpu", "+++ applied/resourcequota/gpu", `-    requests.nvidia.com/gpu: "4"`, `+    requests.nvidia.com/gpu: "8"`} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("expected %q in the diff:\n%s", expected, diff)
+		}
+	}
+
+	if _, changed, _ := Diff(nil, applied); !changed {
+		t.Error("expected the created object to be changed")
+	}
+}
+
+func TestDiffRedactsSecrets(t *testing.T) {
+	newSecret := func(labels map[string]string, data map[string]interface{}) *unstructured.Unstructured {
+		secret := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"namespace": "kantaloupe-system", "name": "registry"},
+			"data":       data,
+			"stringData": map[string]interface{}{"token": "plain-token"},
+		}}
+		secret.SetLabels(labels)
+		secret.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"data":{"password":"c2VjcmV0"}}`})
+		return secret
+	}
+	live := newSecret(map[string]string{"team": "a"}, map[string]interface{}{"password": "c2VjcmV0", "username": "YWRtaW4="})
+
+	// the secret values are not printed next to the changed labels.
+	diff, changed, err := Diff(live, newSecret(map[string]string{"team": "b"}, map[string]interface{}{"password": "c2VjcmV0", "username": "YWRtaW4="}))
+	if err != nil || !changed {
+		t.Fatalf("expected diff, got %v %v", changed, err)
+	}
+	for _, secret := range []string{"c2VjcmV0", "YWRtaW4=", "plain-token"} {
+		if strings.Contains(diff, secret) {
+			t.Errorf("the secret value %q is in the diff:\n%s", secret, diff)
+		}
+	}
+	if !strings.Contains(diff, "+    team: b") || strings.Contains(diff, "(before)") {
+		t.Errorf("unexpected diff:\n%s", diff)
+	}
+
+	// the changed values are detected and marked without being printed.
+	diff, changed, err = Diff(live, newSecret(map[string]string{"team": "a"}, map[string]interface{}{"password": "bmV3", "username": "YWRtaW4="}))
+	if err != nil || !changed {
+		t.Fatalf("expected diff, got %v %v", changed, err)
+	}
+	for _, expected := range []string{"-  password: '****** (before)'", "+  password: '****** (after)'"} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("expected %q in the diff:\n%s", expected, diff)
+		}
+	}
+	if strings.Contains(diff, "bmV3") || strings.Contains(diff, "c2VjcmV0") {
+		t.Errorf("the secret value is in the diff:\n%s", diff)
+	}
+
+	// the pruned secrets are diffed against nothing.
+	if diff, _, _ := Diff(live, nil); strings.Contains(diff, "c2VjcmV0") || !strings.Contains(diff, "-  password: '****** (before)'") {
+		t.Errorf("unexpected diff of the pruned secret:\n%s", diff)
+	}
+	if password, _, _ := unstructured.NestedString(live.Object, "data", "password"); password != "c2VjcmV0" {
+		t.Errorf("the live secret is modified: %v", live.Object["data"])
+	}
+}
+
+func TestApply(t *testing.T) {
+	appliedBy := map[string]string{constants.AppliedByLabelKey: DefaultFieldManager}
+	client := newFakeClient(newQuota("team", "same", "4", appliedBy), newQuota("team", "changed", "4", appliedBy))
+	s := NewService(&fakeClientManager{client: &engine.Client{Dynamic: client}})
+	ctx := context.TODO()
+	opts := Options{FieldManager: DefaultFieldManager}
+
+	objects := []*Object{newQuotaObject("team", "created", "4"), newQuotaObject("team", "same", "4"), newQuotaObject("team", "changed", "8")}
+	results := s.Apply(ctx, objects, nil, Options{FieldManager: DefaultFieldManager, DryRun: true})
+	if expected := []string{"created created", "same unchanged", "changed configured"}; !slices.Equal(actions(results), expected) {
+		t.Fatalf("expected %v, got %v", expected, actions(results))
+	}
+	if !strings.Contains(results[2].Diff, `+    requests.nvidia.com/gpu: "8"`) {
+		t.Errorf("unexpected diff:\n%s", results[2].Diff)
+	}
+	if live, _ := s.Get(ctx, "member", ResourceQuotaGVR, "team", "created"); live != nil {
+		t.Error("expected the dry run not to create the object")
+	}
+
+	s.Apply(ctx, objects, nil, opts)
+	live, err := s.Get(ctx, "member", ResourceQuotaGVR, "team", "created")
+	if err != nil || live == nil {
+		t.Fatalf("expected the object to be created, got %v", err)
+	}
+	if live.GetLabels()[constants.AppliedByLabelKey] != DefaultFieldManager {
+		t.Errorf("expected the applied-by label, got %v", live.GetLabels())
+	}
+	results = s.Apply(ctx, objects, nil, opts)
+	if expected := []string{"created unchanged", "same unchanged", "changed unchanged"}; !slices.Equal(actions(results), expected) {
+		t.Errorf("expected %v, got %v", expected, actions(results))
+	}
+
+	conflicting := newQuotaObject("team", "conflicting", "4")
+	results = s.Apply(ctx, []*Object{conflicting}, nil, opts)
+	if err := results[0].Err; !k8serrors.IsConflict(err) || !strings.Contains(err.Error(), "force the apply") {
+		t.Errorf("expected the conflict, got %v", err)
+	}
+	opts.Force = true
+	if results = s.Apply(ctx, []*Object{conflicting}, nil, opts); results[0].Err != nil {
+		t.Errorf("expected the forced apply to succeed, got %v", results[0].Err)
+	}
+}
+
+func TestApplyRequires(t *testing.T) {
+	client := newFakeClient()
+	s := NewService(&fakeClientManager{client: &engine.Client{Dynamic: client}})
+	pv, err := ToApplyConfiguration(&corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "data"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+		},
+	}, corev1.SchemeGroupVersion.WithKind("PersistentVolume"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := pv.Object["status"]; ok {
+		t.Error("expected the status to be dropped")
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(pv.Object, "metadata", "creationTimestamp"); ok {
+		t.Error("expected the null creation timestamp to be dropped")
+	}
+	pvc := &unstructured.Unstructured{}
+	pvc.SetAPIVersion("v1")
+	pvc.SetKind("PersistentVolumeClaim")
+	pvc.SetNamespace("team")
+	pvc.SetName("data")
+	object := &Object{Kind: KindStorage, Cluster: "member", Resource: PersistentVolumeClaimGVR, Object: pvc,
+		Requires: []*Object{{Kind: KindStorage, Cluster: "member", Resource: PersistentVolumeGVR, Object: pv}}}
+
+	results := s.Apply(context.TODO(), []*Object{object}, nil, Options{FieldManager: DefaultFieldManager})
+	if results[0].Err != nil || results[0].Action != ActionCreated {
+		t.Fatalf("expected the storage to be created, got %v %v", results[0].Action, results[0].Err)
+	}
+	for _, expected := range []string{"+++ applied/persistentvolume/data", "+++ applied/persistentvolumeclaim/data"} {
+		if !strings.Contains(results[0].Diff, expected) {
+			t.Errorf("expected %q in the diff:\n%s", expected, results[0].Diff)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	appliedBy := map[string]string{constants.AppliedByLabelKey: DefaultFieldManager}
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{"type": "kubernetes.io/dockerconfigjson"}}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetNamespace("kantaloupe-system")
+	secret.SetName("registry")
+	secret.SetLabels(appliedBy)
+	client := newFakeClient(
+		newQuota("team", "kept", "4", appliedBy),
+		newQuota("team", "removed", "4", appliedBy),
+		newQuota("team", "other", "4", map[string]string{constants.AppliedByLabelKey: "other"}),
+		newQuota("team", "unmanaged", "4", nil),
+		secret,
+	)
+	s := NewService(&fakeClientManager{client: &engine.Client{Dynamic: client}, unreachable: []string{"offline"}})
+	ctx := context.TODO()
+	opts := Options{FieldManager: DefaultFieldManager}
+
+	// the unreachable clusters are skipped instead of failing the listing.
+	objects := []*Object{newQuotaObject("team", "kept", "4")}
+	prunable, skipped, err := s.ListPrunable(ctx, []string{"offline", "member"}, objects, DefaultFieldManager)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 1 || skipped[0].Cluster != "offline" || skipped[0].Err == nil {
+		t.Errorf("expected the offline cluster skipped, got %+v", skipped)
+	}
+	var names []string
+	for _, object := range prunable {
+		names = append(names, object.Kind+" "+object.Cluster+" "+object.Object.GetName())
+	}
+	if expected := []string{"Quota member removed", "CredentialReference local-cluster registry"}; !slices.Equal(names, expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+
+	// the objects are not pruned if any object fails to be applied.
+	results := s.Apply(ctx, append([]*Object{newQuotaObject("team", "conflicting", "4")}, objects...), prunable, opts)
+	if expected := []string{"conflicting error", "kept unchanged"}; !slices.Equal(actions(results), expected) {
+		t.Fatalf("expected %v, got %v", expected, actions(results))
+	}
+
+	results = s.Apply(ctx, objects, prunable, Options{FieldManager: DefaultFieldManager, DryRun: true})
+	if expected := []string{"kept unchanged", "removed pruned", "registry pruned"}; !slices.Equal(actions(results), expected) {
+		t.Fatalf("expected %v, got %v", expected, actions(results))
+	}
+	if live, _ := s.Get(ctx, "member", ResourceQuotaGVR, "team", "removed"); live == nil {
+		t.Error("expected the dry run not to delete the object")
+	}
+
+	s.Apply(ctx, objects, prunable, opts)
+	if live, _ := s.Get(ctx, "member", ResourceQuotaGVR, "team", "removed"); live != nil {
+		t.Error("expected the object to be deleted")
+	}
+	// the credential references are released, their secrets are kept.
+	if live, _ := s.Get(ctx, engine.LocalCluster, SecretGVR, "kantaloupe-system", "registry"); live == nil {
+		t.Error("expected the secret to be kept")
+	}
+}
@@ -0,0 +1,148 @@
+package apply
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// ignoredMetadata are the fields of the metadata maintained by the apiserver.
+var ignoredMetadata = []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"}
+
+const (
+	redacted = "******"
+	// lastAppliedAnnotation is the copy of the object kept by kubectl apply, the data of the
+	// secrets is in it as well.
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// secretFields are the fields of the secrets whose values are redacted in the diffs.
+var secretFields = []string{"data", "stringData"}
+
+// Diff returns the unified diff from the live object to the applied one and whether they differ,
+// the fields maintained by the apiserver and the status are ignored. A nil object is absent.
+// The values of the secrets are redacted in the diff, the changed ones are marked.
+func Diff(live, applied *unstructured.Unstructured) (string, bool, error) {
+	from, err := diffable(live)
+	if err != nil {
+		return "", false, err
+	}
+	to, err := diffable(applied)
+	if err != nil {
+		return "", false, err
+	}
+	if from == to {
+		return "", false, nil
+	}
+	if isSecret(live) || isSecret(applied) {
+		live, applied = redactSecrets(live, applied)
+		if from, err = diffable(live); err != nil {
+			return "", false, err
+		}
+		if to, err = diffable(applied); err != nil {
+			return "", false, err
+		}
+	}
+
+	name := ""
+	for _, object := range []*unstructured.Unstructured{applied, live} {
+		if object != nil {
+			name = strings.ToLower(object.GetKind()) + "/" + object.GetName()
+			break
+		}
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "live/" + name,
+		ToFile:   "applied/" + name,
+		Context:  3,
+	})
+	return diff, true, err
+}
+
+func diffable(object *unstructured.Unstructured) (string, error) {
+	if object == nil {
+		return "", nil
+	}
+	object = object.DeepCopy()
+	delete(object.Object, "status")
+	for _, field := range ignoredMetadata {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+	data, err := yaml.Marshal(object.Object)
+	return string(data), err
+}
+
+func isSecret(object *unstructured.Unstructured) bool {
+	return object != nil && object.GetKind() == "Secret" && object.GroupVersionKind().Group == ""
+}
+
+// redactSecrets returns the copies of the secrets with the values replaced by the placeholder,
+// the values differing between them are marked before and after like kubectl diff does.
+func redactSecrets(live, applied *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	live, applied = live.DeepCopy(), applied.DeepCopy()
+	for _, field := range secretFields {
+		liveValues, appliedValues := nestedValues(live, field), nestedValues(applied, field)
+		changed := map[string]bool{}
+		for key := range liveValues {
+			changed[key] = true
+		}
+		for key, value := range appliedValues {
+			liveValue, ok := liveValues[key]
+			changed[key] = !ok || !reflect.DeepEqual(value, liveValue)
+		}
+		for key := range liveValues {
+			liveValues[key] = redactedValue(changed[key], " (before)")
+		}
+		for key := range appliedValues {
+			appliedValues[key] = redactedValue(changed[key], " (after)")
+		}
+		setNestedValues(live, field, liveValues)
+		setNestedValues(applied, field, appliedValues)
+	}
+	for _, object := range []*unstructured.Unstructured{live, applied} {
+		if object == nil {
+			continue
+		}
+		if annotations := object.GetAnnotations(); annotations != nil {
+			if _, ok := annotations[lastAppliedAnnotation]; ok {
+				annotations[lastAppliedAnnotation] = redacted
+				object.SetAnnotations(annotations)
+			}
+		}
+	}
+	return live, applied
+}
+
+func redactedValue(changed bool, mark string) string {
+	if changed {
+		return redacted + mark
+	}
+	return redacted
+}
+
+func nestedValues(object *unstructured.Unstructured, field string) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	values, _, _ := unstructured.NestedMap(object.Object, field)
+	return values
+}
+
+func setNestedValues(object *unstructured.Unstructured, field string, values map[string]interface{}) {
+	if object != nil && values != nil {
+		object.Object[field] = values
+	}
+}
+
+func joinDiffs(diffs []string) string {
+	var b strings.Builder
+	for _, diff := range diffs {
+		b.WriteString(diff)
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package apply
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// APIVersion is the apiVersion of the documents of the bundles.
+	APIVersion = "kantaloupe.dynamia.ai/v1"
+
+	KindCluster             = "Cluster"
+	KindCredentialReference = "CredentialReference"
+	KindQuota               = "Quota"
+	KindStorage             = "Storage"
+	KindKantaloupeflow      = "Kantaloupeflow"
+)
+
+// Kinds are the kinds of the documents in the order they are applied, the objects are
+// applied before the ones depending on them.
+var Kinds = []string{KindCluster, KindCredentialReference, KindQuota, KindStorage, KindKantaloupeflow}
+
+// Document is a document of a bundle.
+type Document struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	// Spec is decoded by the kind, e.g. the spec of a Kantaloupeflow is a KantaloupeflowSpec.
+	Spec json.RawMessage `json:"spec,omitempty"`
+}
+
+// Metadata is the metadata of a document.
+type Metadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	// Cluster is the member cluster of the object, the clusters and the credential references
+	// are in the global cluster.
+	Cluster     string            `json:"cluster,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// String returns the kind and the path of the object of the document for the errors.
+func (d *Document) String() string {
+	path := []string{d.Metadata.Cluster, d.Metadata.Namespace, d.Metadata.Name}
+	path = slices.DeleteFunc(path, func(s string) bool { return s == "" })
+	return d.Kind + " " + strings.Join(path, "/")
+}
+
+// ParseManifests parses the multi-document YAML bundle, the empty documents are skipped.
+func ParseManifests(manifests string) ([]*Document, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(manifests)))
+	var documents []*Document
+	for i := 1; ; i++ {
+		data, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %d: %w", i, err)
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+
+		document := &Document{}
+		if err := yaml.UnmarshalStrict(data, document); err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+		}
+		if document.APIVersion == "" && document.Kind == "" && document.Metadata.Name == "" && document.Spec == nil {
+			// the document only has comments.
+			continue
+		}
+		if err := document.validate(); err != nil {
+			return nil, fmt.Errorf("document %d is invalid: %w", i, err)
+		}
+		documents = append(documents, document)
+	}
+}
+
+func (d *Document) validate() error {
+	if d.APIVersion != APIVersion {
+		return fmt.Errorf("apiVersion %q is not supported, it must be %s", d.APIVersion, APIVersion)
+	}
+	if !slices.Contains(Kinds, d.Kind) {
+		return fmt.Errorf("kind %q is not supported, it must be one of %s", d.Kind, strings.Join(Kinds, ", "))
+	}
+	if d.Metadata.Name == "" {
+		return errors.New("the name is required")
+	}
+	return nil
+}
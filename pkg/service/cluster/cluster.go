@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -20,9 +21,11 @@ import (
 	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
 	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+	"github.com/dynamia-ai/kantaloupe/pkg/service/operation"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/env"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/gclient"
 	"github.com/dynamia-ai/kantaloupe/pkg/utils/namespace"
+	nodeutil "github.com/dynamia-ai/kantaloupe/pkg/utils/node"
 )
 
 type Service interface {
@@ -35,6 +38,9 @@ type Service interface {
 	ValidatePrometheusAddress(ctx context.Context, prometheusAddress string) (bool, error)
 }
 
+// rollbackTimeout is how long the rollback of a failed CreateCluster may take.
+const rollbackTimeout = 30 * time.Second
+
 type service struct {
 	clientManager engine.ClientManagerInterface
 }
@@ -60,7 +66,8 @@ func (s *service) ListClusters(ctx context.Context) ([]clustercrdv1alpha1.Cluste
 	return clusters.Items, nil
 }
 
-// CreateCluster creates a cluster cr in the gl	obal cluster.
+// CreateCluster creates a cluster cr in the global cluster, the kubeconfig secret and the
+// cluster cr are rolled back if it fails. The progress is reported if it runs in an operation.
 func (s *service) CreateCluster(
 	ctx context.Context,
 	cluster *clustercrdv1alpha1.Cluster,
@@ -75,6 +82,7 @@ func (s *service) CreateCluster(
 	}
 
 	// Init clusterid from kube-system namespace uid.
+	operation.Report(ctx, 10, "connecting to the cluster")
 	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
@@ -110,6 +118,12 @@ func (s *service) CreateCluster(
 			}
 		}
 	}
+	// the secret of an existing cluster of the same name must not be replaced.
+	if _, err := localClient.Clusters().Get(ctx, cluster.Name, metav1.GetOptions{}); err == nil {
+		return nil, apierrors.NewAlreadyExists(clustercrdv1alpha1.Resource("clusters"), cluster.Name)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
 
 	// create secret for cluster.
 	clusterSecret := &corev1.Secret{
@@ -121,11 +135,21 @@ func (s *service) CreateCluster(
 		Data: map[string][]byte{"config": []byte(kubeconfig)},
 	}
 
+	operation.Report(ctx, 30, "creating the kubeconfig secret")
+	_, err = localClient.CoreV1().Secrets(clusterSecret.Namespace).Get(ctx, clusterSecret.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	secretExisted := err == nil
 	secret, err := s.createOrUpdateKubeconfig(ctx, clusterSecret)
 	if err != nil {
 		klog.ErrorS(err, "failed to create kubeconfig for cluster", "cluster", klog.KObj(cluster))
 		return nil, err
 	}
+	// the secret left by a previous attempt is kept.
+	rollback := func(created bool) {
+		s.rollbackCluster(ctx, localClient, cluster.Name, clusterSecret, created, !secretExisted)
+	}
 
 	// set the secret reference to cluster cr.
 	cluster.Spec.SecretRef = &clustercrdv1alpha1.LocalSecretReference{
@@ -133,34 +157,56 @@ func (s *service) CreateCluster(
 		Namespace: secret.Namespace,
 	}
 
+	operation.Report(ctx, 50, "installing the service monitors")
 	if err := prepareCluster(ctx, cluster.Spec.Type, localClient, k8sCli); err != nil {
 		klog.ErrorS(err, "failed to prepare cluster", "cluster", klog.KObj(cluster))
+		rollback(false)
 		return nil, err
 	}
 
+	operation.Report(ctx, 80, "creating the cluster")
 	created, err := localClient.Clusters().Create(ctx, cluster, metav1.CreateOptions{})
 	if err != nil {
 		klog.ErrorS(err, "failed to create cluster", "cluster", klog.KObj(cluster))
+		rollback(false)
 		return nil, err
 	}
 
 	//  set ownerReference to secret.
 	if err := controllerutil.SetOwnerReference(created, clusterSecret, gclient.NewSchema()); err != nil {
+		rollback(true)
 		return nil, err
 	}
 
 	if _, err := s.createOrUpdateKubeconfig(ctx, clusterSecret); err != nil {
 		klog.ErrorS(err, "faild to set owner reference for cluster secret", "cluster", klog.KObj(cluster))
-		deleterr := localClient.Clusters().Delete(ctx, cluster.Name, metav1.DeleteOptions{})
-		if deleterr != nil {
-			klog.ErrorS(deleterr, "failed to clear failed create cluster", "cluster", klog.KObj(cluster))
-		}
+		rollback(true)
 		return nil, err
 	}
 
 	return cluster, nil
 }
 
+// rollbackCluster deletes the cluster cr and the kubeconfig secret created by a failed
+// CreateCluster, it goes on when the context is canceled. The service monitors are kept in the
+// member cluster, they are installed again by the next attempt.
+func (s *service) rollbackCluster(ctx context.Context, localClient *engine.Client, name string, secret *corev1.Secret, cluster, createdSecret bool) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+	if cluster {
+		if err := localClient.Clusters().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "failed to clear failed create cluster", "cluster", name)
+		}
+	}
+	if createdSecret {
+		err := localClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "failed to clear the kubeconfig secret of failed create cluster", "cluster", name, "secret", klog.KObj(secret))
+		}
+	}
+	klog.InfoS("Rolled back the failed create cluster", "cluster", name)
+}
+
 // Getcluster gets cluster cr from global cluster.
 func (s *service) GetCluster(ctx context.Context, name string) (*clustercrdv1alpha1.Cluster, error) {
 	localClient, err := s.clientManager.GeteClient(engine.LocalCluster)
@@ -300,13 +346,30 @@ func prepareCluster(ctx context.Context, clusterType string, localClient, newCli
 	if err != nil && !apierrors.IsAlreadyExists(err) {
 		return err
 	}
-	switch clusterType {
-	case "METAX":
-		names = append(names, constants.MetaxSMTemplateName)
-	case "NVIDIA":
-		names = append(names, constants.NvidiaSMTemplateName)
-	case "ASCEND":
-		names = append(names, constants.AscendSMTemplateName)
+
+	// install the exporter monitors of every vendor present in the cluster, the type
+	// specified on integration is kept in case the device plugins are not running yet.
+	nodes := &corev1.NodeList{}
+	if err := newClient.List(ctx, nodes); err != nil {
+		return err
+	}
+	nodePtrs := make([]*corev1.Node, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		nodePtrs = append(nodePtrs, &nodes.Items[i])
+	}
+	clusterTypes := nodeutil.DetectAcceleratorTypes(nodePtrs)
+	if !slices.Contains(clusterTypes, clusterType) {
+		clusterTypes = append(clusterTypes, clusterType)
+	}
+	for _, t := range clusterTypes {
+		switch t {
+		case "METAX":
+			names = append(names, constants.MetaxSMTemplateName)
+		case "NVIDIA":
+			names = append(names, constants.NvidiaSMTemplateName)
+		case "ASCEND":
+			names = append(names, constants.AscendSMTemplateName)
+		}
 	}
 	for _, name := range names {
 		if err := createServiceMonitor(ctx, localClient, newClient, name); err != nil {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"io"
 
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -26,25 +27,26 @@ type Service interface {
 	GetSecret(ctx context.Context, cluster, namespace, name string) (*corev1.Secret, error)
 	DeleteSecret(ctx context.Context, cluster, namespace, name string) error
 	CreateSecret(ctx context.Context, cluster, namespace string, secret *corev1.Secret) (*corev1.Secret, error)
-	ListSecrets(ctx context.Context, cluster, namespace string) ([]*corev1.Secret, error)
+	ListSecrets(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*corev1.Secret, error)
 
-	ListStorageClasses(ctx context.Context, cluster string) ([]*storagev1.StorageClass, error)
+	ListStorageClasses(ctx context.Context, cluster string, opts metav1.ListOptions) ([]*storagev1.StorageClass, error)
 
 	ListNamespaces(ctx context.Context, cluster string) ([]*corev1.Namespace, error)
 
 	CreatePersistentVolumeClaim(ctx context.Context, cluster, namespace string, pvc *corev1.PersistentVolumeClaim, storageType storagev1alpha1.StorageType) (*corev1.PersistentVolumeClaim, error)
 	DeletePersistentVolumeClaim(ctx context.Context, cluster, namespace, name string) error
-	ListPersistentVolumeClaims(ctx context.Context, cluster, namespace string, managerFlag bool) ([]*corev1.PersistentVolumeClaim, error)
+	ListPersistentVolumeClaims(ctx context.Context, cluster, namespace string, managerFlag bool, opts metav1.ListOptions) ([]*corev1.PersistentVolumeClaim, error)
 	AddPersistentVolumeClaimLabel(ctx context.Context, cluster, namespace, name string) (*corev1.PersistentVolumeClaim, error)
 
 	ListEventsByDeployment(ctx context.Context, cluster, namespace, deployment string) ([]*corev1.Event, error)
-	ListEventsByPod(ctx context.Context, cluster, namespace, pod string) ([]*corev1.Event, error)
-	ListEvents(ctx context.Context, cluster, namespace string) ([]*corev1.Event, error)
+	ListEventsByPod(ctx context.Context, cluster, namespace, pod string, opts metav1.ListOptions) ([]*corev1.Event, error)
+	ListEvents(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*corev1.Event, error)
 
 	ListPods(ctx context.Context, cluster, namespace, labelSelector string) ([]*corev1.Pod, error)
 	GetPod(ctx context.Context, cluster, namespace, pod string) (*corev1.Pod, error)
+	GetPodLogs(ctx context.Context, cluster, namespace, pod string, opts *corev1.PodLogOptions) (io.ReadCloser, error)
 
-	ListNodes(ctx context.Context, cluster string) ([]*corev1.Node, error)
+	ListNodes(ctx context.Context, cluster string, opts metav1.ListOptions) ([]*corev1.Node, error)
 	GetNode(ctx context.Context, cluster, name string) (*corev1.Node, error)
 	PutNodeLabels(ctx context.Context, clusterName, name string, labels map[string]string) (map[string]string, error)
 	PutNodeTaints(ctx context.Context, clusterName, name string, taints []*corev1.Taint) ([]corev1.Taint, error)
@@ -68,7 +70,7 @@ type service struct {
 }
 
 func (s *service) ListPersistentVolumes(ctx context.Context, cluster string) ([]*corev1.PersistentVolume, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +84,7 @@ func (s *service) ListPersistentVolumes(ctx context.Context, cluster string) ([]
 }
 
 func (s *service) GetPersistentVolume(ctx context.Context, cluster, name string) (*corev1.PersistentVolume, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +92,7 @@ func (s *service) GetPersistentVolume(ctx context.Context, cluster, name string)
 }
 
 func (s *service) CreatePersistentVolume(ctx context.Context, cluster string, persistentVolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +100,7 @@ func (s *service) CreatePersistentVolume(ctx context.Context, cluster string, pe
 }
 
 func (s *service) UpdatePersistentVolume(ctx context.Context, cluster string, persistentvolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +108,7 @@ func (s *service) UpdatePersistentVolume(ctx context.Context, cluster string, pe
 }
 
 func (s *service) DeletePersistentVolume(ctx context.Context, cluster, name string) error {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return err
 	}
@@ -114,7 +116,7 @@ func (s *service) DeletePersistentVolume(ctx context.Context, cluster, name stri
 }
 
 func (s *service) CreateSecret(ctx context.Context, cluster, namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +124,7 @@ func (s *service) CreateSecret(ctx context.Context, cluster, namespace string, s
 }
 
 func (s *service) GetSecret(ctx context.Context, cluster, namespace, name string) (*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +136,7 @@ func (s *service) GetSecret(ctx context.Context, cluster, namespace, name string
 }
 
 func (s *service) UpdateSecret(ctx context.Context, cluster string, secret *corev1.Secret) (*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -142,20 +144,20 @@ func (s *service) UpdateSecret(ctx context.Context, cluster string, secret *core
 }
 
 func (s *service) DeleteSecret(ctx context.Context, cluster, namespace, name string) error {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return err
 	}
 	return client.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
-func (s *service) ListSecrets(ctx context.Context, cluster, namespace string) ([]*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListSecrets(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*corev1.Secret, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
-	secrets, err := client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	secrets, err := client.CoreV1().Secrets(namespace).List(ctx, opts)
 	if err != nil {
 		klog.ErrorS(err, "failed to list secrets")
 		return nil, err
@@ -164,7 +166,7 @@ func (s *service) ListSecrets(ctx context.Context, cluster, namespace string) ([
 }
 
 func (s *service) ListPods(ctx context.Context, cluster, namespace, labelSelector string) ([]*corev1.Pod, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -176,12 +178,12 @@ func (s *service) ListPods(ctx context.Context, cluster, namespace, labelSelecto
 	return utils.SliceToPointerSlice(pods.Items), nil
 }
 
-func (s *service) ListStorageClasses(ctx context.Context, cluster string) ([]*storagev1.StorageClass, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListStorageClasses(ctx context.Context, cluster string, opts metav1.ListOptions) ([]*storagev1.StorageClass, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
-	storageClasses, err := client.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	storageClasses, err := client.StorageV1().StorageClasses().List(ctx, opts)
 	if err != nil {
 		klog.ErrorS(err, "failed to list storage classes")
 		return nil, err
@@ -191,7 +193,7 @@ func (s *service) ListStorageClasses(ctx context.Context, cluster string) ([]*st
 }
 
 func (s *service) ListNamespaces(ctx context.Context, cluster string) ([]*corev1.Namespace, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +207,7 @@ func (s *service) ListNamespaces(ctx context.Context, cluster string) ([]*corev1
 }
 
 func (s *service) CreatePersistentVolumeClaim(ctx context.Context, cluster, namespace string, pvc *corev1.PersistentVolumeClaim, storageType storagev1alpha1.StorageType) (*corev1.PersistentVolumeClaim, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -221,32 +223,28 @@ func (s *service) CreatePersistentVolumeClaim(ctx context.Context, cluster, name
 }
 
 func (s *service) DeletePersistentVolumeClaim(ctx context.Context, cluster, namespace, name string) error {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return err
 	}
 	return client.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
-func (s *service) ListPersistentVolumeClaims(ctx context.Context, cluster, namespace string, managerFlag bool) ([]*corev1.PersistentVolumeClaim, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListPersistentVolumeClaims(ctx context.Context, cluster, namespace string, managerFlag bool, opts metav1.ListOptions) ([]*corev1.PersistentVolumeClaim, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
-	labelSelector := ""
 	if managerFlag {
-		labelSelector = constants.ManagedByLabelKey + "=" + constants.ManagedByLabelValue
-	}
-	options := metav1.ListOptions{
-		LabelSelector: labelSelector,
+		opts.LabelSelector = utils.JoinSelectors(opts.LabelSelector, constants.ManagedByLabelKey+"="+constants.ManagedByLabelValue)
 	}
 
 	if namespace == constants.SelectAll {
 		namespace = corev1.NamespaceAll
 	}
 
-	pvcs, err := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, options)
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, opts)
 	if err != nil {
 		klog.ErrorS(err, "failed to list pvcs")
 		return nil, err
@@ -256,7 +254,7 @@ func (s *service) ListPersistentVolumeClaims(ctx context.Context, cluster, names
 }
 
 func (s *service) AddPersistentVolumeClaimLabel(ctx context.Context, cluster, namespace, name string) (*corev1.PersistentVolumeClaim, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -291,12 +289,12 @@ func getStorageType(pv *corev1.PersistentVolume) storagev1alpha1.StorageType {
 	return storageType
 }
 
-func (s *service) ListEvents(ctx context.Context, cluster, namespace string) ([]*corev1.Event, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListEvents(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*corev1.Event, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
-	events, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	events, err := client.CoreV1().Events(namespace).List(ctx, opts)
 	if err != nil {
 		klog.ErrorS(err, "failed to list events")
 		return nil, err
@@ -306,7 +304,7 @@ func (s *service) ListEvents(ctx context.Context, cluster, namespace string) ([]
 }
 
 func (s *service) ListEventsByDeployment(ctx context.Context, cluster, namespace, deployment string) ([]*corev1.Event, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get client")
 		return nil, err
@@ -326,8 +324,8 @@ func (s *service) ListEventsByDeployment(ctx context.Context, cluster, namespace
 	return utils.SliceToPointerSlice(events.Items), nil
 }
 
-func (s *service) ListEventsByPod(ctx context.Context, cluster, namespace, pod string) ([]*corev1.Event, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListEventsByPod(ctx context.Context, cluster, namespace, pod string, opts metav1.ListOptions) ([]*corev1.Event, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get client")
 		return nil, err
@@ -336,9 +334,8 @@ func (s *service) ListEventsByPod(ctx context.Context, cluster, namespace, pod s
 	if err != nil {
 		return nil, err
 	}
-	events, err := client.CoreV1().Events(po.Namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: "involvedObject.kind=Pod,involvedObject.name=" + po.Name,
-	})
+	opts.FieldSelector = utils.JoinSelectors(opts.FieldSelector, "involvedObject.kind=Pod,involvedObject.name="+po.Name)
+	events, err := client.CoreV1().Events(po.Namespace).List(ctx, opts)
 	if err != nil {
 		klog.ErrorS(err, "failed to list events")
 		return nil, err
@@ -348,20 +345,29 @@ func (s *service) ListEventsByPod(ctx context.Context, cluster, namespace, pod s
 }
 
 func (s *service) GetPod(ctx context.Context, cluster, namespace, pod string) (*corev1.Pod, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 	return client.CoreV1().Pods(namespace).Get(ctx, pod, metav1.GetOptions{})
 }
 
-func (s *service) ListNodes(ctx context.Context, cluster string) ([]*corev1.Node, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+// GetPodLogs opens the log stream of a container of the pod, the caller must close it.
+func (s *service) GetPodLogs(ctx context.Context, cluster, namespace, pod string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return client.CoreV1().Pods(namespace).GetLogs(pod, opts).Stream(ctx)
+}
+
+func (s *service) ListNodes(ctx context.Context, cluster string, opts metav1.ListOptions) ([]*corev1.Node, error) {
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	nodes, err := client.CoreV1().Nodes().List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -370,7 +376,7 @@ func (s *service) ListNodes(ctx context.Context, cluster string) ([]*corev1.Node
 }
 
 func (s *service) PutNodeLabels(ctx context.Context, cluster, name string, labels map[string]string) (map[string]string, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -384,7 +390,7 @@ func (s *service) PutNodeLabels(ctx context.Context, cluster, name string, label
 }
 
 func (s *service) PutNodeTaints(ctx context.Context, cluster, name string, taints []*corev1.Taint) ([]corev1.Taint, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -399,7 +405,7 @@ func (s *service) PutNodeTaints(ctx context.Context, cluster, name string, taint
 }
 
 func (s *service) UpdateNodeAnnotations(ctx context.Context, cluster, node string, annotations map[string]string) (map[string]string, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +419,7 @@ func (s *service) UpdateNodeAnnotations(ctx context.Context, cluster, node strin
 }
 
 func (s *service) UnScheduleNode(ctx context.Context, cluster, node string, unschedulable bool) (*corev1.Node, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +433,7 @@ func (s *service) UnScheduleNode(ctx context.Context, cluster, node string, unsc
 }
 
 func (s *service) UpdateNode(ctx context.Context, cluster string, node *corev1.Node) (*corev1.Node, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -435,7 +441,7 @@ func (s *service) UpdateNode(ctx context.Context, cluster string, node *corev1.N
 }
 
 func (s *service) GetNode(ctx context.Context, cluster, name string) (*corev1.Node, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -448,7 +454,7 @@ func (s *service) GetNode(ctx context.Context, cluster, name string) (*corev1.No
 }
 
 func (s *service) GetConfigMap(ctx context.Context, cluster, namespace, name string) (*corev1.ConfigMap, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -461,7 +467,7 @@ func (s *service) GetConfigMap(ctx context.Context, cluster, namespace, name str
 }
 
 func (s *service) UpdateConfigMap(ctx context.Context, cluster, namespace string, configmap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func (s *service) CreateCredential(
 	credType credentialv1alpha1.CredentialType,
 	data map[string]string,
 ) (*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client", "cluster", cluster)
 		return nil, err
@@ -148,7 +148,7 @@ func (s *service) UpdateCredential(
 	credType credentialv1alpha1.CredentialType,
 	data map[string]string,
 ) (*corev1.Secret, error) {
-	client, err := s.clientManager.GeteClient(cluster)
+	client, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client", "cluster", cluster)
 		return nil, err
@@ -0,0 +1,97 @@
+// Package fanout runs the queries of the member clusters in parallel for the views aggregating
+// the clusters, a slow or failed cluster only misses its own result.
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultConcurrency is the number of the clusters queried at the same time.
+	DefaultConcurrency = 10
+	// DefaultTimeout is how long a cluster is given to answer.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Options configures a fan-out, the zero values are the defaults.
+type Options struct {
+	// Concurrency is the number of the clusters queried at the same time.
+	Concurrency int
+	// Timeout bounds the query of every cluster, the clusters not answering in time fail with
+	// DeadlineExceeded.
+	Timeout time.Duration
+}
+
+// Result is the result of the query of a cluster.
+type Result[T any] struct {
+	Cluster string
+	Value   T
+	// Err is the error of the cluster, Value is the zero value if it is set.
+	Err error
+}
+
+// Run queries the clusters in parallel and returns their results in the order of the clusters.
+// The clusters not queried before ctx is done fail with the error of ctx.
+func Run[T any](ctx context.Context, clusters []string, opts Options, query func(ctx context.Context, cluster string) (T, error)) []Result[T] {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	results := make([]Result[T], len(clusters))
+	semaphore := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		results[i].Cluster = cluster
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(result *Result[T]) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			result.Value, result.Err = queryCluster(ctx, result.Cluster, opts.Timeout, query)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+func queryCluster[T any](ctx context.Context, cluster string, timeout time.Duration, query func(ctx context.Context, cluster string) (T, error)) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			value, err = zero, status.Errorf(codes.Internal, "the query of cluster %s panicked: %v", cluster, r)
+			klog.ErrorS(fmt.Errorf("%v", r), "Query of the cluster panicked", "cluster", cluster)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	value, err = query(ctx, cluster)
+	if err != nil {
+		var zero T
+		if ctx.Err() == context.DeadlineExceeded {
+			err = status.Errorf(codes.DeadlineExceeded, "cluster %s did not answer in %s: %v", cluster, timeout, err)
+		}
+		klog.V(4).InfoS("Failed to query the cluster", "cluster", cluster, "err", err)
+		return zero, err
+	}
+	return value, nil
+}
@@ -0,0 +1,86 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRun(t *testing.T) {
+	var running, peak atomic.Int32
+	failed := errors.New("prometheus is unavailable")
+	results := Run(context.Background(), []string{"c0", "c1", "slow", "broken", "c4"}, Options{Concurrency: 2, Timeout: 50 * time.Millisecond},
+		func(ctx context.Context, cluster string) (string, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			switch cluster {
+			case "slow":
+				<-ctx.Done()
+				return "", ctx.Err()
+			case "broken":
+				return "", failed
+			}
+			time.Sleep(5 * time.Millisecond)
+			return "value of " + cluster, nil
+		})
+
+	if len(results) != 5 {
+		t.Fatalf("Run() returned %d results, want 5", len(results))
+	}
+	for i, cluster := range []string{"c0", "c1", "c4"} {
+		result := results[[]int{0, 1, 4}[i]]
+		if result.Cluster != cluster || result.Value != "value of "+cluster || result.Err != nil {
+			t.Errorf("result = %+v, want the value of %s", result, cluster)
+		}
+	}
+	if results[2].Cluster != "slow" || status.Code(results[2].Err) != codes.DeadlineExceeded {
+		t.Errorf("result = %+v, want the slow cluster timed out", results[2])
+	}
+	if results[3].Cluster != "broken" || !errors.Is(results[3].Err, failed) || results[3].Value != "" {
+		t.Errorf("result = %+v, want the error of the broken cluster", results[3])
+	}
+	if peak.Load() > 2 {
+		t.Errorf("%d clusters are queried at the same time, want at most 2", peak.Load())
+	}
+}
+
+func TestRunPanic(t *testing.T) {
+	results := Run(context.Background(), []string{"c0", "c1"}, Options{}, func(_ context.Context, cluster string) (int, error) {
+		if cluster == "c1" {
+			panic("boom")
+		}
+		return 1, nil
+	})
+	if results[0].Value != 1 || results[0].Err != nil || status.Code(results[1].Err) != codes.Internal {
+		t.Errorf("results = %+v, want the panic of c1 as its error", results)
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var queried atomic.Int32
+	results := Run(ctx, []string{"c0", "c1", "c2"}, Options{Concurrency: 1}, func(ctx context.Context, _ string) (int, error) {
+		queried.Add(1)
+		return 0, ctx.Err()
+	})
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("result = %+v, want canceled", result)
+		}
+	}
+	if queried.Load() != 0 {
+		t.Errorf("%d clusters are queried after the context is canceled", queried.Load())
+	}
+}
@@ -0,0 +1,45 @@
+package kantaloupeflow
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	clustercrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/cluster/v1alpha1"
+	flowcrdv1alpha1 "github.com/dynamia-ai/kantaloupe/api/crd/apis/kantaloupeflow/v1alpha1"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
+
+// ValidateCapabilities rejects the kantaloupeflow options the cluster can not serve,
+// it is skipped if the capabilities of the cluster are not discovered yet.
+func ValidateCapabilities(cluster *clustercrdv1alpha1.Cluster, flow *flowcrdv1alpha1.KantaloupeFlow) error {
+	capabilities := cluster.Status.Capabilities
+	if capabilities == nil {
+		return nil
+	}
+	for _, network := range flow.Spec.Networking {
+		if !slices.Contains(capabilities.KantaloupeFlowFeatures, clustercrdv1alpha1.KantaloupeFlowFeature(network.Type)) {
+			return status.Errorf(codes.FailedPrecondition, "networking type %s of %s is not supported by cluster %s, the route of gateway-api is not installed",
+				network.Type, network.Name, cluster.GetName())
+		}
+	}
+	if requestGPUSharing(flow) && !slices.Contains(capabilities.KantaloupeFlowFeatures, clustercrdv1alpha1.KantaloupeFlowFeatureGPUSharing) {
+		return status.Errorf(codes.FailedPrecondition, "gpu sharing is not supported by cluster %s, HAMi is not installed", cluster.GetName())
+	}
+	return nil
+}
+
+// requestGPUSharing returns whether the kantaloupeflow requests the vGPU resources served by HAMi.
+func requestGPUSharing(flow *flowcrdv1alpha1.KantaloupeFlow) bool {
+	sharingResources := []corev1.ResourceName{constants.NvidiaGPUCores, constants.NvidiaGPUMemory, constants.MetaxGPU, constants.MetaxGPUMemory}
+	for _, container := range flow.Spec.Template.Spec.Containers {
+		for _, name := range sharingResources {
+			if _, ok := container.Resources.Limits[name]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -28,7 +28,7 @@ type Service interface {
 	CreateKantaloupeflow(ctx context.Context, cluster string, flow *flowcrdv1alpha1.KantaloupeFlow) (*flowcrdv1alpha1.KantaloupeFlow, error)
 	GetKantaloupeflow(ctx context.Context, cluster, namespace, name string) (*flowcrdv1alpha1.KantaloupeFlow, error)
 	DeleteKantaloupeflow(ctx context.Context, cluster, namespace, name string) error
-	ListKantaloupeflows(ctx context.Context, cluster, namespace string) ([]*flowcrdv1alpha1.KantaloupeFlow, error)
+	ListKantaloupeflows(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*flowcrdv1alpha1.KantaloupeFlow, error)
 	UpdataKantaloupeflow(ctx context.Context, cluster string, flow *flowcrdv1alpha1.KantaloupeFlow) error
 }
 
@@ -43,11 +43,24 @@ func NewService(clientManager engine.ClientManagerInterface) Service {
 }
 
 func (s *service) CreateKantaloupeflow(ctx context.Context, cluster string, flow *flowcrdv1alpha1.KantaloupeFlow) (*flowcrdv1alpha1.KantaloupeFlow, error) {
-	c, err := s.clientManager.GeteClient(cluster)
+	c, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
+	PreparePlugins(flow, nil)
+	if err := c.Create(ctx, flow); err != nil {
+		klog.ErrorS(err, "failed to create kantaloupeflow", "kantaloupeflow", klog.KObj(flow))
+		return nil, err
+	}
+
+	return flow, nil
+}
+
+// PreparePlugins adds the networkings and the environments of the plugins to the kantaloupeflow.
+// The generated secrets are kept from the existing kantaloupeflow if it is not nil, so applying
+// the same kantaloupeflow again changes nothing.
+func PreparePlugins(flow, existing *flowcrdv1alpha1.KantaloupeFlow) {
 	// create networkings for kantaloupeflow.
 	networkings := []flowcrdv1alpha1.Networking{}
 	container := flow.Spec.Template.Spec.Containers[0]
@@ -70,7 +83,7 @@ func (s *service) CreateKantaloupeflow(ctx context.Context, cluster string, flow
 		})
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name:  constants.EnvSSHRootPasswordKey,
-			Value: utils.RandomString(passwordRandomLength),
+			Value: generatedEnv(existing, constants.EnvSSHRootPasswordKey, func() string { return utils.RandomString(passwordRandomLength) }),
 		})
 	}
 	if slices.Contains(flow.Spec.Plugins, flowcrdv1alpha1.VscodePluginType) {
@@ -98,23 +111,29 @@ func (s *service) CreateKantaloupeflow(ctx context.Context, cluster string, flow
 		})
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name:  constants.EnvJupyterToken,
-			Value: rand.String(64),
+			Value: generatedEnv(existing, constants.EnvJupyterToken, func() string { return rand.String(64) }),
 		})
 	}
 
 	flow.Spec.Networking = networkings
 	flow.Spec.Template.Spec.Containers[0] = container
+}
 
-	if err := c.Create(ctx, flow); err != nil {
-		klog.ErrorS(err, "failed to create kantaloupeflow", "kantaloupeflow", klog.KObj(flow))
-		return nil, err
+// generatedEnv returns the value of the environment of the first container of the existing
+// kantaloupeflow, a new one is generated if it is absent.
+func generatedEnv(existing *flowcrdv1alpha1.KantaloupeFlow, name string, generate func() string) string {
+	if existing != nil && len(existing.Spec.Template.Spec.Containers) > 0 {
+		for _, e := range existing.Spec.Template.Spec.Containers[0].Env {
+			if e.Name == name && e.Value != "" {
+				return e.Value
+			}
+		}
 	}
-
-	return flow, nil
+	return generate()
 }
 
 func (s *service) GetKantaloupeflow(ctx context.Context, cluster, namespace, name string) (*flowcrdv1alpha1.KantaloupeFlow, error) {
-	c, err := s.clientManager.GeteClient(cluster)
+	c, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -129,15 +148,15 @@ func (s *service) GetKantaloupeflow(ctx context.Context, cluster, namespace, nam
 }
 
 func (s *service) DeleteKantaloupeflow(ctx context.Context, cluster, namespace, name string) error {
-	c, err := s.clientManager.GeteClient(cluster)
+	c, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return err
 	}
 	return c.Delete(ctx, &flowcrdv1alpha1.KantaloupeFlow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
 }
 
-func (s *service) ListKantaloupeflows(ctx context.Context, cluster, namespace string) ([]*flowcrdv1alpha1.KantaloupeFlow, error) {
-	c, err := s.clientManager.GeteClient(cluster)
+func (s *service) ListKantaloupeflows(ctx context.Context, cluster, namespace string, opts metav1.ListOptions) ([]*flowcrdv1alpha1.KantaloupeFlow, error) {
+	c, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +166,7 @@ func (s *service) ListKantaloupeflows(ctx context.Context, cluster, namespace st
 	}
 
 	flows := &flowcrdv1alpha1.KantaloupeFlowList{}
-	if err := c.List(ctx, flows, &client.ListOptions{Namespace: namespace}); err != nil {
+	if err := c.List(ctx, flows, &client.ListOptions{Namespace: namespace, Raw: &opts}); err != nil {
 		return nil, err
 	}
 
@@ -155,7 +174,7 @@ func (s *service) ListKantaloupeflows(ctx context.Context, cluster, namespace st
 }
 
 func (s *service) UpdataKantaloupeflow(ctx context.Context, cluster string, flow *flowcrdv1alpha1.KantaloupeFlow) error {
-	c, err := s.clientManager.GeteClient(cluster)
+	c, err := s.clientManager.GeteClientForRequest(ctx, cluster)
 	if err != nil {
 		return err
 	}
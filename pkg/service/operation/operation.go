@@ -0,0 +1,263 @@
+// Package operation runs the long-running calls in the background, e.g. integrating a cluster,
+// the callers poll the operations for their progress and results or cancel them.
+package operation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+)
+
+const (
+	// DefaultTimeout is how long an operation runs before it fails with DeadlineExceeded.
+	DefaultTimeout = 10 * time.Minute
+	// DefaultMaxRunning is the number of the operations running at the same time, more are
+	// rejected until some are done.
+	DefaultMaxRunning = 100
+	// retention is how long the operations are kept after they are done.
+	retention = time.Hour
+)
+
+var (
+	// ErrNotFound is returned when the operation does not exist or is expired.
+	ErrNotFound = errors.New("the operation is not found")
+	// ErrTooManyOperations is returned when too many operations are running.
+	ErrTooManyOperations = status.Error(codes.ResourceExhausted, "too many operations are running, retry later")
+)
+
+// Meta describes the call started an operation.
+type Meta struct {
+	// Method is the full name of the RPC.
+	Method string
+	// User is the name of the caller, only the user and the platform admins may access the
+	// operation.
+	User    string
+	Cluster string
+}
+
+// Operation is a snapshot of an operation.
+type Operation struct {
+	ID string
+	Meta
+	State operationsv1alpha1.OperationState
+	// Progress is the percentage done, from 0 to 100.
+	Progress   int32
+	Message    string
+	CreateTime time.Time
+	UpdateTime time.Time
+	// Result is the response of the call if the operation is succeeded.
+	Result proto.Message
+	// Err is the error the operation failed or was canceled with.
+	Err error
+}
+
+// Done reports whether the operation is succeeded, failed or canceled.
+func (o Operation) Done() bool {
+	return o.State != operationsv1alpha1.OperationState_RUNNING
+}
+
+// Func is the body of an operation, it must roll back its changes when it fails, including
+// when its context is canceled.
+type Func func(ctx context.Context) (proto.Message, error)
+
+// Manager runs and keeps the operations in memory, the operations are lost when the apiserver
+// restarts.
+type Manager struct {
+	ctx        context.Context
+	timeout    time.Duration
+	operations *ttlcache.Cache[string, *operation]
+
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+}
+
+type operation struct {
+	mu sync.RWMutex
+	Operation
+	cancel   context.CancelFunc
+	canceled bool
+}
+
+// NewManager returns the manager of the operations, the running ones are canceled when the
+// context is done.
+func NewManager(ctx context.Context) *Manager {
+	return newManager(ctx, DefaultTimeout, DefaultMaxRunning)
+}
+
+func newManager(ctx context.Context, timeout time.Duration, maxRunning int) *Manager {
+	m := &Manager{
+		ctx:        ctx,
+		timeout:    timeout,
+		maxRunning: maxRunning,
+		// the retention starts when the operations are done, getting them must not extend it.
+		operations: ttlcache.New(ttlcache.WithDisableTouchOnHit[string, *operation]()),
+	}
+	go m.operations.Start()
+	context.AfterFunc(ctx, m.operations.Stop)
+	return m
+}
+
+// Start runs the function in an operation and returns it at once. The operation outlives the
+// request, but keeps the values of its context, e.g. the user impersonated in the clusters.
+// ErrTooManyOperations is returned if the limit of the running operations is reached.
+func (m *Manager) Start(ctx context.Context, meta Meta, run Func) (Operation, error) {
+	if !m.acquire() {
+		klog.V(2).InfoS("Rejected the operation, too many operations are running", "method", meta.Method, "user", meta.User, "cluster", meta.Cluster)
+		return Operation{}, ErrTooManyOperations
+	}
+	now := time.Now()
+	op := &operation{Operation: Operation{
+		ID:         uuid.NewString(),
+		Meta:       meta,
+		State:      operationsv1alpha1.OperationState_RUNNING,
+		CreateTime: now,
+		UpdateTime: now,
+	}}
+	runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.timeout)
+	stop := context.AfterFunc(m.ctx, cancel)
+	op.cancel = cancel
+	m.operations.Set(op.ID, op, ttlcache.NoTTL)
+	started := op.snapshot()
+
+	go func() {
+		defer cancel()
+		defer stop()
+		result, err := safeRun(context.WithValue(runCtx, operationKey{}, op), run)
+		// the operation no longer counts once it is seen done.
+		m.release()
+		op.finish(result, err, runCtx.Err())
+		m.operations.Set(op.ID, op, retention)
+
+		finished := op.snapshot()
+		klog.InfoS("Operation finished", "id", finished.ID, "method", finished.Method, "user", finished.User,
+			"cluster", finished.Cluster, "state", finished.State, "duration", finished.UpdateTime.Sub(finished.CreateTime), "err", finished.Err)
+	}()
+	klog.V(4).InfoS("Operation started", "id", op.ID, "method", meta.Method, "user", meta.User, "cluster", meta.Cluster)
+	return started, nil
+}
+
+// acquire counts a running operation, it reports false if the limit is reached.
+func (m *Manager) acquire() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running >= m.maxRunning {
+		return false
+	}
+	m.running++
+	return true
+}
+
+func (m *Manager) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running--
+}
+
+func safeRun(ctx context.Context, run Func) (result proto.Message, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			klog.ErrorS(fmt.Errorf("%v", r), "Operation panicked")
+			result, err = nil, status.Errorf(codes.Internal, "the operation panicked: %v", r)
+		}
+	}()
+	return run(ctx)
+}
+
+// Get returns the operation of the ID.
+func (m *Manager) Get(id string) (Operation, error) {
+	op, err := m.get(id)
+	if err != nil {
+		return Operation{}, err
+	}
+	return op.snapshot(), nil
+}
+
+// Cancel cancels the operation of the ID, it returns the operation as it is if it is done.
+// The operation is canceled once its function returns after rolling back.
+func (m *Manager) Cancel(id string) (Operation, error) {
+	op, err := m.get(id)
+	if err != nil {
+		return Operation{}, err
+	}
+	op.mu.Lock()
+	if !op.Done() {
+		op.canceled = true
+		op.Message = "canceling"
+		op.UpdateTime = time.Now()
+	}
+	op.mu.Unlock()
+	op.cancel()
+	return op.snapshot(), nil
+}
+
+func (m *Manager) get(id string) (*operation, error) {
+	item := m.operations.Get(id)
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	return item.Value(), nil
+}
+
+func (o *operation) snapshot() Operation {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.Operation
+}
+
+func (o *operation) finish(result proto.Message, err, ctxErr error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.UpdateTime = time.Now()
+	switch {
+	case err == nil:
+		o.State = operationsv1alpha1.OperationState_SUCCEEDED
+		o.Progress = 100
+		o.Message = "succeeded"
+		o.Result = result
+	case o.canceled:
+		o.State = operationsv1alpha1.OperationState_CANCELED
+		o.Message = "canceled"
+		o.Err = status.Error(codes.Canceled, "the operation is canceled")
+	case errors.Is(ctxErr, context.DeadlineExceeded):
+		o.State = operationsv1alpha1.OperationState_FAILED
+		o.Message = "timed out"
+		o.Err = status.Errorf(codes.DeadlineExceeded, "the operation timed out: %v", err)
+	default:
+		o.State = operationsv1alpha1.OperationState_FAILED
+		o.Message = "failed"
+		o.Err = err
+	}
+}
+
+type operationKey struct{}
+
+// Report updates the progress and the message of the operation running in the context, it does
+// nothing if the context is not of an operation, e.g. when the same code serves a synchronous
+// call.
+func Report(ctx context.Context, progress int32, message string) {
+	op, ok := ctx.Value(operationKey{}).(*operation)
+	if !ok {
+		return
+	}
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	if op.Done() || op.canceled {
+		return
+	}
+	op.Progress = min(max(progress, op.Progress), 99)
+	op.Message = message
+	op.UpdateTime = time.Now()
+	klog.V(4).InfoS("Operation progressed", "id", op.ID, "progress", op.Progress, "message", message)
+}
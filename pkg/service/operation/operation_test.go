@@ -0,0 +1,190 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	operationsv1alpha1 "github.com/dynamia-ai/kantaloupe/api/operations/v1alpha1"
+)
+
+func waitDone(t *testing.T, m *Manager, id string) Operation {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		op, err := m.Get(id)
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		if op.Done() {
+			return op
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("operation %s is not done in time", id)
+	return Operation{}
+}
+
+func mustStart(t *testing.T, m *Manager, ctx context.Context, meta Meta, run Func) Operation {
+	t.Helper()
+	op, err := m.Start(ctx, meta, run)
+	if err != nil {
+		t.Fatalf("Start() = %v", err)
+	}
+	return op
+}
+
+func TestManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := NewManager(ctx)
+
+	progressed := make(chan struct{})
+	proceed := make(chan struct{})
+	op := mustStart(t, m, context.TODO(), Meta{Method: "/kantaloupev1.Cluster/IntegrateClusterAsync", User: "alice", Cluster: "member"},
+		func(ctx context.Context) (proto.Message, error) {
+			Report(ctx, 40, "creating the cluster")
+			close(progressed)
+			<-proceed
+			return wrapperspb.String("member"), nil
+		})
+	if op.ID == "" || op.State != operationsv1alpha1.OperationState_RUNNING || op.User != "alice" {
+		t.Fatalf("Start() = %+v", op)
+	}
+
+	<-progressed
+	running, err := m.Get(op.ID)
+	if err != nil || running.Progress != 40 || running.Message != "creating the cluster" || running.Done() {
+		t.Errorf("Get() = %+v, %v, want the reported progress", running, err)
+	}
+	close(proceed)
+
+	done := waitDone(t, m, op.ID)
+	if done.State != operationsv1alpha1.OperationState_SUCCEEDED || done.Progress != 100 || done.Err != nil ||
+		!proto.Equal(done.Result, wrapperspb.String("member")) {
+		t.Errorf("operation = %+v, want succeeded with the result", done)
+	}
+
+	if _, err := m.Get("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() = %v, want ErrNotFound", err)
+	}
+}
+
+func TestManagerFailed(t *testing.T) {
+	m := newManager(context.Background(), 50*time.Millisecond, DefaultMaxRunning)
+
+	failed := errors.New("failed to create the secret")
+	op := mustStart(t, m, context.TODO(), Meta{}, func(context.Context) (proto.Message, error) {
+		return nil, failed
+	})
+	if done := waitDone(t, m, op.ID); done.State != operationsv1alpha1.OperationState_FAILED || !errors.Is(done.Err, failed) || done.Result != nil {
+		t.Errorf("operation = %+v, want failed", done)
+	}
+
+	op = mustStart(t, m, context.TODO(), Meta{}, func(ctx context.Context) (proto.Message, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if done := waitDone(t, m, op.ID); done.State != operationsv1alpha1.OperationState_FAILED || status.Code(done.Err) != codes.DeadlineExceeded {
+		t.Errorf("operation = %+v, want timed out", done)
+	}
+
+	op = mustStart(t, m, context.TODO(), Meta{}, func(context.Context) (proto.Message, error) {
+		panic("boom")
+	})
+	if done := waitDone(t, m, op.ID); done.State != operationsv1alpha1.OperationState_FAILED || status.Code(done.Err) != codes.Internal {
+		t.Errorf("operation = %+v, want failed after the panic", done)
+	}
+}
+
+func TestManagerCancel(t *testing.T) {
+	m := NewManager(context.Background())
+
+	// the request context is canceled when the call returns, the operation keeps running.
+	reqCtx, reqCancel := context.WithCancel(context.Background())
+	rolledBack := make(chan struct{})
+	op := mustStart(t, m, reqCtx, Meta{}, func(ctx context.Context) (proto.Message, error) {
+		<-ctx.Done()
+		close(rolledBack)
+		return nil, ctx.Err()
+	})
+	reqCancel()
+	if running, _ := m.Get(op.ID); running.Done() {
+		t.Fatalf("operation = %+v, want running after the request returned", running)
+	}
+
+	if _, err := m.Cancel(op.ID); err != nil {
+		t.Fatalf("Cancel() = %v", err)
+	}
+	<-rolledBack
+	done := waitDone(t, m, op.ID)
+	if done.State != operationsv1alpha1.OperationState_CANCELED || status.Code(done.Err) != codes.Canceled {
+		t.Errorf("operation = %+v, want canceled", done)
+	}
+
+	// canceling a done operation does not change it.
+	if canceled, err := m.Cancel(op.ID); err != nil || canceled.State != operationsv1alpha1.OperationState_CANCELED {
+		t.Errorf("Cancel() = %+v, %v", canceled, err)
+	}
+	if _, err := m.Cancel("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Cancel() = %v, want ErrNotFound", err)
+	}
+
+	// the running operations are canceled when the apiserver stops.
+	ctx, stop := context.WithCancel(context.Background())
+	m = NewManager(ctx)
+	op = mustStart(t, m, context.TODO(), Meta{}, func(ctx context.Context) (proto.Message, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	stop()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if got := m.operations.Get(op.ID); got != nil && got.Value().snapshot().Done() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the operation is not stopped with the manager")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestManagerMaxRunning(t *testing.T) {
+	m := newManager(context.Background(), DefaultTimeout, 2)
+
+	proceed := make(chan struct{})
+	block := func(context.Context) (proto.Message, error) {
+		<-proceed
+		return nil, nil
+	}
+	first := mustStart(t, m, context.TODO(), Meta{}, block)
+	second := mustStart(t, m, context.TODO(), Meta{}, block)
+
+	_, err := m.Start(context.TODO(), Meta{}, block)
+	if !errors.Is(err, ErrTooManyOperations) || status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Start() = %v, want ErrTooManyOperations", err)
+	}
+
+	// the done operations no longer count, whether they succeeded or not.
+	close(proceed)
+	waitDone(t, m, first.ID)
+	waitDone(t, m, second.ID)
+	op := mustStart(t, m, context.TODO(), Meta{}, func(context.Context) (proto.Message, error) {
+		panic("boom")
+	})
+	waitDone(t, m, op.ID)
+	mustStart(t, m, context.TODO(), Meta{}, block)
+	mustStart(t, m, context.TODO(), Meta{}, block)
+}
+
+func TestReport(t *testing.T) {
+	// reporting outside of an operation does nothing.
+	Report(context.TODO(), 50, "ignored")
+}
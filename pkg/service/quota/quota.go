@@ -21,7 +21,7 @@ import (
 // Service defines the interface for quota operations.
 type Service interface {
 	// ListQuotas lists resource quotas with filtering options.
-	ListQuotas(ctx context.Context, namespace, cluster string) ([]*corev1.ResourceQuota, error)
+	ListQuotas(ctx context.Context, namespace, cluster string, opts metav1.ListOptions) ([]*corev1.ResourceQuota, error)
 
 	// CreateQuota creates a new resource quota.
 	CreateQuota(ctx context.Context, name, namespace, cluster string, hard map[string]string) (*corev1.ResourceQuota, error)
@@ -50,13 +50,13 @@ func NewService(clientManager engine.ClientManagerInterface, client engine.Prome
 	}
 }
 
-// getClient returns a Kubernetes client for the specified cluster.
-func (s *service) getClient(cluster string) (*engine.Client, error) {
+// getClient returns a Kubernetes client for the specified cluster acting for the request.
+func (s *service) getClient(ctx context.Context, cluster string) (*engine.Client, error) {
 	target := cluster
 	if target == "" {
 		target = engine.LocalCluster
 	}
-	return s.clientManager.GeteClient(target)
+	return s.clientManager.GeteClientForRequest(ctx, target)
 }
 
 // getNamespace returns the namespace, defaulting if necessary.
@@ -68,8 +68,8 @@ func getNamespace(ns string) string {
 }
 
 // ListQuotas lists resource quotas with optional filtering.
-func (s *service) ListQuotas(ctx context.Context, namespace, cluster string) ([]*corev1.ResourceQuota, error) {
-	client, err := s.getClient(cluster)
+func (s *service) ListQuotas(ctx context.Context, namespace, cluster string, opts metav1.ListOptions) ([]*corev1.ResourceQuota, error) {
+	client, err := s.getClient(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client")
 		return nil, err
@@ -78,7 +78,7 @@ func (s *service) ListQuotas(ctx context.Context, namespace, cluster string) ([]
 	if namespace == constants.SelectAll {
 		namespace = metav1.NamespaceAll
 	}
-	quotaList, err := client.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	quotaList, err := client.CoreV1().ResourceQuotas(namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (s *service) ListQuotas(ctx context.Context, namespace, cluster string) ([]
 }
 
 func (s *service) GetQuota(ctx context.Context, name, namespace, cluster string) (*corev1.ResourceQuota, error) {
-	client, err := s.getClient(cluster)
+	client, err := s.getClient(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client", "cluster", cluster)
 		return nil, err
@@ -111,7 +111,7 @@ func (s *service) CreateQuota(
 	name, ns, cluster string,
 	hard map[string]string,
 ) (*corev1.ResourceQuota, error) {
-	client, err := s.getClient(cluster)
+	client, err := s.getClient(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client", "cluster", cluster)
 		return nil, err
@@ -170,7 +170,7 @@ func (s *service) UpdateQuota(
 	name, ns, cluster string,
 	hard map[string]string,
 ) (*corev1.ResourceQuota, error) {
-	client, err := s.getClient(cluster)
+	client, err := s.getClient(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client", "cluster", cluster)
 		return nil, err
@@ -222,7 +222,7 @@ func (s *service) UpdateQuota(
 
 // DeleteQuota deletes a resource quota by name.
 func (s *service) DeleteQuota(ctx context.Context, name, namespace, cluster string) error {
-	client, err := s.getClient(cluster)
+	client, err := s.getClient(ctx, cluster)
 	if err != nil {
 		klog.ErrorS(err, "failed to get Kubernetes client")
 		return err
@@ -0,0 +1,363 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+const (
+	// historySize is the number of the latest events kept by every informer to resume
+	// the watches from.
+	historySize = 1000
+	// watcherBufferSize is the number of the events a watcher may fall behind, the
+	// watcher is closed if it falls further.
+	watcherBufferSize = 256
+	// heartbeatPeriod is the period of the BOOKMARK events keeping the idle streams alive.
+	heartbeatPeriod = 30 * time.Second
+	// idleTimeout is how long an informer is kept after its last watcher leaves, so the
+	// reconnecting watchers are resumed without listing the cluster again.
+	idleTimeout = 5 * time.Minute
+	// syncTimeout is how long a watch waits for the informer to list the cluster.
+	syncTimeout = 30 * time.Second
+)
+
+var (
+	// ErrResourceVersionExpired is returned when the resource version to resume from is
+	// not in the history any more, the watch must be restarted without resource version.
+	ErrResourceVersionExpired = errors.New("the resource version is too old, restart the watch without resource version")
+	// ErrWatcherTooSlow is returned when the watcher falls too far behind the events, the
+	// watch can be resumed from the last received resource version.
+	ErrWatcherTooSlow = errors.New("the watcher falls behind the events, resume the watch from the last resource version")
+	// ErrSyncTimeout is returned when the cluster can not be listed in time.
+	ErrSyncTimeout = errors.New("timed out waiting for the resources of the cluster to be listed")
+)
+
+var (
+	ClusterGVR        = schema.GroupVersionResource{Group: "cluster.dynamia.io", Version: "v1alpha1", Resource: "clusters"}
+	KantaloupeflowGVR = schema.GroupVersionResource{Group: "kantaloupeflow.dynamia.io", Version: "v1alpha1", Resource: "kantaloupeflows"}
+	NodeGVR           = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	EventGVR          = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+)
+
+// Event is a change of the watched resources.
+type Event struct {
+	Type watch.EventType
+	// Object is nil for the watch.Bookmark events.
+	Object *unstructured.Unstructured
+	// ResourceVersion is the version to resume the watch from after this event.
+	ResourceVersion string
+}
+
+// Options limits the events sent to a watcher.
+type Options struct {
+	// Namespace limits the watch to the namespace, empty watches all namespaces.
+	Namespace string
+	// LabelSelector limits the watch to the matching objects, nil matches everything.
+	LabelSelector labels.Selector
+	// ResourceVersion resumes the watch after the event of the version, the current
+	// objects are sent as watch.Added events first if it is empty.
+	ResourceVersion string
+	// Filter drops the objects the watcher is not interested in or not allowed to see.
+	Filter func(obj *unstructured.Unstructured) bool
+}
+
+func (o *Options) matches(obj *unstructured.Unstructured) bool {
+	if o.Namespace != "" && obj.GetNamespace() != o.Namespace {
+		return false
+	}
+	if o.LabelSelector != nil && !o.LabelSelector.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+	return o.Filter == nil || o.Filter(obj)
+}
+
+// Service streams the changes of the resources of the clusters. The watchers of the same
+// resources of a cluster share one informer, so the clusters are watched once no matter
+// how many clients are connected.
+type Service interface {
+	// Watch sends the events of the resources of the cluster to send until ctx is done or
+	// send fails. The events are delivered at least once.
+	Watch(ctx context.Context, cluster string, gvr schema.GroupVersionResource, opts Options, send func(*Event) error) error
+}
+
+type service struct {
+	clientManager engine.ClientManagerInterface
+
+	lock      sync.Mutex
+	informers map[informerKey]*sharedInformer
+}
+
+type informerKey struct {
+	cluster string
+	gvr     schema.GroupVersionResource
+}
+
+// NewService builds the watch service, the informers are built with the clients of the
+// clusters, the events are filtered by the callers for the users of the requests.
+func NewService(clientManager engine.ClientManagerInterface) Service {
+	return &service{
+		clientManager: clientManager,
+		informers:     make(map[informerKey]*sharedInformer),
+	}
+}
+
+func (s *service) Watch(ctx context.Context, cluster string, gvr schema.GroupVersionResource, opts Options, send func(*Event) error) error {
+	informer, err := s.acquire(cluster, gvr)
+	if err != nil {
+		return err
+	}
+	defer s.release(informer)
+
+	if err := informer.waitForSync(ctx); err != nil {
+		return err
+	}
+
+	w := &watcher{events: make(chan *Event, watcherBufferSize)}
+	initial, err := informer.register(w, opts.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer informer.unregister(w)
+
+	resourceVersion := opts.ResourceVersion
+	sendEvent := func(event *Event) error {
+		resourceVersion = event.ResourceVersion
+		if event.Type != watch.Bookmark && !opts.matches(event.Object) {
+			return nil
+		}
+		return send(event)
+	}
+	for _, event := range initial {
+		if err := sendEvent(event); err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(heartbeatPeriod)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.events:
+			if !ok {
+				return ErrWatcherTooSlow
+			}
+			if err := sendEvent(event); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if err := send(&Event{Type: watch.Bookmark, ResourceVersion: resourceVersion}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// acquire returns the informer of the resources of the cluster, it is built and started
+// if no one watches the resources.
+func (s *service) acquire(cluster string, gvr schema.GroupVersionResource) (*sharedInformer, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := informerKey{cluster: cluster, gvr: gvr}
+	if informer, ok := s.informers[key]; ok {
+		informer.refs++
+		if informer.idle != nil {
+			informer.idle.Stop()
+			informer.idle = nil
+		}
+		return informer, nil
+	}
+
+	client, err := s.clientManager.GeteClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	informer, err := newSharedInformer(client.Dynamic, gvr)
+	if err != nil {
+		return nil, err
+	}
+	informer.refs = 1
+	s.informers[key] = informer
+	klog.V(4).InfoS("Started the informer of the watchers", "cluster", cluster, "resource", gvr)
+	return informer, nil
+}
+
+// release stops the informer if no one watches it for idleTimeout.
+func (s *service) release(informer *sharedInformer) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	informer.refs--
+	if informer.refs > 0 {
+		return
+	}
+	informer.idle = time.AfterFunc(idleTimeout, func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		for key, i := range s.informers {
+			if i == informer && informer.refs == 0 {
+				delete(s.informers, key)
+				informer.cancel()
+				klog.V(4).InfoS("Stopped the idle informer of the watchers", "cluster", key.cluster, "resource", key.gvr)
+			}
+		}
+	})
+}
+
+// sharedInformer is the informer shared by the watchers of the same resources of a cluster.
+type sharedInformer struct {
+	informer     cache.SharedIndexInformer
+	registration cache.ResourceEventHandlerRegistration
+	cancel       context.CancelFunc
+
+	// refs and idle are protected by the lock of the service.
+	refs int
+	idle *time.Timer
+
+	lock sync.Mutex
+	// history keeps the latest events to resume the watches from.
+	history             []*Event
+	lastResourceVersion string
+	watchers            map[*watcher]struct{}
+}
+
+type watcher struct {
+	events chan *Event
+}
+
+func newSharedInformer(client dynamic.Interface, gvr schema.GroupVersionResource) (*sharedInformer, error) {
+	s := &sharedInformer{
+		informer: dynamicinformer.NewFilteredDynamicInformer(client, gvr, metav1.NamespaceAll, 0, cache.Indexers{}, nil).Informer(),
+		watchers: make(map[*watcher]struct{}),
+	}
+	registration, err := s.informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			// the initial objects are sent from the store when the watches start.
+			if !isInInitialList {
+				s.record(watch.Added, obj)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if oldObj.(*unstructured.Unstructured).GetResourceVersion() != newObj.(*unstructured.Unstructured).GetResourceVersion() {
+				s.record(watch.Modified, newObj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			s.record(watch.Deleted, obj)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.registration = registration
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.informer.RunWithContext(ctx)
+	return s, nil
+}
+
+func (s *sharedInformer) waitForSync(ctx context.Context) error {
+	syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), s.registration.HasSynced) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return ErrSyncTimeout
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.lastResourceVersion == "" {
+		s.lastResourceVersion = s.informer.LastSyncResourceVersion()
+	}
+	return nil
+}
+
+func (s *sharedInformer) record(eventType watch.EventType, obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	event := &Event{Type: eventType, Object: u, ResourceVersion: u.GetResourceVersion()}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.history = append(s.history, event)
+	if len(s.history) > 2*historySize {
+		s.history = slices.Clone(s.history[len(s.history)-historySize:])
+	}
+	s.lastResourceVersion = event.ResourceVersion
+
+	for w := range s.watchers {
+		select {
+		case w.events <- event:
+		default:
+			// never block the informer for a slow watcher.
+			delete(s.watchers, w)
+			close(w.events)
+		}
+	}
+}
+
+// register adds the watcher and returns the events to send before the new ones, they are
+// the current objects if resourceVersion is empty, otherwise the events after it.
+func (s *sharedInformer) register(w *watcher, resourceVersion string) ([]*Event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var events []*Event
+	switch {
+	case resourceVersion == "":
+		for _, obj := range s.informer.GetStore().List() {
+			if u, ok := obj.(*unstructured.Unstructured); ok {
+				events = append(events, &Event{Type: watch.Added, Object: u, ResourceVersion: u.GetResourceVersion()})
+			}
+		}
+		events = append(events, &Event{Type: watch.Bookmark, ResourceVersion: s.lastResourceVersion})
+	case resourceVersion == s.lastResourceVersion:
+	default:
+		i := slices.IndexFunc(s.history, func(event *Event) bool {
+			return event.ResourceVersion == resourceVersion
+		})
+		if i < 0 {
+			return nil, ErrResourceVersionExpired
+		}
+		events = slices.Clone(s.history[i+1:])
+	}
+
+	s.watchers[w] = struct{}{}
+	return events, nil
+}
+
+func (s *sharedInformer) unregister(w *watcher) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.watchers[w]; ok {
+		delete(s.watchers, w)
+		close(w.events)
+	}
+}
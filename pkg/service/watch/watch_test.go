@@ -0,0 +1,116 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/engine"
+)
+
+type fakeClientManager struct {
+	client *engine.Client
+}
+
+func (m *fakeClientManager) GeteClient(string) (*engine.Client, error) {
+	return m.client, nil
+}
+
+func (m *fakeClientManager) GeteClientForRequest(context.Context, string) (*engine.Client, error) {
+	return m.client, nil
+}
+
+func newNode(name, resourceVersion string, nodeLabels map[string]string) *unstructured.Unstructured {
+	node := &unstructured.Unstructured{}
+	node.SetAPIVersion("v1")
+	node.SetKind("Node")
+	node.SetName(name)
+	node.SetResourceVersion(resourceVersion)
+	node.SetLabels(nodeLabels)
+	return node
+}
+
+func TestWatch(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{NodeGVR: "NodeList"}, newNode("node1", "1", nil))
+	// the informer must not miss the objects created before its watch is established.
+	watchStarted := make(chan struct{})
+	client.PrependWatchReactor("nodes", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		w, err := client.Tracker().Watch(NodeGVR, action.GetNamespace())
+		close(watchStarted)
+		return true, w, err
+	})
+	s := NewService(&fakeClientManager{client: &engine.Client{Dynamic: client}})
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	all := startWatch(ctx, t, s, Options{})
+	expectEvents(t, all, "ADDED node1 1", "BOOKMARK  ")
+	<-watchStarted
+
+	nodes := client.Resource(NodeGVR)
+	if _, err := nodes.Create(ctx, newNode("node2", "2", map[string]string{"gpu": "true"}), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nodes.Update(ctx, newNode("node1", "3", nil), metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectEvents(t, all, "ADDED node2 2", "MODIFIED node1 3")
+
+	resumed := startWatch(ctx, t, s, Options{ResourceVersion: "2"})
+	gpu := startWatch(ctx, t, s, Options{LabelSelector: labels.SelectorFromSet(labels.Set{"gpu": "true"})})
+	expectEvents(t, resumed, "MODIFIED node1 3")
+	expectEvents(t, gpu, "ADDED node2 2", "BOOKMARK  3")
+
+	if err := nodes.Delete(ctx, "node2", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectEvents(t, all, "DELETED node2 2")
+	expectEvents(t, resumed, "DELETED node2 2")
+	expectEvents(t, gpu, "DELETED node2 2")
+
+	err := s.Watch(ctx, "member1", NodeGVR, Options{ResourceVersion: "unknown"}, func(*Event) error { return nil })
+	if !errors.Is(err, ErrResourceVersionExpired) {
+		t.Errorf("Watch() error = %v, want %v", err, ErrResourceVersionExpired)
+	}
+}
+
+func startWatch(ctx context.Context, t *testing.T, s Service, opts Options) <-chan string {
+	t.Helper()
+	events := make(chan string, 100)
+	go func() {
+		_ = s.Watch(ctx, "member1", NodeGVR, opts, func(event *Event) error {
+			var name string
+			if event.Object != nil {
+				name = event.Object.GetName()
+			}
+			events <- string(event.Type) + " " + name + " " + event.ResourceVersion
+			return nil
+		})
+	}()
+	return events
+}
+
+func expectEvents(t *testing.T, events <-chan string, expected ...string) {
+	t.Helper()
+	for _, expect := range expected {
+		select {
+		case event := <-events:
+			if event != expect {
+				t.Fatalf("got event %q, want %q", event, expect)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for event %q", expect)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package annotations
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -15,6 +16,65 @@ const (
 	PodNeuronsAnnotation    = "hami.io/aws-neuron-devices-allocated"
 )
 
+// NodeDevice is a device registered on the node by a HAMi device plugin.
+type NodeDevice struct {
+	ID     string `json:"id"`
+	Count  int32  `json:"count"`
+	Memory int64  `json:"devmem"`
+	Core   int32  `json:"devcore"`
+	Type   string `json:"type"`
+	Health bool   `json:"health"`
+}
+
+// ParseNodeDevicesAnnotation parses the node register annotation written by HAMi device plugins.
+// Both the legacy "id,count,devmem,devcore,type,numa,health:" encoding and the JSON encoding are supported.
+func ParseNodeDevicesAnnotation(annotation string) ([]*NodeDevice, error) {
+	annotation = strings.TrimSpace(annotation)
+	if strings.HasPrefix(annotation, "[") {
+		res := []*NodeDevice{}
+		if err := json.Unmarshal([]byte(annotation), &res); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	res := []*NodeDevice{}
+	for device := range strings.SplitSeq(annotation, ":") {
+		if device == "" || device == ";" {
+			continue
+		}
+		values := strings.Split(device, ",")
+		if len(values) < 5 {
+			return nil, errors.New("invalid format for annotation: " + device)
+		}
+		count, err := strconv.ParseInt(values[1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		memory, err := strconv.ParseInt(values[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		core, err := strconv.ParseInt(values[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		health := true
+		if len(values) > 6 {
+			health, _ = strconv.ParseBool(values[6])
+		}
+		res = append(res, &NodeDevice{
+			ID:     values[0],
+			Count:  int32(count),
+			Memory: memory,
+			Core:   int32(core),
+			Type:   values[4],
+			Health: health,
+		})
+	}
+	return res, nil
+}
+
 type GPUAllocation struct {
 	UUID   string
 	Vendor string
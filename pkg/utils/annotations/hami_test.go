@@ -91,3 +91,42 @@ func TestMarshalUnmarshal_RoundTrip(t *testing.T) {
 		t.Errorf("round-trip failed: got %+v, want %+v", parsed, original)
 	}
 }
+
+func TestParseNodeDevicesAnnotation_Legacy(t *testing.T) {
+	input := "GPU-0,10,15360,100,NVIDIA-Tesla T4,0,true:GPU-1,10,15360,100,NVIDIA-Tesla T4,0,false:"
+	expected := []*NodeDevice{
+		{ID: "GPU-0", Count: 10, Memory: 15360, Core: 100, Type: "NVIDIA-Tesla T4", Health: true},
+		{ID: "GPU-1", Count: 10, Memory: 15360, Core: 100, Type: "NVIDIA-Tesla T4", Health: false},
+	}
+
+	devices, err := ParseNodeDevicesAnnotation(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(devices, expected) {
+		t.Errorf("got %+v, want %+v", devices, expected)
+	}
+}
+
+func TestParseNodeDevicesAnnotation_JSON(t *testing.T) {
+	input := `[{"id":"npu-0","count":4,"devmem":65536,"devcore":100,"type":"Ascend910B","numa":0,"health":true}]`
+	expected := []*NodeDevice{
+		{ID: "npu-0", Count: 4, Memory: 65536, Core: 100, Type: "Ascend910B", Health: true},
+	}
+
+	devices, err := ParseNodeDevicesAnnotation(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(devices, expected) {
+		t.Errorf("got %+v, want %+v", devices, expected)
+	}
+}
+
+func TestParseNodeDevicesAnnotation_InvalidFormat(t *testing.T) {
+	for _, input := range []string{"GPU-0,10,15360:", "GPU-0,ten,15360,100,T4,0,true:", "[{"} {
+		if _, err := ParseNodeDevicesAnnotation(input); err == nil {
+			t.Errorf("expected error for %q, got nil", input)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	// overrides are the values of the variables set in the process, e.g. by the configuration
+	// files, they take precedence over the environment.
+	overrides     map[string]string
+	overridesLock sync.RWMutex
+)
+
+// Override replaces the values of the variables set in the process, the variables not in
+// values are read from the environment again.
+func Override(values map[string]string) {
+	copied := make(map[string]string, len(values))
+	for name, value := range values {
+		copied[name] = value
+	}
+
+	overridesLock.Lock()
+	defer overridesLock.Unlock()
+	overrides = copied
+}
+
+func lookupEnv(name string) (string, bool) {
+	overridesLock.RLock()
+	value, ok := overrides[name]
+	overridesLock.RUnlock()
+	if ok {
+		return value, true
+	}
+	return os.LookupEnv(name)
+}
+
+// Values returns the effective values of the registered variables by their names.
+func Values() map[string]string {
+	values := make(map[string]string)
+	for _, v := range VarDescriptions() {
+		value, ok := lookupEnv(v.Name)
+		if !ok {
+			value = v.DefaultValue
+		}
+		values[v.Name] = value
+	}
+	return values
+}
+
+// Validate checks the values are of the registered variables and parsed as their types.
+func Validate(values map[string]string) []error {
+	var errList []error
+	for name, value := range values {
+		v, ok := lookupVar(name)
+		if !ok {
+			errList = append(errList, fmt.Errorf("unknown environment variable %s", name))
+			continue
+		}
+		if err := v.parse(value); err != nil {
+			errList = append(errList, fmt.Errorf("invalid value %q of the environment variable %s: %w", value, name, err))
+		}
+	}
+	return errList
+}
+
+func lookupVar(name string) (Var, bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	v, ok := allVars[name]
+	return v, ok
+}
+
+// parse checks the value is parsed as the type of the variable.
+func (v Var) parse(value string) error {
+	var err error
+	switch v.Type {
+	case BOOL:
+		_, err = strconv.ParseBool(value)
+	case INT:
+		_, err = strconv.Atoi(value)
+	case FLOAT:
+		_, err = strconv.ParseFloat(value, 64)
+	case DURATION:
+		_, err = time.ParseDuration(value)
+	}
+	return err
+}
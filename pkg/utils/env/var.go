@@ -3,7 +3,6 @@ package env
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
 	"strconv"
 	"sync"
@@ -245,7 +244,7 @@ func (v StringVar) Get() string {
 // Otherwise the returned value will be the default and the boolean will
 // be false.
 func (v StringVar) Lookup() (string, bool) {
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -267,7 +266,7 @@ func (v BoolVar) Get() bool {
 // Otherwise the returned value will be the default and the boolean will
 // be false.
 func (v BoolVar) Lookup() (bool, bool) {
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -295,7 +294,7 @@ func (v IntVar) Get() int {
 // Otherwise the returned value will be the default and the boolean will
 // be false.
 func (v IntVar) Lookup() (int, bool) {
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -323,7 +322,7 @@ func (v FloatVar) Get() float64 {
 // Otherwise the returned value will be the default and the boolean will
 // be false.
 func (v FloatVar) Lookup() (float64, bool) {
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -351,7 +350,7 @@ func (v DurationVar) Get() time.Duration {
 // Otherwise the returned value will be the default and the boolean will
 // be false.
 func (v DurationVar) Lookup() (time.Duration, bool) {
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -385,7 +384,7 @@ func (v GenericVar[T]) Lookup() (T, bool) {
 	if v.delegate != nil {
 		return v.delegate.Lookup()
 	}
-	result, ok := os.LookupEnv(v.Name)
+	result, ok := lookupEnv(v.Name)
 	if !ok {
 		result = v.DefaultValue
 	}
@@ -8,5 +8,20 @@ var ErrCurrentNamespaceNotFound = errors.New("current namespace not found")
 // ErrHTTPServerPortAllDisabled is returned when the insecure and secure port can not be disabled at the same time.
 var ErrHTTPServerPortAllDisabled = errors.New("insecure and secure port can not be disabled at the same time")
 
+// ErrTLSCertificateRequired is returned when the secure port is enabled without the tls cert and private key.
+var ErrTLSCertificateRequired = errors.New("tls cert file and private key are required by the secure port")
+
+// ErrClientCAWithoutSecurePort is returned when the client CA file is set but the secure port is disabled.
+var ErrClientCAWithoutSecurePort = errors.New("client CA file requires the secure port")
+
+// ErrNegativeRateLimit is returned when the QPS, burst or concurrency of the rate limits is negative.
+var ErrNegativeRateLimit = errors.New("the QPS, burst and max in-flight requests of the rate limits can not be negative")
+
+// ErrInvalidMethodRateLimit is returned when the rate limit of a method is not in the form of method=qps[:burst].
+var ErrInvalidMethodRateLimit = errors.New("the method rate limit must be in the form of method=qps[:burst] with positive values")
+
+// ErrNegativeShutdownDuration is returned when the shutdown delay or timeout is negative.
+var ErrNegativeShutdownDuration = errors.New("the shutdown delay and timeout can not be negative")
+
 // ErrPrometheusClientUninitialized is returned when the prometheus client is uninitialized.
 var ErrPrometheusClientUninitialized = errors.New("prometheus client uninitialized")
@@ -1,8 +1,14 @@
 package utils
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/util/jsonpath"
 )
 
 type JSONPatchOperation string
@@ -74,3 +80,89 @@ func NodeScheduleConvert(unschedulable bool) ([]byte, error) {
 		Value:     unschedulable,
 	}).ToBytes()
 }
+
+// sortFieldAliases are the sort fields of the list APIs predating the JSONPath expressions.
+var sortFieldAliases = map[string]string{
+	"name":              "{.metadata.name}",
+	"field_name":        "{.metadata.name}",
+	"namespace":         "{.metadata.namespace}",
+	"created_at":        "{.metadata.creationTimestamp}",
+	"createdTime":       "{.metadata.creationTimestamp}",
+	"creationTimestamp": "{.metadata.creationTimestamp}",
+}
+
+// ToJSONPath converts a field to a JSONPath expression. The field is either an expression like
+// {.metadata.name}, or a dotted path like metadata.creation_timestamp whose snake case segments
+// are converted to the lower camel case of the JSON names.
+func ToJSONPath(field string) string {
+	if expression, ok := sortFieldAliases[field]; ok {
+		return expression
+	}
+	if strings.HasPrefix(field, "{") {
+		return field
+	}
+	segments := strings.Split(strings.TrimPrefix(field, "."), ".")
+	for i, segment := range segments {
+		parts := strings.Split(segment, "_")
+		for j := 1; j < len(parts); j++ {
+			if len(parts[j]) > 0 {
+				parts[j] = strings.ToUpper(parts[j][:1]) + parts[j][1:]
+			}
+		}
+		segments[i] = strings.Join(parts, "")
+	}
+	return "{." + strings.Join(segments, ".") + "}"
+}
+
+// ParseJSONPath parses the field converted by ToJSONPath, the missing keys are evaluated to
+// empty rather than errors since the optional fields are omitted from the JSON objects.
+func ParseJSONPath(field string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New(field).AllowMissingKeys(true)
+	if err := jp.Parse(ToJSONPath(field)); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+// JSONPathText evaluates the expression against the JSON object of obj, so the kubernetes
+// objects and the protobuf messages are both addressed by their JSON names.
+func JSONPathText(jp *jsonpath.JSONPath, obj interface{}) (string, error) {
+	object, err := toJSONObject(obj)
+	if err != nil {
+		return "", err
+	}
+	return jsonPathText(jp, object)
+}
+
+func jsonPathText(jp *jsonpath.JSONPath, object interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, object); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// toJSONObject converts obj to the generic JSON value it is marshaled to, the numbers are
+// kept as json.Number.
+func toJSONObject(obj interface{}) (interface{}, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if message, ok := obj.(proto.Message); ok {
+		data, err = protojson.Marshal(message)
+	} else {
+		data, err = json.Marshal(obj)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var object interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
@@ -2,6 +2,16 @@ package utils
 
 import (
 	"math"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
 )
 
 // Define events for execute space objects.
@@ -24,6 +34,13 @@ func WithLabelSelector(labels string) func(*ListOptions) {
 	}
 }
 
+// WithFieldSelector selects the items by their fields on the API server.
+func WithFieldSelector(fields string) func(*ListOptions) {
+	return func(opts *ListOptions) {
+		opts.FieldSelector = fields
+	}
+}
+
 func WithPage(page, pageSize int32) func(*ListOptions) {
 	return func(opts *ListOptions) {
 		opts.Page = page
@@ -38,6 +55,25 @@ func WithSort(sortBy, sortDir string) func(*ListOptions) {
 	}
 }
 
+// WithSortOption sorts by the field of the option, in descending order unless asc is set.
+// Without the field, the items are kept in the order of their namespaces and names.
+func WithSortOption(option *types.SortOption) func(*ListOptions) {
+	return func(opts *ListOptions) {
+		opts.SortBy = option.GetField()
+		opts.SortDir = constants.SortByAsc
+		if opts.SortBy != "" && !option.GetAsc() {
+			opts.SortDir = constants.SortByDesc
+		}
+	}
+}
+
+// WithContinue selects the page after the cursor returned in the pagination of the previous page.
+func WithContinue(token string) func(*ListOptions) {
+	return func(opts *ListOptions) {
+		opts.Continue = token
+	}
+}
+
 func WithFuzzyName(fuzzy string) func(*ListOptions) {
 	return func(opts *ListOptions) {
 		opts.FuzzyName = fuzzy
@@ -69,12 +105,40 @@ type ListOptions struct {
 	Pages          int32
 	Total          int
 	FuzzyName      string // fuzzy search by name
-	SortBy         string // sort
+	SortBy         string // sort field, a JSONPath expression or a dotted path
 	SortDir        string // result order, default asc
 	LabelSelector  string
+	FieldSelector  string
+	Continue       string // cursor of the page, it takes precedence over Page
 	ClusterOptions *ListClusterOptions
 }
 
+// KubeListOptions validates the selectors and returns the list options pushing them down to
+// the API server.
+func (o *ListOptions) KubeListOptions() (metav1.ListOptions, error) {
+	if _, err := labels.Parse(o.LabelSelector); err != nil {
+		return metav1.ListOptions{}, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %v", o.LabelSelector, err)
+	}
+	if _, err := fields.ParseSelector(o.FieldSelector); err != nil {
+		return metav1.ListOptions{}, status.Errorf(codes.InvalidArgument, "invalid field selector %q: %v", o.FieldSelector, err)
+	}
+	return metav1.ListOptions{
+		LabelSelector: o.LabelSelector,
+		FieldSelector: o.FieldSelector,
+	}, nil
+}
+
+// JoinSelectors joins the label or field selectors, the items must match all of them.
+func JoinSelectors(selectors ...string) string {
+	nonEmpty := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		if selector != "" {
+			nonEmpty = append(nonEmpty, selector)
+		}
+	}
+	return strings.Join(nonEmpty, ",")
+}
+
 type ListClusterOptions struct {
 	Type     string
 	State    string
@@ -19,8 +19,8 @@ import (
 
 // ClusterClient stands for a cluster Clientset for the given member cluster.
 type ClusterClient struct {
-	KubeClient      *kubeclientset.Clientset
-	GeneratedClient *generatedclient.Clientset
+	KubeClient      kubeclientset.Interface
+	GeneratedClient generatedclient.Interface
 	ClusterName     string
 }
 
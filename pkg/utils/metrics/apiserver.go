@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grpcRequestsMetricsName               = "apiserver_grpc_requests_total"
+	grpcRequestDurationMetricsName        = "apiserver_grpc_request_duration_seconds"
+	prometheusQueryDurationMetricsName    = "apiserver_prometheus_query_duration_seconds"
+	prometheusQueryFailuresMetricsName    = "apiserver_prometheus_query_failures_total"
+	clusterClientCacheRequestsName        = "cluster_client_cache_requests_total"
+	clusterClientBuildsName               = "cluster_client_builds_total"
+	clusterClientBuildDurationMetricsName = "cluster_client_build_duration_seconds"
+
+	// grpcTypeUnary and grpcTypeStream are the types of the RPCs.
+	grpcTypeUnary  = "unary"
+	grpcTypeStream = "stream"
+
+	// ClientShared is the client of a cluster shared by the requests, ClientImpersonated is
+	// the client impersonating a user.
+	ClientShared       = "shared"
+	ClientImpersonated = "impersonated"
+)
+
+var (
+	// grpcRequests reports the RPCs handled by the apiserver, the errors are the ones with the
+	// codes other than OK.
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: grpcRequestsMetricsName,
+		Help: "Number of the RPCs handled by the apiserver, including the ones forwarded by the grpc-gateway.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"})
+
+	// grpcRequestDuration reports the latency of the RPCs handled by the apiserver.
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    grpcRequestDurationMetricsName,
+		Help:    "Duration in seconds for the apiserver to handle the RPCs, the streams last until they are closed.",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"})
+
+	// prometheusQueryDuration reports the latency of the queries to Prometheus.
+	prometheusQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: prometheusQueryDurationMetricsName,
+		Help: "Duration in seconds for the queries of the apiserver to Prometheus, the type is query or query_range.",
+	}, []string{"type"})
+
+	// prometheusQueryFailures reports the queries to Prometheus failed.
+	prometheusQueryFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: prometheusQueryFailuresMetricsName,
+		Help: "Number of the failed queries of the apiserver to Prometheus by the type of the query and the reason.",
+	}, []string{"type", "reason"})
+
+	// clusterClientCacheRequests reports the lookups of the clients of the given cluster.
+	clusterClientCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterClientCacheRequestsName,
+		Help: "Number of the lookups of the cached clients of the cluster, the client is shared or impersonated, the result is hit or miss.",
+	}, []string{"cluster_name", "client", "result"})
+
+	// clusterClientBuilds reports the clients of the given cluster built.
+	clusterClientBuilds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterClientBuildsName,
+		Help: "Number of the clients of the cluster built, the result is success or failure.",
+	}, []string{"cluster_name", "client", "result"})
+
+	// clusterClientBuildDuration reports the duration of building the clients of the given cluster.
+	clusterClientBuildDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: clusterClientBuildDurationMetricsName,
+		Help: "Duration in seconds for building a client of the cluster, including reading its credential.",
+	}, []string{"cluster_name", "client"})
+)
+
+// UnaryServerInterceptor records the count and the latency of the unary RPCs, it is the
+// outermost interceptor so the RPCs rejected by the others are recorded as well.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		recordGRPCRequest(info.FullMethod, grpcTypeUnary, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the count and the latency of the streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		recordGRPCRequest(info.FullMethod, grpcTypeStream, start, err)
+		return err
+	}
+}
+
+func recordGRPCRequest(fullMethod, grpcType string, start time.Time, err error) {
+	service, method := splitMethodName(fullMethod)
+	code := status.Code(err).String()
+	grpcRequests.WithLabelValues(service, method, grpcType, code).Inc()
+	grpcRequestDuration.WithLabelValues(service, method, grpcType, code).Observe(time.Since(start).Seconds())
+}
+
+// splitMethodName splits /package.Service/Method into the service and the method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// RecordPrometheusQuery records the query of the type to Prometheus, the reason is the
+// cause of the failure and ignored if the query succeeded.
+func RecordPrometheusQuery(queryType string, startTime time.Time, reason string, err error) {
+	prometheusQueryDuration.WithLabelValues(queryType).Observe(time.Since(startTime).Seconds())
+	if err != nil {
+		prometheusQueryFailures.WithLabelValues(queryType, reason).Inc()
+	}
+}
+
+// RecordClusterClientCache records the lookup of the cached client of the cluster.
+func RecordClusterClientCache(cluster, client string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	clusterClientCacheRequests.WithLabelValues(cluster, client, result).Inc()
+}
+
+// RecordClusterClientBuild records the client of the cluster built.
+func RecordClusterClientBuild(cluster, client string, startTime time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	clusterClientBuilds.WithLabelValues(cluster, client, result).Inc()
+	clusterClientBuildDuration.WithLabelValues(cluster, client).Observe(time.Since(startTime).Seconds())
+}
+
+// APIServerCollectors returns the collectors about the RPCs of the apiserver and the calls
+// to its backends, Prometheus and the clusters.
+func APIServerCollectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		grpcRequests,
+		grpcRequestDuration,
+		prometheusQueryDuration,
+		prometheusQueryFailures,
+		clusterClientCacheRequests,
+		clusterClientBuilds,
+		clusterClientBuildDuration,
+	}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/kantaloupe.dynamia.ai.v1.Cluster/GetCluster"}
+
+	for _, err := range []error{nil, nil, status.Error(codes.NotFound, "cluster member1 not found")} {
+		_, _ = interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, err
+		})
+	}
+
+	if got := testutil.ToFloat64(grpcRequests.WithLabelValues("kantaloupe.dynamia.ai.v1.Cluster", "GetCluster", grpcTypeUnary, codes.OK.String())); got != 2 {
+		t.Errorf("requests with code OK = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(grpcRequests.WithLabelValues("kantaloupe.dynamia.ai.v1.Cluster", "GetCluster", grpcTypeUnary, codes.NotFound.String())); got != 1 {
+		t.Errorf("requests with code NotFound = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(grpcRequestDuration, grpcRequestDurationMetricsName); got != 2 {
+		t.Errorf("duration series = %v, want 2", got)
+	}
+}
+
+func TestSplitMethodName(t *testing.T) {
+	tests := []struct {
+		fullMethod  string
+		wantService string
+		wantMethod  string
+	}{
+		{fullMethod: "/kantaloupe.dynamia.ai.v1.Core/ListNodes", wantService: "kantaloupe.dynamia.ai.v1.Core", wantMethod: "ListNodes"},
+		{fullMethod: "ListNodes", wantService: "unknown", wantMethod: "ListNodes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			service, method := splitMethodName(tt.fullMethod)
+			if service != tt.wantService || method != tt.wantMethod {
+				t.Errorf("splitMethodName() = %q, %q, want %q, %q", service, method, tt.wantService, tt.wantMethod)
+			}
+		})
+	}
+}
@@ -16,6 +16,9 @@ import (
 	"github.com/dynamia-ai/kantaloupe/pkg/version"
 )
 
+// Path is the path the metrics are served at.
+const Path = "/metrics"
+
 var (
 	Defaults        DefaultMetrics
 	defaultRegistry compbasemetrics.KubeRegistry
@@ -45,7 +48,7 @@ func (m DefaultMetrics) Install(router *mux.Router) {
 	RawMustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 	RawMustRegister(collectors.NewGoCollector())
 
-	router.Handle("/kapis/metrics", Handler())
+	router.Handle(Path, Handler()).Methods(http.MethodGet)
 }
 
 // Overwrite version.Get.
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	throttledRequestsMetricsName       = "apiserver_throttled_requests_total"
+	clusterInflightRequestsMetricsName = "cluster_api_inflight_requests"
+	clusterThrottledRequestsName       = "cluster_api_throttled_requests_total"
+)
+
+var (
+	// throttledRequests reports the requests rejected by the rate limits of the apiserver.
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: throttledRequestsMetricsName,
+		Help: "Number of the requests rejected by the rate limits of the apiserver, the limit is user or method.",
+	}, []string{"limit", "method"})
+
+	// clusterInflightRequests reports the requests to the given cluster being served.
+	clusterInflightRequests = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: clusterInflightRequestsMetricsName,
+		Help: "Number of the in-flight requests to the cluster limited by the concurrency limit.",
+	}, []string{"cluster_name"})
+
+	// clusterThrottledRequests reports the requests to the given cluster waiting for the concurrency limit.
+	clusterThrottledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: clusterThrottledRequestsName,
+		Help: "Number of the requests to the cluster waiting for the concurrency limit.",
+	}, []string{"cluster_name"})
+)
+
+// RecordThrottledRequest records the request of the method rejected by the limit.
+func RecordThrottledRequest(limit, method string) {
+	throttledRequests.WithLabelValues(limit, method).Inc()
+}
+
+// RecordClusterInflightRequests adds the delta to the in-flight requests of the cluster.
+func RecordClusterInflightRequests(cluster string, delta float64) {
+	clusterInflightRequests.WithLabelValues(cluster).Add(delta)
+}
+
+// RecordClusterThrottledRequest records the request to the cluster waiting for the concurrency limit.
+func RecordClusterThrottledRequest(cluster string) {
+	clusterThrottledRequests.WithLabelValues(cluster).Inc()
+}
+
+// RateLimitCollectors returns the collectors about the rate limits of the apiserver.
+func RateLimitCollectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		throttledRequests,
+		clusterInflightRequests,
+		clusterThrottledRequests,
+	}
+}
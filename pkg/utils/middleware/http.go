@@ -29,6 +29,12 @@ func (r *ResponseWriter) Flush() {
 	r.ResponseWriter.(http.Flusher).Flush()
 }
 
+// Unwrap returns the wrapped writer, so http.ResponseController reaches its optional
+// interfaces, e.g. http.Hijacker for the WebSocket upgrades.
+func (r *ResponseWriter) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func LogRequestAndResponse(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -1,6 +1,29 @@
 package nodeutil
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
+
+// Accelerator types detected from the nodes, they are the vendors of the GPU summaries as well,
+// the values are the same with the cluster types.
+const (
+	AcceleratorTypeNVIDIA = "NVIDIA"
+	AcceleratorTypeMetax  = "METAX"
+	AcceleratorTypeAscend = "ASCEND"
+	AcceleratorTypeNeuron = "NEURON"
+)
+
+var providerLabels = []struct {
+	labelKey string
+	provider string
+}{
+	{labelKey: constants.GCPLabelKey, provider: "GCP_GKE"},
+	{labelKey: constants.AWSLabelKey, provider: "AWS_EKS"},
+}
 
 func IsNodeReady(node *v1.Node) bool {
 	for _, c := range node.Status.Conditions {
@@ -10,3 +33,47 @@ func IsNodeReady(node *v1.Node) bool {
 	}
 	return false
 }
+
+// DetectProvider infers the cloud provider of a cluster from the labels of its nodes,
+// GENERIC is returned if none of the nodes is managed by a known provider.
+func DetectProvider(nodes []*v1.Node) string {
+	for _, node := range nodes {
+		for _, p := range providerLabels {
+			if _, ok := node.Labels[p.labelKey]; ok {
+				return p.provider
+			}
+		}
+	}
+	return constants.DefaultProvider
+}
+
+// DetectAcceleratorTypes returns the types of all accelerator vendors registered on the nodes,
+// a cluster with mixed vendors has more than one type.
+func DetectAcceleratorTypes(nodes []*v1.Node) []string {
+	found := map[string]bool{}
+	for _, node := range nodes {
+		if _, ok := node.Annotations[constants.NvidiaNodeRegisterAnnotationKey]; ok {
+			found[AcceleratorTypeNVIDIA] = true
+		}
+		if _, ok := node.Annotations[constants.MetaxNodeRegisterAnnotationKey]; ok {
+			found[AcceleratorTypeMetax] = true
+		}
+		for key := range node.Annotations {
+			if strings.HasPrefix(key, constants.AscendNodeRegisterAnnotationPrefix) {
+				found[AcceleratorTypeAscend] = true
+				break
+			}
+		}
+		if _, ok := node.Status.Allocatable[constants.AWSNeuron]; ok {
+			found[AcceleratorTypeNeuron] = true
+		}
+	}
+
+	types := []string{}
+	for _, t := range []string{AcceleratorTypeNVIDIA, AcceleratorTypeMetax, AcceleratorTypeAscend, AcceleratorTypeNeuron} {
+		if found[t] {
+			types = append(types, t)
+		}
+	}
+	return types
+}
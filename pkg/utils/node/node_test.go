@@ -0,0 +1,84 @@
+package nodeutil
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
+
+func TestDetectProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodes    []*v1.Node
+		expected string
+	}{
+		{
+			name:     "no nodes",
+			expected: constants.DefaultProvider,
+		},
+		{
+			name: "eks node group",
+			nodes: []*v1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{constants.AWSLabelKey: "gpu"}}},
+			},
+			expected: "AWS_EKS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectProvider(tt.nodes); got != tt.expected {
+				t.Errorf("DetectProvider() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDetectAcceleratorTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodes    []*v1.Node
+		expected []string
+	}{
+		{
+			name:     "cpu only",
+			nodes:    []*v1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "cpu-node"}}},
+			expected: []string{},
+		},
+		{
+			name: "mixed vendors",
+			nodes: []*v1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "neuron-node",
+						Annotations: map[string]string{constants.AscendNodeRegisterAnnotationPrefix + "910B": "[]"},
+					},
+					Status: v1.NodeStatus{
+						Allocatable: v1.ResourceList{constants.AWSNeuron: resource.MustParse("2")},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "nvidia-node",
+						Annotations: map[string]string{constants.NvidiaNodeRegisterAnnotationKey: ""},
+					},
+				},
+			},
+			expected: []string{AcceleratorTypeNVIDIA, AcceleratorTypeAscend, AcceleratorTypeNeuron},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectAcceleratorTypes(tt.nodes); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("DetectAcceleratorTypes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,6 +1,16 @@
 package utils
 
-import "github.com/dynamia-ai/kantaloupe/api/types"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dynamia-ai/kantaloupe/api/types"
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
 
 func NewPage(page, pageSize int32, total int) *types.Pagination {
 	if page < 1 {
@@ -64,3 +74,76 @@ func CalculatePages(pageSize int32, total int) int32 {
 	}
 	return int32(pages)
 }
+
+// continueToken is the cursor of the pages, it is the sort key of the last item of the previous
+// page, so the next page starts right after it however the list changes in the meantime.
+type continueToken struct {
+	SortBy  string  `json:"s,omitempty"`
+	SortDir string  `json:"d,omitempty"`
+	Last    sortKey `json:"l"`
+}
+
+func encodeContinueToken(token continueToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeContinueToken(value string, opts *ListOptions) (*continueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid continue token")
+	}
+	token := &continueToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid continue token")
+	}
+	if token.SortBy != opts.SortBy || token.SortDir != opts.SortDir {
+		return nil, status.Error(codes.InvalidArgument, "the continue token is issued for another sort order")
+	}
+	return token, nil
+}
+
+// PageList sorts the items by the sort field of opts and returns the page selected by the
+// continue cursor, or by the page number without it. The pagination counts all the items and
+// carries the cursor of the next page. The items sharing the sort value are ordered by their
+// namespace and name, so the cursors address a stable position.
+func PageList[T any](items []T, opts *ListOptions) ([]T, *types.Pagination, error) {
+	ascending := opts.SortDir != constants.SortByDesc
+	keys, err := sortByJSONPath(items, opts.SortBy, ascending)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, pageSize := int(opts.Page), int(opts.PageSize)
+	start, end := CalculateIndexByPage(page, pageSize, len(items))
+	if opts.Continue != "" {
+		token, err := decodeContinueToken(opts.Continue, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		start, _ = slices.BinarySearchFunc(keys, token.Last, func(key, last sortKey) int {
+			c := key.compare(last)
+			if !ascending {
+				c = -c
+			}
+			// the keys up to the cursor are on the previous pages.
+			if c <= 0 {
+				return -1
+			}
+			return 1
+		})
+		end = len(items)
+		if pageSize > 0 && start+pageSize < end {
+			end = start + pageSize
+		}
+		if pageSize > 0 {
+			page = start/pageSize + 1
+		}
+	}
+
+	pagination := NewPage(int32(page), opts.PageSize, len(items))
+	if end < len(items) && end > start {
+		pagination.Continue = encodeContinueToken(continueToken{SortBy: opts.SortBy, SortDir: opts.SortDir, Last: keys[end-1]})
+	}
+	return items[start:end], pagination, nil
+}
@@ -1,6 +1,16 @@
 package utils
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/constants"
+)
 
 func TestCalculateIndexByPage(t *testing.T) {
 	type testCase struct {
@@ -33,3 +43,79 @@ func TestCalculateIndexByPage(t *testing.T) {
 		}
 	}
 }
+
+func TestPageList(t *testing.T) {
+	newSecrets := func(names ...string) []*corev1.Secret {
+		secrets := make([]*corev1.Secret, 0, len(names))
+		for _, name := range names {
+			secrets = append(secrets, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+		}
+		return secrets
+	}
+	names := func(secrets []*corev1.Secret) string {
+		var names []string
+		for _, secret := range secrets {
+			names = append(names, secret.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	opts := NewListOption(WithPage(2, 2), WithSort("name", constants.SortByDesc))
+	paged, pagination, err := PageList(newSecrets("a", "b", "c", "d", "e"), opts)
+	if err != nil {
+		t.Fatalf("PageList() error = %v", err)
+	}
+	if names(paged) != "c,b" || pagination.Total != 5 || pagination.Pages != 3 || pagination.Continue == "" {
+		t.Fatalf("PageList() = %s %v, want the second page", names(paged), pagination)
+	}
+
+	// the cursor resumes after the last item however the list changes.
+	opts.Continue = pagination.Continue
+	paged, pagination, err = PageList(newSecrets("a", "aa", "d", "e"), opts)
+	if err != nil {
+		t.Fatalf("PageList() error = %v", err)
+	}
+	if names(paged) != "aa,a" || pagination.Total != 4 || pagination.Continue != "" {
+		t.Errorf("PageList() = %s %v, want the last page after the cursor", names(paged), pagination)
+	}
+
+	// the cursors are bound to the sort order.
+	opts.SortDir = constants.SortByAsc
+	if _, _, err := PageList(newSecrets("a"), opts); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PageList() error = %v, want InvalidArgument for the cursor of another sort order", err)
+	}
+	opts.Continue = "not a token"
+	if _, _, err := PageList(newSecrets("a"), opts); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PageList() error = %v, want InvalidArgument for an invalid cursor", err)
+	}
+
+	// all the items are returned in a page without a limit.
+	opts = NewListOption(WithPage(1, -1))
+	paged, pagination, err = PageList(newSecrets("b", "a"), opts)
+	if err != nil {
+		t.Fatalf("PageList() error = %v", err)
+	}
+	if names(paged) != "a,b" || pagination.PageSize != -1 || pagination.Continue != "" {
+		t.Errorf("PageList() = %s %v, want all the items", names(paged), pagination)
+	}
+}
+
+func TestKubeListOptions(t *testing.T) {
+	opts := NewListOption(WithLabelSelector("app=flow"), WithFieldSelector(JoinSelectors("", "type=Warning", "reason=Failed")))
+	listOptions, err := opts.KubeListOptions()
+	if err != nil {
+		t.Fatalf("KubeListOptions() error = %v", err)
+	}
+	if listOptions.LabelSelector != "app=flow" || listOptions.FieldSelector != "type=Warning,reason=Failed" {
+		t.Errorf("KubeListOptions() = %+v", listOptions)
+	}
+
+	for _, opts := range []*ListOptions{
+		NewListOption(WithLabelSelector("app in flow")),
+		NewListOption(WithFieldSelector("type")),
+	} {
+		if _, err := opts.KubeListOptions(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("KubeListOptions() error = %v, want InvalidArgument", err)
+		}
+	}
+}
@@ -229,3 +229,18 @@ func GetPodContainer(pod *corev1.Pod, containerArg string) int32 {
 	}
 	return result
 }
+
+// defaultContainerAnnotation selects the default container of a pod, it is honored by kubectl as well.
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// DefaultContainerName returns the container of the pod used when none is specified, it is the
+// one of the kubectl.kubernetes.io/default-container annotation or the first container.
+func DefaultContainerName(pod *corev1.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+	return pod.Spec.Containers[0].Name
+}
@@ -1,13 +1,17 @@
 package utils
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
 )
 
 type FieldNameMapper func(apiField string) (goField string, ok bool)
@@ -145,3 +149,128 @@ func CombinedMapper(static map[string]string) FieldNameMapper {
 		return snakeMapper(field)
 	}
 }
+
+// identityJSONPath identifies the items, it orders the items sharing the value of the sort field.
+var identityJSONPath = func() *jsonpath.JSONPath {
+	jp := jsonpath.New("identity").AllowMissingKeys(true)
+	if err := jp.Parse("{.metadata.namespace}/{.metadata.name}"); err != nil {
+		panic(err)
+	}
+	return jp
+}()
+
+// sortKey is the position of an item in a sorted list.
+type sortKey struct {
+	// Value is the value of the sort field.
+	Value string `json:"v,omitempty"`
+	// ID is the namespace and name of the item.
+	ID string `json:"i,omitempty"`
+}
+
+func (k sortKey) compare(other sortKey) int {
+	if c := compareFieldValues(k.Value, other.Value); c != 0 {
+		return c
+	}
+	return strings.Compare(k.ID, other.ID)
+}
+
+// Kinds of the field values, the values of different kinds are ordered by their kinds so the
+// order is total, e.g. with the numbers and the strings mixed in a field.
+const (
+	missingValue = iota
+	quantityValue
+	timestampValue
+	stringValue
+)
+
+// fieldValue is a value of the sort field parsed as its kind.
+type fieldValue struct {
+	kind      int
+	quantity  resource.Quantity
+	timestamp time.Time
+	text      string
+}
+
+func parseFieldValue(s string) fieldValue {
+	if s == "" {
+		return fieldValue{kind: missingValue}
+	}
+	if q, err := resource.ParseQuantity(s); err == nil {
+		return fieldValue{kind: quantityValue, quantity: q}
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return fieldValue{kind: timestampValue, timestamp: t}
+	}
+	return fieldValue{kind: stringValue, text: s}
+}
+
+// compareFieldValues compares the values as quantities or numbers, then as timestamps, and
+// falls back to the strings. The missing values come first, then the quantities, the
+// timestamps and the strings.
+func compareFieldValues(a, b string) int {
+	va, vb := parseFieldValue(a), parseFieldValue(b)
+	if va.kind != vb.kind {
+		return cmp.Compare(va.kind, vb.kind)
+	}
+	switch va.kind {
+	case quantityValue:
+		return va.quantity.Cmp(vb.quantity)
+	case timestampValue:
+		return va.timestamp.Compare(vb.timestamp)
+	case stringValue:
+		return strings.Compare(va.text, vb.text)
+	}
+	return 0
+}
+
+// SortByJSONPath sorts the items by the field converted by ToJSONPath, e.g. metadata.name or
+// {.status.phase}, the items sharing the value are ordered by their namespace and name.
+func SortByJSONPath[T any](items []T, field string, ascending bool) error {
+	_, err := sortByJSONPath(items, field, ascending)
+	return err
+}
+
+// sortByJSONPath sorts the items in place and returns their sort keys in the same order.
+func sortByJSONPath[T any](items []T, field string, ascending bool) ([]sortKey, error) {
+	var jp *jsonpath.JSONPath
+	if field != "" {
+		var err error
+		if jp, err = ParseJSONPath(field); err != nil {
+			return nil, fmt.Errorf("invalid sort field %q: %w", field, err)
+		}
+	}
+
+	keys := make([]sortKey, len(items))
+	for i, item := range items {
+		object, err := toJSONObject(item)
+		if err != nil {
+			return nil, err
+		}
+		if jp != nil {
+			if keys[i].Value, err = jsonPathText(jp, object); err != nil {
+				return nil, fmt.Errorf("invalid sort field %q: %w", field, err)
+			}
+		}
+		if keys[i].ID, err = jsonPathText(identityJSONPath, object); err != nil {
+			return nil, err
+		}
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		if ascending {
+			return keys[a].compare(keys[b])
+		}
+		return keys[b].compare(keys[a])
+	})
+
+	sortedItems, sortedKeys := make([]T, len(items)), make([]sortKey, len(items))
+	for i, j := range order {
+		sortedItems[i], sortedKeys[i] = items[j], keys[j]
+	}
+	copy(items, sortedItems)
+	return sortedKeys, nil
+}
@@ -1,8 +1,14 @@
 package utils
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Profile struct {
@@ -91,3 +97,84 @@ func TestCombinedMapper(t *testing.T) {
 		t.Fatalf("unexpected sort result: %+v", users)
 	}
 }
+
+func TestToJSONPath(t *testing.T) {
+	for field, expect := range map[string]string{
+		"created_at":                   "{.metadata.creationTimestamp}",
+		"name":                         "{.metadata.name}",
+		"metadata.creation_timestamp":  "{.metadata.creationTimestamp}",
+		".status.phase":                "{.status.phase}",
+		"{.spec.hard['limits\\.cpu']}": "{.spec.hard['limits\\.cpu']}",
+	} {
+		if got := ToJSONPath(field); got != expect {
+			t.Errorf("ToJSONPath(%q) = %q, want %q", field, got, expect)
+		}
+	}
+}
+
+func TestSortByJSONPath(t *testing.T) {
+	newNode := func(name, cpu string, created int) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.Unix(int64(created), 0)},
+			Status:     corev1.NodeStatus{Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}},
+		}
+	}
+	nodes := []*corev1.Node{newNode("b", "8", 3), newNode("c", "500m", 1), newNode("a", "16", 2), newNode("d", "8", 4)}
+	names := func() string {
+		var names []string
+		for _, node := range nodes {
+			names = append(names, node.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	tests := []struct {
+		field     string
+		ascending bool
+		expect    string
+	}{
+		{field: "", ascending: true, expect: "a,b,c,d"},
+		{field: "created_at", ascending: false, expect: "d,b,a,c"},
+		{field: "metadata.creation_timestamp", ascending: true, expect: "c,a,b,d"},
+		// quantities are compared by their values, the ties are ordered by name.
+		{field: "{.status.capacity.cpu}", ascending: true, expect: "c,b,d,a"},
+		{field: "status.capacity.cpu", ascending: false, expect: "a,d,b,c"},
+		// missing values sort first.
+		{field: "metadata.labels.zone", ascending: true, expect: "a,b,c,d"},
+	}
+	for _, tt := range tests {
+		if err := SortByJSONPath(nodes, tt.field, tt.ascending); err != nil {
+			t.Fatalf("SortByJSONPath(%q) error = %v", tt.field, err)
+		}
+		if got := names(); got != tt.expect {
+			t.Errorf("SortByJSONPath(%q, %v) = %s, want %s", tt.field, tt.ascending, got, tt.expect)
+		}
+	}
+
+	if err := SortByJSONPath(nodes, "{.metadata.name", true); err == nil {
+		t.Error("SortByJSONPath() with an invalid expression succeeded")
+	}
+}
+
+func TestCompareFieldValues(t *testing.T) {
+	// the numbers, the timestamps and the strings are mixed, e.g. in the labels.
+	values := []string{"1a", "2024-01-02T00:00:00Z", "10", "", "2", "500m", "b", "2024-01-01T00:00:00Z"}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				if compareFieldValues(a, b) <= 0 && compareFieldValues(b, c) <= 0 && compareFieldValues(a, c) > 0 {
+					t.Errorf("compareFieldValues is not transitive for %q <= %q <= %q", a, b, c)
+				}
+			}
+			if compareFieldValues(a, b) != -compareFieldValues(b, a) {
+				t.Errorf("compareFieldValues(%q, %q) is not antisymmetric", a, b)
+			}
+		}
+	}
+
+	slices.SortFunc(values, compareFieldValues)
+	expect := []string{"", "500m", "2", "10", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", "1a", "b"}
+	if !slices.Equal(values, expect) {
+		t.Errorf("sorted values = %q, want %q", values, expect)
+	}
+}
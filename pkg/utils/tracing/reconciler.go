@@ -0,0 +1,25 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Reconciler wraps the reconciler of the controller, every reconcile is traced in a span
+// with the object reconciled, the result and attrs, e.g. the cluster of the controller.
+func Reconciler(controller string, r reconcile.Reconciler, attrs ...attribute.KeyValue) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+		ctx, span := Start(ctx, "Reconcile "+controller, append([]attribute.KeyValue{
+			attribute.String("kantaloupe.controller", controller),
+			attribute.String("k8s.namespace.name", req.Namespace),
+			attribute.String("kantaloupe.object.name", req.Name),
+		}, attrs...)...)
+		defer func() {
+			span.SetAttributes(attribute.String("kantaloupe.reconcile.requeue_after", result.RequeueAfter.String()))
+			End(span, err)
+		}()
+		return r.Reconcile(ctx, req)
+	})
+}
@@ -0,0 +1,156 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/klog/v2"
+
+	"github.com/dynamia-ai/kantaloupe/pkg/version"
+)
+
+const (
+	// instrumentationName is the name of the tracer of kantaloupe.
+	instrumentationName = "github.com/dynamia-ai/kantaloupe"
+
+	// ExporterNone disables the tracing, the spans are not recorded.
+	ExporterNone = "none"
+	// ExporterStdout writes the spans to the stdout as JSON lines, e.g. for local debugging.
+	ExporterStdout = "stdout"
+	// ExporterOTLP exports the spans to an OTLP collector over gRPC.
+	ExporterOTLP = "otlp"
+
+	// ClusterKey is the attribute of the cluster the span works on.
+	ClusterKey = attribute.Key("kantaloupe.cluster")
+)
+
+// Options are the options of the tracing.
+type Options struct {
+	// Exporter is one of none, stdout and otlp.
+	Exporter string
+	// OTLPEndpoint is the host:port of the OTLP collector, the OTEL_EXPORTER_OTLP_ENDPOINT
+	// environment variable or localhost:4317 is used if empty.
+	OTLPEndpoint string
+	// OTLPInsecure disables the TLS of the connection to the OTLP collector.
+	OTLPInsecure bool
+	// SamplingRatio is the ratio of the traces started by kantaloupe to sample, the traces
+	// started by the callers follow their sampling decisions.
+	SamplingRatio float64
+}
+
+// AddFlags adds flags to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Exporter, "tracing-exporter", ExporterNone,
+		"The exporter of the OpenTelemetry traces, one of none, stdout and otlp.")
+	fs.StringVar(&o.OTLPEndpoint, "tracing-otlp-endpoint", "",
+		"The host:port of the OTLP gRPC collector the traces are exported to, "+
+			"it defaults to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable or localhost:4317.")
+	fs.BoolVar(&o.OTLPInsecure, "tracing-otlp-insecure", false,
+		"Connect to the OTLP collector without TLS.")
+	fs.Float64Var(&o.SamplingRatio, "tracing-sampling-ratio", 1,
+		"The ratio of the traces started by the component to sample, between 0 and 1.")
+}
+
+// Validate validates the options.
+func (o *Options) Validate() []error {
+	var errList []error
+
+	switch o.Exporter {
+	case ExporterNone, ExporterStdout, ExporterOTLP:
+	default:
+		errList = append(errList, fmt.Errorf("unsupported --tracing-exporter %q, it must be one of none, stdout and otlp", o.Exporter))
+	}
+	if o.SamplingRatio < 0 || o.SamplingRatio > 1 {
+		errList = append(errList, errors.New("--tracing-sampling-ratio must be between 0 and 1"))
+	}
+
+	return errList
+}
+
+// Setup installs the global tracer provider of the component, the returned function flushes
+// the buffered spans and stops the exporter. The trace context is propagated in the W3C
+// headers even if the tracing is disabled, so the traces of the callers are not broken.
+func Setup(ctx context.Context, opts Options, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		klog.V(4).InfoS("OpenTelemetry error", "err", err)
+	}))
+
+	var exporter sdktrace.SpanExporter
+	switch opts.Exporter {
+	case ExporterStdout:
+		exporter = NewWriterExporter(os.Stdout)
+	case ExporterOTLP:
+		var exporterOpts []otlptracegrpc.Option
+		if opts.OTLPEndpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpoint(opts.OTLPEndpoint))
+		}
+		if opts.OTLPInsecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+		var err error
+		if exporter, err = otlptracegrpc.New(ctx, exporterOpts...); err != nil {
+			return nil, fmt.Errorf("failed to create the OTLP trace exporter: %w", err)
+		}
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName), semconv.ServiceVersion(version.Get().GitVersion)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	klog.InfoS("Tracing is enabled", "exporter", opts.Exporter, "samplingRatio", opts.SamplingRatio)
+	return provider.Shutdown, nil
+}
+
+// Shutdown calls the shutdown function returned by Setup with a timeout.
+func Shutdown(shutdown func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		klog.ErrorS(err, "Failed to flush the traces")
+	}
+}
+
+// Tracer returns the tracer of kantaloupe from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts the span of the operation, it is a child of the span of ctx if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error of the operation on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
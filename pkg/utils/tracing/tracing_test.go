@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{name: "disabled", opts: Options{Exporter: ExporterNone, SamplingRatio: 1}},
+		{name: "otlp", opts: Options{Exporter: ExporterOTLP, OTLPEndpoint: "collector:4317", SamplingRatio: 0.1}},
+		{name: "unknown exporter", opts: Options{Exporter: "jaeger", SamplingRatio: 1}, wantErr: true},
+		{name: "sampling ratio out of range", opts: Options{Exporter: ExporterStdout, SamplingRatio: 2}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := tt.opts.Validate(); (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReconciler(t *testing.T) {
+	var buf bytes.Buffer
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewWriterExporter(&buf)))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	reconcileErr := errors.New("cluster unreachable")
+	r := Reconciler("cluster-controller", reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		_, span := Start(ctx, "Prometheus.Query")
+		End(span, nil)
+		return reconcile.Result{}, reconcileErr
+	}), ClusterKey.String("member1"))
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "member1"}}); !errors.Is(err, reconcileErr) {
+		t.Fatalf("Reconcile() error = %v, want %v", err, reconcileErr)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d spans, want 2: %s", len(lines), buf.String())
+	}
+	var query, reconciled span
+	if err := json.Unmarshal([]byte(lines[0]), &query); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &reconciled); err != nil {
+		t.Fatal(err)
+	}
+	if reconciled.Name != "Reconcile cluster-controller" || !strings.HasPrefix(reconciled.Status, "Error") {
+		t.Errorf("reconcile span = %+v, want the failed reconcile", reconciled)
+	}
+	if reconciled.Attributes["kantaloupe.cluster"] != "member1" || reconciled.Attributes["kantaloupe.object.name"] != "member1" {
+		t.Errorf("reconcile span attributes = %v", reconciled.Attributes)
+	}
+	if query.TraceID != reconciled.TraceID || query.ParentSpanID != reconciled.SpanID {
+		t.Errorf("query span = %+v, want the child of the reconcile span %s", query, reconciled.SpanID)
+	}
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// writerExporter writes the spans to a writer as JSON lines, one line per span.
+type writerExporter struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+var _ sdktrace.SpanExporter = (*writerExporter)(nil)
+
+// NewWriterExporter returns the exporter writing the spans to w as JSON lines.
+func NewWriterExporter(w io.Writer) sdktrace.SpanExporter {
+	return &writerExporter{encoder: json.NewEncoder(w)}
+}
+
+// span is the line written for a span.
+type span struct {
+	TraceID      string                 `json:"traceID"`
+	SpanID       string                 `json:"spanID"`
+	ParentSpanID string                 `json:"parentSpanID,omitempty"`
+	Name         string                 `json:"name"`
+	Kind         string                 `json:"kind"`
+	StartTime    time.Time              `json:"startTime"`
+	Duration     string                 `json:"duration"`
+	Status       string                 `json:"status,omitempty"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *writerExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, s := range spans {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := span{
+			TraceID:   s.SpanContext().TraceID().String(),
+			SpanID:    s.SpanContext().SpanID().String(),
+			Name:      s.Name(),
+			Kind:      s.SpanKind().String(),
+			StartTime: s.StartTime(),
+			Duration:  s.EndTime().Sub(s.StartTime()).String(),
+		}
+		if s.Parent().IsValid() {
+			line.ParentSpanID = s.Parent().SpanID().String()
+		}
+		if s.Status().Code != codes.Unset {
+			line.Status = s.Status().Code.String() + " " + s.Status().Description
+		}
+		if attrs := s.Attributes(); len(attrs) > 0 {
+			line.Attributes = make(map[string]interface{}, len(attrs))
+			for _, attr := range attrs {
+				line.Attributes[string(attr.Key)] = attr.Value.AsInterface()
+			}
+		}
+		if err := e.encoder.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *writerExporter) Shutdown(context.Context) error {
+	return nil
+}